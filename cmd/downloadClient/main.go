@@ -1,11 +1,16 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/spf13/cobra"
 	"goload/internal/configs"
+	"goload/internal/dataaccess/database"
+	"goload/internal/logic"
 	"goload/internal/wiring"
 	"log"
+	"os"
+	"strconv"
 )
 
 var (
@@ -15,6 +20,9 @@ var (
 
 const (
 	flagConfigFilePath = "config-file-path"
+	flagQueueName      = "queue-name"
+	flagOffset         = "offset"
+	flagLimit          = "limit"
 )
 
 func server() *cobra.Command {
@@ -42,12 +50,153 @@ func server() *cobra.Command {
 	return command
 }
 
+func printDeadLetterMessage(message database.DeadLetterMessage) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(map[string]any{
+		"id":               message.ID,
+		"queue_name":       message.QueueName,
+		"payload":          string(message.Payload),
+		"last_error":       message.LastError,
+		"attempt_list":     message.AttemptList.Data,
+		"dead_lettered_at": message.DeadLetteredAt,
+		"replay_count":     message.ReplayCount,
+		"replayed_at":      message.ReplayedAt.Time,
+	})
+}
+
+// withDeadLetterLogic runs fn with a dead letter logic built from the command's config file.
+func withDeadLetterLogic(cmd *cobra.Command, fn func(deadLetterLogic logic.DeadLetter) error) error {
+	configFilePath, err := cmd.Flags().GetString(flagConfigFilePath)
+	if err != nil {
+		return err
+	}
+
+	deadLetterLogic, cleanup, err := wiring.InitializeDeadLetterLogic(configs.ConfigFilePath(configFilePath))
+	if err != nil {
+		return err
+	}
+
+	defer cleanup()
+
+	return fn(deadLetterLogic)
+}
+
+func deadLetterList() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List dead-lettered messages, newest first",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queueName, err := cmd.Flags().GetString(flagQueueName)
+			if err != nil {
+				return err
+			}
+
+			offset, err := cmd.Flags().GetUint64(flagOffset)
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetUint64(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			return withDeadLetterLogic(cmd, func(deadLetterLogic logic.DeadLetter) error {
+				messageList, err := deadLetterLogic.GetDeadLetterMessageList(
+					cmd.Context(),
+					logic.GetDeadLetterMessageListParams{QueueName: queueName, Offset: offset, Limit: limit},
+				)
+				if err != nil {
+					return err
+				}
+
+				for _, message := range messageList {
+					fmt.Printf(
+						"%d\t%s\t%s\treplayed %d time(s)\t%s\n",
+						message.ID, message.QueueName, message.DeadLetteredAt.Format("2006-01-02T15:04:05Z07:00"),
+						message.ReplayCount, message.LastError)
+				}
+
+				return nil
+			})
+		},
+	}
+
+	command.Flags().String(flagQueueName, "", "If provided, only list messages dead-lettered from this queue")
+	command.Flags().Uint64(flagOffset, 0, "Number of messages to skip")
+	command.Flags().Uint64(flagLimit, 100, "Maximum number of messages to list")
+	return command
+}
+
+func deadLetterInspect() *cobra.Command {
+	return &cobra.Command{
+		Use:   "inspect <id>",
+		Short: "Print a dead-lettered message with its payload and attempt history",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid id: %w", err)
+			}
+
+			return withDeadLetterLogic(cmd, func(deadLetterLogic logic.DeadLetter) error {
+				message, err := deadLetterLogic.GetDeadLetterMessage(cmd.Context(), id)
+				if err != nil {
+					return err
+				}
+
+				return printDeadLetterMessage(message)
+			})
+		},
+	}
+}
+
+func deadLetterReplay() *cobra.Command {
+	return &cobra.Command{
+		Use:   "replay <id>",
+		Short: "Publish a dead-lettered message to its original queue again",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid id: %w", err)
+			}
+
+			return withDeadLetterLogic(cmd, func(deadLetterLogic logic.DeadLetter) error {
+				message, err := deadLetterLogic.ReplayDeadLetterMessage(cmd.Context(), id)
+				if err != nil {
+					return err
+				}
+
+				return printDeadLetterMessage(message)
+			})
+		},
+	}
+}
+
+func deadLetter() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "dead-letter",
+		Short: "Manage messages that could not be handled",
+	}
+
+	command.PersistentFlags().String(flagConfigFilePath, "", "If provided, will use the provide config file")
+	command.AddCommand(
+		deadLetterList(),
+		deadLetterInspect(),
+		deadLetterReplay(),
+	)
+	return command
+}
+
 func main() {
 	rootCommand := &cobra.Command{
 		Version: fmt.Sprintf("%s-%s", version, commitHash),
 	}
 	rootCommand.AddCommand(
 		server(),
+		deadLetter(),
 	)
 
 	if err := rootCommand.Execute(); err != nil {
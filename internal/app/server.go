@@ -5,7 +5,9 @@ import (
 	"goload/internal/handler/consumers"
 	"goload/internal/handler/grpc"
 	"goload/internal/handler/http"
-	"goload/internal/utils"
+	"goload/internal/handler/jobs"
+	"os/signal"
+	"sync"
 	"syscall"
 
 	"go.uber.org/zap"
@@ -15,6 +17,7 @@ type Server struct {
 	grpcServer   grpc.Server
 	httpServer   http.Server
 	rootConsumer consumers.Root
+	rootJob      jobs.Root
 	logger       *zap.Logger
 }
 
@@ -22,32 +25,50 @@ func NewServer(
 	grpcServer grpc.Server,
 	httpServer http.Server,
 	rootConsumer consumers.Root,
+	rootJob jobs.Root,
 	logger *zap.Logger,
 ) *Server {
 	return &Server{
 		grpcServer:   grpcServer,
 		httpServer:   httpServer,
 		rootConsumer: rootConsumer,
+		rootJob:      rootJob,
 		logger:       logger,
 	}
 }
 
 func (s Server) Start() error {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
 	go func() {
-		err := s.grpcServer.Start(context.Background())
+		err := s.grpcServer.Start(ctx)
 		s.logger.With(zap.Error(err)).Info("grpc server stopped")
 	}()
 
 	go func() {
-		err := s.httpServer.Start(context.Background())
+		err := s.httpServer.Start(ctx)
 		s.logger.With(zap.Error(err)).Info("http server stopped")
 	}()
 
+	// Consumers and jobs stop on their own once ctx is cancelled, wait for them so in-flight messages are
+	// handled and their offsets committed before exiting.
+	waitGroup := sync.WaitGroup{}
+	waitGroup.Add(2)
+
 	go func() {
-		err := s.rootConsumer.Start(context.Background())
+		defer waitGroup.Done()
+		err := s.rootConsumer.Start(ctx)
 		s.logger.With(zap.Error(err)).Info("message queue consumer stopped")
 	}()
 
-	utils.BlockUntilSignal(syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		defer waitGroup.Done()
+		err := s.rootJob.Start(ctx)
+		s.logger.With(zap.Error(err)).Info("background jobs stopped")
+	}()
+
+	<-ctx.Done()
+	waitGroup.Wait()
 	return nil
 }
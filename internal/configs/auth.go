@@ -2,13 +2,81 @@ package configs
 
 import "time"
 
+type HashAlgorithm string
+
+const (
+	HashAlgorithmBcrypt   HashAlgorithm = "bcrypt"
+	HashAlgorithmArgon2ID HashAlgorithm = "argon2id"
+)
+
+// Argon2ID holds the argon2id parameters, Memory is in KiB.
+type Argon2ID struct {
+	Memory      uint32 `yaml:"memory"`
+	Iterations  uint32 `yaml:"iterations"`
+	Parallelism uint8  `yaml:"parallelism"`
+	SaltLength  uint32 `yaml:"salt_length"`
+	KeyLength   uint32 `yaml:"key_length"`
+}
+
 type Hash struct {
-	HashCost int `yaml:"cost"`
+	// Algorithm is what new hashes are made with, bcrypt if empty. Hashes made with the other algorithm still verify.
+	Algorithm HashAlgorithm `yaml:"algorithm"`
+	// HashCost is the bcrypt cost.
+	HashCost int      `yaml:"cost"`
+	Argon2ID Argon2ID `yaml:"argon2id"`
+}
+
+// PasswordPolicy applies to new passwords, MinLength and MaxLength count characters.
+type PasswordPolicy struct {
+	MinLength        int  `yaml:"min_length"`
+	MaxLength        int  `yaml:"max_length"`
+	RequireLowercase bool `yaml:"require_lowercase"`
+	RequireUppercase bool `yaml:"require_uppercase"`
+	RequireDigit     bool `yaml:"require_digit"`
+	RequireSymbol    bool `yaml:"require_symbol"`
+}
+
+type SigningKeySource string
+
+const (
+	SigningKeySourceDatabase SigningKeySource = "database"
+	SigningKeySourceFile     SigningKeySource = "file"
+	SigningKeySourceConfig   SigningKeySource = "config"
+)
+
+type SigningKey struct {
+	Source SigningKeySource `yaml:"source"`
+	// PrivateKey is a PEM encoded RSA private key, used when Source is config.
+	PrivateKey string `yaml:"private_key"`
+	// PrivateKeyFile is the path of a PEM encoded RSA private key, used when Source is file.
+	PrivateKeyFile string `yaml:"private_key_file"`
+	// EncryptionKey is a base64 encoded 32 bytes key used to encrypt private keys stored in the database.
+	EncryptionKey         string `yaml:"encryption_key"`
+	RotationInterval      string `yaml:"rotation_interval"`
+	RotationOverlap       string `yaml:"rotation_overlap"`
+	RotationCheckInterval string `yaml:"rotation_check_interval"`
+}
+
+func (s SigningKey) GetRotationIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(s.RotationInterval)
+}
+
+func (s SigningKey) GetRotationOverlapDuration() (time.Duration, error) {
+	return time.ParseDuration(s.RotationOverlap)
+}
+
+func (s SigningKey) GetRotationCheckIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(s.RotationCheckInterval)
 }
 
 type Token struct {
 	ExpiresIn                   string `yaml:"expires_in"`
 	RegenerateTokenBeforeExpiry string `yaml:"regenerate_token_before_expiry"`
+	RefreshTokenExpiresIn       string `yaml:"refresh_token_expires_in"`
+	// ActiveSessionCacheTTL is how long a session found active in the database is trusted without checking it again.
+	// It bounds how late a revocation is noticed by the replicas that do not share the cache.
+	ActiveSessionCacheTTL string     `yaml:"active_session_cache_ttl"`
+	SigningKey            SigningKey `yaml:"signing_key"`
 }
 
 func (t Token) GetExpiresInDuration() (time.Duration, error) {
@@ -19,7 +87,81 @@ func (t Token) GetGenerateTokenBeforeExpiryDuration() (time.Duration, error) {
 	return time.ParseDuration(t.RegenerateTokenBeforeExpiry)
 }
 
+func (t Token) GetRefreshTokenExpiresInDuration() (time.Duration, error) {
+	return time.ParseDuration(t.RefreshTokenExpiresIn)
+}
+
+func (t Token) GetActiveSessionCacheTTLDuration() (time.Duration, error) {
+	return time.ParseDuration(t.ActiveSessionCacheTTL)
+}
+
+type OIDC struct {
+	Enabled bool `yaml:"enabled"`
+	// IssuerURL is where the provider metadata is discovered, at <issuer_url>/.well-known/openid-configuration.
+	IssuerURL    string `yaml:"issuer_url"`
+	ClientID     string `yaml:"client_id"`
+	ClientSecret string `yaml:"client_secret"`
+	// RedirectURL must point to /auth/oidc/callback of the HTTP server, and be registered with the provider.
+	RedirectURL string   `yaml:"redirect_url"`
+	ScopeList   []string `yaml:"scope_list"`
+	// GroupsClaim is the ID token claim listing the groups of the user, and GroupRoleMap maps a group to a role.
+	// When GroupRoleMap is not empty the role of an account is synced from its groups on every login.
+	GroupsClaim  string            `yaml:"groups_claim"`
+	GroupRoleMap map[string]string `yaml:"group_role_map"`
+	// LinkByEmail links a new identity to the account named after its verified email, instead of rejecting it.
+	LinkByEmail          bool   `yaml:"link_by_email"`
+	LoginExpiresIn       string `yaml:"login_expires_in"`
+	PostLoginRedirectURL string `yaml:"post_login_redirect_url"`
+}
+
+func (o OIDC) GetLoginExpiresInDuration() (time.Duration, error) {
+	return time.ParseDuration(o.LoginExpiresIn)
+}
+
+// LoginThrottle slows down and locks out password guessing. A limit of 0 disables it.
+type LoginThrottle struct {
+	MaxFailedAttemptCountPerAccount  uint64 `yaml:"max_failed_attempt_count_per_account"`
+	MaxFailedAttemptCountPerClientIP uint64 `yaml:"max_failed_attempt_count_per_client_ip"`
+	// LockoutDuration is how long failed attempts are counted for after the last one, and so how long an account
+	// or client IP over its limit stays locked out.
+	LockoutDuration string `yaml:"lockout_duration"`
+	// A failed attempt is answered after InitialDelay, doubled for every earlier failed attempt up to MaxDelay.
+	InitialDelay string `yaml:"initial_delay"`
+	MaxDelay     string `yaml:"max_delay"`
+}
+
+func (l LoginThrottle) GetLockoutDuration() (time.Duration, error) {
+	return time.ParseDuration(l.LockoutDuration)
+}
+
+func (l LoginThrottle) GetInitialDelayDuration() (time.Duration, error) {
+	return time.ParseDuration(l.InitialDelay)
+}
+
+func (l LoginThrottle) GetMaxDelayDuration() (time.Duration, error) {
+	return time.ParseDuration(l.MaxDelay)
+}
+
+// TwoFactor configures TOTP two-factor authentication. A role in RequiredRoleList only takes effect for an account
+// once it has enabled two-factor authentication, until then the account acts as a user.
+type TwoFactor struct {
+	// Issuer names the service in authenticator apps.
+	Issuer string `yaml:"issuer"`
+	// EncryptionKey is a base64 encoded 32 bytes key used to encrypt TOTP secrets stored in the database.
+	EncryptionKey     string   `yaml:"encryption_key"`
+	RecoveryCodeCount int      `yaml:"recovery_code_count"`
+	RequiredRoleList  []string `yaml:"required_role_list"`
+}
+
 type Auth struct {
-	Hash  Hash
-	Token Token
+	Hash           Hash
+	Token          Token
+	OIDC           OIDC           `yaml:"oidc"`
+	PasswordPolicy PasswordPolicy `yaml:"password_policy"`
+	TwoFactor      TwoFactor      `yaml:"two_factor"`
+	// LoginThrottle applies to CreateSession.
+	LoginThrottle LoginThrottle `yaml:"login_throttle"`
+	// AdminAccountNameList lists the accounts that are made admins when they are created or log in, it is how the
+	// first admin is bootstrapped.
+	AdminAccountNameList []string `yaml:"admin_account_name_list"`
 }
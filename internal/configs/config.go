@@ -10,14 +10,19 @@ import (
 type ConfigFilePath string
 
 type Config struct {
-	GRPC     GRPC           `yaml:"grpc"`
-	HTTP     HTTP           `yaml:"http"`
-	Log      Log            `yaml:"log"`
-	Auth     Auth           `yaml:"auth"`
-	Database Database       `yaml:"database"`
-	Cache    Cache          `yaml:"cache"`
-	MQ       MQ             `yaml:"mq"`
-	Download DownloadConfig `yaml:"download"`
+	GRPC      GRPC           `yaml:"grpc"`
+	HTTP      HTTP           `yaml:"http"`
+	Log       Log            `yaml:"log"`
+	Auth      Auth           `yaml:"auth"`
+	Database  Database       `yaml:"database"`
+	Cache     Cache          `yaml:"cache"`
+	MQ        MQ             `yaml:"mq"`
+	Download  DownloadConfig `yaml:"download"`
+	Retention Retention      `yaml:"retention"`
+	Outbox    Outbox         `yaml:"outbox"`
+	Webhook   Webhook        `yaml:"webhook"`
+	Quota     Quota          `yaml:"quota"`
+	ShareLink ShareLink      `yaml:"share_link"`
 }
 
 func NewConfig(filePath ConfigFilePath) (Config, error) {
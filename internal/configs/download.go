@@ -1,5 +1,7 @@
 package configs
 
+import "time"
+
 type DownloadMode string
 
 const (
@@ -8,6 +10,21 @@ const (
 )
 
 type DownloadConfig struct {
-	Mode        DownloadMode `yaml:"mode"`
-	DownloadDir string       `yaml:"download_dir"`
+	Mode                   DownloadMode `yaml:"mode"`
+	DownloadDir            string       `yaml:"download_dir"`
+	HeartbeatInterval      string       `yaml:"heartbeat_interval"`
+	StaleTaskTimeout       string       `yaml:"stale_task_timeout"`
+	StaleTaskCheckInterval string       `yaml:"stale_task_check_interval"`
+}
+
+func (d DownloadConfig) GetHeartbeatIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(d.HeartbeatInterval)
+}
+
+func (d DownloadConfig) GetStaleTaskTimeoutDuration() (time.Duration, error) {
+	return time.ParseDuration(d.StaleTaskTimeout)
+}
+
+func (d DownloadConfig) GetStaleTaskCheckIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(d.StaleTaskCheckInterval)
 }
@@ -1,6 +1,70 @@
 package configs
 
+import "time"
+
+type MQRetry struct {
+	// MaxAttempts is how many times a message is handled before it is dead-lettered, 0 retries forever.
+	MaxAttempts    uint64 `yaml:"max_attempts"`
+	InitialBackoff string `yaml:"initial_backoff"`
+	MaxBackoff     string `yaml:"max_backoff"`
+}
+
+func (m MQRetry) GetInitialBackoffDuration() (time.Duration, error) {
+	return time.ParseDuration(m.InitialBackoff)
+}
+
+func (m MQRetry) GetMaxBackoffDuration() (time.Duration, error) {
+	return time.ParseDuration(m.MaxBackoff)
+}
+
+type MQRedis struct {
+	// ClaimMinIdleTime is how long a message must have been pending with another consumer, e.g. one that crashed
+	// and never came back, before it is claimed. Messages being handled are kept claimed by their consumer.
+	ClaimMinIdleTime string `yaml:"claim_min_idle_time"`
+	ClaimInterval    string `yaml:"claim_interval"`
+	// MaxLen trims each stream to MaxLen messages when producing, 0 keeps every message. Trimming does not
+	// look at consumer groups, so it must leave room for the messages not acknowledged yet.
+	MaxLen int64 `yaml:"max_len"`
+	// ApproxMaxLen trims with "~", which is much cheaper and may keep a few more messages than MaxLen.
+	ApproxMaxLen bool `yaml:"approx_max_len"`
+}
+
+func (m MQRedis) GetClaimMinIdleTimeDuration() (time.Duration, error) {
+	return time.ParseDuration(m.ClaimMinIdleTime)
+}
+
+func (m MQRedis) GetClaimIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(m.ClaimInterval)
+}
+
+type MQType string
+
+const (
+	MQTypeKafka    MQType = "kafka"
+	MQTypeInMemory MQType = "in_memory"
+	MQTypeRedis    MQType = "redis"
+	MQTypeNATS     MQType = "nats"
+)
+
 type MQ struct {
+	Type MQType `yaml:"type"`
+	// Addresses are the Kafka brokers, the NATS servers, or the Redis server as the only item.
 	Addresses []string `yaml:"addresses"`
-	ClientID  string   `yaml:"client_id"`
+	// Username and Password are used to authenticate with Redis and NATS.
+	Username        string  `yaml:"username"`
+	Password        string  `yaml:"password"`
+	ClientID        string  `yaml:"client_id"`
+	ConsumerGroupID string  `yaml:"consumer_group_id"`
+	Retry           MQRetry `yaml:"retry"`
+	// QueueRetry overrides Retry for the queues it contains.
+	QueueRetry map[string]MQRetry `yaml:"queue_retry"`
+	Redis      MQRedis            `yaml:"redis"`
+}
+
+func (m MQ) GetQueueRetry(queueName string) MQRetry {
+	if queueRetry, ok := m.QueueRetry[queueName]; ok {
+		return queueRetry
+	}
+
+	return m.Retry
 }
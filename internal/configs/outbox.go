@@ -0,0 +1,22 @@
+package configs
+
+import "time"
+
+type Outbox struct {
+	RelayInterval             string `yaml:"relay_interval"`
+	RelayBatchSize            uint64 `yaml:"relay_batch_size"`
+	PruneInterval             string `yaml:"prune_interval"`
+	PublishedMessageRetention string `yaml:"published_message_retention"`
+}
+
+func (o Outbox) GetRelayIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(o.RelayInterval)
+}
+
+func (o Outbox) GetPruneIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(o.PruneInterval)
+}
+
+func (o Outbox) GetPublishedMessageRetentionDuration() (time.Duration, error) {
+	return time.ParseDuration(o.PublishedMessageRetention)
+}
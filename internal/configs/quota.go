@@ -0,0 +1,8 @@
+package configs
+
+// Quota is the default quota of every account, an admin can override it per account. 0 means unlimited.
+type Quota struct {
+	MaxDownloadTaskCount uint64 `yaml:"max_download_task_count"`
+	// MaxActiveDownloadTaskCount limits the tasks of an account that are pending or downloading at the same time.
+	MaxActiveDownloadTaskCount uint64 `yaml:"max_active_download_task_count"`
+}
@@ -0,0 +1,26 @@
+package configs
+
+import "time"
+
+type Retention struct {
+	Interval                string `yaml:"interval"`
+	FileTTL                 string `yaml:"file_ttl"`
+	FailedTaskGracePeriod   string `yaml:"failed_task_grace_period"`
+	OrphanedFileGracePeriod string `yaml:"orphaned_file_grace_period"`
+}
+
+func (r Retention) GetIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(r.Interval)
+}
+
+func (r Retention) GetFileTTLDuration() (time.Duration, error) {
+	return time.ParseDuration(r.FileTTL)
+}
+
+func (r Retention) GetFailedTaskGracePeriodDuration() (time.Duration, error) {
+	return time.ParseDuration(r.FailedTaskGracePeriod)
+}
+
+func (r Retention) GetOrphanedFileGracePeriodDuration() (time.Duration, error) {
+	return time.ParseDuration(r.OrphanedFileGracePeriod)
+}
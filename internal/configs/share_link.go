@@ -0,0 +1,20 @@
+package configs
+
+import "time"
+
+type ShareLink struct {
+	// BaseURL is where the HTTP server is reachable from outside, share link URLs are built on it.
+	BaseURL string `yaml:"base_url"`
+	// SigningKey is a base64 encoded key of at least 32 bytes used to sign share link URLs.
+	SigningKey       string `yaml:"signing_key"`
+	DefaultExpiresIn string `yaml:"default_expires_in"`
+	MaxExpiresIn     string `yaml:"max_expires_in"`
+}
+
+func (s ShareLink) GetDefaultExpiresInDuration() (time.Duration, error) {
+	return time.ParseDuration(s.DefaultExpiresIn)
+}
+
+func (s ShareLink) GetMaxExpiresInDuration() (time.Duration, error) {
+	return time.ParseDuration(s.MaxExpiresIn)
+}
@@ -0,0 +1,31 @@
+package configs
+
+import "time"
+
+type Webhook struct {
+	DeliveryInterval  string `yaml:"delivery_interval"`
+	DeliveryBatchSize uint64 `yaml:"delivery_batch_size"`
+	RequestTimeout    string `yaml:"request_timeout"`
+	// MaxAttempts is the number of deliveries attempted before giving up, 0 means 1.
+	MaxAttempts    uint32 `yaml:"max_attempts"`
+	InitialBackoff string `yaml:"initial_backoff"`
+	MaxBackoff     string `yaml:"max_backoff"`
+	// AllowPrivateNetwork lets deliveries reach loopback, private and link-local addresses, for local development.
+	AllowPrivateNetwork bool `yaml:"allow_private_network"`
+}
+
+func (w Webhook) GetDeliveryIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(w.DeliveryInterval)
+}
+
+func (w Webhook) GetRequestTimeoutDuration() (time.Duration, error) {
+	return time.ParseDuration(w.RequestTimeout)
+}
+
+func (w Webhook) GetInitialBackoffDuration() (time.Duration, error) {
+	return time.ParseDuration(w.InitialBackoff)
+}
+
+func (w Webhook) GetMaxBackoffDuration() (time.Duration, error) {
+	return time.ParseDuration(w.MaxBackoff)
+}
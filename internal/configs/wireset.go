@@ -12,4 +12,9 @@ var WireSet = wire.NewSet(
 	wire.FieldsOf(new(Config), "Cache"),
 	wire.FieldsOf(new(Config), "MQ"),
 	wire.FieldsOf(new(Config), "Download"),
+	wire.FieldsOf(new(Config), "Retention"),
+	wire.FieldsOf(new(Config), "Outbox"),
+	wire.FieldsOf(new(Config), "Webhook"),
+	wire.FieldsOf(new(Config), "Quota"),
+	wire.FieldsOf(new(Config), "ShareLink"),
 )
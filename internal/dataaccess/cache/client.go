@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/go-redis/redis/v8"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"goload/internal/configs"
 	"goload/internal/utils"
@@ -21,7 +22,13 @@ var (
 type Client interface {
 	Set(ctx context.Context, key string, data any, ttl time.Duration) error
 	Get(ctx context.Context, key string) (any, error)
+	// GetAndDelete atomically gets and deletes the data, so it can be read only once.
+	GetAndDelete(ctx context.Context, key string) (any, error)
+	// Increment atomically increments the counter and resets its ttl, a missing counter starts at 0.
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
+	Delete(ctx context.Context, key string) error
 	AddToSet(ctx context.Context, key string, data ...any) error
+	RemoveFromSet(ctx context.Context, key string, data ...any) error
 	IsDataSet(ctx context.Context, key string, data any) (bool, error)
 }
 
@@ -59,6 +66,49 @@ func (c redisClient) Get(ctx context.Context, key string) (any, error) {
 	return data, nil
 }
 
+func (c redisClient) GetAndDelete(ctx context.Context, key string) (any, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key))
+
+	data, err := c.redisClient.GetDel(ctx, key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrCacheMiss
+		}
+
+		logger.With(zap.Error(err)).Error("Failed to get and delete data from cache")
+		return nil, status.Error(codes.Internal, "failed to get and delete data from cache")
+	}
+	return data, nil
+}
+
+func (c redisClient) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key)).With(zap.Duration("ttl", ttl))
+
+	var incrCmd *redis.IntCmd
+	_, err := c.redisClient.TxPipelined(ctx, func(pipeliner redis.Pipeliner) error {
+		incrCmd = pipeliner.Incr(ctx, key)
+		pipeliner.Expire(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("Failed to increment counter inside cache")
+		return 0, status.Error(codes.Internal, "failed to increment counter inside cache")
+	}
+
+	return incrCmd.Val(), nil
+}
+
+func (c redisClient) Delete(ctx context.Context, key string) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key))
+
+	if err := c.redisClient.Del(ctx, key).Err(); err != nil {
+		logger.With(zap.Error(err)).Error("Failed to delete data from cache")
+		return status.Error(codes.Internal, "failed to delete data from cache")
+	}
+
+	return nil
+}
+
 func (c redisClient) AddToSet(ctx context.Context, key string, data ...any) error {
 	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key)).With(zap.Any("data", data))
 
@@ -70,6 +120,17 @@ func (c redisClient) AddToSet(ctx context.Context, key string, data ...any) erro
 	return nil
 }
 
+func (c redisClient) RemoveFromSet(ctx context.Context, key string, data ...any) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key)).With(zap.Any("data", data))
+
+	if err := c.redisClient.SRem(ctx, key, data...).Err(); err != nil {
+		logger.With(zap.Error(err)).Error("Failed to remove data from set inside cache")
+		return status.Error(codes.Internal, "failed to remove data from set inside cache")
+	}
+
+	return nil
+}
+
 func (c redisClient) IsDataSet(ctx context.Context, key string, data any) (bool, error) {
 	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key)).With(zap.Any("data", data))
 
@@ -93,19 +154,54 @@ func NewRedisClient(cacheConfig configs.Cache, logger *zap.Logger) Client {
 	}
 }
 
+type inMemoryCacheEntry struct {
+	data any
+	// expiresAt is zero for data that never expires, like a 0 ttl with Redis.
+	expiresAt time.Time
+}
+
 type inMemoryClient struct {
-	cache      map[string]any
+	cache      map[string]inMemoryCacheEntry
 	cacheMutex *sync.Mutex
 	logger     *zap.Logger
 }
 
+func getInMemoryCacheEntryExpiresAt(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+
+	return time.Now().Add(ttl)
+}
+
+// get must be called with cacheMutex held, it removes the data once it has expired.
+func (i inMemoryClient) get(key string) (any, bool) {
+	entry, ok := i.cache[key]
+	if !ok {
+		return nil, false
+	}
+
+	if !entry.expiresAt.IsZero() && !time.Now().Before(entry.expiresAt) {
+		delete(i.cache, key)
+		return nil, false
+	}
+
+	return entry.data, true
+}
+
 func (i inMemoryClient) Set(ctx context.Context, key string, data any, ttl time.Duration) error {
-	i.cache[key] = data
+	i.cacheMutex.Lock()
+	defer i.cacheMutex.Unlock()
+
+	i.cache[key] = inMemoryCacheEntry{data: data, expiresAt: getInMemoryCacheEntryExpiresAt(ttl)}
 	return nil
 }
 
 func (i inMemoryClient) Get(ctx context.Context, key string) (any, error) {
-	data, ok := i.cache[key]
+	i.cacheMutex.Lock()
+	defer i.cacheMutex.Unlock()
+
+	data, ok := i.get(key)
 	if !ok {
 		return nil, ErrCacheMiss
 	}
@@ -113,12 +209,53 @@ func (i inMemoryClient) Get(ctx context.Context, key string) (any, error) {
 	return data, nil
 }
 
+func (i inMemoryClient) GetAndDelete(ctx context.Context, key string) (any, error) {
+	i.cacheMutex.Lock()
+	defer i.cacheMutex.Unlock()
+
+	data, ok := i.get(key)
+	if !ok {
+		return nil, ErrCacheMiss
+	}
+
+	delete(i.cache, key)
+	return data, nil
+}
+
+func (i inMemoryClient) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	i.cacheMutex.Lock()
+	defer i.cacheMutex.Unlock()
+
+	data, _ := i.get(key)
+	counter, _ := data.(int64)
+	counter++
+	i.cache[key] = inMemoryCacheEntry{data: counter, expiresAt: getInMemoryCacheEntryExpiresAt(ttl)}
+	return counter, nil
+}
+
+func (i inMemoryClient) Delete(ctx context.Context, key string) error {
+	i.cacheMutex.Lock()
+	defer i.cacheMutex.Unlock()
+
+	delete(i.cache, key)
+	return nil
+}
+
 func (i inMemoryClient) AddToSet(ctx context.Context, key string, data ...any) error {
 	i.cacheMutex.Lock()
 	defer i.cacheMutex.Unlock()
 	set := i.getSet(key)
 	set = append(set, data...)
-	i.cache[key] = set
+	i.cache[key] = inMemoryCacheEntry{data: set}
+	return nil
+}
+
+func (i inMemoryClient) RemoveFromSet(ctx context.Context, key string, data ...any) error {
+	i.cacheMutex.Lock()
+	defer i.cacheMutex.Unlock()
+
+	set := i.getSet(key)
+	i.cache[key] = inMemoryCacheEntry{data: lo.Without(set, data...)}
 	return nil
 }
 
@@ -138,7 +275,7 @@ func (i inMemoryClient) IsDataSet(ctx context.Context, key string, data any) (bo
 }
 
 func (i inMemoryClient) getSet(key string) []any {
-	setValue, ok := i.cache[key]
+	setValue, ok := i.get(key)
 	if !ok {
 		return make([]any, 0)
 	}
@@ -153,7 +290,7 @@ func (i inMemoryClient) getSet(key string) []any {
 
 func NewInMemoryClient(logger *zap.Logger) Client {
 	return &inMemoryClient{
-		cache:      make(map[string]any),
+		cache:      make(map[string]inMemoryCacheEntry),
 		cacheMutex: new(sync.Mutex),
 		logger:     logger,
 	}
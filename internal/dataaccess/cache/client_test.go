@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestInMemoryClientTTL(t *testing.T) {
+	ctx := context.Background()
+
+	testCaseList := []struct {
+		name        string
+		ttl         time.Duration
+		wait        time.Duration
+		expectedHit bool
+	}{
+		{name: "kept within its ttl", ttl: time.Minute, expectedHit: true},
+		{name: "expired after its ttl", ttl: 10 * time.Millisecond, wait: 20 * time.Millisecond},
+		{name: "kept without ttl", wait: 20 * time.Millisecond, expectedHit: true},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			client := NewInMemoryClient(zap.NewNop())
+			if err := client.Set(ctx, "set", "value", testCase.ttl); err != nil {
+				t.Fatalf("failed to set: %v", err)
+			}
+
+			if _, err := client.Increment(ctx, "counter", testCase.ttl); err != nil {
+				t.Fatalf("failed to increment: %v", err)
+			}
+
+			time.Sleep(testCase.wait)
+
+			_, err := client.Get(ctx, "set")
+			if hit := err == nil; hit != testCase.expectedHit || (err != nil && !errors.Is(err, ErrCacheMiss)) {
+				t.Fatalf("expected hit %t, got %v", testCase.expectedHit, err)
+			}
+
+			// an expired counter starts over
+			counter, err := client.Increment(ctx, "counter", testCase.ttl)
+			if err != nil {
+				t.Fatalf("failed to increment: %v", err)
+			}
+
+			if expectedCounter := map[bool]int64{true: 2, false: 1}[testCase.expectedHit]; counter != expectedCounter {
+				t.Fatalf("expected counter %d, got %d", expectedCounter, counter)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goload/internal/utils"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// FailedLoginAttempt counts the failed logins of an account name and of a client IP. A counter expires ttl after
+// the last failed login it counted.
+type FailedLoginAttempt interface {
+	GetAccountCount(ctx context.Context, accountName string) (uint64, error)
+	GetClientIPCount(ctx context.Context, clientIP string) (uint64, error)
+	IncrementAccountCount(ctx context.Context, accountName string, ttl time.Duration) (uint64, error)
+	IncrementClientIPCount(ctx context.Context, clientIP string, ttl time.Duration) (uint64, error)
+	ResetAccountCount(ctx context.Context, accountName string) error
+	// WithNamespace returns counters kept apart from those of other namespaces, so that failures of other
+	// passwords cannot lock out a login or the other way around.
+	WithNamespace(namespace string) FailedLoginAttempt
+}
+
+type failedLoginAttempt struct {
+	client    Client
+	namespace string
+	logger    *zap.Logger
+}
+
+func NewFailedLoginAttempt(
+	client Client,
+	logger *zap.Logger,
+) FailedLoginAttempt {
+	return &failedLoginAttempt{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (c failedLoginAttempt) getKeyPrefix() string {
+	if c.namespace == "" {
+		return "failed_login_attempt"
+	}
+
+	return fmt.Sprintf("failed_login_attempt:%s", c.namespace)
+}
+
+func (c failedLoginAttempt) getAccountCacheKey(accountName string) string {
+	return fmt.Sprintf("%s:account:%s", c.getKeyPrefix(), accountName)
+}
+
+func (c failedLoginAttempt) getClientIPCacheKey(clientIP string) string {
+	return fmt.Sprintf("%s:client_ip:%s", c.getKeyPrefix(), clientIP)
+}
+
+func (c failedLoginAttempt) getCount(ctx context.Context, key string) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key))
+
+	cacheEntry, err := c.client.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, ErrCacheMiss) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	switch count := cacheEntry.(type) {
+	case string:
+		return strconv.ParseUint(count, 10, 64)
+	case int64:
+		return uint64(count), nil
+	default:
+		logger.Error("cache entry is not a counter")
+		return 0, errors.New("cache entry is not a counter")
+	}
+}
+
+func (c failedLoginAttempt) increment(ctx context.Context, key string, ttl time.Duration) (uint64, error) {
+	count, err := c.client.Increment(ctx, key, ttl)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(count), nil
+}
+
+func (c failedLoginAttempt) GetAccountCount(ctx context.Context, accountName string) (uint64, error) {
+	return c.getCount(ctx, c.getAccountCacheKey(accountName))
+}
+
+func (c failedLoginAttempt) GetClientIPCount(ctx context.Context, clientIP string) (uint64, error) {
+	return c.getCount(ctx, c.getClientIPCacheKey(clientIP))
+}
+
+func (c failedLoginAttempt) IncrementAccountCount(
+	ctx context.Context,
+	accountName string,
+	ttl time.Duration,
+) (uint64, error) {
+	return c.increment(ctx, c.getAccountCacheKey(accountName), ttl)
+}
+
+func (c failedLoginAttempt) IncrementClientIPCount(
+	ctx context.Context,
+	clientIP string,
+	ttl time.Duration,
+) (uint64, error) {
+	return c.increment(ctx, c.getClientIPCacheKey(clientIP), ttl)
+}
+
+func (c failedLoginAttempt) ResetAccountCount(ctx context.Context, accountName string) error {
+	return c.client.Delete(ctx, c.getAccountCacheKey(accountName))
+}
+
+func (c failedLoginAttempt) WithNamespace(namespace string) FailedLoginAttempt {
+	return &failedLoginAttempt{
+		client:    c.client,
+		namespace: namespace,
+		logger:    c.logger,
+	}
+}
@@ -0,0 +1,101 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestFailedLoginAttemptNamespace(t *testing.T) {
+	ctx := context.Background()
+
+	testCaseList := []struct {
+		name string
+		// resetLogin and resetNamespaced reset the account count of logins and of the namespace
+		resetLogin              bool
+		resetNamespaced         bool
+		expectedLoginCount      uint64
+		expectedNamespacedCount uint64
+	}{
+		{name: "counted apart", expectedLoginCount: 1, expectedNamespacedCount: 2},
+		{
+			name: "resetting the namespace leaves logins alone", resetNamespaced: true,
+			expectedLoginCount: 1, expectedNamespacedCount: 0,
+		},
+		{
+			name: "resetting logins leaves the namespace alone", resetLogin: true,
+			expectedLoginCount: 0, expectedNamespacedCount: 2,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			login := NewFailedLoginAttempt(NewInMemoryClient(zap.NewNop()), zap.NewNop())
+			namespaced := login.WithNamespace("share_link")
+
+			// a share link ID can look like an account name, the namespace keeps their counters apart
+			if _, err := login.IncrementAccountCount(ctx, "1", time.Minute); err != nil {
+				t.Fatalf("failed to increment count: %v", err)
+			}
+
+			if _, err := login.IncrementClientIPCount(ctx, "192.0.2.1", time.Minute); err != nil {
+				t.Fatalf("failed to increment count: %v", err)
+			}
+
+			for i := 0; i < 2; i++ {
+				if _, err := namespaced.IncrementAccountCount(ctx, "1", time.Minute); err != nil {
+					t.Fatalf("failed to increment count: %v", err)
+				}
+			}
+
+			if testCase.resetLogin {
+				if err := login.ResetAccountCount(ctx, "1"); err != nil {
+					t.Fatalf("failed to reset count: %v", err)
+				}
+			}
+
+			if testCase.resetNamespaced {
+				if err := namespaced.ResetAccountCount(ctx, "1"); err != nil {
+					t.Fatalf("failed to reset count: %v", err)
+				}
+			}
+
+			for _, counter := range []struct {
+				name          string
+				getCount      func() (uint64, error)
+				expectedCount uint64
+			}{
+				{
+					name:          "login",
+					getCount:      func() (uint64, error) { return login.GetAccountCount(ctx, "1") },
+					expectedCount: testCase.expectedLoginCount,
+				},
+				{
+					name:          "namespace",
+					getCount:      func() (uint64, error) { return namespaced.GetAccountCount(ctx, "1") },
+					expectedCount: testCase.expectedNamespacedCount,
+				},
+				{
+					name:          "login client ip",
+					getCount:      func() (uint64, error) { return login.GetClientIPCount(ctx, "192.0.2.1") },
+					expectedCount: 1,
+				},
+				{
+					name:     "namespace client ip",
+					getCount: func() (uint64, error) { return namespaced.GetClientIPCount(ctx, "192.0.2.1") },
+				},
+			} {
+				count, err := counter.getCount()
+				if err != nil {
+					t.Fatalf("failed to get %s count: %v", counter.name, err)
+				}
+
+				if count != counter.expectedCount {
+					t.Fatalf("expected %s count %d, got %d", counter.name, counter.expectedCount, count)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"goload/internal/utils"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// OIDCLoginStateData is what the login request needs to remember until the identity provider redirects back.
+type OIDCLoginStateData struct {
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+}
+
+// OIDCLoginState keeps the pending OpenID Connect logins by their state parameter. An entry can only be taken
+// once, so a callback cannot be replayed.
+type OIDCLoginState interface {
+	Add(ctx context.Context, state string, data OIDCLoginStateData, ttl time.Duration) error
+	Take(ctx context.Context, state string) (OIDCLoginStateData, error)
+}
+
+type oidcLoginState struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewOIDCLoginState(
+	client Client,
+	logger *zap.Logger,
+) OIDCLoginState {
+	return &oidcLoginState{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (c oidcLoginState) getOIDCLoginStateCacheKey(state string) string {
+	return fmt.Sprintf("oidc_login_state:%s", state)
+}
+
+func (c oidcLoginState) Add(ctx context.Context, state string, data OIDCLoginStateData, ttl time.Duration) error {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to marshal oidc login state")
+		return err
+	}
+
+	if err = c.client.Set(ctx, c.getOIDCLoginStateCacheKey(state), string(dataBytes), ttl); err != nil {
+		logger.With(zap.Error(err)).Error("failed to set oidc login state into cache")
+		return err
+	}
+
+	return nil
+}
+
+func (c oidcLoginState) Take(ctx context.Context, state string) (OIDCLoginStateData, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+
+	cacheEntry, err := c.client.GetAndDelete(ctx, c.getOIDCLoginStateCacheKey(state))
+	if err != nil {
+		return OIDCLoginStateData{}, err
+	}
+
+	dataString, ok := cacheEntry.(string)
+	if !ok {
+		logger.Error("cache entry is not of type string")
+		return OIDCLoginStateData{}, errors.New("cache entry is not of type string")
+	}
+
+	data := OIDCLoginStateData{}
+	if err = json.Unmarshal([]byte(dataString), &data); err != nil {
+		logger.With(zap.Error(err)).Error("failed to unmarshal oidc login state")
+		return OIDCLoginStateData{}, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goload/internal/utils"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RevokedSession is the revocation list checked for every access token. An entry only needs to live as long as
+// the access tokens issued for the session. It also remembers for a while the sessions found active in the database,
+// which a revocation of the session overrides.
+type RevokedSession interface {
+	Add(ctx context.Context, sessionID uint64, ttl time.Duration) error
+	AddActive(ctx context.Context, sessionID uint64, ttl time.Duration) error
+	// Get returns false for found when the session is neither in the revocation list nor known to be active.
+	Get(ctx context.Context, sessionID uint64) (revoked bool, found bool, err error)
+}
+
+type revokedSession struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewRevokedSession(
+	client Client,
+	logger *zap.Logger,
+) RevokedSession {
+	return &revokedSession{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (c revokedSession) getRevokedSessionCacheKey(sessionID uint64) string {
+	return fmt.Sprintf("revoked_session:%d", sessionID)
+}
+
+func (c revokedSession) getActiveSessionCacheKey(sessionID uint64) string {
+	return fmt.Sprintf("active_session:%d", sessionID)
+}
+
+func (c revokedSession) Add(ctx context.Context, sessionID uint64, ttl time.Duration) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("session_id", sessionID))
+	if err := c.client.Set(ctx, c.getRevokedSessionCacheKey(sessionID), 1, ttl); err != nil {
+		logger.With(zap.Error(err)).Error("failed to add session to revocation list in cache")
+		return err
+	}
+
+	return nil
+}
+
+func (c revokedSession) AddActive(ctx context.Context, sessionID uint64, ttl time.Duration) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("session_id", sessionID))
+	if err := c.client.Set(ctx, c.getActiveSessionCacheKey(sessionID), 1, ttl); err != nil {
+		logger.With(zap.Error(err)).Error("failed to add active session to cache")
+		return err
+	}
+
+	return nil
+}
+
+func (c revokedSession) has(ctx context.Context, key string) (bool, error) {
+	_, err := c.client.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, ErrCacheMiss) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (c revokedSession) Get(ctx context.Context, sessionID uint64) (bool, bool, error) {
+	// the revocation list is read first, an active entry set before a revocation must not hide it
+	revoked, err := c.has(ctx, c.getRevokedSessionCacheKey(sessionID))
+	if err != nil || revoked {
+		return revoked, revoked, err
+	}
+
+	active, err := c.has(ctx, c.getActiveSessionCacheKey(sessionID))
+	if err != nil {
+		return false, false, err
+	}
+
+	return false, active, nil
+}
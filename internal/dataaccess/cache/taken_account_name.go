@@ -14,6 +14,7 @@ const (
 type TakenAccountName interface {
 	Add(ctx context.Context, accountName string) error
 	Has(ctx context.Context, accountName string) (bool, error)
+	Remove(ctx context.Context, accountName string) error
 }
 
 type takenAccountName struct {
@@ -50,3 +51,13 @@ func (c takenAccountName) Has(ctx context.Context, accountName string) (bool, er
 
 	return result, nil
 }
+
+func (c takenAccountName) Remove(ctx context.Context, accountName string) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("account_name", accountName))
+	if err := c.client.RemoveFromSet(ctx, setKeyNameTakenAccountName, accountName); err != nil {
+		logger.With(zap.Error(err)).Error("failed to remove account name from set in cache")
+		return err
+	}
+
+	return nil
+}
@@ -5,11 +5,12 @@ import (
 	"fmt"
 	"go.uber.org/zap"
 	"goload/internal/utils"
+	"time"
 )
 
 type TokenPublicKey interface {
 	Get(ctx context.Context, id uint64) (string, error)
-	Set(ctx context.Context, id uint64, data string) error
+	Set(ctx context.Context, id uint64, data string, ttl time.Duration) error
 }
 
 type tokenPublicKey struct {
@@ -50,7 +51,7 @@ func (c tokenPublicKey) Get(ctx context.Context, id uint64) (string, error) {
 	return publicKey, nil
 }
 
-func (c tokenPublicKey) Set(ctx context.Context, id uint64, data string) error {
+func (c tokenPublicKey) Set(ctx context.Context, id uint64, data string, ttl time.Duration) error {
 	cacheKey := c.getTokenPublicKeyCacheKey(id)
-	return c.client.Set(ctx, cacheKey, data, 0)
+	return c.client.Set(ctx, cacheKey, data, ttl)
 }
@@ -6,4 +6,7 @@ var WireSet = wire.NewSet(
 	NewRedisClient,
 	NewTakenAccountName,
 	NewTokenPublicKey,
+	NewRevokedSession,
+	NewOIDCLoginState,
+	NewFailedLoginAttempt,
 )
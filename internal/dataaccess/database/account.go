@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"database/sql"
 	"github.com/doug-martin/goqu/v9"
 	"go.uber.org/zap"
 	"goload/internal/utils"
@@ -17,17 +18,40 @@ var (
 const (
 	ColNameAccountsID          = "id"
 	ColNameAccountsAccountName = "account_name"
+	ColNameAccountsRole        = "role"
+	ColNameAccountsDisabledAt  = "disabled_at"
+	// ColNameAccountsTwoFactorEnabledAt is set once the account confirmed its TOTP enrolment.
+	ColNameAccountsTwoFactorEnabledAt = "two_factor_enabled_at"
+	// ColNameAccountsQuotaMaxDownloadTaskCount and ColNameAccountsQuotaMaxActiveDownloadTaskCount override the
+	// configured quota of the account when they are not NULL.
+	ColNameAccountsQuotaMaxDownloadTaskCount       = "quota_max_download_task_count"
+	ColNameAccountsQuotaMaxActiveDownloadTaskCount = "quota_max_active_download_task_count"
 )
 
 type Account struct {
-	ID          uint64 `db:"id" goqu:"skipinsert,skipupdate"`
-	AccountName string `db:"account_name"`
+	ID                              uint64        `db:"id" goqu:"skipinsert,skipupdate"`
+	AccountName                     string        `db:"account_name"`
+	FileTTLSeconds                  sql.NullInt64 `db:"file_ttl_seconds"`
+	Role                            string        `db:"role"`
+	DisabledAt                      sql.NullTime  `db:"disabled_at"`
+	QuotaMaxDownloadTaskCount       sql.NullInt64 `db:"quota_max_download_task_count"`
+	QuotaMaxActiveDownloadTaskCount sql.NullInt64 `db:"quota_max_active_download_task_count"`
+	TwoFactorEnabledAt              sql.NullTime  `db:"two_factor_enabled_at"`
 }
 
 type AccountDataAccessor interface {
 	CreateAccount(ctx context.Context, account Account) (uint64, error)
 	GetAccountByID(ctx context.Context, id uint64) (Account, error)
 	GetAccountByAccountName(ctx context.Context, accountName string) (Account, error)
+	GetAccountListByIDList(ctx context.Context, idList []uint64) ([]Account, error)
+	GetAccountByIDWithXLock(ctx context.Context, id uint64) (Account, error)
+	GetAccountList(ctx context.Context, offset, limit uint64) ([]Account, error)
+	GetAccountCount(ctx context.Context) (uint64, error)
+	UpdateAccountRole(ctx context.Context, id uint64, role string) error
+	UpdateAccountDisabledAt(ctx context.Context, id uint64, disabledAt sql.NullTime) error
+	UpdateAccountQuota(ctx context.Context, account Account) error
+	UpdateAccountTwoFactorEnabledAt(ctx context.Context, id uint64, twoFactorEnabledAt sql.NullTime) error
+	DeleteAccount(ctx context.Context, id uint64) error
 	WithDatabase(database Database) AccountDataAccessor
 }
 
@@ -53,6 +77,7 @@ func (a accountDataAccessor) CreateAccount(ctx context.Context, account Account)
 		Insert(TabNameAccounts).
 		Rows(goqu.Record{
 			ColNameAccountsAccountName: account.AccountName,
+			ColNameAccountsRole:        account.Role,
 		}).
 		Executor().
 		ExecContext(ctx)
@@ -70,13 +95,17 @@ func (a accountDataAccessor) CreateAccount(ctx context.Context, account Account)
 	return uint64(lastInsertedID), nil
 }
 
-func (a accountDataAccessor) GetAccountByID(ctx context.Context, id uint64) (Account, error) {
+func (a accountDataAccessor) getAccountByID(ctx context.Context, id uint64, withXLock bool) (Account, error) {
 	logger := utils.LoggerWithContext(ctx, a.logger)
-	account := Account{}
-	found, err := a.database.
+	query := a.database.
 		From(TabNameAccounts).
-		Where(goqu.C(ColNameAccountsID).Eq(id)).
-		ScanStructContext(ctx, &account)
+		Where(goqu.C(ColNameAccountsID).Eq(id))
+	if withXLock {
+		query = query.ForUpdate(goqu.Wait)
+	}
+
+	account := Account{}
+	found, err := query.ScanStructContext(ctx, &account)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to get account by id")
 		return Account{}, status.Error(codes.Internal, "failed to get account by id")
@@ -90,6 +119,14 @@ func (a accountDataAccessor) GetAccountByID(ctx context.Context, id uint64) (Acc
 	return account, nil
 }
 
+func (a accountDataAccessor) GetAccountByID(ctx context.Context, id uint64) (Account, error) {
+	return a.getAccountByID(ctx, id, false)
+}
+
+func (a accountDataAccessor) GetAccountByIDWithXLock(ctx context.Context, id uint64) (Account, error) {
+	return a.getAccountByID(ctx, id, true)
+}
+
 func (a accountDataAccessor) GetAccountByAccountName(ctx context.Context, accountName string) (Account, error) {
 	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("account_name", accountName))
 	account := Account{}
@@ -110,6 +147,111 @@ func (a accountDataAccessor) GetAccountByAccountName(ctx context.Context, accoun
 	return account, nil
 }
 
+func (a accountDataAccessor) GetAccountListByIDList(ctx context.Context, idList []uint64) ([]Account, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64s("id_list", idList))
+	if len(idList) == 0 {
+		return []Account{}, nil
+	}
+
+	accountList := []Account{}
+	err := a.database.
+		From(TabNameAccounts).
+		Where(goqu.C(ColNameAccountsID).In(idList)).
+		ScanStructsContext(ctx, &accountList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account list by id list")
+		return nil, status.Error(codes.Internal, "failed to get account list by id list")
+	}
+
+	return accountList, nil
+}
+
+func (a accountDataAccessor) GetAccountList(ctx context.Context, offset, limit uint64) ([]Account, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	accountList := []Account{}
+	err := a.database.
+		From(TabNameAccounts).
+		Order(goqu.C(ColNameAccountsID).Asc()).
+		Offset(uint(offset)).
+		Limit(uint(limit)).
+		ScanStructsContext(ctx, &accountList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account list")
+		return nil, status.Error(codes.Internal, "failed to get account list")
+	}
+
+	return accountList, nil
+}
+
+func (a accountDataAccessor) GetAccountCount(ctx context.Context) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	count, err := a.database.
+		From(TabNameAccounts).
+		CountContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account count")
+		return 0, status.Error(codes.Internal, "failed to get account count")
+	}
+
+	return uint64(count), nil
+}
+
+func (a accountDataAccessor) updateAccount(ctx context.Context, id uint64, record goqu.Record) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", id))
+
+	if _, err := a.database.
+		Update(TabNameAccounts).
+		Set(record).
+		Where(goqu.C(ColNameAccountsID).Eq(id)).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to update account")
+		return status.Error(codes.Internal, "failed to update account")
+	}
+
+	return nil
+}
+
+func (a accountDataAccessor) UpdateAccountRole(ctx context.Context, id uint64, role string) error {
+	return a.updateAccount(ctx, id, goqu.Record{ColNameAccountsRole: role})
+}
+
+func (a accountDataAccessor) UpdateAccountDisabledAt(ctx context.Context, id uint64, disabledAt sql.NullTime) error {
+	return a.updateAccount(ctx, id, goqu.Record{ColNameAccountsDisabledAt: disabledAt})
+}
+
+func (a accountDataAccessor) UpdateAccountQuota(ctx context.Context, account Account) error {
+	return a.updateAccount(ctx, account.ID, goqu.Record{
+		ColNameAccountsQuotaMaxDownloadTaskCount:       account.QuotaMaxDownloadTaskCount,
+		ColNameAccountsQuotaMaxActiveDownloadTaskCount: account.QuotaMaxActiveDownloadTaskCount,
+	})
+}
+
+func (a accountDataAccessor) UpdateAccountTwoFactorEnabledAt(
+	ctx context.Context,
+	id uint64,
+	twoFactorEnabledAt sql.NullTime,
+) error {
+	return a.updateAccount(ctx, id, goqu.Record{ColNameAccountsTwoFactorEnabledAt: twoFactorEnabledAt})
+}
+
+func (a accountDataAccessor) DeleteAccount(ctx context.Context, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", id))
+
+	if _, err := a.database.
+		Delete(TabNameAccounts).
+		Where(goqu.Ex{ColNameAccountsID: id}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete account")
+		return status.Error(codes.Internal, "failed to delete account")
+	}
+
+	return nil
+}
+
 func (a accountDataAccessor) WithDatabase(database Database) AccountDataAccessor {
 	return &accountDataAccessor{
 		database: database,
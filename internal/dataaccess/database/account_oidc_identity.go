@@ -0,0 +1,161 @@
+package database
+
+import (
+	"context"
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"goload/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var (
+	TabNameAccountOIDCIdentities   = goqu.T("account_oidc_identities")
+	ErrAccountOIDCIdentityNotFound = status.Error(codes.NotFound, "account oidc identity not found")
+)
+
+const (
+	ColNameAccountOIDCIdentityID          = "id"
+	ColNameAccountOIDCIdentityOfAccountID = "of_account_id"
+	ColNameAccountOIDCIdentityIssuer      = "issuer"
+	ColNameAccountOIDCIdentitySubject     = "subject"
+	ColNameAccountOIDCIdentityEmail       = "email"
+	ColNameAccountOIDCIdentityLastLoginAt = "last_login_at"
+)
+
+// AccountOIDCIdentity links an account to the subject of an OpenID Connect identity provider.
+type AccountOIDCIdentity struct {
+	ID          uint64    `db:"id" goqu:"skipinsert,skipupdate"`
+	OfAccountID uint64    `db:"of_account_id"`
+	Issuer      string    `db:"issuer"`
+	Subject     string    `db:"subject"`
+	Email       string    `db:"email"`
+	CreatedAt   time.Time `db:"created_at"`
+	LastLoginAt time.Time `db:"last_login_at"`
+}
+
+type AccountOIDCIdentityDataAccessor interface {
+	CreateAccountOIDCIdentity(ctx context.Context, identity AccountOIDCIdentity) (uint64, error)
+	GetAccountOIDCIdentityByIssuerSubject(ctx context.Context, issuer, subject string) (AccountOIDCIdentity, error)
+	UpdateAccountOIDCIdentityLastLogin(ctx context.Context, id uint64, email string, lastLoginAt time.Time) error
+	DeleteAccountOIDCIdentityListOfAccount(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) AccountOIDCIdentityDataAccessor
+}
+
+type accountOIDCIdentityDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewAccountOIDCIdentityDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) AccountOIDCIdentityDataAccessor {
+	return &accountOIDCIdentityDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (a accountOIDCIdentityDataAccessor) CreateAccountOIDCIdentity(
+	ctx context.Context,
+	identity AccountOIDCIdentity,
+) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("of_account_id", identity.OfAccountID))
+
+	result, err := a.database.
+		Insert(TabNameAccountOIDCIdentities).
+		Rows(identity).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create account oidc identity")
+		return 0, status.Error(codes.Internal, "failed to create account oidc identity")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (a accountOIDCIdentityDataAccessor) GetAccountOIDCIdentityByIssuerSubject(
+	ctx context.Context,
+	issuer, subject string,
+) (AccountOIDCIdentity, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.String("issuer", issuer)).
+		With(zap.String("subject", subject))
+
+	identity := AccountOIDCIdentity{}
+	found, err := a.database.
+		From(TabNameAccountOIDCIdentities).
+		Where(goqu.Ex{
+			ColNameAccountOIDCIdentityIssuer:  issuer,
+			ColNameAccountOIDCIdentitySubject: subject,
+		}).
+		ScanStructContext(ctx, &identity)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account oidc identity by issuer and subject")
+		return AccountOIDCIdentity{}, status.Error(codes.Internal, "failed to get account oidc identity")
+	}
+
+	if !found {
+		return AccountOIDCIdentity{}, ErrAccountOIDCIdentityNotFound
+	}
+
+	return identity, nil
+}
+
+func (a accountOIDCIdentityDataAccessor) UpdateAccountOIDCIdentityLastLogin(
+	ctx context.Context,
+	id uint64,
+	email string,
+	lastLoginAt time.Time,
+) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", id))
+
+	if _, err := a.database.
+		Update(TabNameAccountOIDCIdentities).
+		Set(goqu.Record{
+			ColNameAccountOIDCIdentityEmail:       email,
+			ColNameAccountOIDCIdentityLastLoginAt: lastLoginAt,
+		}).
+		Where(goqu.Ex{ColNameAccountOIDCIdentityID: id}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to update account oidc identity")
+		return status.Error(codes.Internal, "failed to update account oidc identity")
+	}
+
+	return nil
+}
+
+func (a accountOIDCIdentityDataAccessor) DeleteAccountOIDCIdentityListOfAccount(
+	ctx context.Context,
+	accountID uint64,
+) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	if _, err := a.database.
+		Delete(TabNameAccountOIDCIdentities).
+		Where(goqu.Ex{ColNameAccountOIDCIdentityOfAccountID: accountID}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete account oidc identity list of account")
+		return status.Error(codes.Internal, "failed to delete account oidc identity list of account")
+	}
+
+	return nil
+}
+
+func (a accountOIDCIdentityDataAccessor) WithDatabase(database Database) AccountOIDCIdentityDataAccessor {
+	return &accountOIDCIdentityDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
@@ -28,6 +28,7 @@ type AccountPasswordDataAccessor interface {
 	CreateAccountPassword(ctx context.Context, accountPassword AccountPassword) error
 	GetAccountPassword(ctx context.Context, ofAccountID uint64) (AccountPassword, error)
 	UpdateAccountPassword(ctx context.Context, accountPassword AccountPassword) error
+	DeleteAccountPassword(ctx context.Context, ofAccountID uint64) error
 	WithDatabase(database Database) AccountPasswordDataAccessor
 }
 
@@ -103,6 +104,21 @@ func (a accountPasswordDataAccessor) UpdateAccountPassword(ctx context.Context,
 	return nil
 }
 
+func (a accountPasswordDataAccessor) DeleteAccountPassword(ctx context.Context, ofAccountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("of_account_id", ofAccountID))
+
+	if _, err := a.database.
+		Delete(TabNameAccountPasswords).
+		Where(goqu.Ex{ColNameAccountPasswordsOfAccountID: ofAccountID}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete account password")
+		return status.Error(codes.Internal, "failed to delete account password")
+	}
+
+	return nil
+}
+
 func (a accountPasswordDataAccessor) WithDatabase(database Database) AccountPasswordDataAccessor {
 	return &accountPasswordDataAccessor{
 		database: database,
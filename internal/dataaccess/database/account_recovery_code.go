@@ -0,0 +1,134 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"goload/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var (
+	TabNameAccountRecoveryCodes = goqu.T("account_recovery_codes")
+)
+
+const (
+	ColNameAccountRecoveryCodeOfAccountID = "of_account_id"
+	ColNameAccountRecoveryCodeCodeHash    = "code_hash"
+	ColNameAccountRecoveryCodeUsedAt      = "used_at"
+)
+
+// AccountRecoveryCode is a one-time code that stands in for a TOTP code, only the SHA-256 of the code is stored.
+type AccountRecoveryCode struct {
+	ID          uint64       `db:"id" goqu:"skipinsert,skipupdate"`
+	OfAccountID uint64       `db:"of_account_id"`
+	CodeHash    string       `db:"code_hash"`
+	CreatedAt   time.Time    `db:"created_at"`
+	UsedAt      sql.NullTime `db:"used_at"`
+}
+
+type AccountRecoveryCodeDataAccessor interface {
+	CreateAccountRecoveryCodeList(ctx context.Context, recoveryCodeList []AccountRecoveryCode) error
+	// UseAccountRecoveryCode marks the unused code with the hash as used, it returns false if there is none.
+	UseAccountRecoveryCode(ctx context.Context, accountID uint64, codeHash string, usedAt time.Time) (bool, error)
+	DeleteAccountRecoveryCodeListOfAccount(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) AccountRecoveryCodeDataAccessor
+}
+
+type accountRecoveryCodeDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewAccountRecoveryCodeDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) AccountRecoveryCodeDataAccessor {
+	return &accountRecoveryCodeDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (a accountRecoveryCodeDataAccessor) CreateAccountRecoveryCodeList(
+	ctx context.Context,
+	recoveryCodeList []AccountRecoveryCode,
+) error {
+	if len(recoveryCodeList) == 0 {
+		return nil
+	}
+
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("of_account_id", recoveryCodeList[0].OfAccountID))
+
+	if _, err := a.database.
+		Insert(TabNameAccountRecoveryCodes).
+		Rows(recoveryCodeList).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to create account recovery code list")
+		return status.Error(codes.Internal, "failed to create account recovery code list")
+	}
+
+	return nil
+}
+
+func (a accountRecoveryCodeDataAccessor) UseAccountRecoveryCode(
+	ctx context.Context,
+	accountID uint64,
+	codeHash string,
+	usedAt time.Time,
+) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	result, err := a.database.
+		Update(TabNameAccountRecoveryCodes).
+		Set(goqu.Record{ColNameAccountRecoveryCodeUsedAt: usedAt}).
+		Where(
+			goqu.C(ColNameAccountRecoveryCodeOfAccountID).Eq(accountID),
+			goqu.C(ColNameAccountRecoveryCodeCodeHash).Eq(codeHash),
+			goqu.C(ColNameAccountRecoveryCodeUsedAt).IsNull(),
+		).
+		Limit(1).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to use account recovery code")
+		return false, status.Error(codes.Internal, "failed to use account recovery code")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get rows affected")
+		return false, status.Error(codes.Internal, "failed to get rows affected")
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (a accountRecoveryCodeDataAccessor) DeleteAccountRecoveryCodeListOfAccount(
+	ctx context.Context,
+	accountID uint64,
+) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	if _, err := a.database.
+		Delete(TabNameAccountRecoveryCodes).
+		Where(goqu.Ex{ColNameAccountRecoveryCodeOfAccountID: accountID}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete account recovery code list of account")
+		return status.Error(codes.Internal, "failed to delete account recovery code list of account")
+	}
+
+	return nil
+}
+
+func (a accountRecoveryCodeDataAccessor) WithDatabase(database Database) AccountRecoveryCodeDataAccessor {
+	return &accountRecoveryCodeDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
@@ -0,0 +1,131 @@
+package database
+
+import (
+	"context"
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"goload/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var (
+	TabNameAccountTOTPs    = goqu.T("account_totps")
+	ErrAccountTOTPNotFound = status.Error(codes.NotFound, "account totp not found")
+)
+
+const (
+	ColNameAccountTOTPOfAccountID      = "of_account_id"
+	ColNameAccountTOTPLastUsedTimeStep = "last_used_time_step"
+)
+
+// AccountTOTP is the TOTP secret of an account, encrypted. LastUsedTimeStep is the time step of the last accepted
+// code, a code of the same or an earlier time step is not accepted again.
+type AccountTOTP struct {
+	OfAccountID      uint64    `db:"of_account_id"`
+	EncryptedSecret  string    `db:"encrypted_secret"`
+	LastUsedTimeStep uint64    `db:"last_used_time_step"`
+	CreatedAt        time.Time `db:"created_at"`
+}
+
+type AccountTOTPDataAccessor interface {
+	CreateAccountTOTP(ctx context.Context, accountTOTP AccountTOTP) error
+	GetAccountTOTPWithXLock(ctx context.Context, accountID uint64) (AccountTOTP, error)
+	UpdateAccountTOTPLastUsedTimeStep(ctx context.Context, accountID uint64, lastUsedTimeStep uint64) error
+	DeleteAccountTOTP(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) AccountTOTPDataAccessor
+}
+
+type accountTOTPDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewAccountTOTPDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) AccountTOTPDataAccessor {
+	return &accountTOTPDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (a accountTOTPDataAccessor) CreateAccountTOTP(ctx context.Context, accountTOTP AccountTOTP) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("of_account_id", accountTOTP.OfAccountID))
+
+	if _, err := a.database.
+		Insert(TabNameAccountTOTPs).
+		Rows(accountTOTP).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to create account totp")
+		return status.Error(codes.Internal, "failed to create account totp")
+	}
+
+	return nil
+}
+
+func (a accountTOTPDataAccessor) GetAccountTOTPWithXLock(ctx context.Context, accountID uint64) (AccountTOTP, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	accountTOTP := AccountTOTP{}
+	found, err := a.database.
+		From(TabNameAccountTOTPs).
+		Where(goqu.Ex{ColNameAccountTOTPOfAccountID: accountID}).
+		ForUpdate(goqu.Wait).
+		ScanStructContext(ctx, &accountTOTP)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account totp")
+		return AccountTOTP{}, status.Error(codes.Internal, "failed to get account totp")
+	}
+
+	if !found {
+		return AccountTOTP{}, ErrAccountTOTPNotFound
+	}
+
+	return accountTOTP, nil
+}
+
+func (a accountTOTPDataAccessor) UpdateAccountTOTPLastUsedTimeStep(
+	ctx context.Context,
+	accountID uint64,
+	lastUsedTimeStep uint64,
+) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	if _, err := a.database.
+		Update(TabNameAccountTOTPs).
+		Set(goqu.Record{ColNameAccountTOTPLastUsedTimeStep: lastUsedTimeStep}).
+		Where(goqu.Ex{ColNameAccountTOTPOfAccountID: accountID}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to update last used time step of account totp")
+		return status.Error(codes.Internal, "failed to update last used time step of account totp")
+	}
+
+	return nil
+}
+
+func (a accountTOTPDataAccessor) DeleteAccountTOTP(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	if _, err := a.database.
+		Delete(TabNameAccountTOTPs).
+		Where(goqu.Ex{ColNameAccountTOTPOfAccountID: accountID}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete account totp")
+		return status.Error(codes.Internal, "failed to delete account totp")
+	}
+
+	return nil
+}
+
+func (a accountTOTPDataAccessor) WithDatabase(database Database) AccountTOTPDataAccessor {
+	return &accountTOTPDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
@@ -0,0 +1,178 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"goload/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var (
+	TabNameAPIKeys    = goqu.T("api_keys")
+	ErrAPIKeyNotFound = status.Error(codes.NotFound, "api key not found")
+)
+
+const (
+	ColNameAPIKeyID          = "id"
+	ColNameAPIKeyOfAccountID = "of_account_id"
+	ColNameAPIKeyKeyHash     = "key_hash"
+	ColNameAPIKeyLastUsedAt  = "last_used_at"
+	ColNameAPIKeyRevokedAt   = "revoked_at"
+)
+
+type APIKey struct {
+	ID          uint64 `db:"id" goqu:"skipinsert,skipupdate"`
+	OfAccountID uint64 `db:"of_account_id"`
+	Name        string `db:"name"`
+	KeyHash     string `db:"key_hash"`
+	// Scopes is the comma separated list of the scopes granted to the key.
+	Scopes     string       `db:"scopes"`
+	CreatedAt  time.Time    `db:"created_at"`
+	ExpiresAt  sql.NullTime `db:"expires_at"`
+	LastUsedAt sql.NullTime `db:"last_used_at"`
+	RevokedAt  sql.NullTime `db:"revoked_at"`
+}
+
+type APIKeyDataAccessor interface {
+	CreateAPIKey(ctx context.Context, apiKey APIKey) (uint64, error)
+	GetAPIKey(ctx context.Context, id uint64) (APIKey, error)
+	// GetAPIKeyListOfAccount returns the keys of the account that are not revoked.
+	GetAPIKeyListOfAccount(ctx context.Context, accountID uint64) ([]APIKey, error)
+	UpdateAPIKeyKeyHash(ctx context.Context, id uint64, keyHash string) error
+	UpdateAPIKeyLastUsedAt(ctx context.Context, id uint64, lastUsedAt time.Time) error
+	UpdateAPIKeyRevokedAt(ctx context.Context, id uint64, revokedAt time.Time) error
+	DeleteAPIKeyListOfAccount(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) APIKeyDataAccessor
+}
+
+type apiKeyDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewAPIKeyDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) APIKeyDataAccessor {
+	return &apiKeyDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (a apiKeyDataAccessor) CreateAPIKey(ctx context.Context, apiKey APIKey) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("of_account_id", apiKey.OfAccountID))
+
+	result, err := a.database.
+		Insert(TabNameAPIKeys).
+		Rows(apiKey).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create api key")
+		return 0, status.Error(codes.Internal, "failed to create api key")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (a apiKeyDataAccessor) GetAPIKey(ctx context.Context, id uint64) (APIKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", id))
+
+	apiKey := APIKey{}
+	found, err := a.database.
+		From(TabNameAPIKeys).
+		Where(goqu.Ex{ColNameAPIKeyID: id}).
+		ScanStructContext(ctx, &apiKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get api key by id")
+		return APIKey{}, status.Error(codes.Internal, "failed to get api key by id")
+	}
+
+	if !found {
+		logger.Warn("cannot find api key by id")
+		return APIKey{}, ErrAPIKeyNotFound
+	}
+
+	return apiKey, nil
+}
+
+func (a apiKeyDataAccessor) GetAPIKeyListOfAccount(ctx context.Context, accountID uint64) ([]APIKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	apiKeys := []APIKey{}
+	err := a.database.
+		From(TabNameAPIKeys).
+		Where(
+			goqu.C(ColNameAPIKeyOfAccountID).Eq(accountID),
+			goqu.C(ColNameAPIKeyRevokedAt).IsNull(),
+		).
+		Order(goqu.C(ColNameAPIKeyID).Asc()).
+		ScanStructsContext(ctx, &apiKeys)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get api key list of account")
+		return nil, status.Error(codes.Internal, "failed to get api key list of account")
+	}
+
+	return apiKeys, nil
+}
+
+func (a apiKeyDataAccessor) updateAPIKey(ctx context.Context, id uint64, record goqu.Record) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", id))
+
+	if _, err := a.database.
+		Update(TabNameAPIKeys).
+		Set(record).
+		Where(goqu.Ex{ColNameAPIKeyID: id}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to update api key")
+		return status.Error(codes.Internal, "failed to update api key")
+	}
+
+	return nil
+}
+
+func (a apiKeyDataAccessor) UpdateAPIKeyKeyHash(ctx context.Context, id uint64, keyHash string) error {
+	return a.updateAPIKey(ctx, id, goqu.Record{ColNameAPIKeyKeyHash: keyHash})
+}
+
+func (a apiKeyDataAccessor) UpdateAPIKeyLastUsedAt(ctx context.Context, id uint64, lastUsedAt time.Time) error {
+	return a.updateAPIKey(ctx, id, goqu.Record{ColNameAPIKeyLastUsedAt: lastUsedAt})
+}
+
+func (a apiKeyDataAccessor) UpdateAPIKeyRevokedAt(ctx context.Context, id uint64, revokedAt time.Time) error {
+	return a.updateAPIKey(ctx, id, goqu.Record{ColNameAPIKeyRevokedAt: revokedAt})
+}
+
+func (a apiKeyDataAccessor) DeleteAPIKeyListOfAccount(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	if _, err := a.database.
+		Delete(TabNameAPIKeys).
+		Where(goqu.Ex{ColNameAPIKeyOfAccountID: accountID}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete api key list of account")
+		return status.Error(codes.Internal, "failed to delete api key list of account")
+	}
+
+	return nil
+}
+
+func (a apiKeyDataAccessor) WithDatabase(database Database) APIKeyDataAccessor {
+	return &apiKeyDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
@@ -0,0 +1,171 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"goload/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var (
+	TabNameDeadLetterMessages    = goqu.T("dead_letter_messages")
+	ErrDeadLetterMessageNotFound = status.Error(codes.NotFound, "dead letter message not found")
+)
+
+const (
+	ColNameDeadLetterMessageID        = "id"
+	ColNameDeadLetterMessageQueueName = "queue_name"
+)
+
+type DeadLetterMessage struct {
+	ID             uint64       `db:"id" goqu:"skipinsert,skipupdate"`
+	QueueName      string       `db:"queue_name"`
+	Payload        []byte       `db:"payload"`
+	LastError      string       `db:"last_error"`
+	AttemptList    JSON         `db:"attempt_list"`
+	DeadLetteredAt time.Time    `db:"dead_lettered_at"`
+	ReplayCount    uint64       `db:"replay_count"`
+	ReplayedAt     sql.NullTime `db:"replayed_at"`
+}
+
+type DeadLetterMessageDataAccessor interface {
+	CreateDeadLetterMessage(ctx context.Context, message DeadLetterMessage) (uint64, error)
+	// GetDeadLetterMessageList returns messages of all queues when queueName is empty.
+	GetDeadLetterMessageList(ctx context.Context, queueName string, offset, limit uint64) ([]DeadLetterMessage, error)
+	GetDeadLetterMessage(ctx context.Context, id uint64) (DeadLetterMessage, error)
+	GetDeadLetterMessageWithXLock(ctx context.Context, id uint64) (DeadLetterMessage, error)
+	UpdateDeadLetterMessage(ctx context.Context, message DeadLetterMessage) error
+	WithDatabase(database Database) DeadLetterMessageDataAccessor
+}
+
+type deadLetterMessageDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewDeadLetterMessageDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) DeadLetterMessageDataAccessor {
+	return &deadLetterMessageDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (d deadLetterMessageDataAccessor) CreateDeadLetterMessage(
+	ctx context.Context,
+	message DeadLetterMessage,
+) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.String("queue_name", message.QueueName))
+
+	result, err := d.database.
+		Insert(TabNameDeadLetterMessages).
+		Rows(message).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create dead letter message")
+		return 0, status.Error(codes.Internal, "failed to create dead letter message")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (d deadLetterMessageDataAccessor) GetDeadLetterMessageList(
+	ctx context.Context,
+	queueName string,
+	offset, limit uint64,
+) ([]DeadLetterMessage, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.String("queue_name", queueName))
+
+	query := d.database.
+		From(TabNameDeadLetterMessages).
+		Order(goqu.C(ColNameDeadLetterMessageID).Desc()).
+		Offset(uint(offset)).
+		Limit(uint(limit))
+	if queueName != "" {
+		query = query.Where(goqu.Ex{ColNameDeadLetterMessageQueueName: queueName})
+	}
+
+	messages := []DeadLetterMessage{}
+	if err := query.ScanStructsContext(ctx, &messages); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get dead letter message list")
+		return nil, status.Error(codes.Internal, "failed to get dead letter message list")
+	}
+
+	return messages, nil
+}
+
+func (d deadLetterMessageDataAccessor) getDeadLetterMessage(
+	ctx context.Context,
+	id uint64,
+	withXLock bool,
+) (DeadLetterMessage, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
+
+	query := d.database.
+		From(TabNameDeadLetterMessages).
+		Where(goqu.Ex{ColNameDeadLetterMessageID: id})
+	if withXLock {
+		query = query.ForUpdate(goqu.Wait)
+	}
+
+	message := DeadLetterMessage{}
+	found, err := query.ScanStructContext(ctx, &message)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get dead letter message by id")
+		return DeadLetterMessage{}, status.Error(codes.Internal, "failed to get dead letter message by id")
+	}
+
+	if !found {
+		logger.Warn("cannot find dead letter message by id")
+		return DeadLetterMessage{}, ErrDeadLetterMessageNotFound
+	}
+
+	return message, nil
+}
+
+func (d deadLetterMessageDataAccessor) GetDeadLetterMessage(ctx context.Context, id uint64) (DeadLetterMessage, error) {
+	return d.getDeadLetterMessage(ctx, id, false)
+}
+
+func (d deadLetterMessageDataAccessor) GetDeadLetterMessageWithXLock(
+	ctx context.Context,
+	id uint64,
+) (DeadLetterMessage, error) {
+	return d.getDeadLetterMessage(ctx, id, true)
+}
+
+func (d deadLetterMessageDataAccessor) UpdateDeadLetterMessage(ctx context.Context, message DeadLetterMessage) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", message.ID))
+
+	if _, err := d.database.
+		Update(TabNameDeadLetterMessages).
+		Set(message).
+		Where(goqu.Ex{ColNameDeadLetterMessageID: message.ID}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to update dead letter message")
+		return status.Error(codes.Internal, "failed to update dead letter message")
+	}
+
+	return nil
+}
+
+func (d deadLetterMessageDataAccessor) WithDatabase(database Database) DeadLetterMessageDataAccessor {
+	return &deadLetterMessageDataAccessor{
+		database: database,
+		logger:   d.logger,
+	}
+}
@@ -2,12 +2,17 @@ package database
 
 import (
 	"context"
+	"database/sql"
 	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	go_load "goload/internal/generated/downloadClient/v1"
 	"goload/internal/utils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"strings"
+	"time"
 )
 
 var (
@@ -18,29 +23,100 @@ var (
 const (
 	ColNameDownloadTaskId             = "id"
 	ColNameDownloadTaskOfAccountID    = "of_account_id"
+	ColNameDownloadTaskOfWorkspaceID  = "of_workspace_id"
 	ColNameDownloadTaskDownloadType   = "download_type"
 	ColNameDownloadTaskURL            = "url"
 	ColNameDownloadTaskDownloadStatus = "download_status"
 	ColNameDownloadTaskMetadata       = "metadata"
+	ColNameDownloadTaskExpiresAt      = "expires_at"
+	ColNameDownloadTaskUpdatedAt      = "updated_at"
+	ColNameDownloadTaskCreatedAt      = "created_at"
+	ColNameDownloadTaskAttempt        = "attempt"
 )
 
 type DownloadTask struct {
 	ID             uint64                 `db:"id" goqu:"skipinsert,skipupdate"`
 	OfAccountID    uint64                 `db:"of_account_id"`
+	OfWorkspaceID  sql.NullInt64          `db:"of_workspace_id"`
 	DownloadType   go_load.DownloadType   `db:"download_type"`
 	URL            string                 `db:"url"`
 	DownloadStatus go_load.DownloadStatus `db:"download_status"`
 	Metadata       JSON                   `db:"metadata"`
+	ExpiresAt      sql.NullTime           `db:"expires_at"`
+	CreatedAt      time.Time              `db:"created_at"`
+	UpdatedAt      time.Time              `db:"updated_at"`
+	StartedAt      sql.NullTime           `db:"started_at"`
+	FinishedAt     sql.NullTime           `db:"finished_at"`
+	Attempt        uint32                 `db:"attempt"`
+	CallbackURL    string                 `db:"callback_url"`
+	CallbackSecret string                 `db:"callback_secret"`
+}
+
+// DownloadTaskListFilter selects the download tasks of the workspace OfWorkspaceID, whoever created them, or the
+// personal download tasks of OfAccountID when OfWorkspaceID is 0.
+type DownloadTaskListFilter struct {
+	OfAccountID        uint64
+	OfWorkspaceID      uint64
+	DownloadStatusList []go_load.DownloadStatus
+	DownloadType       go_load.DownloadType
+	URLContains        string
+	CreatedAfter       *time.Time
+	CreatedBefore      *time.Time
+	TagList            []string
+	LabelSelector      map[string]string
+}
+
+type DownloadTaskListSortKey int
+
+const (
+	DownloadTaskListSortKeyID DownloadTaskListSortKey = iota
+	DownloadTaskListSortKeyCreatedAt
+)
+
+type DownloadTaskListSort struct {
+	Key        DownloadTaskListSortKey
+	Descending bool
+}
+
+// DownloadTaskListCursor points at the last row of the previous page, rows strictly after it in the
+// requested sort order are returned.
+type DownloadTaskListCursor struct {
+	CreatedAt time.Time
+	ID        uint64
 }
 
 type DownloadTaskDataAccessor interface {
 	CreateDownloadTask(ctx context.Context, task DownloadTask) (uint64, error)
-	GetDownloadTaskListOfAccount(ctx context.Context, accountID, offset, limit uint64) ([]DownloadTask, error)
-	GetDownloadTaskCountOfAccount(ctx context.Context, accountId uint64) (uint64, error)
+	GetDownloadTaskList(
+		ctx context.Context,
+		filter DownloadTaskListFilter,
+		sort DownloadTaskListSort,
+		cursor *DownloadTaskListCursor,
+		offset, limit uint64,
+	) ([]DownloadTask, error)
+	GetDownloadTaskCount(ctx context.Context, filter DownloadTaskListFilter) (uint64, error)
 	GetDownloadTask(ctx context.Context, id uint64) (DownloadTask, error)
 	GetDownloadTaskWithXLock(ctx context.Context, id uint64) (DownloadTask, error)
+	GetDownloadTaskListByIDList(ctx context.Context, idList []uint64) ([]DownloadTask, error)
+	GetDownloadTaskListByIDListWithXLock(ctx context.Context, idList []uint64) ([]DownloadTask, error)
+	GetDownloadTaskListWithStatusUpdatedBefore(
+		ctx context.Context,
+		statusList []go_load.DownloadStatus,
+		updatedBefore time.Time,
+		limit uint64,
+	) ([]DownloadTask, error)
 	UpdateDownloadTask(ctx context.Context, task DownloadTask) error
+	// UpdateDownloadTaskHeartbeat sets updated_at of a task that is still downloading the given attempt, and returns
+	// false when the task has moved on.
+	UpdateDownloadTaskHeartbeat(ctx context.Context, id uint64, attempt uint32, updatedAt time.Time) (bool, error)
+	// UpdateDownloadTaskListOfAccountIDInWorkspace hands the download tasks an account created in a workspace over
+	// to another account.
+	UpdateDownloadTaskListOfAccountIDInWorkspace(
+		ctx context.Context,
+		workspaceID, fromAccountID, toAccountID uint64,
+	) error
 	DeleteDownloadTask(ctx context.Context, id uint64) error
+	DeleteDownloadTaskList(ctx context.Context, idList []uint64) error
 	WithDatabase(database Database) DownloadTaskDataAccessor
 }
 
@@ -60,7 +136,8 @@ func NewDownloadTaskDataAccessor(
 }
 
 func (d downloadTaskDataAccessor) CreateDownloadTask(ctx context.Context, task DownloadTask) (uint64, error) {
-	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("task", task))
+	// the task is not logged whole, its metadata can hold the credentials of its request options
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("of_account_id", task.OfAccountID))
 
 	result, err := d.database.
 		Insert(TabNameDownloadTasks).
@@ -97,34 +174,173 @@ func (d downloadTaskDataAccessor) DeleteDownloadTask(ctx context.Context, id uin
 	return nil
 }
 
-func (d downloadTaskDataAccessor) GetDownloadTaskCountOfAccount(ctx context.Context, userID uint64) (uint64, error) {
-	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("account_id", userID))
+func (d downloadTaskDataAccessor) DeleteDownloadTaskList(ctx context.Context, idList []uint64) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64s("id_list", idList))
+	if len(idList) == 0 {
+		return nil
+	}
+
+	if _, err := d.database.
+		Delete(TabNameDownloadTasks).
+		Where(goqu.C(ColNameDownloadTaskId).In(idList)).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete download task list")
+		return status.Error(codes.Internal, "failed to delete download task list")
+	}
+
+	return nil
+}
+
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+func (d downloadTaskDataAccessor) getDownloadTaskListFilterExpression(filter DownloadTaskListFilter) exp.Expression {
+	expressionList := []exp.Expression{
+		goqu.C(ColNameDownloadTaskOfAccountID).Eq(filter.OfAccountID),
+		goqu.C(ColNameDownloadTaskOfWorkspaceID).IsNull(),
+	}
+	if filter.OfWorkspaceID != 0 {
+		expressionList = []exp.Expression{goqu.C(ColNameDownloadTaskOfWorkspaceID).Eq(filter.OfWorkspaceID)}
+	}
+
+	if len(filter.DownloadStatusList) > 0 {
+		expressionList = append(expressionList, goqu.C(ColNameDownloadTaskDownloadStatus).In(filter.DownloadStatusList))
+	}
+
+	if filter.DownloadType != go_load.DownloadType_DOWNLOAD_TYPE_UNSPECIFIED {
+		expressionList = append(expressionList, goqu.C(ColNameDownloadTaskDownloadType).Eq(filter.DownloadType))
+	}
+
+	if filter.URLContains != "" {
+		expressionList = append(
+			expressionList,
+			goqu.C(ColNameDownloadTaskURL).Like("%"+escapeLikePattern(filter.URLContains)+"%"),
+		)
+	}
+
+	if filter.CreatedAfter != nil {
+		expressionList = append(expressionList, goqu.C(ColNameDownloadTaskCreatedAt).Gte(*filter.CreatedAfter))
+	}
+
+	if filter.CreatedBefore != nil {
+		expressionList = append(expressionList, goqu.C(ColNameDownloadTaskCreatedAt).Lt(*filter.CreatedBefore))
+	}
+
+	for _, tag := range filter.TagList {
+		expressionList = append(expressionList, goqu.C(ColNameDownloadTaskId).In(
+			d.database.
+				From(TabNameDownloadTaskTags).
+				Select(ColNameDownloadTaskTagsOfDownloadTaskID).
+				Where(goqu.Ex{ColNameDownloadTaskTagsTag: tag}),
+		))
+	}
+
+	for labelKey, labelValue := range filter.LabelSelector {
+		expressionList = append(expressionList, goqu.C(ColNameDownloadTaskId).In(
+			d.database.
+				From(TabNameDownloadTaskLabels).
+				Select(ColNameDownloadTaskLabelsOfDownloadTaskID).
+				Where(goqu.Ex{
+					ColNameDownloadTaskLabelsLabelKey:   labelKey,
+					ColNameDownloadTaskLabelsLabelValue: labelValue,
+				}),
+		))
+	}
+
+	return goqu.And(expressionList...)
+}
+
+func (d downloadTaskDataAccessor) getDownloadTaskListCursorExpression(
+	sort DownloadTaskListSort,
+	cursor DownloadTaskListCursor,
+) exp.Expression {
+	idColumn := goqu.C(ColNameDownloadTaskId)
+	if sort.Key == DownloadTaskListSortKeyID {
+		if sort.Descending {
+			return idColumn.Lt(cursor.ID)
+		}
+
+		return idColumn.Gt(cursor.ID)
+	}
+
+	createdAtColumn := goqu.C(ColNameDownloadTaskCreatedAt)
+	if sort.Descending {
+		return goqu.Or(
+			createdAtColumn.Lt(cursor.CreatedAt),
+			goqu.And(createdAtColumn.Eq(cursor.CreatedAt), idColumn.Lt(cursor.ID)),
+		)
+	}
+
+	return goqu.Or(
+		createdAtColumn.Gt(cursor.CreatedAt),
+		goqu.And(createdAtColumn.Eq(cursor.CreatedAt), idColumn.Gt(cursor.ID)),
+	)
+}
+
+func (d downloadTaskDataAccessor) getDownloadTaskListOrderExpressionList(
+	sort DownloadTaskListSort,
+) []exp.OrderedExpression {
+	columnList := []exp.IdentifierExpression{goqu.C(ColNameDownloadTaskId)}
+	if sort.Key == DownloadTaskListSortKeyCreatedAt {
+		columnList = []exp.IdentifierExpression{goqu.C(ColNameDownloadTaskCreatedAt), goqu.C(ColNameDownloadTaskId)}
+	}
+
+	return lo.Map(columnList, func(column exp.IdentifierExpression, _ int) exp.OrderedExpression {
+		if sort.Descending {
+			return column.Desc()
+		}
+
+		return column.Asc()
+	})
+}
+
+func (d downloadTaskDataAccessor) GetDownloadTaskCount(
+	ctx context.Context,
+	filter DownloadTaskListFilter,
+) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("filter", filter))
 
 	count, err := d.database.
 		From(TabNameDownloadTasks).
-		Where(goqu.Ex{ColNameDownloadTaskOfAccountID: userID}).
+		Where(d.getDownloadTaskListFilterExpression(filter)).
 		CountContext(ctx)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get download task count of account")
-		return 0, status.Error(codes.Internal, "failed to get download task count of account")
+		logger.With(zap.Error(err)).Error("failed to get download task count")
+		return 0, status.Error(codes.Internal, "failed to get download task count")
 	}
 
 	return uint64(count), nil
 }
 
-func (d downloadTaskDataAccessor) GetDownloadTaskListOfAccount(ctx context.Context, userID uint64, offset uint64, limit uint64) ([]DownloadTask, error) {
-	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("account_id", userID))
+func (d downloadTaskDataAccessor) GetDownloadTaskList(
+	ctx context.Context,
+	filter DownloadTaskListFilter,
+	sort DownloadTaskListSort,
+	cursor *DownloadTaskListCursor,
+	offset, limit uint64,
+) ([]DownloadTask, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Any("filter", filter)).
+		With(zap.Any("sort", sort)).
+		With(zap.Any("cursor", cursor))
+
+	query := d.database.
+		From(TabNameDownloadTasks).
+		Where(d.getDownloadTaskListFilterExpression(filter)).
+		Order(d.getDownloadTaskListOrderExpressionList(sort)...).
+		Limit(uint(limit))
+	if cursor != nil {
+		query = query.Where(d.getDownloadTaskListCursorExpression(sort, *cursor))
+	} else {
+		query = query.Offset(uint(offset))
+	}
 
 	tasks := []DownloadTask{}
-	err := d.database.
-		From(TabNameDownloadTasks).
-		Where(goqu.Ex{ColNameDownloadTaskOfAccountID: userID}).
-		Offset(uint(offset)).
-		Limit(uint(limit)).
-		ScanStructsContext(ctx, &tasks)
-	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get download task list of account")
-		return nil, status.Error(codes.Internal, "failed to get download task list of account")
+	if err := query.ScanStructsContext(ctx, &tasks); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task list")
+		return nil, status.Error(codes.Internal, "failed to get download task list")
 	}
 
 	return tasks, nil
@@ -146,7 +362,7 @@ func (d downloadTaskDataAccessor) GetDownloadTask(ctx context.Context, id uint64
 
 	if !found {
 		logger.Warn("cannot find download task by id")
-		return DownloadTask{}, status.Error(codes.NotFound, "cannot find download task by id")
+		return DownloadTask{}, ErrDownloadTaskNotFound
 	}
 
 	return task, nil
@@ -175,8 +391,82 @@ func (d downloadTaskDataAccessor) GetDownloadTaskWithXLock(ctx context.Context,
 	return task, nil
 }
 
+func (d downloadTaskDataAccessor) GetDownloadTaskListByIDList(
+	ctx context.Context,
+	idList []uint64,
+) ([]DownloadTask, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64s("id_list", idList))
+	if len(idList) == 0 {
+		return []DownloadTask{}, nil
+	}
+
+	tasks := []DownloadTask{}
+	err := d.database.
+		From(TabNameDownloadTasks).
+		Where(goqu.C(ColNameDownloadTaskId).In(idList)).
+		ScanStructsContext(ctx, &tasks)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task list by id list")
+		return nil, status.Error(codes.Internal, "failed to get download task list by id list")
+	}
+
+	return tasks, nil
+}
+
+func (d downloadTaskDataAccessor) GetDownloadTaskListByIDListWithXLock(
+	ctx context.Context,
+	idList []uint64,
+) ([]DownloadTask, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64s("id_list", idList))
+	if len(idList) == 0 {
+		return []DownloadTask{}, nil
+	}
+
+	tasks := []DownloadTask{}
+	err := d.database.
+		From(TabNameDownloadTasks).
+		Where(goqu.C(ColNameDownloadTaskId).In(idList)).
+		Order(goqu.C(ColNameDownloadTaskId).Asc()).
+		ForUpdate(goqu.Wait).
+		ScanStructsContext(ctx, &tasks)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task list by id list")
+		return nil, status.Error(codes.Internal, "failed to get download task list by id list")
+	}
+
+	return tasks, nil
+}
+
+func (d downloadTaskDataAccessor) GetDownloadTaskListWithStatusUpdatedBefore(
+	ctx context.Context,
+	statusList []go_load.DownloadStatus,
+	updatedBefore time.Time,
+	limit uint64,
+) ([]DownloadTask, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Any("status_list", statusList)).
+		With(zap.Time("updated_before", updatedBefore))
+
+	tasks := []DownloadTask{}
+	err := d.database.
+		From(TabNameDownloadTasks).
+		Where(
+			goqu.C(ColNameDownloadTaskDownloadStatus).In(statusList),
+			goqu.C(ColNameDownloadTaskUpdatedAt).Lt(updatedBefore),
+		).
+		Order(goqu.C(ColNameDownloadTaskId).Asc()).
+		Limit(uint(limit)).
+		ScanStructsContext(ctx, &tasks)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task list with status updated before")
+		return nil, status.Error(codes.Internal, "failed to get download task list with status updated before")
+	}
+
+	return tasks, nil
+}
+
 func (d downloadTaskDataAccessor) UpdateDownloadTask(ctx context.Context, task DownloadTask) error {
-	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("task", task))
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", task.ID))
 
 	if _, err := d.database.
 		Update(TabNameDownloadTasks).
@@ -191,6 +481,63 @@ func (d downloadTaskDataAccessor) UpdateDownloadTask(ctx context.Context, task D
 	return nil
 }
 
+func (d downloadTaskDataAccessor) UpdateDownloadTaskHeartbeat(
+	ctx context.Context,
+	id uint64,
+	attempt uint32,
+	updatedAt time.Time,
+) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id)).With(zap.Uint32("attempt", attempt))
+
+	result, err := d.database.
+		Update(TabNameDownloadTasks).
+		Set(goqu.Record{ColNameDownloadTaskUpdatedAt: updatedAt}).
+		Where(
+			goqu.C(ColNameDownloadTaskId).Eq(id),
+			goqu.C(ColNameDownloadTaskDownloadStatus).Eq(go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING),
+			goqu.C(ColNameDownloadTaskAttempt).Eq(attempt),
+		).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update download task heartbeat")
+		return false, status.Error(codes.Internal, "failed to update download task heartbeat")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get rows affected")
+		return false, status.Error(codes.Internal, "failed to get rows affected")
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (d downloadTaskDataAccessor) UpdateDownloadTaskListOfAccountIDInWorkspace(
+	ctx context.Context,
+	workspaceID, fromAccountID, toAccountID uint64,
+) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Uint64("workspace_id", workspaceID)).
+		With(zap.Uint64("from_account_id", fromAccountID)).
+		With(zap.Uint64("to_account_id", toAccountID))
+
+	if _, err := d.database.
+		Update(TabNameDownloadTasks).
+		Set(goqu.Record{ColNameDownloadTaskOfAccountID: toAccountID}).
+		Where(goqu.Ex{
+			ColNameDownloadTaskOfWorkspaceID: workspaceID,
+			ColNameDownloadTaskOfAccountID:   fromAccountID,
+		}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to update account of download task list in workspace")
+		return status.Error(codes.Internal, "failed to update account of download task list in workspace")
+	}
+
+	return nil
+}
+
 func (d downloadTaskDataAccessor) WithDatabase(database Database) DownloadTaskDataAccessor {
 	return &downloadTaskDataAccessor{
 		database: database,
@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var (
+	TabNameDownloadTaskEvents = goqu.T("download_task_events")
+)
+
+const (
+	ColNameDownloadTaskEventsID               = "id"
+	ColNameDownloadTaskEventsOfDownloadTaskID = "of_download_task_id"
+)
+
+type DownloadTaskEvent struct {
+	ID                 uint64                 `db:"id" goqu:"skipinsert,skipupdate"`
+	OfDownloadTaskID   uint64                 `db:"of_download_task_id"`
+	FromDownloadStatus go_load.DownloadStatus `db:"from_download_status"`
+	ToDownloadStatus   go_load.DownloadStatus `db:"to_download_status"`
+	Actor              string                 `db:"actor"`
+	Reason             string                 `db:"reason"`
+	CreatedAt          time.Time              `db:"created_at"`
+}
+
+type DownloadTaskEventDataAccessor interface {
+	CreateDownloadTaskEvent(ctx context.Context, event DownloadTaskEvent) (uint64, error)
+	GetDownloadTaskEventListOfDownloadTask(ctx context.Context, downloadTaskID uint64) ([]DownloadTaskEvent, error)
+	WithDatabase(database Database) DownloadTaskEventDataAccessor
+}
+
+type downloadTaskEventDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewDownloadTaskEventDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) DownloadTaskEventDataAccessor {
+	return &downloadTaskEventDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (d downloadTaskEventDataAccessor) CreateDownloadTaskEvent(
+	ctx context.Context,
+	event DownloadTaskEvent,
+) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("event", event))
+
+	result, err := d.database.
+		Insert(TabNameDownloadTaskEvents).
+		Rows(event).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create download task event")
+		return 0, status.Error(codes.Internal, "failed to create download task event")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (d downloadTaskEventDataAccessor) GetDownloadTaskEventListOfDownloadTask(
+	ctx context.Context,
+	downloadTaskID uint64,
+) ([]DownloadTaskEvent, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("download_task_id", downloadTaskID))
+
+	eventList := []DownloadTaskEvent{}
+	err := d.database.
+		From(TabNameDownloadTaskEvents).
+		Where(goqu.Ex{ColNameDownloadTaskEventsOfDownloadTaskID: downloadTaskID}).
+		Order(goqu.C(ColNameDownloadTaskEventsID).Asc()).
+		ScanStructsContext(ctx, &eventList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task event list of download task")
+		return nil, status.Error(codes.Internal, "failed to get download task event list of download task")
+	}
+
+	return eventList, nil
+}
+
+func (d downloadTaskEventDataAccessor) WithDatabase(database Database) DownloadTaskEventDataAccessor {
+	return &downloadTaskEventDataAccessor{
+		database: database,
+		logger:   d.logger,
+	}
+}
@@ -0,0 +1,125 @@
+package database
+
+import (
+	"context"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"goload/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameDownloadTaskLabels = goqu.T("download_task_labels")
+)
+
+const (
+	ColNameDownloadTaskLabelsOfDownloadTaskID = "of_download_task_id"
+	ColNameDownloadTaskLabelsLabelKey         = "label_key"
+	ColNameDownloadTaskLabelsLabelValue       = "label_value"
+)
+
+type DownloadTaskLabel struct {
+	OfDownloadTaskID uint64 `db:"of_download_task_id"`
+	LabelKey         string `db:"label_key"`
+	LabelValue       string `db:"label_value"`
+}
+
+type DownloadTaskLabelDataAccessor interface {
+	SetDownloadTaskLabelMap(ctx context.Context, downloadTaskID uint64, labelMap map[string]string) error
+	GetDownloadTaskLabelMapOfDownloadTaskList(
+		ctx context.Context,
+		downloadTaskIDList []uint64,
+	) (map[uint64]map[string]string, error)
+	WithDatabase(database Database) DownloadTaskLabelDataAccessor
+}
+
+type downloadTaskLabelDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewDownloadTaskLabelDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) DownloadTaskLabelDataAccessor {
+	return &downloadTaskLabelDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (d downloadTaskLabelDataAccessor) SetDownloadTaskLabelMap(
+	ctx context.Context,
+	downloadTaskID uint64,
+	labelMap map[string]string,
+) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Uint64("download_task_id", downloadTaskID)).
+		With(zap.Any("label_map", labelMap))
+
+	if _, err := d.database.
+		Delete(TabNameDownloadTaskLabels).
+		Where(goqu.Ex{ColNameDownloadTaskLabelsOfDownloadTaskID: downloadTaskID}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete download task label map")
+		return status.Error(codes.Internal, "failed to delete download task label map")
+	}
+
+	if len(labelMap) == 0 {
+		return nil
+	}
+
+	if _, err := d.database.
+		Insert(TabNameDownloadTaskLabels).
+		Rows(lo.MapToSlice(labelMap, func(key string, value string) DownloadTaskLabel {
+			return DownloadTaskLabel{OfDownloadTaskID: downloadTaskID, LabelKey: key, LabelValue: value}
+		})).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to create download task label map")
+		return status.Error(codes.Internal, "failed to create download task label map")
+	}
+
+	return nil
+}
+
+func (d downloadTaskLabelDataAccessor) GetDownloadTaskLabelMapOfDownloadTaskList(
+	ctx context.Context,
+	downloadTaskIDList []uint64,
+) (map[uint64]map[string]string, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64s("download_task_id_list", downloadTaskIDList))
+	if len(downloadTaskIDList) == 0 {
+		return map[uint64]map[string]string{}, nil
+	}
+
+	labelList := []DownloadTaskLabel{}
+	err := d.database.
+		From(TabNameDownloadTaskLabels).
+		Where(goqu.C(ColNameDownloadTaskLabelsOfDownloadTaskID).In(downloadTaskIDList)).
+		ScanStructsContext(ctx, &labelList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task label map")
+		return nil, status.Error(codes.Internal, "failed to get download task label map")
+	}
+
+	downloadTaskIDToLabelMap := make(map[uint64]map[string]string)
+	for _, label := range labelList {
+		if _, ok := downloadTaskIDToLabelMap[label.OfDownloadTaskID]; !ok {
+			downloadTaskIDToLabelMap[label.OfDownloadTaskID] = make(map[string]string)
+		}
+
+		downloadTaskIDToLabelMap[label.OfDownloadTaskID][label.LabelKey] = label.LabelValue
+	}
+
+	return downloadTaskIDToLabelMap, nil
+}
+
+func (d downloadTaskLabelDataAccessor) WithDatabase(database Database) DownloadTaskLabelDataAccessor {
+	return &downloadTaskLabelDataAccessor{
+		database: database,
+		logger:   d.logger,
+	}
+}
@@ -0,0 +1,120 @@
+package database
+
+import (
+	"context"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"goload/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TabNameDownloadTaskTags = goqu.T("download_task_tags")
+)
+
+const (
+	ColNameDownloadTaskTagsOfDownloadTaskID = "of_download_task_id"
+	ColNameDownloadTaskTagsTag              = "tag"
+)
+
+type DownloadTaskTag struct {
+	OfDownloadTaskID uint64 `db:"of_download_task_id"`
+	Tag              string `db:"tag"`
+}
+
+type DownloadTaskTagDataAccessor interface {
+	SetDownloadTaskTagList(ctx context.Context, downloadTaskID uint64, tagList []string) error
+	GetDownloadTaskTagListOfDownloadTaskList(
+		ctx context.Context,
+		downloadTaskIDList []uint64,
+	) (map[uint64][]string, error)
+	WithDatabase(database Database) DownloadTaskTagDataAccessor
+}
+
+type downloadTaskTagDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewDownloadTaskTagDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) DownloadTaskTagDataAccessor {
+	return &downloadTaskTagDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (d downloadTaskTagDataAccessor) SetDownloadTaskTagList(
+	ctx context.Context,
+	downloadTaskID uint64,
+	tagList []string,
+) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Uint64("download_task_id", downloadTaskID)).
+		With(zap.Strings("tag_list", tagList))
+
+	if _, err := d.database.
+		Delete(TabNameDownloadTaskTags).
+		Where(goqu.Ex{ColNameDownloadTaskTagsOfDownloadTaskID: downloadTaskID}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete download task tag list")
+		return status.Error(codes.Internal, "failed to delete download task tag list")
+	}
+
+	if len(tagList) == 0 {
+		return nil
+	}
+
+	if _, err := d.database.
+		Insert(TabNameDownloadTaskTags).
+		Rows(lo.Map(tagList, func(tag string, _ int) DownloadTaskTag {
+			return DownloadTaskTag{OfDownloadTaskID: downloadTaskID, Tag: tag}
+		})).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to create download task tag list")
+		return status.Error(codes.Internal, "failed to create download task tag list")
+	}
+
+	return nil
+}
+
+func (d downloadTaskTagDataAccessor) GetDownloadTaskTagListOfDownloadTaskList(
+	ctx context.Context,
+	downloadTaskIDList []uint64,
+) (map[uint64][]string, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64s("download_task_id_list", downloadTaskIDList))
+	if len(downloadTaskIDList) == 0 {
+		return map[uint64][]string{}, nil
+	}
+
+	tagList := []DownloadTaskTag{}
+	err := d.database.
+		From(TabNameDownloadTaskTags).
+		Where(goqu.C(ColNameDownloadTaskTagsOfDownloadTaskID).In(downloadTaskIDList)).
+		Order(goqu.C(ColNameDownloadTaskTagsTag).Asc()).
+		ScanStructsContext(ctx, &tagList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task tag list")
+		return nil, status.Error(codes.Internal, "failed to get download task tag list")
+	}
+
+	downloadTaskIDToTagList := make(map[uint64][]string)
+	for _, tag := range tagList {
+		downloadTaskIDToTagList[tag.OfDownloadTaskID] = append(downloadTaskIDToTagList[tag.OfDownloadTaskID], tag.Tag)
+	}
+
+	return downloadTaskIDToTagList, nil
+}
+
+func (d downloadTaskTagDataAccessor) WithDatabase(database Database) DownloadTaskTagDataAccessor {
+	return &downloadTaskTagDataAccessor{
+		database: database,
+		logger:   d.logger,
+	}
+}
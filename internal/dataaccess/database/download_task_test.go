@@ -0,0 +1,121 @@
+package database
+
+import (
+	go_load "goload/internal/generated/downloadClient/v1"
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+)
+
+func getTestSQL(t *testing.T, expression exp.Expression, orderList ...exp.OrderedExpression) string {
+	t.Helper()
+
+	query, _, err := goqu.Dialect("mysql").From(TabNameDownloadTasks).Where(expression).Order(orderList...).ToSQL()
+	if err != nil {
+		t.Fatalf("failed to build query: %v", err)
+	}
+
+	return query
+}
+
+func TestGetDownloadTaskListFilterExpression(t *testing.T) {
+	createdAfter := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	testCaseList := []struct {
+		name        string
+		filter      DownloadTaskListFilter
+		expectedSQL string
+	}{
+		{
+			name:   "personal tasks",
+			filter: DownloadTaskListFilter{OfAccountID: 1},
+			expectedSQL: "SELECT * FROM `download_tasks` " +
+				"WHERE ((`of_account_id` = 1) AND (`of_workspace_id` IS NULL))",
+		},
+		{
+			name:        "workspace tasks",
+			filter:      DownloadTaskListFilter{OfAccountID: 1, OfWorkspaceID: 2},
+			expectedSQL: "SELECT * FROM `download_tasks` WHERE (`of_workspace_id` = 2)",
+		},
+		{
+			name: "status, type and creation time",
+			filter: DownloadTaskListFilter{
+				OfWorkspaceID: 2,
+				DownloadStatusList: []go_load.DownloadStatus{
+					go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED, go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS,
+				},
+				DownloadType: go_load.DownloadType_DOWNLOAD_TYPE_HTTP,
+				CreatedAfter: &createdAfter,
+			},
+			expectedSQL: "SELECT * FROM `download_tasks` WHERE ((`of_workspace_id` = 2) " +
+				"AND (`download_status` IN (3, 4)) AND (`download_type` = 1) " +
+				"AND (`created_at` >= '2024-01-02 03:04:05'))",
+		},
+		{
+			name:   "url with like wildcards",
+			filter: DownloadTaskListFilter{OfWorkspaceID: 2, URLContains: `50%_off\`},
+			expectedSQL: "SELECT * FROM `download_tasks` WHERE ((`of_workspace_id` = 2) " +
+				"AND (`url` LIKE BINARY '%50\\\\%\\\\_off\\\\\\\\%'))",
+		},
+		{
+			name:   "tag",
+			filter: DownloadTaskListFilter{OfWorkspaceID: 2, TagList: []string{"video"}},
+			expectedSQL: "SELECT * FROM `download_tasks` WHERE ((`of_workspace_id` = 2) AND (`id` IN " +
+				"((SELECT `of_download_task_id` FROM `download_task_tags` WHERE (`tag` = 'video')))))",
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			d := downloadTaskDataAccessor{database: goqu.New("mysql", nil)}
+			if query := getTestSQL(t, d.getDownloadTaskListFilterExpression(testCase.filter)); query !=
+				testCase.expectedSQL {
+				t.Fatalf("expected query\n%s\ngot\n%s", testCase.expectedSQL, query)
+			}
+		})
+	}
+}
+
+func TestGetDownloadTaskListCursorExpression(t *testing.T) {
+	cursor := DownloadTaskListCursor{CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), ID: 7}
+	testCaseList := []struct {
+		name        string
+		sort        DownloadTaskListSort
+		expectedSQL string
+	}{
+		{
+			name:        "id ascending",
+			sort:        DownloadTaskListSort{Key: DownloadTaskListSortKeyID},
+			expectedSQL: "SELECT * FROM `download_tasks` WHERE (`id` > 7) ORDER BY `id` ASC",
+		},
+		{
+			name:        "id descending",
+			sort:        DownloadTaskListSort{Key: DownloadTaskListSortKeyID, Descending: true},
+			expectedSQL: "SELECT * FROM `download_tasks` WHERE (`id` < 7) ORDER BY `id` DESC",
+		},
+		{
+			name: "created_at ascending breaks ties by id",
+			sort: DownloadTaskListSort{Key: DownloadTaskListSortKeyCreatedAt},
+			expectedSQL: "SELECT * FROM `download_tasks` WHERE ((`created_at` > '2024-01-02 03:04:05') OR " +
+				"((`created_at` = '2024-01-02 03:04:05') AND (`id` > 7))) ORDER BY `created_at` ASC, `id` ASC",
+		},
+		{
+			name: "created_at descending breaks ties by id",
+			sort: DownloadTaskListSort{Key: DownloadTaskListSortKeyCreatedAt, Descending: true},
+			expectedSQL: "SELECT * FROM `download_tasks` WHERE ((`created_at` < '2024-01-02 03:04:05') OR " +
+				"((`created_at` = '2024-01-02 03:04:05') AND (`id` < 7))) ORDER BY `created_at` DESC, `id` DESC",
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			d := downloadTaskDataAccessor{}
+			query := getTestSQL(t, d.getDownloadTaskListCursorExpression(testCase.sort, cursor),
+				d.getDownloadTaskListOrderExpressionList(testCase.sort)...)
+			if query != testCase.expectedSQL {
+				t.Fatalf("expected query\n%s\ngot\n%s", testCase.expectedSQL, query)
+			}
+		})
+	}
+}
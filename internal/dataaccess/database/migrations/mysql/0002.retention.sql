@@ -0,0 +1,30 @@
+-- +migrate Up
+ALTER TABLE accounts
+    ADD COLUMN file_ttl_seconds BIGINT UNSIGNED NULL;
+
+ALTER TABLE download_tasks
+    ADD COLUMN expires_at DATETIME NULL,
+    ADD COLUMN updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    ADD INDEX download_tasks_download_status_updated_at_idx (download_status, updated_at);
+
+CREATE TABLE IF NOT EXISTS retention_runs (
+                                              id BIGINT UNSIGNED AUTO_INCREMENT,
+                                              started_at DATETIME NOT NULL,
+                                              finished_at DATETIME NOT NULL,
+                                              expired_file_count BIGINT UNSIGNED NOT NULL,
+                                              orphaned_file_count BIGINT UNSIGNED NOT NULL,
+                                              purged_task_count BIGINT UNSIGNED NOT NULL,
+                                              reclaimed_bytes BIGINT UNSIGNED NOT NULL,
+                                              PRIMARY KEY (id)
+    );
+
+-- +migrate Down
+DROP TABLE IF EXISTS retention_runs;
+
+ALTER TABLE download_tasks
+    DROP INDEX download_tasks_download_status_updated_at_idx,
+    DROP COLUMN updated_at,
+    DROP COLUMN expires_at;
+
+ALTER TABLE accounts
+    DROP COLUMN file_ttl_seconds;
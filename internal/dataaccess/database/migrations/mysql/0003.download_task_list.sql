@@ -0,0 +1,11 @@
+-- +migrate Up
+ALTER TABLE download_tasks
+    ADD COLUMN created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD INDEX download_tasks_of_account_id_created_at_id_idx (of_account_id, created_at, id),
+    ADD INDEX download_tasks_of_account_id_download_status_id_idx (of_account_id, download_status, id);
+
+-- +migrate Down
+ALTER TABLE download_tasks
+    DROP INDEX download_tasks_of_account_id_download_status_id_idx,
+    DROP INDEX download_tasks_of_account_id_created_at_id_idx,
+    DROP COLUMN created_at;
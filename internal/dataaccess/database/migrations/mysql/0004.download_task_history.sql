@@ -0,0 +1,26 @@
+-- +migrate Up
+ALTER TABLE download_tasks
+    MODIFY COLUMN updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN started_at DATETIME NULL,
+    ADD COLUMN finished_at DATETIME NULL;
+
+CREATE TABLE IF NOT EXISTS download_task_events (
+                                                    id BIGINT UNSIGNED AUTO_INCREMENT,
+                                                    of_download_task_id BIGINT UNSIGNED NOT NULL,
+                                                    from_download_status SMALLINT NOT NULL,
+                                                    to_download_status SMALLINT NOT NULL,
+                                                    actor VARCHAR(256) NOT NULL,
+                                                    reason TEXT NOT NULL,
+                                                    created_at DATETIME NOT NULL,
+                                                    PRIMARY KEY (id),
+    INDEX download_task_events_of_download_task_id_id_idx (of_download_task_id, id),
+    FOREIGN KEY (of_download_task_id) REFERENCES download_tasks(id) ON DELETE CASCADE
+    );
+
+-- +migrate Down
+DROP TABLE IF EXISTS download_task_events;
+
+ALTER TABLE download_tasks
+    DROP COLUMN finished_at,
+    DROP COLUMN started_at,
+    MODIFY COLUMN updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP;
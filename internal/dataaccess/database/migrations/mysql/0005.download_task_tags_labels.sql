@@ -0,0 +1,22 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS download_task_tags (
+                                                  of_download_task_id BIGINT UNSIGNED NOT NULL,
+                                                  tag VARCHAR(64) NOT NULL,
+                                                  PRIMARY KEY (of_download_task_id, tag),
+    INDEX download_task_tags_tag_of_download_task_id_idx (tag, of_download_task_id),
+    FOREIGN KEY (of_download_task_id) REFERENCES download_tasks(id) ON DELETE CASCADE
+    );
+
+CREATE TABLE IF NOT EXISTS download_task_labels (
+                                                    of_download_task_id BIGINT UNSIGNED NOT NULL,
+                                                    label_key VARCHAR(64) NOT NULL,
+                                                    label_value VARCHAR(256) NOT NULL,
+                                                    PRIMARY KEY (of_download_task_id, label_key),
+    INDEX download_task_labels_key_value_of_download_task_id_idx (label_key, label_value, of_download_task_id),
+    FOREIGN KEY (of_download_task_id) REFERENCES download_tasks(id) ON DELETE CASCADE
+    );
+
+-- +migrate Down
+DROP TABLE IF EXISTS download_task_labels;
+
+DROP TABLE IF EXISTS download_task_tags;
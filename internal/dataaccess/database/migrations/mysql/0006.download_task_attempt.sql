@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE download_tasks
+    ADD COLUMN attempt INT UNSIGNED NOT NULL DEFAULT 1;
+
+-- +migrate Down
+ALTER TABLE download_tasks
+    DROP COLUMN attempt;
@@ -0,0 +1,13 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS outbox_messages (
+                                               id BIGINT UNSIGNED AUTO_INCREMENT,
+                                               queue_name VARCHAR(256) NOT NULL,
+                                               payload MEDIUMBLOB NOT NULL,
+                                               created_at DATETIME NOT NULL,
+                                               published_at DATETIME NULL,
+                                               PRIMARY KEY (id),
+    INDEX outbox_messages_published_at_id_idx (published_at, id)
+    );
+
+-- +migrate Down
+DROP TABLE IF EXISTS outbox_messages;
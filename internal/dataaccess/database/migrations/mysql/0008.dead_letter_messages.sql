@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS dead_letter_messages (
+                                                    id BIGINT UNSIGNED AUTO_INCREMENT,
+                                                    queue_name VARCHAR(256) NOT NULL,
+                                                    payload MEDIUMBLOB NOT NULL,
+                                                    last_error TEXT NOT NULL,
+                                                    attempt_list TEXT NOT NULL,
+                                                    dead_lettered_at DATETIME NOT NULL,
+                                                    replay_count INT UNSIGNED NOT NULL DEFAULT 0,
+                                                    replayed_at DATETIME NULL,
+                                                    PRIMARY KEY (id),
+    INDEX dead_letter_messages_queue_name_id_idx (queue_name, id)
+    );
+
+-- +migrate Down
+DROP TABLE IF EXISTS dead_letter_messages;
@@ -0,0 +1,47 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS webhooks (
+                                        id BIGINT UNSIGNED AUTO_INCREMENT,
+                                        of_account_id BIGINT UNSIGNED NOT NULL,
+                                        url TEXT NOT NULL,
+                                        secret VARCHAR(128) NOT NULL,
+                                        created_at DATETIME NOT NULL,
+                                        PRIMARY KEY (id),
+    INDEX webhooks_of_account_id_idx (of_account_id),
+    FOREIGN KEY (of_account_id) REFERENCES accounts(id)
+    );
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+                                                  id BIGINT UNSIGNED AUTO_INCREMENT,
+                                                  of_account_id BIGINT UNSIGNED NOT NULL,
+                                                  of_webhook_id BIGINT UNSIGNED NOT NULL DEFAULT 0,
+                                                  of_download_task_id BIGINT UNSIGNED NOT NULL DEFAULT 0,
+                                                  event_id VARCHAR(64) NOT NULL,
+                                                  event_type VARCHAR(64) NOT NULL,
+                                                  url TEXT NOT NULL,
+                                                  secret VARCHAR(128) NOT NULL,
+                                                  payload MEDIUMBLOB NOT NULL,
+                                                  delivery_status SMALLINT NOT NULL,
+                                                  attempt INT UNSIGNED NOT NULL DEFAULT 0,
+                                                  last_response_status_code INT NOT NULL DEFAULT 0,
+                                                  last_error TEXT NOT NULL,
+                                                  next_attempt_at DATETIME NOT NULL,
+                                                  created_at DATETIME NOT NULL,
+                                                  delivered_at DATETIME NULL,
+                                                  PRIMARY KEY (id),
+    UNIQUE (event_id, of_webhook_id),
+    INDEX webhook_deliveries_status_next_attempt_at_idx (delivery_status, next_attempt_at),
+    INDEX webhook_deliveries_of_account_id_id_idx (of_account_id, id)
+    );
+
+ALTER TABLE download_tasks
+    ADD COLUMN callback_url VARCHAR(2048) NOT NULL DEFAULT '',
+    ADD COLUMN callback_secret VARCHAR(128) NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE download_tasks
+    DROP COLUMN callback_secret,
+    DROP COLUMN callback_url;
+
+DROP TABLE IF EXISTS webhook_deliveries;
+
+DROP TABLE IF EXISTS webhooks;
@@ -0,0 +1,17 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS sessions (
+                                        id BIGINT UNSIGNED AUTO_INCREMENT,
+                                        of_account_id BIGINT UNSIGNED NOT NULL,
+                                        refresh_token_hash CHAR(64) NOT NULL,
+                                        previous_refresh_token_hash CHAR(64) NOT NULL DEFAULT '',
+                                        created_at DATETIME NOT NULL,
+                                        refreshed_at DATETIME NOT NULL,
+                                        expires_at DATETIME NOT NULL,
+                                        revoked_at DATETIME NULL,
+                                        PRIMARY KEY (id),
+    INDEX sessions_of_account_id_idx (of_account_id),
+    FOREIGN KEY (of_account_id) REFERENCES accounts(id)
+    );
+
+-- +migrate Down
+DROP TABLE IF EXISTS sessions;
@@ -0,0 +1,16 @@
+-- +migrate Up
+ALTER TABLE token_public_keys
+    ADD COLUMN encrypted_private_key TEXT NULL,
+    ADD COLUMN created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN retired_at DATETIME NULL,
+    ADD INDEX token_public_keys_retired_at_idx (retired_at);
+
+-- keys generated on boot before this migration have no stored private key and can never sign again
+UPDATE token_public_keys SET retired_at = CURRENT_TIMESTAMP;
+
+-- +migrate Down
+ALTER TABLE token_public_keys
+    DROP INDEX token_public_keys_retired_at_idx,
+    DROP COLUMN retired_at,
+    DROP COLUMN created_at,
+    DROP COLUMN encrypted_private_key;
@@ -0,0 +1,13 @@
+-- +migrate Up
+ALTER TABLE accounts
+    ADD COLUMN role VARCHAR(32) NOT NULL DEFAULT 'user',
+    ADD COLUMN disabled_at DATETIME NULL,
+    ADD COLUMN quota_max_download_task_count BIGINT UNSIGNED NULL,
+    ADD COLUMN quota_max_active_download_task_count BIGINT UNSIGNED NULL;
+
+-- +migrate Down
+ALTER TABLE accounts
+    DROP COLUMN quota_max_active_download_task_count,
+    DROP COLUMN quota_max_download_task_count,
+    DROP COLUMN disabled_at,
+    DROP COLUMN role;
@@ -0,0 +1,18 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS api_keys (
+                                        id BIGINT UNSIGNED AUTO_INCREMENT,
+                                        of_account_id BIGINT UNSIGNED NOT NULL,
+                                        name VARCHAR(128) NOT NULL,
+                                        key_hash VARCHAR(128) NOT NULL,
+                                        scopes VARCHAR(512) NOT NULL,
+                                        created_at DATETIME NOT NULL,
+                                        expires_at DATETIME NULL,
+                                        last_used_at DATETIME NULL,
+                                        revoked_at DATETIME NULL,
+                                        PRIMARY KEY (id),
+    INDEX api_keys_of_account_id_idx (of_account_id),
+    FOREIGN KEY (of_account_id) REFERENCES accounts(id)
+    );
+
+-- +migrate Down
+DROP TABLE IF EXISTS api_keys;
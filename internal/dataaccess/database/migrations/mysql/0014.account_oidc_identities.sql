@@ -0,0 +1,17 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS account_oidc_identities (
+                                        id BIGINT UNSIGNED AUTO_INCREMENT,
+                                        of_account_id BIGINT UNSIGNED NOT NULL,
+                                        issuer VARCHAR(256) NOT NULL,
+                                        subject VARCHAR(256) NOT NULL,
+                                        email VARCHAR(256) NOT NULL DEFAULT '',
+                                        created_at DATETIME NOT NULL,
+                                        last_login_at DATETIME NOT NULL,
+                                        PRIMARY KEY (id),
+    UNIQUE account_oidc_identities_issuer_subject_idx (issuer, subject),
+    INDEX account_oidc_identities_of_account_id_idx (of_account_id),
+    FOREIGN KEY (of_account_id) REFERENCES accounts(id)
+    );
+
+-- +migrate Down
+DROP TABLE IF EXISTS account_oidc_identities;
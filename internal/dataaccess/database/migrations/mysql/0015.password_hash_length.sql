@@ -0,0 +1,13 @@
+-- +migrate Up
+ALTER TABLE account_passwords
+    MODIFY COLUMN hash VARCHAR(255) NOT NULL;
+
+ALTER TABLE api_keys
+    MODIFY COLUMN key_hash VARCHAR(255) NOT NULL;
+
+-- +migrate Down
+ALTER TABLE api_keys
+    MODIFY COLUMN key_hash VARCHAR(128) NOT NULL;
+
+ALTER TABLE account_passwords
+    MODIFY COLUMN hash VARCHAR(128) NOT NULL;
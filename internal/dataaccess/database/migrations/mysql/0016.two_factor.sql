@@ -0,0 +1,31 @@
+-- +migrate Up
+ALTER TABLE accounts
+    ADD COLUMN two_factor_enabled_at DATETIME NULL;
+
+CREATE TABLE IF NOT EXISTS account_totps (
+                                        of_account_id BIGINT UNSIGNED NOT NULL,
+                                        encrypted_secret VARCHAR(256) NOT NULL,
+                                        last_used_time_step BIGINT UNSIGNED NOT NULL DEFAULT 0,
+                                        created_at DATETIME NOT NULL,
+                                        PRIMARY KEY (of_account_id),
+    FOREIGN KEY (of_account_id) REFERENCES accounts(id)
+    );
+
+CREATE TABLE IF NOT EXISTS account_recovery_codes (
+                                        id BIGINT UNSIGNED AUTO_INCREMENT,
+                                        of_account_id BIGINT UNSIGNED NOT NULL,
+                                        code_hash VARCHAR(64) NOT NULL,
+                                        created_at DATETIME NOT NULL,
+                                        used_at DATETIME NULL,
+                                        PRIMARY KEY (id),
+    INDEX account_recovery_codes_of_account_id_code_hash_idx (of_account_id, code_hash),
+    FOREIGN KEY (of_account_id) REFERENCES accounts(id)
+    );
+
+-- +migrate Down
+DROP TABLE IF EXISTS account_recovery_codes;
+
+DROP TABLE IF EXISTS account_totps;
+
+ALTER TABLE accounts
+    DROP COLUMN two_factor_enabled_at;
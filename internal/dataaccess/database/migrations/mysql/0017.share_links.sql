@@ -0,0 +1,19 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS share_links (
+                                        id BIGINT UNSIGNED AUTO_INCREMENT,
+                                        of_account_id BIGINT UNSIGNED NOT NULL,
+                                        of_download_task_id BIGINT UNSIGNED NOT NULL,
+                                        password_hash VARCHAR(255) NOT NULL DEFAULT '',
+                                        max_download_count BIGINT UNSIGNED NULL,
+                                        download_count BIGINT UNSIGNED NOT NULL DEFAULT 0,
+                                        expires_at DATETIME NOT NULL,
+                                        created_at DATETIME NOT NULL,
+                                        revoked_at DATETIME NULL,
+                                        PRIMARY KEY (id),
+    INDEX share_links_of_account_id_idx (of_account_id),
+    FOREIGN KEY (of_account_id) REFERENCES accounts(id),
+    FOREIGN KEY (of_download_task_id) REFERENCES download_tasks(id) ON DELETE CASCADE
+    );
+
+-- +migrate Down
+DROP TABLE IF EXISTS share_links;
@@ -0,0 +1,37 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS workspaces (
+                                        id BIGINT UNSIGNED AUTO_INCREMENT,
+                                        display_name VARCHAR(256) NOT NULL,
+                                        quota_max_download_task_count BIGINT UNSIGNED NULL,
+                                        quota_max_active_download_task_count BIGINT UNSIGNED NULL,
+                                        created_at DATETIME NOT NULL,
+                                        PRIMARY KEY (id)
+    );
+
+CREATE TABLE IF NOT EXISTS workspace_members (
+                                        of_workspace_id BIGINT UNSIGNED NOT NULL,
+                                        of_account_id BIGINT UNSIGNED NOT NULL,
+                                        role VARCHAR(32) NOT NULL,
+                                        created_at DATETIME NOT NULL,
+                                        PRIMARY KEY (of_workspace_id, of_account_id),
+    INDEX workspace_members_of_account_id_idx (of_account_id),
+    FOREIGN KEY (of_workspace_id) REFERENCES workspaces(id) ON DELETE CASCADE,
+    FOREIGN KEY (of_account_id) REFERENCES accounts(id)
+    );
+
+ALTER TABLE download_tasks
+    ADD COLUMN of_workspace_id BIGINT UNSIGNED NULL,
+    ADD INDEX download_tasks_of_workspace_id_created_at_id_idx (of_workspace_id, created_at, id),
+    ADD INDEX download_tasks_of_workspace_id_download_status_id_idx (of_workspace_id, download_status, id),
+    ADD CONSTRAINT download_tasks_of_workspace_id_fk FOREIGN KEY (of_workspace_id) REFERENCES workspaces(id);
+
+-- +migrate Down
+ALTER TABLE download_tasks
+    DROP FOREIGN KEY download_tasks_of_workspace_id_fk,
+    DROP INDEX download_tasks_of_workspace_id_download_status_id_idx,
+    DROP INDEX download_tasks_of_workspace_id_created_at_id_idx,
+    DROP COLUMN of_workspace_id;
+
+DROP TABLE IF EXISTS workspace_members;
+
+DROP TABLE IF EXISTS workspaces;
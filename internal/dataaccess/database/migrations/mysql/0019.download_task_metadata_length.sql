@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE download_tasks
+    MODIFY COLUMN metadata MEDIUMTEXT NOT NULL;
+
+-- +migrate Down
+ALTER TABLE download_tasks
+    MODIFY COLUMN metadata TEXT NOT NULL;
@@ -0,0 +1,176 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"go.uber.org/zap"
+	"goload/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var (
+	TabNameOutboxMessages = goqu.T("outbox_messages")
+)
+
+const (
+	ColNameOutboxMessageID          = "id"
+	ColNameOutboxMessageCreatedAt   = "created_at"
+	ColNameOutboxMessagePublishedAt = "published_at"
+)
+
+type OutboxMessage struct {
+	ID          uint64       `db:"id" goqu:"skipinsert,skipupdate"`
+	QueueName   string       `db:"queue_name"`
+	Payload     []byte       `db:"payload"`
+	CreatedAt   time.Time    `db:"created_at"`
+	PublishedAt sql.NullTime `db:"published_at"`
+}
+
+type OutboxMessageDataAccessor interface {
+	CreateOutboxMessage(ctx context.Context, message OutboxMessage) (uint64, error)
+	// GetPendingOutboxMessageListWithXLock skips rows locked by other relays, so several instances can relay
+	// concurrently without publishing the same message twice.
+	GetPendingOutboxMessageListWithXLock(ctx context.Context, limit uint64) ([]OutboxMessage, error)
+	GetOldestPendingOutboxMessage(ctx context.Context) (OutboxMessage, bool, error)
+	UpdateOutboxMessageListPublished(ctx context.Context, idList []uint64, publishedAt time.Time) error
+	DeleteOutboxMessageListPublishedBefore(ctx context.Context, publishedBefore time.Time, limit uint64) (uint64, error)
+	WithDatabase(database Database) OutboxMessageDataAccessor
+}
+
+type outboxMessageDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewOutboxMessageDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) OutboxMessageDataAccessor {
+	return &outboxMessageDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (o outboxMessageDataAccessor) CreateOutboxMessage(ctx context.Context, message OutboxMessage) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.String("queue_name", message.QueueName))
+
+	result, err := o.database.
+		Insert(TabNameOutboxMessages).
+		Rows(message).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create outbox message")
+		return 0, status.Error(codes.Internal, "failed to create outbox message")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (o outboxMessageDataAccessor) GetPendingOutboxMessageListWithXLock(
+	ctx context.Context,
+	limit uint64,
+) ([]OutboxMessage, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	messages := []OutboxMessage{}
+	err := o.database.
+		From(TabNameOutboxMessages).
+		Where(goqu.C(ColNameOutboxMessagePublishedAt).IsNull()).
+		Order(goqu.C(ColNameOutboxMessageID).Asc()).
+		Limit(uint(limit)).
+		ForUpdate(exp.SkipLocked).
+		ScanStructsContext(ctx, &messages)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get pending outbox message list")
+		return nil, status.Error(codes.Internal, "failed to get pending outbox message list")
+	}
+
+	return messages, nil
+}
+
+func (o outboxMessageDataAccessor) GetOldestPendingOutboxMessage(ctx context.Context) (OutboxMessage, bool, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	message := OutboxMessage{}
+	found, err := o.database.
+		From(TabNameOutboxMessages).
+		Where(goqu.C(ColNameOutboxMessagePublishedAt).IsNull()).
+		Order(goqu.C(ColNameOutboxMessageID).Asc()).
+		ScanStructContext(ctx, &message)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get oldest pending outbox message")
+		return OutboxMessage{}, false, status.Error(codes.Internal, "failed to get oldest pending outbox message")
+	}
+
+	return message, found, nil
+}
+
+func (o outboxMessageDataAccessor) UpdateOutboxMessageListPublished(
+	ctx context.Context,
+	idList []uint64,
+	publishedAt time.Time,
+) error {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.Uint64s("id_list", idList))
+	if len(idList) == 0 {
+		return nil
+	}
+
+	if _, err := o.database.
+		Update(TabNameOutboxMessages).
+		Set(goqu.Record{ColNameOutboxMessagePublishedAt: publishedAt}).
+		Where(goqu.C(ColNameOutboxMessageID).In(idList)).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to mark outbox message list as published")
+		return status.Error(codes.Internal, "failed to mark outbox message list as published")
+	}
+
+	return nil
+}
+
+func (o outboxMessageDataAccessor) DeleteOutboxMessageListPublishedBefore(
+	ctx context.Context,
+	publishedBefore time.Time,
+	limit uint64,
+) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger).With(zap.Time("published_before", publishedBefore))
+
+	result, err := o.database.
+		Delete(TabNameOutboxMessages).
+		Where(goqu.C(ColNameOutboxMessagePublishedAt).Lt(publishedBefore)).
+		Order(goqu.C(ColNameOutboxMessagePublishedAt).Asc()).
+		Limit(uint(limit)).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete published outbox message list")
+		return 0, status.Error(codes.Internal, "failed to delete published outbox message list")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get rows affected")
+		return 0, status.Error(codes.Internal, "failed to get rows affected")
+	}
+
+	return uint64(rowsAffected), nil
+}
+
+func (o outboxMessageDataAccessor) WithDatabase(database Database) OutboxMessageDataAccessor {
+	return &outboxMessageDataAccessor{
+		database: database,
+		logger:   o.logger,
+	}
+}
@@ -0,0 +1,74 @@
+package database
+
+import (
+	"context"
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"goload/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var (
+	TabNameRetentionRuns = goqu.T("retention_runs")
+)
+
+type RetentionRun struct {
+	ID                uint64    `db:"id" goqu:"skipinsert,skipupdate"`
+	StartedAt         time.Time `db:"started_at"`
+	FinishedAt        time.Time `db:"finished_at"`
+	ExpiredFileCount  uint64    `db:"expired_file_count"`
+	OrphanedFileCount uint64    `db:"orphaned_file_count"`
+	PurgedTaskCount   uint64    `db:"purged_task_count"`
+	ReclaimedBytes    uint64    `db:"reclaimed_bytes"`
+}
+
+type RetentionRunDataAccessor interface {
+	CreateRetentionRun(ctx context.Context, run RetentionRun) (uint64, error)
+	WithDatabase(database Database) RetentionRunDataAccessor
+}
+
+type retentionRunDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewRetentionRunDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) RetentionRunDataAccessor {
+	return &retentionRunDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (r retentionRunDataAccessor) CreateRetentionRun(ctx context.Context, run RetentionRun) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, r.logger).With(zap.Any("run", run))
+
+	result, err := r.database.
+		Insert(TabNameRetentionRuns).
+		Rows(run).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create retention run")
+		return 0, status.Error(codes.Internal, "failed to create retention run")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (r retentionRunDataAccessor) WithDatabase(database Database) RetentionRunDataAccessor {
+	return &retentionRunDataAccessor{
+		database: database,
+		logger:   r.logger,
+	}
+}
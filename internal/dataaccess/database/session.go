@@ -0,0 +1,205 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"goload/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var (
+	TabNameSessions    = goqu.T("sessions")
+	ErrSessionNotFound = status.Error(codes.NotFound, "session not found")
+)
+
+const (
+	ColNameSessionID          = "id"
+	ColNameSessionOfAccountID = "of_account_id"
+	ColNameSessionExpiresAt   = "expires_at"
+	ColNameSessionRevokedAt   = "revoked_at"
+)
+
+type Session struct {
+	ID               uint64 `db:"id" goqu:"skipinsert,skipupdate"`
+	OfAccountID      uint64 `db:"of_account_id"`
+	RefreshTokenHash string `db:"refresh_token_hash"`
+	// PreviousRefreshTokenHash is kept to tell concurrent refreshes apart from a reused refresh token.
+	PreviousRefreshTokenHash string       `db:"previous_refresh_token_hash"`
+	CreatedAt                time.Time    `db:"created_at"`
+	RefreshedAt              time.Time    `db:"refreshed_at"`
+	ExpiresAt                time.Time    `db:"expires_at"`
+	RevokedAt                sql.NullTime `db:"revoked_at"`
+}
+
+type SessionDataAccessor interface {
+	CreateSession(ctx context.Context, session Session) (uint64, error)
+	GetSession(ctx context.Context, id uint64) (Session, error)
+	GetSessionWithXLock(ctx context.Context, id uint64) (Session, error)
+	// GetActiveSessionListOfAccountWithXLock returns the sessions of the account that are neither revoked nor
+	// expired at now.
+	GetActiveSessionListOfAccountWithXLock(ctx context.Context, accountID uint64, now time.Time) ([]Session, error)
+	UpdateSession(ctx context.Context, session Session) error
+	UpdateSessionListRevoked(ctx context.Context, idList []uint64, revokedAt time.Time) error
+	DeleteSessionListOfAccount(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) SessionDataAccessor
+}
+
+type sessionDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewSessionDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) SessionDataAccessor {
+	return &sessionDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (s sessionDataAccessor) CreateSession(ctx context.Context, session Session) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("of_account_id", session.OfAccountID))
+
+	result, err := s.database.
+		Insert(TabNameSessions).
+		Rows(session).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create session")
+		return 0, status.Error(codes.Internal, "failed to create session")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (s sessionDataAccessor) getSession(ctx context.Context, id uint64, withXLock bool) (Session, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("id", id))
+
+	query := s.database.
+		From(TabNameSessions).
+		Where(goqu.Ex{ColNameSessionID: id})
+	if withXLock {
+		query = query.ForUpdate(goqu.Wait)
+	}
+
+	session := Session{}
+	found, err := query.ScanStructContext(ctx, &session)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get session by id")
+		return Session{}, status.Error(codes.Internal, "failed to get session by id")
+	}
+
+	if !found {
+		logger.Warn("cannot find session by id")
+		return Session{}, ErrSessionNotFound
+	}
+
+	return session, nil
+}
+
+func (s sessionDataAccessor) GetSession(ctx context.Context, id uint64) (Session, error) {
+	return s.getSession(ctx, id, false)
+}
+
+func (s sessionDataAccessor) GetSessionWithXLock(ctx context.Context, id uint64) (Session, error) {
+	return s.getSession(ctx, id, true)
+}
+
+func (s sessionDataAccessor) GetActiveSessionListOfAccountWithXLock(
+	ctx context.Context,
+	accountID uint64,
+	now time.Time,
+) ([]Session, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("account_id", accountID))
+
+	sessions := []Session{}
+	err := s.database.
+		From(TabNameSessions).
+		Where(
+			goqu.C(ColNameSessionOfAccountID).Eq(accountID),
+			goqu.C(ColNameSessionRevokedAt).IsNull(),
+			goqu.C(ColNameSessionExpiresAt).Gt(now),
+		).
+		ForUpdate(goqu.Wait).
+		ScanStructsContext(ctx, &sessions)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get active session list of account")
+		return nil, status.Error(codes.Internal, "failed to get active session list of account")
+	}
+
+	return sessions, nil
+}
+
+func (s sessionDataAccessor) UpdateSession(ctx context.Context, session Session) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("id", session.ID))
+
+	if _, err := s.database.
+		Update(TabNameSessions).
+		Set(session).
+		Where(goqu.Ex{ColNameSessionID: session.ID}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to update session")
+		return status.Error(codes.Internal, "failed to update session")
+	}
+
+	return nil
+}
+
+func (s sessionDataAccessor) UpdateSessionListRevoked(
+	ctx context.Context,
+	idList []uint64,
+	revokedAt time.Time,
+) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64s("id_list", idList))
+	if len(idList) == 0 {
+		return nil
+	}
+
+	if _, err := s.database.
+		Update(TabNameSessions).
+		Set(goqu.Record{ColNameSessionRevokedAt: revokedAt}).
+		Where(goqu.C(ColNameSessionID).In(idList)).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to revoke session list")
+		return status.Error(codes.Internal, "failed to revoke session list")
+	}
+
+	return nil
+}
+
+func (s sessionDataAccessor) DeleteSessionListOfAccount(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("account_id", accountID))
+
+	if _, err := s.database.
+		Delete(TabNameSessions).
+		Where(goqu.Ex{ColNameSessionOfAccountID: accountID}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete session list of account")
+		return status.Error(codes.Internal, "failed to delete session list of account")
+	}
+
+	return nil
+}
+
+func (s sessionDataAccessor) WithDatabase(database Database) SessionDataAccessor {
+	return &sessionDataAccessor{
+		database: database,
+		logger:   s.logger,
+	}
+}
@@ -0,0 +1,242 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"goload/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var (
+	TabNameShareLinks    = goqu.T("share_links")
+	ErrShareLinkNotFound = status.Error(codes.NotFound, "share link not found")
+)
+
+const (
+	ColNameShareLinkID               = "id"
+	ColNameShareLinkOfAccountID      = "of_account_id"
+	ColNameShareLinkOfDownloadTaskID = "of_download_task_id"
+	ColNameShareLinkMaxDownloadCount = "max_download_count"
+	ColNameShareLinkDownloadCount    = "download_count"
+	ColNameShareLinkExpiresAt        = "expires_at"
+	ColNameShareLinkRevokedAt        = "revoked_at"
+)
+
+// ShareLink lets anyone with its URL download the file of a download task. PasswordHash is empty when the link has
+// no password, and MaxDownloadCount is NULL when the number of downloads is not limited.
+type ShareLink struct {
+	ID               uint64        `db:"id" goqu:"skipinsert,skipupdate"`
+	OfAccountID      uint64        `db:"of_account_id"`
+	OfDownloadTaskID uint64        `db:"of_download_task_id"`
+	PasswordHash     string        `db:"password_hash"`
+	MaxDownloadCount sql.NullInt64 `db:"max_download_count"`
+	DownloadCount    uint64        `db:"download_count"`
+	ExpiresAt        time.Time     `db:"expires_at"`
+	CreatedAt        time.Time     `db:"created_at"`
+	RevokedAt        sql.NullTime  `db:"revoked_at"`
+}
+
+type ShareLinkDataAccessor interface {
+	CreateShareLink(ctx context.Context, shareLink ShareLink) (uint64, error)
+	GetShareLink(ctx context.Context, id uint64) (ShareLink, error)
+	// GetActiveShareLinkListOfAccount returns the links of the account that are neither revoked nor expired.
+	GetActiveShareLinkListOfAccount(ctx context.Context, accountID uint64, now time.Time) ([]ShareLink, error)
+	// IncrementShareLinkDownloadCount counts a download, it returns false if the link has no downloads left.
+	IncrementShareLinkDownloadCount(ctx context.Context, id uint64) (bool, error)
+	UpdateShareLinkRevokedAt(ctx context.Context, id uint64, revokedAt time.Time) error
+	// UpdateShareLinkListRevokedAtOfAccountInWorkspace revokes the links the account made to the download tasks of
+	// a workspace.
+	UpdateShareLinkListRevokedAtOfAccountInWorkspace(
+		ctx context.Context,
+		accountID, workspaceID uint64,
+		revokedAt time.Time,
+	) error
+	DeleteShareLinkListOfAccount(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) ShareLinkDataAccessor
+}
+
+type shareLinkDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewShareLinkDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) ShareLinkDataAccessor {
+	return &shareLinkDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (s shareLinkDataAccessor) CreateShareLink(ctx context.Context, shareLink ShareLink) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("of_account_id", shareLink.OfAccountID))
+
+	result, err := s.database.
+		Insert(TabNameShareLinks).
+		Rows(shareLink).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create share link")
+		return 0, status.Error(codes.Internal, "failed to create share link")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (s shareLinkDataAccessor) GetShareLink(ctx context.Context, id uint64) (ShareLink, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("id", id))
+
+	shareLink := ShareLink{}
+	found, err := s.database.
+		From(TabNameShareLinks).
+		Where(goqu.Ex{ColNameShareLinkID: id}).
+		ScanStructContext(ctx, &shareLink)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get share link by id")
+		return ShareLink{}, status.Error(codes.Internal, "failed to get share link by id")
+	}
+
+	if !found {
+		logger.Warn("cannot find share link by id")
+		return ShareLink{}, ErrShareLinkNotFound
+	}
+
+	return shareLink, nil
+}
+
+func (s shareLinkDataAccessor) GetActiveShareLinkListOfAccount(
+	ctx context.Context,
+	accountID uint64,
+	now time.Time,
+) ([]ShareLink, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("account_id", accountID))
+
+	shareLinks := []ShareLink{}
+	err := s.database.
+		From(TabNameShareLinks).
+		Where(
+			goqu.C(ColNameShareLinkOfAccountID).Eq(accountID),
+			goqu.C(ColNameShareLinkRevokedAt).IsNull(),
+			goqu.C(ColNameShareLinkExpiresAt).Gt(now),
+		).
+		Order(goqu.C(ColNameShareLinkID).Asc()).
+		ScanStructsContext(ctx, &shareLinks)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get active share link list of account")
+		return nil, status.Error(codes.Internal, "failed to get active share link list of account")
+	}
+
+	return shareLinks, nil
+}
+
+func (s shareLinkDataAccessor) IncrementShareLinkDownloadCount(ctx context.Context, id uint64) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("id", id))
+
+	// the limit is checked in the same statement, so concurrent downloads cannot go over it
+	result, err := s.database.
+		Update(TabNameShareLinks).
+		Set(goqu.Record{ColNameShareLinkDownloadCount: goqu.L("? + 1", goqu.C(ColNameShareLinkDownloadCount))}).
+		Where(
+			goqu.C(ColNameShareLinkID).Eq(id),
+			goqu.Or(
+				goqu.C(ColNameShareLinkMaxDownloadCount).IsNull(),
+				goqu.C(ColNameShareLinkDownloadCount).Lt(goqu.C(ColNameShareLinkMaxDownloadCount)),
+			),
+		).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to increment download count of share link")
+		return false, status.Error(codes.Internal, "failed to increment download count of share link")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get rows affected")
+		return false, status.Error(codes.Internal, "failed to get rows affected")
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (s shareLinkDataAccessor) UpdateShareLinkRevokedAt(ctx context.Context, id uint64, revokedAt time.Time) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("id", id))
+
+	if _, err := s.database.
+		Update(TabNameShareLinks).
+		Set(goqu.Record{ColNameShareLinkRevokedAt: revokedAt}).
+		Where(goqu.Ex{ColNameShareLinkID: id}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to update share link")
+		return status.Error(codes.Internal, "failed to update share link")
+	}
+
+	return nil
+}
+
+func (s shareLinkDataAccessor) UpdateShareLinkListRevokedAtOfAccountInWorkspace(
+	ctx context.Context,
+	accountID, workspaceID uint64,
+	revokedAt time.Time,
+) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).
+		With(zap.Uint64("account_id", accountID)).
+		With(zap.Uint64("workspace_id", workspaceID))
+
+	if _, err := s.database.
+		Update(TabNameShareLinks).
+		Set(goqu.Record{ColNameShareLinkRevokedAt: revokedAt}).
+		Where(
+			goqu.C(ColNameShareLinkOfAccountID).Eq(accountID),
+			goqu.C(ColNameShareLinkRevokedAt).IsNull(),
+			goqu.C(ColNameShareLinkOfDownloadTaskID).In(
+				s.database.
+					From(TabNameDownloadTasks).
+					Select(ColNameDownloadTaskId).
+					Where(goqu.Ex{ColNameDownloadTaskOfWorkspaceID: workspaceID}),
+			),
+		).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to revoke share link list of account in workspace")
+		return status.Error(codes.Internal, "failed to revoke share link list of account in workspace")
+	}
+
+	return nil
+}
+
+func (s shareLinkDataAccessor) DeleteShareLinkListOfAccount(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("account_id", accountID))
+
+	if _, err := s.database.
+		Delete(TabNameShareLinks).
+		Where(goqu.Ex{ColNameShareLinkOfAccountID: accountID}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete share link list of account")
+		return status.Error(codes.Internal, "failed to delete share link list of account")
+	}
+
+	return nil
+}
+
+func (s shareLinkDataAccessor) WithDatabase(database Database) ShareLinkDataAccessor {
+	return &shareLinkDataAccessor{
+		database: database,
+		logger:   s.logger,
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"goload/internal/utils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"time"
 )
 
 var (
@@ -17,16 +18,30 @@ var (
 const (
 	ColNameTokenPublicKeysID        = "id"
 	ColNameTokenPublicKeysPublicKey = "public_key"
+	ColNameTokenPublicKeysRetiredAt = "retired_at"
 )
 
 type TokenPublicKey struct {
 	ID        uint64 `db:"id" goqu:"skipinsert,skipupdate"`
 	PublicKey string `db:"public_key"`
+	// EncryptedPrivateKey is only set for keys generated and rotated by the application, keys loaded from a file
+	// or the config keep their private key outside the database.
+	EncryptedPrivateKey sql.NullString `db:"encrypted_private_key"`
+	CreatedAt           time.Time      `db:"created_at"`
+	// RetiredAt is when the key stopped signing tokens, it can still verify tokens for a while after that.
+	RetiredAt sql.NullTime `db:"retired_at"`
 }
 
 type TokenPublicKeyDataAccessor interface {
 	CreatePublicKey(ctx context.Context, tokenPublicKey TokenPublicKey) (uint64, error)
 	GetPublicKey(ctx context.Context, id uint64) (TokenPublicKey, error)
+	GetPublicKeyByPublicKey(ctx context.Context, publicKey string) (TokenPublicKey, error)
+	// GetLatestActivePublicKeyWithXLock returns the newest key that is not retired.
+	GetLatestActivePublicKeyWithXLock(ctx context.Context) (TokenPublicKey, error)
+	// GetPublicKeyListRetiredAfter returns the keys that are not retired or retired after retiredAfter.
+	GetPublicKeyListRetiredAfter(ctx context.Context, retiredAfter time.Time) ([]TokenPublicKey, error)
+	UpdateOtherPublicKeyListRetired(ctx context.Context, activeID uint64, retiredAt time.Time) error
+	DeletePublicKeyListRetiredBefore(ctx context.Context, retiredBefore time.Time) (int64, error)
 	WithDatabase(database Database) TokenPublicKeyDataAccessor
 }
 
@@ -52,9 +67,7 @@ func (a tokenPublicKeyDataAccessor) CreatePublicKey(
 	logger := utils.LoggerWithContext(ctx, a.logger)
 	result, err := a.database.
 		Insert(TabNameTokenPublicKeys).
-		Rows(goqu.Record{
-			ColNameTokenPublicKeysPublicKey: tokenPublicKey.PublicKey,
-		}).
+		Rows(tokenPublicKey).
 		Executor().
 		ExecContext(ctx)
 	if err != nil {
@@ -96,6 +109,133 @@ func (a tokenPublicKeyDataAccessor) GetPublicKey(ctx context.Context, id uint64)
 	return tokenPublicKey, nil
 }
 
+func (a tokenPublicKeyDataAccessor) GetPublicKeyByPublicKey(
+	ctx context.Context,
+	publicKey string,
+) (TokenPublicKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	tokenPublicKey := TokenPublicKey{}
+	found, err := a.database.
+		Select().
+		From(TabNameTokenPublicKeys).
+		Where(goqu.Ex{
+			ColNameTokenPublicKeysPublicKey: publicKey,
+		}).
+		Order(goqu.C(ColNameTokenPublicKeysID).Desc()).
+		Limit(1).
+		Executor().
+		ScanStructContext(ctx, &tokenPublicKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get public key by public key")
+		return TokenPublicKey{}, status.Error(codes.Internal, "failed to get public key by public key")
+	}
+
+	if !found {
+		return TokenPublicKey{}, sql.ErrNoRows
+	}
+
+	return tokenPublicKey, nil
+}
+
+func (a tokenPublicKeyDataAccessor) GetLatestActivePublicKeyWithXLock(ctx context.Context) (TokenPublicKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	tokenPublicKey := TokenPublicKey{}
+	found, err := a.database.
+		Select().
+		From(TabNameTokenPublicKeys).
+		Where(goqu.C(ColNameTokenPublicKeysRetiredAt).IsNull()).
+		Order(goqu.C(ColNameTokenPublicKeysID).Desc()).
+		Limit(1).
+		ForUpdate(goqu.Wait).
+		Executor().
+		ScanStructContext(ctx, &tokenPublicKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get latest active public key")
+		return TokenPublicKey{}, status.Error(codes.Internal, "failed to get latest active public key")
+	}
+
+	if !found {
+		return TokenPublicKey{}, sql.ErrNoRows
+	}
+
+	return tokenPublicKey, nil
+}
+
+func (a tokenPublicKeyDataAccessor) GetPublicKeyListRetiredAfter(
+	ctx context.Context,
+	retiredAfter time.Time,
+) ([]TokenPublicKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	tokenPublicKeyList := make([]TokenPublicKey, 0)
+	err := a.database.
+		Select().
+		From(TabNameTokenPublicKeys).
+		Where(goqu.Or(
+			goqu.C(ColNameTokenPublicKeysRetiredAt).IsNull(),
+			goqu.C(ColNameTokenPublicKeysRetiredAt).Gt(retiredAfter),
+		)).
+		Order(goqu.C(ColNameTokenPublicKeysID).Desc()).
+		Executor().
+		ScanStructsContext(ctx, &tokenPublicKeyList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get public key list")
+		return nil, status.Error(codes.Internal, "failed to get public key list")
+	}
+
+	return tokenPublicKeyList, nil
+}
+
+func (a tokenPublicKeyDataAccessor) UpdateOtherPublicKeyListRetired(
+	ctx context.Context,
+	activeID uint64,
+	retiredAt time.Time,
+) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("active_id", activeID))
+
+	if _, err := a.database.
+		Update(TabNameTokenPublicKeys).
+		Set(goqu.Record{ColNameTokenPublicKeysRetiredAt: retiredAt}).
+		Where(
+			goqu.C(ColNameTokenPublicKeysRetiredAt).IsNull(),
+			goqu.C(ColNameTokenPublicKeysID).Neq(activeID),
+		).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to retire public key list")
+		return status.Error(codes.Internal, "failed to retire public key list")
+	}
+
+	return nil
+}
+
+func (a tokenPublicKeyDataAccessor) DeletePublicKeyListRetiredBefore(
+	ctx context.Context,
+	retiredBefore time.Time,
+) (int64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	result, err := a.database.
+		Delete(TabNameTokenPublicKeys).
+		Where(goqu.C(ColNameTokenPublicKeysRetiredAt).Lt(retiredBefore)).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete retired public key list")
+		return 0, status.Error(codes.Internal, "failed to delete retired public key list")
+	}
+
+	deletedCount, err := result.RowsAffected()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get deleted public key count")
+		return 0, status.Error(codes.Internal, "failed to get deleted public key count")
+	}
+
+	return deletedCount, nil
+}
+
 func (a tokenPublicKeyDataAccessor) WithDatabase(database Database) TokenPublicKeyDataAccessor {
 	a.database = database
 	return a
@@ -0,0 +1,150 @@
+package database
+
+import (
+	"context"
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"goload/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var (
+	TabNameWebhooks    = goqu.T("webhooks")
+	ErrWebhookNotFound = status.Error(codes.NotFound, "webhook not found")
+)
+
+const (
+	ColNameWebhookID          = "id"
+	ColNameWebhookOfAccountID = "of_account_id"
+)
+
+type Webhook struct {
+	ID          uint64    `db:"id" goqu:"skipinsert,skipupdate"`
+	OfAccountID uint64    `db:"of_account_id"`
+	URL         string    `db:"url"`
+	Secret      string    `db:"secret"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+type WebhookDataAccessor interface {
+	CreateWebhook(ctx context.Context, webhook Webhook) (uint64, error)
+	GetWebhook(ctx context.Context, id uint64) (Webhook, error)
+	GetWebhookListOfAccount(ctx context.Context, accountID uint64) ([]Webhook, error)
+	DeleteWebhook(ctx context.Context, id uint64) error
+	DeleteWebhookListOfAccount(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) WebhookDataAccessor
+}
+
+type webhookDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewWebhookDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) WebhookDataAccessor {
+	return &webhookDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (w webhookDataAccessor) CreateWebhook(ctx context.Context, webhook Webhook) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("of_account_id", webhook.OfAccountID))
+
+	result, err := w.database.
+		Insert(TabNameWebhooks).
+		Rows(webhook).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create webhook")
+		return 0, status.Error(codes.Internal, "failed to create webhook")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (w webhookDataAccessor) GetWebhook(ctx context.Context, id uint64) (Webhook, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("id", id))
+
+	webhook := Webhook{}
+	found, err := w.database.
+		From(TabNameWebhooks).
+		Where(goqu.Ex{ColNameWebhookID: id}).
+		ScanStructContext(ctx, &webhook)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get webhook by id")
+		return Webhook{}, status.Error(codes.Internal, "failed to get webhook by id")
+	}
+
+	if !found {
+		logger.Warn("cannot find webhook by id")
+		return Webhook{}, ErrWebhookNotFound
+	}
+
+	return webhook, nil
+}
+
+func (w webhookDataAccessor) GetWebhookListOfAccount(ctx context.Context, accountID uint64) ([]Webhook, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("account_id", accountID))
+
+	webhooks := []Webhook{}
+	err := w.database.
+		From(TabNameWebhooks).
+		Where(goqu.Ex{ColNameWebhookOfAccountID: accountID}).
+		Order(goqu.C(ColNameWebhookID).Asc()).
+		ScanStructsContext(ctx, &webhooks)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get webhook list of account")
+		return nil, status.Error(codes.Internal, "failed to get webhook list of account")
+	}
+
+	return webhooks, nil
+}
+
+func (w webhookDataAccessor) DeleteWebhook(ctx context.Context, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("id", id))
+
+	if _, err := w.database.
+		Delete(TabNameWebhooks).
+		Where(goqu.Ex{ColNameWebhookID: id}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete webhook")
+		return status.Error(codes.Internal, "failed to delete webhook")
+	}
+
+	return nil
+}
+
+func (w webhookDataAccessor) DeleteWebhookListOfAccount(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("account_id", accountID))
+
+	if _, err := w.database.
+		Delete(TabNameWebhooks).
+		Where(goqu.Ex{ColNameWebhookOfAccountID: accountID}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete webhook list of account")
+		return status.Error(codes.Internal, "failed to delete webhook list of account")
+	}
+
+	return nil
+}
+
+func (w webhookDataAccessor) WithDatabase(database Database) WebhookDataAccessor {
+	return &webhookDataAccessor{
+		database: database,
+		logger:   w.logger,
+	}
+}
@@ -0,0 +1,252 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
+	"go.uber.org/zap"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var (
+	TabNameWebhookDeliveries = goqu.T("webhook_deliveries")
+)
+
+const (
+	ColNameWebhookDeliveryID               = "id"
+	ColNameWebhookDeliveryOfAccountID      = "of_account_id"
+	ColNameWebhookDeliveryOfWebhookID      = "of_webhook_id"
+	ColNameWebhookDeliveryOfDownloadTaskID = "of_download_task_id"
+	ColNameWebhookDeliveryDeliveryStatus   = "delivery_status"
+	ColNameWebhookDeliveryNextAttemptAt    = "next_attempt_at"
+)
+
+type WebhookDelivery struct {
+	ID                     uint64                        `db:"id" goqu:"skipinsert,skipupdate"`
+	OfAccountID            uint64                        `db:"of_account_id"`
+	OfWebhookID            uint64                        `db:"of_webhook_id"`
+	OfDownloadTaskID       uint64                        `db:"of_download_task_id"`
+	EventID                string                        `db:"event_id"`
+	EventType              string                        `db:"event_type"`
+	URL                    string                        `db:"url"`
+	Secret                 string                        `db:"secret"`
+	Payload                []byte                        `db:"payload"`
+	DeliveryStatus         go_load.WebhookDeliveryStatus `db:"delivery_status"`
+	Attempt                uint32                        `db:"attempt"`
+	LastResponseStatusCode int32                         `db:"last_response_status_code"`
+	LastError              string                        `db:"last_error"`
+	NextAttemptAt          time.Time                     `db:"next_attempt_at"`
+	CreatedAt              time.Time                     `db:"created_at"`
+	DeliveredAt            sql.NullTime                  `db:"delivered_at"`
+}
+
+type WebhookDeliveryListFilter struct {
+	OfAccountID      uint64
+	OfWebhookID      uint64
+	OfDownloadTaskID uint64
+}
+
+type WebhookDeliveryDataAccessor interface {
+	CreateWebhookDelivery(ctx context.Context, delivery WebhookDelivery) (uint64, error)
+	// CreateWebhookDeliveryList ignores deliveries of an event to a webhook that already exist, so an event can
+	// be handled more than once.
+	CreateWebhookDeliveryList(ctx context.Context, deliveryList []WebhookDelivery) error
+	// GetDueWebhookDeliveryListWithXLock skips rows locked by other instances, so several instances can deliver
+	// concurrently without sending the same delivery twice.
+	GetDueWebhookDeliveryListWithXLock(ctx context.Context, now time.Time, limit uint64) ([]WebhookDelivery, error)
+	GetWebhookDeliveryList(
+		ctx context.Context,
+		filter WebhookDeliveryListFilter,
+		offset, limit uint64,
+	) ([]WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery WebhookDelivery) error
+	UpdateWebhookDeliveryListNextAttemptAt(ctx context.Context, idList []uint64, nextAttemptAt time.Time) error
+	DeleteWebhookDeliveryListOfAccount(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) WebhookDeliveryDataAccessor
+}
+
+type webhookDeliveryDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewWebhookDeliveryDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) WebhookDeliveryDataAccessor {
+	return &webhookDeliveryDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (w webhookDeliveryDataAccessor) CreateWebhookDelivery(
+	ctx context.Context,
+	delivery WebhookDelivery,
+) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.String("event_id", delivery.EventID))
+
+	result, err := w.database.
+		Insert(TabNameWebhookDeliveries).
+		Rows(delivery).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create webhook delivery")
+		return 0, status.Error(codes.Internal, "failed to create webhook delivery")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (w webhookDeliveryDataAccessor) CreateWebhookDeliveryList(
+	ctx context.Context,
+	deliveryList []WebhookDelivery,
+) error {
+	logger := utils.LoggerWithContext(ctx, w.logger)
+	if len(deliveryList) == 0 {
+		return nil
+	}
+
+	if _, err := w.database.
+		Insert(TabNameWebhookDeliveries).
+		Rows(deliveryList).
+		OnConflict(goqu.DoNothing()).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to create webhook delivery list")
+		return status.Error(codes.Internal, "failed to create webhook delivery list")
+	}
+
+	return nil
+}
+
+func (w webhookDeliveryDataAccessor) GetDueWebhookDeliveryListWithXLock(
+	ctx context.Context,
+	now time.Time,
+	limit uint64,
+) ([]WebhookDelivery, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger)
+
+	deliveries := []WebhookDelivery{}
+	err := w.database.
+		From(TabNameWebhookDeliveries).
+		Where(
+			goqu.C(ColNameWebhookDeliveryDeliveryStatus).Eq(go_load.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING),
+			goqu.C(ColNameWebhookDeliveryNextAttemptAt).Lte(now),
+		).
+		Order(goqu.C(ColNameWebhookDeliveryNextAttemptAt).Asc()).
+		Limit(uint(limit)).
+		ForUpdate(exp.SkipLocked).
+		ScanStructsContext(ctx, &deliveries)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get due webhook delivery list")
+		return nil, status.Error(codes.Internal, "failed to get due webhook delivery list")
+	}
+
+	return deliveries, nil
+}
+
+func (w webhookDeliveryDataAccessor) GetWebhookDeliveryList(
+	ctx context.Context,
+	filter WebhookDeliveryListFilter,
+	offset, limit uint64,
+) ([]WebhookDelivery, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("of_account_id", filter.OfAccountID))
+
+	expression := goqu.Ex{ColNameWebhookDeliveryOfAccountID: filter.OfAccountID}
+	if filter.OfWebhookID != 0 {
+		expression[ColNameWebhookDeliveryOfWebhookID] = filter.OfWebhookID
+	}
+
+	if filter.OfDownloadTaskID != 0 {
+		expression[ColNameWebhookDeliveryOfDownloadTaskID] = filter.OfDownloadTaskID
+	}
+
+	deliveries := []WebhookDelivery{}
+	err := w.database.
+		From(TabNameWebhookDeliveries).
+		Where(expression).
+		Order(goqu.C(ColNameWebhookDeliveryID).Desc()).
+		Offset(uint(offset)).
+		Limit(uint(limit)).
+		ScanStructsContext(ctx, &deliveries)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get webhook delivery list")
+		return nil, status.Error(codes.Internal, "failed to get webhook delivery list")
+	}
+
+	return deliveries, nil
+}
+
+func (w webhookDeliveryDataAccessor) UpdateWebhookDelivery(ctx context.Context, delivery WebhookDelivery) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("id", delivery.ID))
+
+	if _, err := w.database.
+		Update(TabNameWebhookDeliveries).
+		Set(delivery).
+		Where(goqu.Ex{ColNameWebhookDeliveryID: delivery.ID}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to update webhook delivery")
+		return status.Error(codes.Internal, "failed to update webhook delivery")
+	}
+
+	return nil
+}
+
+func (w webhookDeliveryDataAccessor) UpdateWebhookDeliveryListNextAttemptAt(
+	ctx context.Context,
+	idList []uint64,
+	nextAttemptAt time.Time,
+) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64s("id_list", idList))
+	if len(idList) == 0 {
+		return nil
+	}
+
+	if _, err := w.database.
+		Update(TabNameWebhookDeliveries).
+		Set(goqu.Record{ColNameWebhookDeliveryNextAttemptAt: nextAttemptAt}).
+		Where(goqu.C(ColNameWebhookDeliveryID).In(idList)).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to update next attempt time of webhook delivery list")
+		return status.Error(codes.Internal, "failed to update next attempt time of webhook delivery list")
+	}
+
+	return nil
+}
+
+func (w webhookDeliveryDataAccessor) DeleteWebhookDeliveryListOfAccount(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("account_id", accountID))
+
+	if _, err := w.database.
+		Delete(TabNameWebhookDeliveries).
+		Where(goqu.Ex{ColNameWebhookDeliveryOfAccountID: accountID}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete webhook delivery list of account")
+		return status.Error(codes.Internal, "failed to delete webhook delivery list of account")
+	}
+
+	return nil
+}
+
+func (w webhookDeliveryDataAccessor) WithDatabase(database Database) WebhookDeliveryDataAccessor {
+	return &webhookDeliveryDataAccessor{
+		database: database,
+		logger:   w.logger,
+	}
+}
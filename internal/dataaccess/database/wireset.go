@@ -10,4 +10,20 @@ var WireSet = wire.NewSet(
 	NewAccountPasswordDataAccessor,
 	NewDownloadTaskDataAccessor,
 	NewTokenPublicKeyDataAccessor,
+	NewRetentionRunDataAccessor,
+	NewDownloadTaskEventDataAccessor,
+	NewDownloadTaskTagDataAccessor,
+	NewDownloadTaskLabelDataAccessor,
+	NewOutboxMessageDataAccessor,
+	NewDeadLetterMessageDataAccessor,
+	NewWebhookDataAccessor,
+	NewWebhookDeliveryDataAccessor,
+	NewSessionDataAccessor,
+	NewAPIKeyDataAccessor,
+	NewAccountOIDCIdentityDataAccessor,
+	NewAccountTOTPDataAccessor,
+	NewAccountRecoveryCodeDataAccessor,
+	NewShareLinkDataAccessor,
+	NewWorkspaceDataAccessor,
+	NewWorkspaceMemberDataAccessor,
 )
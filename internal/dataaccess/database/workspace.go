@@ -0,0 +1,174 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"goload/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var (
+	TabNameWorkspaces    = goqu.T("workspaces")
+	ErrWorkspaceNotFound = status.Error(codes.NotFound, "workspace not found")
+)
+
+const (
+	ColNameWorkspaceID = "id"
+	// ColNameWorkspaceQuotaMaxDownloadTaskCount and ColNameWorkspaceQuotaMaxActiveDownloadTaskCount override the
+	// configured quota of the workspace when they are not NULL.
+	ColNameWorkspaceQuotaMaxDownloadTaskCount       = "quota_max_download_task_count"
+	ColNameWorkspaceQuotaMaxActiveDownloadTaskCount = "quota_max_active_download_task_count"
+)
+
+type Workspace struct {
+	ID                              uint64        `db:"id" goqu:"skipinsert,skipupdate"`
+	DisplayName                     string        `db:"display_name"`
+	QuotaMaxDownloadTaskCount       sql.NullInt64 `db:"quota_max_download_task_count"`
+	QuotaMaxActiveDownloadTaskCount sql.NullInt64 `db:"quota_max_active_download_task_count"`
+	CreatedAt                       time.Time     `db:"created_at"`
+}
+
+type WorkspaceDataAccessor interface {
+	CreateWorkspace(ctx context.Context, workspace Workspace) (uint64, error)
+	GetWorkspace(ctx context.Context, id uint64) (Workspace, error)
+	GetWorkspaceWithXLock(ctx context.Context, id uint64) (Workspace, error)
+	GetWorkspaceListByIDList(ctx context.Context, idList []uint64) ([]Workspace, error)
+	UpdateWorkspaceQuota(ctx context.Context, workspace Workspace) error
+	DeleteWorkspace(ctx context.Context, id uint64) error
+	WithDatabase(database Database) WorkspaceDataAccessor
+}
+
+type workspaceDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewWorkspaceDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) WorkspaceDataAccessor {
+	return &workspaceDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (w workspaceDataAccessor) CreateWorkspace(ctx context.Context, workspace Workspace) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Any("workspace", workspace))
+
+	result, err := w.database.
+		Insert(TabNameWorkspaces).
+		Rows(workspace).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create workspace")
+		return 0, status.Error(codes.Internal, "failed to create workspace")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (w workspaceDataAccessor) getWorkspace(ctx context.Context, id uint64, xLock bool) (Workspace, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("id", id))
+
+	query := w.database.
+		From(TabNameWorkspaces).
+		Where(goqu.Ex{ColNameWorkspaceID: id})
+	if xLock {
+		query = query.ForUpdate(goqu.Wait)
+	}
+
+	workspace := Workspace{}
+	found, err := query.ScanStructContext(ctx, &workspace)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get workspace by id")
+		return Workspace{}, status.Error(codes.Internal, "failed to get workspace by id")
+	}
+
+	if !found {
+		logger.Warn("cannot find workspace by id")
+		return Workspace{}, ErrWorkspaceNotFound
+	}
+
+	return workspace, nil
+}
+
+func (w workspaceDataAccessor) GetWorkspace(ctx context.Context, id uint64) (Workspace, error) {
+	return w.getWorkspace(ctx, id, false)
+}
+
+func (w workspaceDataAccessor) GetWorkspaceWithXLock(ctx context.Context, id uint64) (Workspace, error) {
+	return w.getWorkspace(ctx, id, true)
+}
+
+func (w workspaceDataAccessor) GetWorkspaceListByIDList(ctx context.Context, idList []uint64) ([]Workspace, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64s("id_list", idList))
+	if len(idList) == 0 {
+		return []Workspace{}, nil
+	}
+
+	workspaceList := []Workspace{}
+	err := w.database.
+		From(TabNameWorkspaces).
+		Where(goqu.C(ColNameWorkspaceID).In(idList)).
+		Order(goqu.C(ColNameWorkspaceID).Asc()).
+		ScanStructsContext(ctx, &workspaceList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get workspace list by id list")
+		return nil, status.Error(codes.Internal, "failed to get workspace list by id list")
+	}
+
+	return workspaceList, nil
+}
+
+func (w workspaceDataAccessor) UpdateWorkspaceQuota(ctx context.Context, workspace Workspace) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("id", workspace.ID))
+
+	if _, err := w.database.
+		Update(TabNameWorkspaces).
+		Set(goqu.Record{
+			ColNameWorkspaceQuotaMaxDownloadTaskCount:       workspace.QuotaMaxDownloadTaskCount,
+			ColNameWorkspaceQuotaMaxActiveDownloadTaskCount: workspace.QuotaMaxActiveDownloadTaskCount,
+		}).
+		Where(goqu.Ex{ColNameWorkspaceID: workspace.ID}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to update workspace")
+		return status.Error(codes.Internal, "failed to update workspace")
+	}
+
+	return nil
+}
+
+func (w workspaceDataAccessor) DeleteWorkspace(ctx context.Context, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("id", id))
+
+	if _, err := w.database.
+		Delete(TabNameWorkspaces).
+		Where(goqu.Ex{ColNameWorkspaceID: id}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete workspace")
+		return status.Error(codes.Internal, "failed to delete workspace")
+	}
+
+	return nil
+}
+
+func (w workspaceDataAccessor) WithDatabase(database Database) WorkspaceDataAccessor {
+	return &workspaceDataAccessor{
+		database: database,
+		logger:   w.logger,
+	}
+}
@@ -0,0 +1,204 @@
+package database
+
+import (
+	"context"
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"goload/internal/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var (
+	TabNameWorkspaceMembers    = goqu.T("workspace_members")
+	ErrWorkspaceMemberNotFound = status.Error(codes.NotFound, "workspace member not found")
+)
+
+const (
+	ColNameWorkspaceMemberOfWorkspaceID = "of_workspace_id"
+	ColNameWorkspaceMemberOfAccountID   = "of_account_id"
+	ColNameWorkspaceMemberRole          = "role"
+	ColNameWorkspaceMemberCreatedAt     = "created_at"
+)
+
+type WorkspaceMember struct {
+	OfWorkspaceID uint64    `db:"of_workspace_id"`
+	OfAccountID   uint64    `db:"of_account_id"`
+	Role          string    `db:"role"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+type WorkspaceMemberDataAccessor interface {
+	CreateWorkspaceMember(ctx context.Context, member WorkspaceMember) error
+	GetWorkspaceMember(ctx context.Context, workspaceID, accountID uint64) (WorkspaceMember, error)
+	GetWorkspaceMemberListOfWorkspace(ctx context.Context, workspaceID uint64) ([]WorkspaceMember, error)
+	GetWorkspaceMemberListOfAccount(ctx context.Context, accountID uint64) ([]WorkspaceMember, error)
+	UpdateWorkspaceMemberRole(ctx context.Context, workspaceID, accountID uint64, role string) error
+	DeleteWorkspaceMember(ctx context.Context, workspaceID, accountID uint64) error
+	DeleteWorkspaceMemberListOfAccount(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) WorkspaceMemberDataAccessor
+}
+
+type workspaceMemberDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewWorkspaceMemberDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) WorkspaceMemberDataAccessor {
+	return &workspaceMemberDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (w workspaceMemberDataAccessor) CreateWorkspaceMember(ctx context.Context, member WorkspaceMember) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Any("member", member))
+
+	if _, err := w.database.
+		Insert(TabNameWorkspaceMembers).
+		Rows(member).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to create workspace member")
+		return status.Error(codes.Internal, "failed to create workspace member")
+	}
+
+	return nil
+}
+
+func (w workspaceMemberDataAccessor) GetWorkspaceMember(
+	ctx context.Context,
+	workspaceID, accountID uint64,
+) (WorkspaceMember, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).
+		With(zap.Uint64("workspace_id", workspaceID)).
+		With(zap.Uint64("account_id", accountID))
+
+	member := WorkspaceMember{}
+	found, err := w.database.
+		From(TabNameWorkspaceMembers).
+		Where(goqu.Ex{
+			ColNameWorkspaceMemberOfWorkspaceID: workspaceID,
+			ColNameWorkspaceMemberOfAccountID:   accountID,
+		}).
+		ScanStructContext(ctx, &member)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get workspace member")
+		return WorkspaceMember{}, status.Error(codes.Internal, "failed to get workspace member")
+	}
+
+	if !found {
+		return WorkspaceMember{}, ErrWorkspaceMemberNotFound
+	}
+
+	return member, nil
+}
+
+func (w workspaceMemberDataAccessor) getWorkspaceMemberList(
+	ctx context.Context,
+	expression goqu.Ex,
+) ([]WorkspaceMember, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Any("expression", expression))
+
+	memberList := []WorkspaceMember{}
+	err := w.database.
+		From(TabNameWorkspaceMembers).
+		Where(expression).
+		Order(
+			goqu.C(ColNameWorkspaceMemberCreatedAt).Asc(),
+			goqu.C(ColNameWorkspaceMemberOfWorkspaceID).Asc(),
+			goqu.C(ColNameWorkspaceMemberOfAccountID).Asc(),
+		).
+		ScanStructsContext(ctx, &memberList)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get workspace member list")
+		return nil, status.Error(codes.Internal, "failed to get workspace member list")
+	}
+
+	return memberList, nil
+}
+
+func (w workspaceMemberDataAccessor) GetWorkspaceMemberListOfWorkspace(
+	ctx context.Context,
+	workspaceID uint64,
+) ([]WorkspaceMember, error) {
+	return w.getWorkspaceMemberList(ctx, goqu.Ex{ColNameWorkspaceMemberOfWorkspaceID: workspaceID})
+}
+
+func (w workspaceMemberDataAccessor) GetWorkspaceMemberListOfAccount(
+	ctx context.Context,
+	accountID uint64,
+) ([]WorkspaceMember, error) {
+	return w.getWorkspaceMemberList(ctx, goqu.Ex{ColNameWorkspaceMemberOfAccountID: accountID})
+}
+
+func (w workspaceMemberDataAccessor) UpdateWorkspaceMemberRole(
+	ctx context.Context,
+	workspaceID, accountID uint64,
+	role string,
+) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).
+		With(zap.Uint64("workspace_id", workspaceID)).
+		With(zap.Uint64("account_id", accountID))
+
+	if _, err := w.database.
+		Update(TabNameWorkspaceMembers).
+		Set(goqu.Record{ColNameWorkspaceMemberRole: role}).
+		Where(goqu.Ex{
+			ColNameWorkspaceMemberOfWorkspaceID: workspaceID,
+			ColNameWorkspaceMemberOfAccountID:   accountID,
+		}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to update workspace member")
+		return status.Error(codes.Internal, "failed to update workspace member")
+	}
+
+	return nil
+}
+
+func (w workspaceMemberDataAccessor) DeleteWorkspaceMember(ctx context.Context, workspaceID, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).
+		With(zap.Uint64("workspace_id", workspaceID)).
+		With(zap.Uint64("account_id", accountID))
+
+	if _, err := w.database.
+		Delete(TabNameWorkspaceMembers).
+		Where(goqu.Ex{
+			ColNameWorkspaceMemberOfWorkspaceID: workspaceID,
+			ColNameWorkspaceMemberOfAccountID:   accountID,
+		}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete workspace member")
+		return status.Error(codes.Internal, "failed to delete workspace member")
+	}
+
+	return nil
+}
+
+func (w workspaceMemberDataAccessor) DeleteWorkspaceMemberListOfAccount(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("account_id", accountID))
+
+	if _, err := w.database.
+		Delete(TabNameWorkspaceMembers).
+		Where(goqu.Ex{ColNameWorkspaceMemberOfAccountID: accountID}).
+		Executor().
+		ExecContext(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete workspace member list of account")
+		return status.Error(codes.Internal, "failed to delete workspace member list of account")
+	}
+
+	return nil
+}
+
+func (w workspaceMemberDataAccessor) WithDatabase(database Database) WorkspaceMemberDataAccessor {
+	return &workspaceMemberDataAccessor{
+		database: database,
+		logger:   w.logger,
+	}
+}
@@ -6,15 +6,27 @@ import (
 	"go.uber.org/zap"
 	"goload/internal/configs"
 	"goload/internal/utils"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"io"
 	"os"
 	"path"
+	"time"
 )
 
+type FileInfo struct {
+	FilePath string
+	Size     uint64
+	ModTime  time.Time
+}
+
 type Client interface {
 	Write(ctx context.Context, filePath string) (io.WriteCloser, error)
 	Read(ctx context.Context, filePath string) (io.ReadCloser, error)
+	// Open returns the file for random access, e.g. to serve byte ranges of it.
+	Open(ctx context.Context, filePath string) (io.ReadSeekCloser, FileInfo, error)
+	Delete(ctx context.Context, filePath string) error
+	List(ctx context.Context) ([]FileInfo, error)
 }
 
 type bufferFileReader struct {
@@ -52,11 +64,16 @@ func NewLocalFileClient(logger *zap.Logger, config configs.DownloadConfig) Clien
 func (l localFileClient) Write(ctx context.Context, filePath string) (io.WriteCloser, error) {
 	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("filePath", filePath))
 
+	if err := os.MkdirAll(l.downloadDirectory, os.ModePerm); err != nil {
+		logger.With(zap.Error(err)).Error("failed to create download directory")
+		return nil, status.Errorf(status.Code(err), "failed to create download directory: %v", err)
+	}
+
 	absolutFilePath := path.Join(l.downloadDirectory, filePath)
-	file, err := os.Open(absolutFilePath)
+	file, err := os.Create(absolutFilePath)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to open file")
-		return nil, status.Errorf(status.Code(err), "failed to open file: %v", err)
+		logger.With(zap.Error(err)).Error("failed to create file")
+		return nil, status.Errorf(status.Code(err), "failed to create file: %v", err)
 	}
 
 	return file, nil
@@ -73,3 +90,76 @@ func (l localFileClient) Read(ctx context.Context, filePath string) (io.ReadClos
 
 	return newBufferFileReader(file), nil
 }
+
+func (l localFileClient) Open(ctx context.Context, filePath string) (io.ReadSeekCloser, FileInfo, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("filePath", filePath))
+	absolutFilePath := path.Join(l.downloadDirectory, filePath)
+	file, err := os.Open(absolutFilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, FileInfo{}, status.Error(codes.NotFound, "file not found")
+		}
+
+		logger.With(zap.Error(err)).Error("failed to open file")
+		return nil, FileInfo{}, status.Errorf(status.Code(err), "failed to open file: %v", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to stat file")
+		// the stat error is the one worth returning
+		_ = file.Close()
+		return nil, FileInfo{}, status.Errorf(status.Code(err), "failed to stat file: %v", err)
+	}
+
+	return file, FileInfo{
+		FilePath: filePath,
+		Size:     uint64(info.Size()),
+		ModTime:  info.ModTime(),
+	}, nil
+}
+
+func (l localFileClient) Delete(ctx context.Context, filePath string) error {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("filePath", filePath))
+	absolutFilePath := path.Join(l.downloadDirectory, filePath)
+	if err := os.Remove(absolutFilePath); err != nil && !os.IsNotExist(err) {
+		logger.With(zap.Error(err)).Error("failed to delete file")
+		return status.Errorf(status.Code(err), "failed to delete file: %v", err)
+	}
+
+	return nil
+}
+
+func (l localFileClient) List(ctx context.Context) ([]FileInfo, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+	entries, err := os.ReadDir(l.downloadDirectory)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []FileInfo{}, nil
+		}
+
+		logger.With(zap.Error(err)).Error("failed to read download directory")
+		return nil, status.Errorf(status.Code(err), "failed to read download directory: %v", err)
+	}
+
+	fileInfoList := make([]FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		info, infoErr := entry.Info()
+		if infoErr != nil {
+			logger.With(zap.Error(infoErr)).With(zap.String("filePath", entry.Name())).Warn("failed to stat file")
+			continue
+		}
+
+		fileInfoList = append(fileInfoList, FileInfo{
+			FilePath: entry.Name(),
+			Size:     uint64(info.Size()),
+			ModTime:  info.ModTime(),
+		})
+	}
+
+	return fileInfoList, nil
+}
@@ -2,17 +2,21 @@ package consumer
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"goload/internal/configs"
+	"goload/internal/dataaccess/mq/producer"
 	"goload/internal/utils"
+	"strings"
+	"time"
 
-	"os"
-	"os/signal"
-
-	"github.com/IBM/sarama"
 	"go.uber.org/zap"
 )
 
+const (
+	consumeRetryBackoff = 5 * time.Second
+)
+
 type HandlerFunc func(ctx context.Context, queueName string, payload []byte) error
 
 type Consumer interface {
@@ -20,75 +24,186 @@ type Consumer interface {
 	Start(ctx context.Context) error
 }
 
-type consumer struct {
-	saramaConsumer            sarama.Consumer
-	queueNameToHandlerFuncMap map[string]HandlerFunc
-	logger                    *zap.Logger
+func NewConsumer(
+	mqConfig configs.MQ,
+	producerClient producer.Client,
+	inMemoryBroker *producer.InMemoryBroker,
+	logger *zap.Logger,
+) (Consumer, error) {
+	messageHandler, err := newMessageHandler(mqConfig, producerClient, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	switch mqConfig.Type {
+	case configs.MQTypeKafka, "":
+		return newKafkaConsumer(mqConfig, messageHandler)
+	case configs.MQTypeInMemory:
+		return newInMemoryConsumer(inMemoryBroker, messageHandler), nil
+	case configs.MQTypeRedis:
+		return newRedisConsumer(mqConfig, messageHandler)
+	case configs.MQTypeNATS:
+		return newNATSConsumer(mqConfig, messageHandler)
+	default:
+		return nil, fmt.Errorf("unsupported mq type: %s", mqConfig.Type)
+	}
 }
 
-func newSaramaConfig(mqConfig configs.MQ) *sarama.Config {
-	saramaConfig := sarama.NewConfig()
-	saramaConfig.ClientID = mqConfig.ClientID
-	saramaConfig.Metadata.Full = true
-	return saramaConfig
+type queueRetry struct {
+	maxAttempts    uint64
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
 }
 
-func NewConsumer(
+func newQueueRetry(retryConfig configs.MQRetry) (queueRetry, error) {
+	initialBackoff, err := retryConfig.GetInitialBackoffDuration()
+	if err != nil {
+		return queueRetry{}, fmt.Errorf("failed to parse initial_backoff: %w", err)
+	}
+
+	maxBackoff, err := retryConfig.GetMaxBackoffDuration()
+	if err != nil {
+		return queueRetry{}, fmt.Errorf("failed to parse max_backoff: %w", err)
+	}
+
+	return queueRetry{
+		maxAttempts:    retryConfig.MaxAttempts,
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+	}, nil
+}
+
+func wait(ctx context.Context, duration time.Duration) error {
+	select {
+	case <-time.After(duration):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// messageHandler is shared by every backend, it keeps the registered handlers and retries and dead-letters
+// messages they fail to handle.
+type messageHandler struct {
+	producerClient            producer.Client
+	defaultQueueRetry         queueRetry
+	queueNameToQueueRetryMap  map[string]queueRetry
+	queueNameToHandlerFuncMap map[string]HandlerFunc
+	logger                    *zap.Logger
+}
+
+func newMessageHandler(
 	mqConfig configs.MQ,
+	producerClient producer.Client,
 	logger *zap.Logger,
-) (Consumer, error) {
-	saramaConsumer, err := sarama.NewConsumer(mqConfig.Addresses, newSaramaConfig(mqConfig))
+) (*messageHandler, error) {
+	defaultQueueRetry, err := newQueueRetry(mqConfig.Retry)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create sarama consumer: %w", err)
+		return nil, err
 	}
 
-	return &consumer{
-		saramaConsumer:            saramaConsumer,
-		logger:                    logger,
+	queueNameToQueueRetryMap := make(map[string]queueRetry)
+	for queueName, retryConfig := range mqConfig.QueueRetry {
+		queueNameToQueueRetryMap[queueName], err = newQueueRetry(retryConfig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid retry of queue %s: %w", queueName, err)
+		}
+	}
+
+	return &messageHandler{
+		producerClient:            producerClient,
+		defaultQueueRetry:         defaultQueueRetry,
+		queueNameToQueueRetryMap:  queueNameToQueueRetryMap,
 		queueNameToHandlerFuncMap: make(map[string]HandlerFunc),
+		logger:                    logger,
 	}, nil
 }
 
-func (c *consumer) RegisterHandler(queueName string, handlerFunc HandlerFunc) {
-	c.queueNameToHandlerFuncMap[queueName] = handlerFunc
+func (m *messageHandler) RegisterHandler(queueName string, handlerFunc HandlerFunc) {
+	m.queueNameToHandlerFuncMap[queueName] = handlerFunc
 }
 
-func (c consumer) consumer(queueName string, handlerFunc HandlerFunc, exitSignalChannel chan os.Signal) error {
-	logger := c.logger.With(zap.String("queue_name", queueName))
+func (m messageHandler) getQueueRetry(queueName string) queueRetry {
+	if retry, ok := m.queueNameToQueueRetryMap[queueName]; ok {
+		return retry
+	}
 
-	partitionConsumer, err := c.saramaConsumer.ConsumePartition(queueName, 0, sarama.OffsetOldest)
+	return m.defaultQueueRetry
+}
+
+// deadLetter publishes the message to the dead letter queue of its queue, retrying until it succeeds since the
+// message would otherwise be lost once it is acknowledged.
+func (m messageHandler) deadLetter(ctx context.Context, deadLetter producer.DeadLetter, retry queueRetry) error {
+	logger := utils.LoggerWithContext(ctx, m.logger).With(zap.String("queue_name", deadLetter.QueueName))
+
+	deadLetterBytes, err := json.Marshal(deadLetter)
 	if err != nil {
-		return fmt.Errorf("failed to create sarama partition consumer: %w", err)
+		logger.With(zap.Error(err)).Error("failed to marshal dead letter")
+		return err
 	}
 
+	deadLetterQueueName := producer.GetDeadLetterQueueName(deadLetter.QueueName)
 	for {
-		select {
-		case message := <-partitionConsumer.Messages():
-			if err := handlerFunc(context.Background(), queueName, message.Value); err != nil {
-				logger.With(zap.Error(err)).Error("failed to handle message")
-			}
-		case <-exitSignalChannel:
+		err = m.producerClient.Produce(ctx, deadLetterQueueName, deadLetterBytes)
+		if err == nil {
+			logger.Warn("message dead-lettered")
 			return nil
 		}
+
+		logger.With(zap.Error(err)).Error("failed to publish dead letter, will retry")
+		if err = wait(ctx, retry.maxBackoff); err != nil {
+			return err
+		}
 	}
 }
 
-func (c consumer) Start(ctx context.Context) error {
-	logger := utils.LoggerWithContext(ctx, c.logger)
-
-	signals := make(chan os.Signal, 1)
-	signal.Notify(signals, os.Interrupt)
+// handleMessage retries a failed message with backoff instead of skipping it, because acknowledging a later
+// message can also acknowledge the failed one on some backends. A message still failing after the queue's max
+// attempts is dead-lettered, except for messages of dead letter queues themselves. It only returns an error when
+// ctx is done before the message is handled or dead-lettered, the message must not be acknowledged then.
+func (m messageHandler) handleMessage(ctx context.Context, queueName string, payload []byte) error {
+	logger := utils.LoggerWithContext(ctx, m.logger).With(zap.String("queue_name", queueName))
 
-	for queueName, handlerFunc := range c.queueNameToHandlerFuncMap {
-		go func(queueName string, handlerFunc HandlerFunc) {
-			if err := c.consumer(queueName, handlerFunc, signals); err != nil {
-				logger.With(zap.String("queue_name", queueName)).
-					With(zap.Error(err)).
-					Error("failed to consume")
-			}
-		}(queueName, handlerFunc)
+	handlerFunc, ok := m.queueNameToHandlerFuncMap[queueName]
+	if !ok {
+		return fmt.Errorf("no handler registered for queue %s", queueName)
 	}
 
-	<-signals
-	return nil
+	var (
+		retry         = m.getQueueRetry(queueName)
+		canDeadLetter = retry.maxAttempts > 0 && !strings.HasSuffix(queueName, producer.DeadLetterQueueNameSuffix)
+		backoff       = retry.initialBackoff
+		attemptList   = make([]producer.DeadLetterAttempt, 0)
+	)
+
+	for attempt := uint64(1); ; attempt++ {
+		err := handlerFunc(ctx, queueName, payload)
+		if err == nil {
+			return nil
+		}
+
+		logger.With(zap.Error(err)).With(zap.Uint64("attempt", attempt)).Error("failed to handle message")
+		if canDeadLetter {
+			attemptList = append(attemptList, producer.DeadLetterAttempt{
+				Attempt:  attempt,
+				Error:    err.Error(),
+				FailedAt: time.Now(),
+			})
+		}
+
+		if canDeadLetter && attempt >= retry.maxAttempts {
+			return m.deadLetter(ctx, producer.DeadLetter{
+				QueueName:      queueName,
+				Payload:        payload,
+				AttemptList:    attemptList,
+				DeadLetteredAt: time.Now(),
+			}, retry)
+		}
+
+		if err = wait(ctx, backoff); err != nil {
+			return err
+		}
+
+		backoff = min(backoff*2, retry.maxBackoff)
+	}
 }
@@ -0,0 +1,111 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/mq/producer"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestInMemoryConsumerDeadLettersFailingMessage(t *testing.T) {
+	testCaseList := []struct {
+		name                  string
+		failedAttemptCount    int64
+		maxAttempts           uint64
+		expectedAttemptCount  int64
+		expectDeadLetter      bool
+		expectedDeadLetterLen int
+	}{
+		{name: "handled on first attempt", maxAttempts: 3, expectedAttemptCount: 1},
+		{name: "handled after retries", failedAttemptCount: 2, maxAttempts: 3, expectedAttemptCount: 3},
+		{
+			name: "dead-lettered after max attempts", failedAttemptCount: 10, maxAttempts: 3,
+			expectedAttemptCount: 3, expectDeadLetter: true, expectedDeadLetterLen: 3,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			mqConfig := configs.MQ{
+				Type: configs.MQTypeInMemory,
+				Retry: configs.MQRetry{
+					MaxAttempts:    testCase.maxAttempts,
+					InitialBackoff: "1ms",
+					MaxBackoff:     "1ms",
+				},
+			}
+			broker := producer.NewInMemoryBroker()
+			producerClient := producer.NewInMemoryClient(broker, zap.NewNop())
+			consumer, err := NewConsumer(mqConfig, producerClient, broker, zap.NewNop())
+			if err != nil {
+				t.Fatalf("failed to create consumer: %v", err)
+			}
+
+			var attemptCount atomic.Int64
+			handled := make(chan struct{})
+			consumer.RegisterHandler("tasks", func(ctx context.Context, queueName string, payload []byte) error {
+				if attemptCount.Add(1) <= testCase.failedAttemptCount {
+					return errors.New("poison message")
+				}
+
+				close(handled)
+				return nil
+			})
+
+			deadLetterChannel := make(chan []byte, 1)
+			consumer.RegisterHandler(producer.GetDeadLetterQueueName("tasks"), func(
+				ctx context.Context, queueName string, payload []byte,
+			) error {
+				deadLetterChannel <- payload
+				return nil
+			})
+
+			// messages produced before the consumer starts are kept for it
+			if err = producerClient.Produce(ctx, "tasks", []byte("payload")); err != nil {
+				t.Fatalf("failed to produce message: %v", err)
+			}
+
+			go func() { _ = consumer.Start(ctx) }()
+
+			if !testCase.expectDeadLetter {
+				select {
+				case <-handled:
+				case <-ctx.Done():
+					t.Fatal("message was not handled")
+				}
+			} else {
+				select {
+				case deadLetterBytes := <-deadLetterChannel:
+					var deadLetter producer.DeadLetter
+					if err = json.Unmarshal(deadLetterBytes, &deadLetter); err != nil {
+						t.Fatalf("failed to unmarshal dead letter: %v", err)
+					}
+
+					if deadLetter.QueueName != "tasks" || string(deadLetter.Payload) != "payload" {
+						t.Fatalf("unexpected dead letter %+v", deadLetter)
+					}
+
+					if len(deadLetter.AttemptList) != testCase.expectedDeadLetterLen {
+						t.Fatalf("expected %d attempts, got %d", testCase.expectedDeadLetterLen,
+							len(deadLetter.AttemptList))
+					}
+				case <-ctx.Done():
+					t.Fatal("message was not dead-lettered")
+				}
+			}
+
+			if attemptCount.Load() != testCase.expectedAttemptCount {
+				t.Fatalf("expected %d attempts, got %d", testCase.expectedAttemptCount, attemptCount.Load())
+			}
+		})
+	}
+}
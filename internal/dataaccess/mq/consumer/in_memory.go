@@ -0,0 +1,51 @@
+package consumer
+
+import (
+	"context"
+	"goload/internal/dataaccess/mq/producer"
+	"sync"
+
+	"github.com/samber/lo"
+)
+
+type inMemoryConsumer struct {
+	*messageHandler
+	inMemoryBroker *producer.InMemoryBroker
+}
+
+func newInMemoryConsumer(inMemoryBroker *producer.InMemoryBroker, messageHandler *messageHandler) Consumer {
+	return &inMemoryConsumer{
+		messageHandler: messageHandler,
+		inMemoryBroker: inMemoryBroker,
+	}
+}
+
+func (c inMemoryConsumer) consume(ctx context.Context, queueName string) {
+	queue := c.inMemoryBroker.GetQueue(queueName)
+	for {
+		select {
+		case payload := <-queue:
+			if err := c.handleMessage(ctx, queueName, payload); err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c inMemoryConsumer) Start(ctx context.Context) error {
+	c.inMemoryBroker.Subscribe(lo.Keys(c.queueNameToHandlerFuncMap))
+
+	waitGroup := sync.WaitGroup{}
+	for queueName := range c.queueNameToHandlerFuncMap {
+		waitGroup.Add(1)
+		go func(queueName string) {
+			defer waitGroup.Done()
+			c.consume(ctx, queueName)
+		}(queueName)
+	}
+
+	waitGroup.Wait()
+	return nil
+}
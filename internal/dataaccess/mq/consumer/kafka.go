@@ -0,0 +1,90 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goload/internal/configs"
+	"goload/internal/utils"
+
+	"github.com/IBM/sarama"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+type kafkaConsumer struct {
+	*messageHandler
+	saramaConsumerGroup sarama.ConsumerGroup
+}
+
+func newSaramaConfig(mqConfig configs.MQ) *sarama.Config {
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.ClientID = mqConfig.ClientID
+	saramaConfig.Metadata.Full = true
+	saramaConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+	saramaConfig.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{
+		sarama.NewBalanceStrategyRange(),
+	}
+	return saramaConfig
+}
+
+func newKafkaConsumer(mqConfig configs.MQ, messageHandler *messageHandler) (Consumer, error) {
+	saramaConsumerGroup, err := sarama.NewConsumerGroup(
+		mqConfig.Addresses, mqConfig.ConsumerGroupID, newSaramaConfig(mqConfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sarama consumer group: %w", err)
+	}
+
+	return &kafkaConsumer{
+		messageHandler:      messageHandler,
+		saramaConsumerGroup: saramaConsumerGroup,
+	}, nil
+}
+
+func (c kafkaConsumer) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (c kafkaConsumer) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (c kafkaConsumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case message, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+
+			if err := c.handleMessage(session.Context(), message.Topic, message.Value); err != nil {
+				return nil
+			}
+
+			session.MarkMessage(message, "")
+		case <-session.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (c kafkaConsumer) Start(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+
+	queueNameList := lo.Keys(c.queueNameToHandlerFuncMap)
+	for {
+		// Consume returns on every rebalance, so it is called again until the context is cancelled.
+		if err := c.saramaConsumerGroup.Consume(ctx, queueNameList, c); err != nil {
+			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+				return nil
+			}
+
+			logger.With(zap.Error(err)).Error("failed to consume")
+			_ = wait(ctx, consumeRetryBackoff)
+		}
+
+		if ctx.Err() != nil {
+			return c.saramaConsumerGroup.Close()
+		}
+	}
+}
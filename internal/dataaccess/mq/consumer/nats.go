@@ -0,0 +1,138 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/mq/producer"
+	"goload/internal/utils"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+)
+
+const (
+	natsAckWait = 30 * time.Second
+)
+
+type natsConsumer struct {
+	*messageHandler
+	conn        *nats.Conn
+	jetStream   jetstream.JetStream
+	durableName string
+}
+
+func newNATSConsumer(mqConfig configs.MQ, messageHandler *messageHandler) (Consumer, error) {
+	conn, err := producer.NewNATSConn(mqConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	jetStream, err := jetstream.New(conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create nats jetstream: %w", err)
+	}
+
+	return &natsConsumer{
+		messageHandler: messageHandler,
+		conn:           conn,
+		jetStream:      jetStream,
+		durableName:    strings.ReplaceAll(mqConfig.ConsumerGroupID, ".", "_"),
+	}, nil
+}
+
+// keepInProgress stops JetStream from redelivering a message while it is still being handled, handlers such as
+// downloads can take much longer than the ack wait.
+func keepInProgress(message jetstream.Msg) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(natsAckWait / 2)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				_ = message.InProgress()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
+
+func (c natsConsumer) consume(ctx context.Context, queueName string) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("queue_name", queueName))
+
+	stream, err := producer.EnsureNATSStream(ctx, c.jetStream, queueName)
+	if err != nil {
+		return fmt.Errorf("failed to create nats stream: %w", err)
+	}
+
+	natsConsumer, err := stream.CreateOrUpdateConsumer(ctx, jetstream.ConsumerConfig{
+		Durable:   c.durableName,
+		AckPolicy: jetstream.AckExplicitPolicy,
+		AckWait:   natsAckWait,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create nats consumer: %w", err)
+	}
+
+	// Messages are pulled one at a time so none waits in a local buffer past its ack wait.
+	messageIterator, err := natsConsumer.Messages(jetstream.PullMaxMessages(1))
+	if err != nil {
+		return fmt.Errorf("failed to pull nats messages: %w", err)
+	}
+
+	go func() {
+		<-ctx.Done()
+		messageIterator.Stop()
+	}()
+
+	for {
+		message, err := messageIterator.Next()
+		if err != nil {
+			if errors.Is(err, jetstream.ErrMsgIteratorClosed) {
+				return nil
+			}
+
+			logger.With(zap.Error(err)).Error("failed to get next nats message")
+			continue
+		}
+
+		stopKeepingInProgress := keepInProgress(message)
+		err = c.handleMessage(ctx, queueName, message.Data())
+		stopKeepingInProgress()
+		if err != nil {
+			return nil
+		}
+
+		if err = message.Ack(); err != nil {
+			logger.With(zap.Error(err)).Error("failed to acknowledge message")
+		}
+	}
+}
+
+func (c natsConsumer) Start(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+
+	waitGroup := sync.WaitGroup{}
+	for queueName := range c.queueNameToHandlerFuncMap {
+		waitGroup.Add(1)
+		go func(queueName string) {
+			defer waitGroup.Done()
+			if err := c.consume(ctx, queueName); err != nil {
+				logger.With(zap.String("queue_name", queueName)).With(zap.Error(err)).Error("failed to consume")
+			}
+		}(queueName)
+	}
+
+	waitGroup.Wait()
+	return c.conn.Drain()
+}
@@ -0,0 +1,313 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/mq/producer"
+	"goload/internal/utils"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+const (
+	redisReadCount = 10
+	redisReadBlock = 5 * time.Second
+	// redisPendingID reads the messages delivered to this consumer but never acknowledged, redisNewID reads
+	// messages never delivered to any consumer of the group.
+	redisPendingID = "0"
+	redisNewID     = ">"
+	// redisClaimStartID starts XAUTOCLAIM from the beginning of the pending entries list, it is also returned once
+	// the whole list has been scanned.
+	redisClaimStartID = "0-0"
+)
+
+type redisConsumer struct {
+	*messageHandler
+	redisClient      *redis.Client
+	groupID          string
+	consumerName     string
+	claimMinIdleTime time.Duration
+	claimInterval    time.Duration
+}
+
+func newRedisConsumer(mqConfig configs.MQ, messageHandler *messageHandler) (Consumer, error) {
+	if len(mqConfig.Addresses) != 1 {
+		return nil, errors.New("redis message queue requires exactly one address")
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hostname: %w", err)
+	}
+
+	claimMinIdleTime, err := mqConfig.Redis.GetClaimMinIdleTimeDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse redis claim_min_idle_time: %w", err)
+	}
+
+	claimInterval, err := mqConfig.Redis.GetClaimIntervalDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse redis claim_interval: %w", err)
+	}
+
+	// a message being handled is claimed again every half of claimMinIdleTime, XCLAIM takes milliseconds
+	if claimMinIdleTime < 2*time.Millisecond || claimInterval <= 0 {
+		return nil, errors.New("redis claim_min_idle_time and claim_interval must be positive")
+	}
+
+	return &redisConsumer{
+		messageHandler: messageHandler,
+		redisClient: redis.NewClient(&redis.Options{
+			Addr:     mqConfig.Addresses[0],
+			Username: mqConfig.Username,
+			Password: mqConfig.Password,
+		}),
+		groupID: mqConfig.ConsumerGroupID,
+		// The name stays the same across restarts, so the messages left pending by a crash are read again.
+		// Those of a consumer that does not come back under the same name are claimed by claim.
+		consumerName:     fmt.Sprintf("%s-%s", mqConfig.ClientID, hostname),
+		claimMinIdleTime: claimMinIdleTime,
+		claimInterval:    claimInterval,
+	}, nil
+}
+
+// keepMessageListClaimed claims the messages again until ctx is done, so that their idle time never reaches
+// claimMinIdleTime and other consumers leave them alone however long the batch takes to handle.
+func (c redisConsumer) keepMessageListClaimed(ctx context.Context, queueName string, messageList []redis.XMessage) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("queue_name", queueName))
+	idList := make([]string, 0, len(messageList))
+	for _, message := range messageList {
+		idList = append(idList, message.ID)
+	}
+
+	ticker := time.NewTicker(c.claimMinIdleTime / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		err := c.redisClient.XClaimJustID(ctx, &redis.XClaimArgs{
+			Stream:   queueName,
+			Group:    c.groupID,
+			Consumer: c.consumerName,
+			Messages: idList,
+		}).Err()
+		if err != nil && ctx.Err() == nil {
+			logger.With(zap.Error(err)).Warn("failed to keep messages claimed")
+		}
+	}
+}
+
+// handleAndAcknowledge handles the messages in order and returns false when ctx is done before they are all
+// handled, the rest are left pending then.
+func (c redisConsumer) handleAndAcknowledge(ctx context.Context, queueName string, messageList []redis.XMessage) bool {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("queue_name", queueName))
+
+	claimCtx, stopClaiming := context.WithCancel(ctx)
+	defer stopClaiming()
+	go c.keepMessageListClaimed(claimCtx, queueName, messageList)
+
+	for _, message := range messageList {
+		// a message trimmed from the stream while pending comes back without its values
+		payload, ok := message.Values[producer.RedisStreamPayloadField].(string)
+		if !ok {
+			logger.With(zap.String("id", message.ID)).Error("redis stream message has no payload, skipping")
+		} else if err := c.handleMessage(ctx, queueName, []byte(payload)); err != nil {
+			return false
+		}
+
+		err := c.redisClient.XAck(context.WithoutCancel(ctx), queueName, c.groupID, message.ID).Err()
+		if err != nil {
+			logger.With(zap.Error(err)).With(zap.String("id", message.ID)).Error("failed to acknowledge message")
+		}
+	}
+
+	return true
+}
+
+// parseRedisXAutoClaimReply reads the reply of XAUTOCLAIM. go-redis v8 only knows the two items reply of Redis 6,
+// Redis 7 adds a third one with the IDs of the messages that were deleted, which are dropped from the pending entries
+// list by Redis itself.
+func parseRedisXAutoClaimReply(reply []any) ([]redis.XMessage, string, error) {
+	if len(reply) < 2 {
+		return nil, "", fmt.Errorf("unexpected xautoclaim reply with %d items", len(reply))
+	}
+
+	nextStartID, ok := reply[0].(string)
+	if !ok {
+		return nil, "", errors.New("unexpected xautoclaim next start id")
+	}
+
+	itemList, ok := reply[1].([]any)
+	if !ok {
+		return nil, "", errors.New("unexpected xautoclaim message list")
+	}
+
+	messageList := make([]redis.XMessage, 0, len(itemList))
+	for _, item := range itemList {
+		entry, ok := item.([]any)
+		if !ok || len(entry) != 2 {
+			return nil, "", errors.New("unexpected xautoclaim message")
+		}
+
+		id, ok := entry[0].(string)
+		if !ok {
+			return nil, "", errors.New("unexpected xautoclaim message id")
+		}
+
+		// Redis 6 returns the messages deleted from the stream with no fields
+		message := redis.XMessage{ID: id, Values: make(map[string]any)}
+		fieldList, _ := entry[1].([]any)
+		for i := 0; i+1 < len(fieldList); i += 2 {
+			if field, ok := fieldList[i].(string); ok {
+				message.Values[field] = fieldList[i+1]
+			}
+		}
+
+		messageList = append(messageList, message)
+	}
+
+	return messageList, nextStartID, nil
+}
+
+func (c redisConsumer) autoClaim(
+	ctx context.Context,
+	queueName string,
+	startID string,
+) ([]redis.XMessage, string, error) {
+	reply, err := c.redisClient.Do(
+		ctx, "xautoclaim", queueName, c.groupID, c.consumerName, c.claimMinIdleTime.Milliseconds(), startID,
+		"count", redisReadCount,
+	).Slice()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return parseRedisXAutoClaimReply(reply)
+}
+
+// claim takes over the messages left pending for longer than claimMinIdleTime by other consumers of the group, and
+// handles them.
+func (c redisConsumer) claim(ctx context.Context, queueName string) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("queue_name", queueName))
+
+	ticker := time.NewTicker(c.claimInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		startID := redisClaimStartID
+		for {
+			messageList, nextStartID, err := c.autoClaim(ctx, queueName, startID)
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.With(zap.Error(err)).Error("failed to claim pending messages")
+				}
+
+				break
+			}
+
+			if len(messageList) > 0 {
+				logger.With(zap.Int("count", len(messageList))).Warn("claimed messages of another consumer")
+				if !c.handleAndAcknowledge(ctx, queueName, messageList) {
+					return
+				}
+			}
+
+			if nextStartID == redisClaimStartID {
+				break
+			}
+
+			startID = nextStartID
+		}
+	}
+}
+
+func (c redisConsumer) consume(ctx context.Context, queueName string) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("queue_name", queueName))
+
+	err := c.redisClient.XGroupCreateMkStream(ctx, queueName, c.groupID, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("failed to create redis consumer group: %w", err)
+	}
+
+	readID := redisPendingID
+	for {
+		streamList, err := c.redisClient.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    c.groupID,
+			Consumer: c.consumerName,
+			Streams:  []string{queueName, readID},
+			Count:    redisReadCount,
+			Block:    redisReadBlock,
+		}).Result()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			if !errors.Is(err, redis.Nil) {
+				logger.With(zap.Error(err)).Error("failed to read from redis stream")
+				_ = wait(ctx, consumeRetryBackoff)
+			}
+
+			continue
+		}
+
+		messageList := make([]redis.XMessage, 0)
+		for _, stream := range streamList {
+			messageList = append(messageList, stream.Messages...)
+		}
+
+		if readID != redisNewID && len(messageList) == 0 {
+			readID = redisNewID
+			continue
+		}
+
+		if readID != redisNewID {
+			readID = messageList[len(messageList)-1].ID
+		}
+
+		if !c.handleAndAcknowledge(ctx, queueName, messageList) {
+			return nil
+		}
+	}
+}
+
+func (c redisConsumer) Start(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+
+	waitGroup := sync.WaitGroup{}
+	for queueName := range c.queueNameToHandlerFuncMap {
+		waitGroup.Add(2)
+		go func(queueName string) {
+			defer waitGroup.Done()
+			if err := c.consume(ctx, queueName); err != nil {
+				logger.With(zap.String("queue_name", queueName)).With(zap.Error(err)).Error("failed to consume")
+			}
+		}(queueName)
+
+		go func(queueName string) {
+			defer waitGroup.Done()
+			c.claim(ctx, queueName)
+		}(queueName)
+	}
+
+	waitGroup.Wait()
+	return c.redisClient.Close()
+}
@@ -0,0 +1,74 @@
+package consumer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-redis/redis/v8"
+)
+
+func TestParseRedisXAutoClaimReply(t *testing.T) {
+	messageItemList := []any{
+		[]any{"1-0", []any{"payload", "first"}},
+		// a message deleted from the stream while pending, as returned by Redis 6
+		[]any{"2-0", nil},
+	}
+	expectedMessageList := []redis.XMessage{
+		{ID: "1-0", Values: map[string]any{"payload": "first"}},
+		{ID: "2-0", Values: map[string]any{}},
+	}
+
+	testCaseList := []struct {
+		name                string
+		reply               []any
+		expectedMessageList []redis.XMessage
+		expectedNextStartID string
+		expectErr           bool
+	}{
+		{
+			name:                "redis 6 reply",
+			reply:               []any{"3-0", messageItemList},
+			expectedMessageList: expectedMessageList,
+			expectedNextStartID: "3-0",
+		},
+		{
+			name:                "redis 7 reply with deleted ids",
+			reply:               []any{"0-0", messageItemList, []any{"4-0"}},
+			expectedMessageList: expectedMessageList,
+			expectedNextStartID: "0-0",
+		},
+		{
+			name:                "nothing to claim",
+			reply:               []any{"0-0", []any{}, []any{}},
+			expectedMessageList: []redis.XMessage{},
+			expectedNextStartID: "0-0",
+		},
+		{name: "too short", reply: []any{"0-0"}, expectErr: true},
+		{name: "malformed message", reply: []any{"0-0", []any{"1-0"}}, expectErr: true},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			messageList, nextStartID, err := parseRedisXAutoClaimReply(testCase.reply)
+			if testCase.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("failed to parse reply: %v", err)
+			}
+
+			if nextStartID != testCase.expectedNextStartID {
+				t.Errorf("expected next start id %q, got %q", testCase.expectedNextStartID, nextStartID)
+			}
+
+			if !reflect.DeepEqual(messageList, testCase.expectedMessageList) {
+				t.Errorf("expected messages %+v, got %+v", testCase.expectedMessageList, messageList)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package producer
+
+import "time"
+
+const (
+	DeadLetterQueueNameSuffix = ".dlq"
+)
+
+func GetDeadLetterQueueName(queueName string) string {
+	return queueName + DeadLetterQueueNameSuffix
+}
+
+type DeadLetterAttempt struct {
+	Attempt  uint64    `json:"attempt"`
+	Error    string    `json:"error"`
+	FailedAt time.Time `json:"failed_at"`
+}
+
+// DeadLetter wraps a message that could not be handled after all of its attempts, it is published to the dead
+// letter queue of the message's queue.
+type DeadLetter struct {
+	QueueName      string              `json:"queue_name"`
+	Payload        []byte              `json:"payload"`
+	AttemptList    []DeadLetterAttempt `json:"attempt_list"`
+	DeadLetteredAt time.Time           `json:"dead_lettered_at"`
+}
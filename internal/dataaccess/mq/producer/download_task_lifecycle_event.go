@@ -0,0 +1,14 @@
+package producer
+
+const (
+	MessageQueueDownloadTaskStarted   = "download_task_started"
+	MessageQueueDownloadTaskProgress  = "download_task_progress"
+	MessageQueueDownloadTaskSucceeded = "download_task_succeeded"
+	MessageQueueDownloadTaskFailed    = "download_task_failed"
+	MessageQueueDownloadTaskCancelled = "download_task_cancelled"
+	MessageQueueDownloadTaskDeleted   = "download_task_deleted"
+
+	// DownloadTaskLifecycleEventSchemaVersion is the schema_version of the go_load.v1.DownloadTaskLifecycleEvent
+	// messages published to the queues above, encoded with protojson.
+	DownloadTaskLifecycleEventSchemaVersion = 1
+)
@@ -0,0 +1,101 @@
+package producer
+
+import (
+	"context"
+	"goload/internal/utils"
+	"sync"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	inMemoryQueueCapacity = 4096
+)
+
+// InMemoryBroker holds the queues of the in_memory message queue type. The same instance is shared by the
+// producer and the consumer of the process, messages do not survive a restart.
+type InMemoryBroker struct {
+	queueNameToChannelMap map[string]chan []byte
+	// subscribedQueueNameSet is nil until the consumer starts, messages of every queue are kept until then.
+	subscribedQueueNameSet map[string]struct{}
+	mutex                  *sync.Mutex
+}
+
+func NewInMemoryBroker() *InMemoryBroker {
+	return &InMemoryBroker{
+		queueNameToChannelMap: make(map[string]chan []byte),
+		mutex:                 new(sync.Mutex),
+	}
+}
+
+func (b *InMemoryBroker) GetQueue(queueName string) chan []byte {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	channel, ok := b.queueNameToChannelMap[queueName]
+	if !ok {
+		channel = make(chan []byte, inMemoryQueueCapacity)
+		b.queueNameToChannelMap[queueName] = channel
+	}
+
+	return channel
+}
+
+// Subscribe is called once by the consumer with every queue it consumes. Messages of other queues are dropped from
+// then on, since nothing would ever take them out of the queue.
+func (b *InMemoryBroker) Subscribe(queueNameList []string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.subscribedQueueNameSet = make(map[string]struct{}, len(queueNameList))
+	for _, queueName := range queueNameList {
+		b.subscribedQueueNameSet[queueName] = struct{}{}
+	}
+}
+
+func (b *InMemoryBroker) isSubscribed(queueName string) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.subscribedQueueNameSet == nil {
+		return true
+	}
+
+	_, ok := b.subscribedQueueNameSet[queueName]
+	return ok
+}
+
+type inMemoryClient struct {
+	inMemoryBroker *InMemoryBroker
+	logger         *zap.Logger
+}
+
+func NewInMemoryClient(
+	inMemoryBroker *InMemoryBroker,
+	logger *zap.Logger,
+) Client {
+	return &inMemoryClient{
+		inMemoryBroker: inMemoryBroker,
+		logger:         logger,
+	}
+}
+
+func (c inMemoryClient) Produce(ctx context.Context, queueName string, payload []byte) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("queue_name", queueName))
+
+	if !c.inMemoryBroker.isSubscribed(queueName) {
+		logger.Debug("dropped message of queue without consumer")
+		return nil
+	}
+
+	// a full queue fails right away instead of blocking, so the caller can roll back and try again later
+	select {
+	case c.inMemoryBroker.GetQueue(queueName) <- payload:
+		return nil
+	default:
+		logger.Error("failed to produce message, queue is full")
+		return status.Error(codes.Unavailable, "failed to produce message, queue is full")
+	}
+}
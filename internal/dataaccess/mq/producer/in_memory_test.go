@@ -0,0 +1,58 @@
+package producer
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInMemoryClientProduce(t *testing.T) {
+	testCaseList := []struct {
+		name                string
+		subscribedQueueList []string
+		queueName           string
+		producedCount       int
+		expectedQueueLen    int
+		expectedErrCode     codes.Code
+	}{
+		{name: "kept before the consumer subscribes", queueName: "a", producedCount: 2, expectedQueueLen: 2},
+		{
+			name: "kept for a subscribed queue", subscribedQueueList: []string{"a"}, queueName: "a",
+			producedCount: 2, expectedQueueLen: 2,
+		},
+		{
+			name: "dropped for a queue without consumer", subscribedQueueList: []string{"a"}, queueName: "b",
+			producedCount: 2, expectedQueueLen: 0,
+		},
+		{
+			name: "fails once the queue is full", queueName: "a", producedCount: inMemoryQueueCapacity + 1,
+			expectedQueueLen: inMemoryQueueCapacity, expectedErrCode: codes.Unavailable,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			broker := NewInMemoryBroker()
+			if testCase.subscribedQueueList != nil {
+				broker.Subscribe(testCase.subscribedQueueList)
+			}
+
+			client := NewInMemoryClient(broker, zap.NewNop())
+			var err error
+			for i := 0; i < testCase.producedCount; i++ {
+				err = client.Produce(context.Background(), testCase.queueName, []byte("payload"))
+			}
+
+			if status.Code(err) != testCase.expectedErrCode {
+				t.Fatalf("expected error with code %s, got %v", testCase.expectedErrCode, err)
+			}
+
+			if queueLen := len(broker.GetQueue(testCase.queueName)); queueLen != testCase.expectedQueueLen {
+				t.Fatalf("expected %d messages in the queue, got %d", testCase.expectedQueueLen, queueLen)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package producer
+
+import (
+	"context"
+	"fmt"
+	"goload/internal/configs"
+	"goload/internal/utils"
+	"strings"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetNATSStreamName returns the JetStream stream backing a queue. Every queue gets its own stream with the queue
+// name as its only subject, stream names cannot contain dots.
+func GetNATSStreamName(queueName string) string {
+	return strings.ReplaceAll(queueName, ".", "_")
+}
+
+// EnsureNATSStream creates the stream backing a queue if it does not exist yet.
+func EnsureNATSStream(ctx context.Context, js jetstream.JetStream, queueName string) (jetstream.Stream, error) {
+	return js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     GetNATSStreamName(queueName),
+		Subjects: []string{queueName},
+	})
+}
+
+func NewNATSConn(mqConfig configs.MQ) (*nats.Conn, error) {
+	options := []nats.Option{nats.Name(mqConfig.ClientID)}
+	if mqConfig.Username != "" {
+		options = append(options, nats.UserInfo(mqConfig.Username, mqConfig.Password))
+	}
+
+	conn, err := nats.Connect(strings.Join(mqConfig.Addresses, ","), options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nats: %w", err)
+	}
+
+	return conn, nil
+}
+
+type natsClient struct {
+	jetStream             jetstream.JetStream
+	ensuredQueueNameSet   map[string]struct{}
+	ensuredQueueNameMutex *sync.Mutex
+	logger                *zap.Logger
+}
+
+func NewNATSClient(
+	mqConfig configs.MQ,
+	logger *zap.Logger,
+) (Client, error) {
+	conn, err := NewNATSConn(mqConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	jetStream, err := jetstream.New(conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create nats jetstream: %w", err)
+	}
+
+	return &natsClient{
+		jetStream:             jetStream,
+		ensuredQueueNameSet:   make(map[string]struct{}),
+		ensuredQueueNameMutex: new(sync.Mutex),
+		logger:                logger,
+	}, nil
+}
+
+func (c natsClient) ensureStream(ctx context.Context, queueName string) error {
+	c.ensuredQueueNameMutex.Lock()
+	defer c.ensuredQueueNameMutex.Unlock()
+
+	if _, ok := c.ensuredQueueNameSet[queueName]; ok {
+		return nil
+	}
+
+	if _, err := EnsureNATSStream(ctx, c.jetStream, queueName); err != nil {
+		return err
+	}
+
+	c.ensuredQueueNameSet[queueName] = struct{}{}
+	return nil
+}
+
+func (c natsClient) Produce(ctx context.Context, queueName string, payload []byte) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("queue_name", queueName)).
+		With(zap.ByteString("payload", payload))
+
+	if err := c.ensureStream(ctx, queueName); err != nil {
+		logger.With(zap.Error(err)).Error("failed to create nats stream")
+		return status.Error(codes.Internal, "failed to produce message")
+	}
+
+	if _, err := c.jetStream.Publish(ctx, queueName, payload); err != nil {
+		logger.With(zap.Error(err)).Error("failed to produce message")
+		return status.Error(codes.Internal, "failed to produce message")
+	}
+
+	return nil
+}
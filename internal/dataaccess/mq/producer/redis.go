@@ -0,0 +1,66 @@
+package producer
+
+import (
+	"context"
+	"errors"
+	"goload/internal/configs"
+	"goload/internal/utils"
+
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	RedisStreamPayloadField = "payload"
+)
+
+type redisClient struct {
+	redisClient  *redis.Client
+	maxLen       int64
+	approxMaxLen bool
+	logger       *zap.Logger
+}
+
+func NewRedisClient(
+	mqConfig configs.MQ,
+	logger *zap.Logger,
+) (Client, error) {
+	if len(mqConfig.Addresses) != 1 {
+		return nil, errors.New("redis message queue requires exactly one address")
+	}
+
+	if mqConfig.Redis.MaxLen < 0 {
+		return nil, errors.New("redis max_len must not be negative")
+	}
+
+	return &redisClient{
+		redisClient: redis.NewClient(&redis.Options{
+			Addr:     mqConfig.Addresses[0],
+			Username: mqConfig.Username,
+			Password: mqConfig.Password,
+		}),
+		maxLen:       mqConfig.Redis.MaxLen,
+		approxMaxLen: mqConfig.Redis.ApproxMaxLen,
+		logger:       logger,
+	}, nil
+}
+
+func (c redisClient) Produce(ctx context.Context, queueName string, payload []byte) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("queue_name", queueName)).
+		With(zap.ByteString("payload", payload))
+
+	if err := c.redisClient.XAdd(ctx, &redis.XAddArgs{
+		Stream: queueName,
+		MaxLen: c.maxLen,
+		Approx: c.approxMaxLen,
+		Values: map[string]any{RedisStreamPayloadField: payload},
+	}).Err(); err != nil {
+		logger.With(zap.Error(err)).Error("failed to produce message")
+		return status.Error(codes.Internal, "failed to produce message")
+	}
+
+	return nil
+}
@@ -3,6 +3,6 @@ package producer
 import "github.com/google/wire"
 
 var WireSet = wire.NewSet(
+	NewInMemoryBroker,
 	NewClient,
-	NewDownloadTaskCreatedProducer,
 )
@@ -60,6 +60,10 @@ func (m *Account) validate(all bool) error {
 
 	// no validation rules for AccountName
 
+	// no validation rules for Role
+
+	// no validation rules for TwoFactorEnabled
+
 	if len(errors) > 0 {
 		return AccountMultiError(errors)
 	}
@@ -196,6 +200,188 @@ func (m *DownloadTask) validate(all bool) error {
 
 	// no validation rules for DownloadStatus
 
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinishedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskValidationError{
+				field:  "FinishedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LabelMap
+
+	// no validation rules for Attempt
+
+	// no validation rules for CallbackUrl
+
+	// no validation rules for WorkspaceId
+
+	if all {
+		switch v := interface{}(m.GetHttpRequestOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "HttpRequestOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "HttpRequestOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHttpRequestOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskValidationError{
+				field:  "HttpRequestOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return DownloadTaskMultiError(errors)
 	}
@@ -273,46 +459,110 @@ var _ interface {
 	ErrorName() string
 } = DownloadTaskValidationError{}
 
-// Validate checks the field values on CreateAccountRequest with the rules
+// Validate checks the field values on HttpRequestOptions with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateAccountRequest) Validate() error {
+func (m *HttpRequestOptions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateAccountRequest with the rules
+// ValidateAll checks the field values on HttpRequestOptions with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateAccountRequestMultiError, or nil if none found.
-func (m *CreateAccountRequest) ValidateAll() error {
+// HttpRequestOptionsMultiError, or nil if none found.
+func (m *HttpRequestOptions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateAccountRequest) validate(all bool) error {
+func (m *HttpRequestOptions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AccountName
+	// no validation rules for Method
 
-	// no validation rules for Password
+	// no validation rules for HeaderMap
+
+	// no validation rules for CookieMap
+
+	// no validation rules for Body
+
+	// no validation rules for UserAgent
+
+	switch v := m.Auth.(type) {
+	case *HttpRequestOptions_BasicAuth:
+		if v == nil {
+			err := HttpRequestOptionsValidationError{
+				field:  "Auth",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetBasicAuth()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HttpRequestOptionsValidationError{
+						field:  "BasicAuth",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HttpRequestOptionsValidationError{
+						field:  "BasicAuth",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBasicAuth()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HttpRequestOptionsValidationError{
+					field:  "BasicAuth",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *HttpRequestOptions_BearerToken:
+		if v == nil {
+			err := HttpRequestOptionsValidationError{
+				field:  "Auth",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for BearerToken
+	default:
+		_ = v // ensures v is used
+	}
 
 	if len(errors) > 0 {
-		return CreateAccountRequestMultiError(errors)
+		return HttpRequestOptionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateAccountRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateAccountRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateAccountRequestMultiError []error
+// HttpRequestOptionsMultiError is an error wrapping multiple validation errors
+// returned by HttpRequestOptions.ValidateAll() if the designated constraints
+// aren't met.
+type HttpRequestOptionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateAccountRequestMultiError) Error() string {
+func (m HttpRequestOptionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -321,11 +571,11 @@ func (m CreateAccountRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateAccountRequestMultiError) AllErrors() []error { return m }
+func (m HttpRequestOptionsMultiError) AllErrors() []error { return m }
 
-// CreateAccountRequestValidationError is the validation error returned by
-// CreateAccountRequest.Validate if the designated constraints aren't met.
-type CreateAccountRequestValidationError struct {
+// HttpRequestOptionsValidationError is the validation error returned by
+// HttpRequestOptions.Validate if the designated constraints aren't met.
+type HttpRequestOptionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -333,24 +583,24 @@ type CreateAccountRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateAccountRequestValidationError) Field() string { return e.field }
+func (e HttpRequestOptionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateAccountRequestValidationError) Reason() string { return e.reason }
+func (e HttpRequestOptionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateAccountRequestValidationError) Cause() error { return e.cause }
+func (e HttpRequestOptionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAccountRequestValidationError) Key() bool { return e.key }
+func (e HttpRequestOptionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAccountRequestValidationError) ErrorName() string {
-	return "CreateAccountRequestValidationError"
+func (e HttpRequestOptionsValidationError) ErrorName() string {
+	return "HttpRequestOptionsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateAccountRequestValidationError) Error() string {
+func (e HttpRequestOptionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -362,14 +612,14 @@ func (e CreateAccountRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAccountRequest.%s: %s%s",
+		"invalid %sHttpRequestOptions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAccountRequestValidationError{}
+var _ error = HttpRequestOptionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -377,46 +627,9931 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAccountRequestValidationError{}
+} = HttpRequestOptionsValidationError{}
 
-// Validate checks the field values on CreateAccountResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateAccountResponse) Validate() error {
+// Validate checks the field values on HttpBasicAuth with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HttpBasicAuth) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateAccountResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateAccountResponseMultiError, or nil if none found.
-func (m *CreateAccountResponse) ValidateAll() error {
+// ValidateAll checks the field values on HttpBasicAuth with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HttpBasicAuthMultiError, or
+// nil if none found.
+func (m *HttpBasicAuth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HttpBasicAuth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return HttpBasicAuthMultiError(errors)
+	}
+
+	return nil
+}
+
+// HttpBasicAuthMultiError is an error wrapping multiple validation errors
+// returned by HttpBasicAuth.ValidateAll() if the designated constraints
+// aren't met.
+type HttpBasicAuthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HttpBasicAuthMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HttpBasicAuthMultiError) AllErrors() []error { return m }
+
+// HttpBasicAuthValidationError is the validation error returned by
+// HttpBasicAuth.Validate if the designated constraints aren't met.
+type HttpBasicAuthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HttpBasicAuthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HttpBasicAuthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HttpBasicAuthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HttpBasicAuthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HttpBasicAuthValidationError) ErrorName() string { return "HttpBasicAuthValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HttpBasicAuthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHttpBasicAuth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HttpBasicAuthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HttpBasicAuthValidationError{}
+
+// Validate checks the field values on DownloadTaskTags with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskTags) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskTags with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskTagsMultiError, or nil if none found.
+func (m *DownloadTaskTags) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskTags) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DownloadTaskTagsMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskTagsMultiError is an error wrapping multiple validation errors
+// returned by DownloadTaskTags.ValidateAll() if the designated constraints
+// aren't met.
+type DownloadTaskTagsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskTagsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskTagsMultiError) AllErrors() []error { return m }
+
+// DownloadTaskTagsValidationError is the validation error returned by
+// DownloadTaskTags.Validate if the designated constraints aren't met.
+type DownloadTaskTagsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskTagsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskTagsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskTagsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskTagsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskTagsValidationError) ErrorName() string { return "DownloadTaskTagsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskTagsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskTags.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskTagsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskTagsValidationError{}
+
+// Validate checks the field values on DownloadTaskLabels with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskLabels) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskLabels with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskLabelsMultiError, or nil if none found.
+func (m *DownloadTaskLabels) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskLabels) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LabelMap
+
+	if len(errors) > 0 {
+		return DownloadTaskLabelsMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskLabelsMultiError is an error wrapping multiple validation errors
+// returned by DownloadTaskLabels.ValidateAll() if the designated constraints
+// aren't met.
+type DownloadTaskLabelsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskLabelsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskLabelsMultiError) AllErrors() []error { return m }
+
+// DownloadTaskLabelsValidationError is the validation error returned by
+// DownloadTaskLabels.Validate if the designated constraints aren't met.
+type DownloadTaskLabelsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskLabelsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskLabelsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskLabelsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskLabelsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskLabelsValidationError) ErrorName() string {
+	return "DownloadTaskLabelsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskLabelsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskLabels.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskLabelsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskLabelsValidationError{}
+
+// Validate checks the field values on DownloadTaskEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskEventMultiError, or nil if none found.
+func (m *DownloadTaskEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DownloadTaskId
+
+	// no validation rules for FromDownloadStatus
+
+	// no validation rules for ToDownloadStatus
+
+	// no validation rules for Actor
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskEventValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DownloadTaskEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskEventMultiError is an error wrapping multiple validation errors
+// returned by DownloadTaskEvent.ValidateAll() if the designated constraints
+// aren't met.
+type DownloadTaskEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskEventMultiError) AllErrors() []error { return m }
+
+// DownloadTaskEventValidationError is the validation error returned by
+// DownloadTaskEvent.Validate if the designated constraints aren't met.
+type DownloadTaskEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskEventValidationError) ErrorName() string {
+	return "DownloadTaskEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskEventValidationError{}
+
+// Validate checks the field values on DownloadTaskFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskFilterMultiError, or nil if none found.
+func (m *DownloadTaskFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadType
+
+	// no validation rules for UrlContains
+
+	if all {
+		switch v := interface{}(m.GetCreatedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskFilterValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskFilterValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskFilterValidationError{
+				field:  "CreatedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskFilterValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskFilterValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskFilterValidationError{
+				field:  "CreatedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LabelSelector
+
+	// no validation rules for WorkspaceId
+
+	if len(errors) > 0 {
+		return DownloadTaskFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskFilterMultiError is an error wrapping multiple validation errors
+// returned by DownloadTaskFilter.ValidateAll() if the designated constraints
+// aren't met.
+type DownloadTaskFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskFilterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskFilterMultiError) AllErrors() []error { return m }
+
+// DownloadTaskFilterValidationError is the validation error returned by
+// DownloadTaskFilter.Validate if the designated constraints aren't met.
+type DownloadTaskFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskFilterValidationError) ErrorName() string {
+	return "DownloadTaskFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskFilterValidationError{}
+
+// Validate checks the field values on Webhook with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Webhook) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Webhook with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in WebhookMultiError, or nil if none found.
+func (m *Webhook) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Webhook) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Url
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebhookMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookMultiError is an error wrapping multiple validation errors returned
+// by Webhook.ValidateAll() if the designated constraints aren't met.
+type WebhookMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookMultiError) AllErrors() []error { return m }
+
+// WebhookValidationError is the validation error returned by Webhook.Validate
+// if the designated constraints aren't met.
+type WebhookValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookValidationError) ErrorName() string { return "WebhookValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhook.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookValidationError{}
+
+// Validate checks the field values on ApiKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ApiKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApiKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ApiKeyMultiError, or nil if none found.
+func (m *ApiKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApiKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUsedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApiKeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApiKeyValidationError{
+				field:  "LastUsedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApiKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApiKeyMultiError is an error wrapping multiple validation errors returned by
+// ApiKey.ValidateAll() if the designated constraints aren't met.
+type ApiKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApiKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApiKeyMultiError) AllErrors() []error { return m }
+
+// ApiKeyValidationError is the validation error returned by ApiKey.Validate if
+// the designated constraints aren't met.
+type ApiKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApiKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApiKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApiKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApiKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApiKeyValidationError) ErrorName() string { return "ApiKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ApiKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApiKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApiKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApiKeyValidationError{}
+
+// Validate checks the field values on ShareLink with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ShareLink) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShareLink with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ShareLinkMultiError, or nil
+// if none found.
+func (m *ShareLink) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShareLink) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DownloadTaskId
+
+	// no validation rules for Url
+
+	// no validation rules for HasPassword
+
+	// no validation rules for MaxDownloadCount
+
+	// no validation rules for DownloadCount
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShareLinkValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShareLinkValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShareLinkValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShareLinkValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShareLinkValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShareLinkValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRevokedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShareLinkValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShareLinkValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevokedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShareLinkValidationError{
+				field:  "RevokedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ShareLinkMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShareLinkMultiError is an error wrapping multiple validation errors returned
+// by ShareLink.ValidateAll() if the designated constraints aren't met.
+type ShareLinkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShareLinkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShareLinkMultiError) AllErrors() []error { return m }
+
+// ShareLinkValidationError is the validation error returned by
+// ShareLink.Validate if the designated constraints aren't met.
+type ShareLinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShareLinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShareLinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShareLinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShareLinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShareLinkValidationError) ErrorName() string { return "ShareLinkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ShareLinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShareLink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShareLinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShareLinkValidationError{}
+
+// Validate checks the field values on Workspace with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Workspace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Workspace with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WorkspaceMultiError, or nil
+// if none found.
+func (m *Workspace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Workspace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DisplayName
+
+	// no validation rules for Role
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkspaceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkspaceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkspaceValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WorkspaceMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkspaceMultiError is an error wrapping multiple validation errors returned
+// by Workspace.ValidateAll() if the designated constraints aren't met.
+type WorkspaceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkspaceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkspaceMultiError) AllErrors() []error { return m }
+
+// WorkspaceValidationError is the validation error returned by
+// Workspace.Validate if the designated constraints aren't met.
+type WorkspaceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkspaceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkspaceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkspaceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkspaceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkspaceValidationError) ErrorName() string { return "WorkspaceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WorkspaceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkspace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkspaceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkspaceValidationError{}
+
+// Validate checks the field values on WorkspaceMember with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WorkspaceMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkspaceMember with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkspaceMemberMultiError, or nil if none found.
+func (m *WorkspaceMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkspaceMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkspaceMemberValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkspaceMemberValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkspaceMemberValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Role
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkspaceMemberValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkspaceMemberValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkspaceMemberValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WorkspaceMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkspaceMemberMultiError is an error wrapping multiple validation errors
+// returned by WorkspaceMember.ValidateAll() if the designated constraints
+// aren't met.
+type WorkspaceMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkspaceMemberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkspaceMemberMultiError) AllErrors() []error { return m }
+
+// WorkspaceMemberValidationError is the validation error returned by
+// WorkspaceMember.Validate if the designated constraints aren't met.
+type WorkspaceMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkspaceMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkspaceMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkspaceMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkspaceMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkspaceMemberValidationError) ErrorName() string { return "WorkspaceMemberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WorkspaceMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkspaceMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkspaceMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkspaceMemberValidationError{}
+
+// Validate checks the field values on WebhookDelivery with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WebhookDelivery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookDelivery with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookDeliveryMultiError, or nil if none found.
+func (m *WebhookDelivery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookDelivery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for WebhookId
+
+	// no validation rules for DownloadTaskId
+
+	// no validation rules for EventId
+
+	// no validation rules for EventType
+
+	// no validation rules for Url
+
+	// no validation rules for DeliveryStatus
+
+	// no validation rules for Attempt
+
+	// no validation rules for LastResponseStatusCode
+
+	// no validation rules for LastError
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNextAttemptAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "NextAttemptAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "NextAttemptAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextAttemptAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryValidationError{
+				field:  "NextAttemptAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeliveredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "DeliveredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "DeliveredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeliveredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryValidationError{
+				field:  "DeliveredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebhookDeliveryMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookDeliveryMultiError is an error wrapping multiple validation errors
+// returned by WebhookDelivery.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookDeliveryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookDeliveryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookDeliveryMultiError) AllErrors() []error { return m }
+
+// WebhookDeliveryValidationError is the validation error returned by
+// WebhookDelivery.Validate if the designated constraints aren't met.
+type WebhookDeliveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookDeliveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookDeliveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookDeliveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookDeliveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookDeliveryValidationError) ErrorName() string { return "WebhookDeliveryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookDeliveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookDelivery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookDeliveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookDeliveryValidationError{}
+
+// Validate checks the field values on BulkDownloadTaskResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkDownloadTaskResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkDownloadTaskResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BulkDownloadTaskResultMultiError, or nil if none found.
+func (m *BulkDownloadTaskResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkDownloadTaskResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	// no validation rules for Outcome
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return BulkDownloadTaskResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkDownloadTaskResultMultiError is an error wrapping multiple validation
+// errors returned by BulkDownloadTaskResult.ValidateAll() if the designated
+// constraints aren't met.
+type BulkDownloadTaskResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkDownloadTaskResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkDownloadTaskResultMultiError) AllErrors() []error { return m }
+
+// BulkDownloadTaskResultValidationError is the validation error returned by
+// BulkDownloadTaskResult.Validate if the designated constraints aren't met.
+type BulkDownloadTaskResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkDownloadTaskResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkDownloadTaskResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkDownloadTaskResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkDownloadTaskResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkDownloadTaskResultValidationError) ErrorName() string {
+	return "BulkDownloadTaskResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkDownloadTaskResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkDownloadTaskResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkDownloadTaskResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkDownloadTaskResultValidationError{}
+
+// Validate checks the field values on CreateAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAccountRequestMultiError, or nil if none found.
+func (m *CreateAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountName
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return CreateAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAccountRequestMultiError) AllErrors() []error { return m }
+
+// CreateAccountRequestValidationError is the validation error returned by
+// CreateAccountRequest.Validate if the designated constraints aren't met.
+type CreateAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAccountRequestValidationError) ErrorName() string {
+	return "CreateAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAccountRequestValidationError{}
+
+// Validate checks the field values on CreateAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAccountResponseMultiError, or nil if none found.
+func (m *CreateAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return CreateAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAccountResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAccountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAccountResponseMultiError) AllErrors() []error { return m }
+
+// CreateAccountResponseValidationError is the validation error returned by
+// CreateAccountResponse.Validate if the designated constraints aren't met.
+type CreateAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAccountResponseValidationError) ErrorName() string {
+	return "CreateAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAccountResponseValidationError{}
+
+// Validate checks the field values on CreateSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSessionRequestMultiError, or nil if none found.
+func (m *CreateSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountName
+
+	// no validation rules for Password
+
+	// no validation rules for TwoFactorCode
+
+	if len(errors) > 0 {
+		return CreateSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSessionRequestMultiError) AllErrors() []error { return m }
+
+// CreateSessionRequestValidationError is the validation error returned by
+// CreateSessionRequest.Validate if the designated constraints aren't met.
+type CreateSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSessionRequestValidationError) ErrorName() string {
+	return "CreateSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSessionRequestValidationError{}
+
+// Validate checks the field values on CreateSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSessionResponseMultiError, or nil if none found.
+func (m *CreateSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateSessionResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateSessionResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateSessionResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSessionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSessionResponseMultiError) AllErrors() []error { return m }
+
+// CreateSessionResponseValidationError is the validation error returned by
+// CreateSessionResponse.Validate if the designated constraints aren't met.
+type CreateSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSessionResponseValidationError) ErrorName() string {
+	return "CreateSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSessionResponseValidationError{}
+
+// Validate checks the field values on RefreshSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshSessionRequestMultiError, or nil if none found.
+func (m *RefreshSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RefreshToken
+
+	if len(errors) > 0 {
+		return RefreshSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by RefreshSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshSessionRequestMultiError) AllErrors() []error { return m }
+
+// RefreshSessionRequestValidationError is the validation error returned by
+// RefreshSessionRequest.Validate if the designated constraints aren't met.
+type RefreshSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshSessionRequestValidationError) ErrorName() string {
+	return "RefreshSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshSessionRequestValidationError{}
+
+// Validate checks the field values on RefreshSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RefreshSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefreshSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefreshSessionResponseMultiError, or nil if none found.
+func (m *RefreshSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefreshSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefreshSessionResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefreshSessionResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefreshSessionResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RefreshSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefreshSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by RefreshSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefreshSessionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefreshSessionResponseMultiError) AllErrors() []error { return m }
+
+// RefreshSessionResponseValidationError is the validation error returned by
+// RefreshSessionResponse.Validate if the designated constraints aren't met.
+type RefreshSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefreshSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefreshSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefreshSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefreshSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefreshSessionResponseValidationError) ErrorName() string {
+	return "RefreshSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefreshSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefreshSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefreshSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefreshSessionResponseValidationError{}
+
+// Validate checks the field values on DeleteSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSessionRequestMultiError, or nil if none found.
+func (m *DeleteSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSessionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteSessionRequestValidationError is the validation error returned by
+// DeleteSessionRequest.Validate if the designated constraints aren't met.
+type DeleteSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSessionRequestValidationError) ErrorName() string {
+	return "DeleteSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSessionRequestValidationError{}
+
+// Validate checks the field values on DeleteSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteSessionResponseMultiError, or nil if none found.
+func (m *DeleteSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteSessionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteSessionResponseMultiError) AllErrors() []error { return m }
+
+// DeleteSessionResponseValidationError is the validation error returned by
+// DeleteSessionResponse.Validate if the designated constraints aren't met.
+type DeleteSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSessionResponseValidationError) ErrorName() string {
+	return "DeleteSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSessionResponseValidationError{}
+
+// Validate checks the field values on DeleteAllSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAllSessionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAllSessionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAllSessionsRequestMultiError, or nil if none found.
+func (m *DeleteAllSessionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAllSessionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteAllSessionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAllSessionsRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteAllSessionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAllSessionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAllSessionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAllSessionsRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAllSessionsRequestValidationError is the validation error returned by
+// DeleteAllSessionsRequest.Validate if the designated constraints aren't met.
+type DeleteAllSessionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAllSessionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAllSessionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAllSessionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAllSessionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAllSessionsRequestValidationError) ErrorName() string {
+	return "DeleteAllSessionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAllSessionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAllSessionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAllSessionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAllSessionsRequestValidationError{}
+
+// Validate checks the field values on DeleteAllSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAllSessionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAllSessionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAllSessionsResponseMultiError, or nil if none found.
+func (m *DeleteAllSessionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAllSessionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RevokedSessionCount
+
+	if len(errors) > 0 {
+		return DeleteAllSessionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAllSessionsResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteAllSessionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteAllSessionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAllSessionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAllSessionsResponseMultiError) AllErrors() []error { return m }
+
+// DeleteAllSessionsResponseValidationError is the validation error returned by
+// DeleteAllSessionsResponse.Validate if the designated constraints aren't met.
+type DeleteAllSessionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAllSessionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAllSessionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAllSessionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAllSessionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAllSessionsResponseValidationError) ErrorName() string {
+	return "DeleteAllSessionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAllSessionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAllSessionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAllSessionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAllSessionsResponseValidationError{}
+
+// Validate checks the field values on GetAccountRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccountRequestMultiError, or nil if none found.
+func (m *GetAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountRequestMultiError is an error wrapping multiple validation errors
+// returned by GetAccountRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountRequestMultiError) AllErrors() []error { return m }
+
+// GetAccountRequestValidationError is the validation error returned by
+// GetAccountRequest.Validate if the designated constraints aren't met.
+type GetAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountRequestValidationError) ErrorName() string {
+	return "GetAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountRequestValidationError{}
+
+// Validate checks the field values on GetAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccountResponseMultiError, or nil if none found.
+func (m *GetAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAccountResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountResponseMultiError is an error wrapping multiple validation errors
+// returned by GetAccountResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountResponseMultiError) AllErrors() []error { return m }
+
+// GetAccountResponseValidationError is the validation error returned by
+// GetAccountResponse.Validate if the designated constraints aren't met.
+type GetAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountResponseValidationError) ErrorName() string {
+	return "GetAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountResponseValidationError{}
+
+// Validate checks the field values on ChangePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangePasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangePasswordRequestMultiError, or nil if none found.
+func (m *ChangePasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangePasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OldPassword
+
+	// no validation rules for NewPassword
+
+	if len(errors) > 0 {
+		return ChangePasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangePasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by ChangePasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ChangePasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangePasswordRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangePasswordRequestMultiError) AllErrors() []error { return m }
+
+// ChangePasswordRequestValidationError is the validation error returned by
+// ChangePasswordRequest.Validate if the designated constraints aren't met.
+type ChangePasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangePasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangePasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangePasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangePasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangePasswordRequestValidationError) ErrorName() string {
+	return "ChangePasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangePasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangePasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangePasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangePasswordRequestValidationError{}
+
+// Validate checks the field values on ChangePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangePasswordResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangePasswordResponseMultiError, or nil if none found.
+func (m *ChangePasswordResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangePasswordResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChangePasswordResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChangePasswordResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChangePasswordResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RevokedSessionCount
+
+	if len(errors) > 0 {
+		return ChangePasswordResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangePasswordResponseMultiError is an error wrapping multiple validation
+// errors returned by ChangePasswordResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ChangePasswordResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangePasswordResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangePasswordResponseMultiError) AllErrors() []error { return m }
+
+// ChangePasswordResponseValidationError is the validation error returned by
+// ChangePasswordResponse.Validate if the designated constraints aren't met.
+type ChangePasswordResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangePasswordResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangePasswordResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangePasswordResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangePasswordResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangePasswordResponseValidationError) ErrorName() string {
+	return "ChangePasswordResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangePasswordResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangePasswordResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangePasswordResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangePasswordResponseValidationError{}
+
+// Validate checks the field values on DeleteAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAccountRequestMultiError, or nil if none found.
+func (m *DeleteAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Password
+
+	// no validation rules for TwoFactorCode
+
+	if len(errors) > 0 {
+		return DeleteAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAccountRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAccountRequestValidationError is the validation error returned by
+// DeleteAccountRequest.Validate if the designated constraints aren't met.
+type DeleteAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAccountRequestValidationError) ErrorName() string {
+	return "DeleteAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAccountRequestValidationError{}
+
+// Validate checks the field values on DeleteAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAccountResponseMultiError, or nil if none found.
+func (m *DeleteAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeletedDownloadTaskCount
+
+	if len(errors) > 0 {
+		return DeleteAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAccountResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteAccountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAccountResponseMultiError) AllErrors() []error { return m }
+
+// DeleteAccountResponseValidationError is the validation error returned by
+// DeleteAccountResponse.Validate if the designated constraints aren't met.
+type DeleteAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAccountResponseValidationError) ErrorName() string {
+	return "DeleteAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAccountResponseValidationError{}
+
+// Validate checks the field values on EnrollTwoFactorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnrollTwoFactorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollTwoFactorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrollTwoFactorRequestMultiError, or nil if none found.
+func (m *EnrollTwoFactorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollTwoFactorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return EnrollTwoFactorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollTwoFactorRequestMultiError is an error wrapping multiple validation
+// errors returned by EnrollTwoFactorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EnrollTwoFactorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollTwoFactorRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollTwoFactorRequestMultiError) AllErrors() []error { return m }
+
+// EnrollTwoFactorRequestValidationError is the validation error returned by
+// EnrollTwoFactorRequest.Validate if the designated constraints aren't met.
+type EnrollTwoFactorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollTwoFactorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollTwoFactorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollTwoFactorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollTwoFactorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollTwoFactorRequestValidationError) ErrorName() string {
+	return "EnrollTwoFactorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrollTwoFactorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollTwoFactorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollTwoFactorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollTwoFactorRequestValidationError{}
+
+// Validate checks the field values on EnrollTwoFactorResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnrollTwoFactorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollTwoFactorResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrollTwoFactorResponseMultiError, or nil if none found.
+func (m *EnrollTwoFactorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollTwoFactorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Secret
+
+	// no validation rules for ProvisioningUri
+
+	if len(errors) > 0 {
+		return EnrollTwoFactorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollTwoFactorResponseMultiError is an error wrapping multiple validation
+// errors returned by EnrollTwoFactorResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EnrollTwoFactorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollTwoFactorResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollTwoFactorResponseMultiError) AllErrors() []error { return m }
+
+// EnrollTwoFactorResponseValidationError is the validation error returned by
+// EnrollTwoFactorResponse.Validate if the designated constraints aren't met.
+type EnrollTwoFactorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollTwoFactorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollTwoFactorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollTwoFactorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollTwoFactorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollTwoFactorResponseValidationError) ErrorName() string {
+	return "EnrollTwoFactorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrollTwoFactorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollTwoFactorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollTwoFactorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollTwoFactorResponseValidationError{}
+
+// Validate checks the field values on ConfirmTwoFactorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTwoFactorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTwoFactorRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmTwoFactorRequestMultiError, or nil if none found.
+func (m *ConfirmTwoFactorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTwoFactorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return ConfirmTwoFactorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTwoFactorRequestMultiError is an error wrapping multiple validation
+// errors returned by ConfirmTwoFactorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmTwoFactorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTwoFactorRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTwoFactorRequestMultiError) AllErrors() []error { return m }
+
+// ConfirmTwoFactorRequestValidationError is the validation error returned by
+// ConfirmTwoFactorRequest.Validate if the designated constraints aren't met.
+type ConfirmTwoFactorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTwoFactorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTwoFactorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTwoFactorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTwoFactorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTwoFactorRequestValidationError) ErrorName() string {
+	return "ConfirmTwoFactorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmTwoFactorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTwoFactorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTwoFactorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTwoFactorRequestValidationError{}
+
+// Validate checks the field values on ConfirmTwoFactorResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTwoFactorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTwoFactorResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmTwoFactorResponseMultiError, or nil if none found.
+func (m *ConfirmTwoFactorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTwoFactorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ConfirmTwoFactorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTwoFactorResponseMultiError is an error wrapping multiple validation
+// errors returned by ConfirmTwoFactorResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmTwoFactorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTwoFactorResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTwoFactorResponseMultiError) AllErrors() []error { return m }
+
+// ConfirmTwoFactorResponseValidationError is the validation error returned by
+// ConfirmTwoFactorResponse.Validate if the designated constraints aren't met.
+type ConfirmTwoFactorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTwoFactorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTwoFactorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTwoFactorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTwoFactorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTwoFactorResponseValidationError) ErrorName() string {
+	return "ConfirmTwoFactorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmTwoFactorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTwoFactorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTwoFactorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTwoFactorResponseValidationError{}
+
+// Validate checks the field values on DisableTwoFactorRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableTwoFactorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableTwoFactorRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableTwoFactorRequestMultiError, or nil if none found.
+func (m *DisableTwoFactorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableTwoFactorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Password
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return DisableTwoFactorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableTwoFactorRequestMultiError is an error wrapping multiple validation
+// errors returned by DisableTwoFactorRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DisableTwoFactorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableTwoFactorRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableTwoFactorRequestMultiError) AllErrors() []error { return m }
+
+// DisableTwoFactorRequestValidationError is the validation error returned by
+// DisableTwoFactorRequest.Validate if the designated constraints aren't met.
+type DisableTwoFactorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableTwoFactorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableTwoFactorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableTwoFactorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableTwoFactorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableTwoFactorRequestValidationError) ErrorName() string {
+	return "DisableTwoFactorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableTwoFactorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableTwoFactorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableTwoFactorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableTwoFactorRequestValidationError{}
+
+// Validate checks the field values on DisableTwoFactorResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableTwoFactorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableTwoFactorResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableTwoFactorResponseMultiError, or nil if none found.
+func (m *DisableTwoFactorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableTwoFactorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DisableTwoFactorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableTwoFactorResponseMultiError is an error wrapping multiple validation
+// errors returned by DisableTwoFactorResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DisableTwoFactorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableTwoFactorResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableTwoFactorResponseMultiError) AllErrors() []error { return m }
+
+// DisableTwoFactorResponseValidationError is the validation error returned by
+// DisableTwoFactorResponse.Validate if the designated constraints aren't met.
+type DisableTwoFactorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableTwoFactorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableTwoFactorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableTwoFactorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableTwoFactorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableTwoFactorResponseValidationError) ErrorName() string {
+	return "DisableTwoFactorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableTwoFactorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableTwoFactorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableTwoFactorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableTwoFactorResponseValidationError{}
+
+// Validate checks the field values on RegenerateRecoveryCodesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegenerateRecoveryCodesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegenerateRecoveryCodesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RegenerateRecoveryCodesRequestMultiError, or nil if none found.
+func (m *RegenerateRecoveryCodesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegenerateRecoveryCodesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if len(errors) > 0 {
+		return RegenerateRecoveryCodesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegenerateRecoveryCodesRequestMultiError is an error wrapping multiple
+// validation errors returned by RegenerateRecoveryCodesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RegenerateRecoveryCodesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegenerateRecoveryCodesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegenerateRecoveryCodesRequestMultiError) AllErrors() []error { return m }
+
+// RegenerateRecoveryCodesRequestValidationError is the validation error
+// returned by RegenerateRecoveryCodesRequest.Validate if the designated
+// constraints aren't met.
+type RegenerateRecoveryCodesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegenerateRecoveryCodesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegenerateRecoveryCodesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegenerateRecoveryCodesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegenerateRecoveryCodesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegenerateRecoveryCodesRequestValidationError) ErrorName() string {
+	return "RegenerateRecoveryCodesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegenerateRecoveryCodesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegenerateRecoveryCodesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegenerateRecoveryCodesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegenerateRecoveryCodesRequestValidationError{}
+
+// Validate checks the field values on RegenerateRecoveryCodesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegenerateRecoveryCodesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegenerateRecoveryCodesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RegenerateRecoveryCodesResponseMultiError, or nil if none found.
+func (m *RegenerateRecoveryCodesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegenerateRecoveryCodesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RegenerateRecoveryCodesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegenerateRecoveryCodesResponseMultiError is an error wrapping multiple
+// validation errors returned by RegenerateRecoveryCodesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RegenerateRecoveryCodesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegenerateRecoveryCodesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegenerateRecoveryCodesResponseMultiError) AllErrors() []error { return m }
+
+// RegenerateRecoveryCodesResponseValidationError is the validation error
+// returned by RegenerateRecoveryCodesResponse.Validate if the designated
+// constraints aren't met.
+type RegenerateRecoveryCodesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegenerateRecoveryCodesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegenerateRecoveryCodesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegenerateRecoveryCodesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegenerateRecoveryCodesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegenerateRecoveryCodesResponseValidationError) ErrorName() string {
+	return "RegenerateRecoveryCodesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegenerateRecoveryCodesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegenerateRecoveryCodesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegenerateRecoveryCodesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegenerateRecoveryCodesResponseValidationError{}
+
+// Validate checks the field values on CreateDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateDownloadTaskRequestMultiError, or nil if none found.
+func (m *CreateDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadType
+
+	// no validation rules for Url
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateDownloadTaskRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateDownloadTaskRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateDownloadTaskRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LabelMap
+
+	// no validation rules for CallbackUrl
+
+	// no validation rules for WorkspaceId
+
+	if all {
+		switch v := interface{}(m.GetHttpRequestOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateDownloadTaskRequestValidationError{
+					field:  "HttpRequestOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateDownloadTaskRequestValidationError{
+					field:  "HttpRequestOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHttpRequestOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateDownloadTaskRequestValidationError{
+				field:  "HttpRequestOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateDownloadTaskRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateDownloadTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// CreateDownloadTaskRequestValidationError is the validation error returned by
+// CreateDownloadTaskRequest.Validate if the designated constraints aren't met.
+type CreateDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateDownloadTaskRequestValidationError) ErrorName() string {
+	return "CreateDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on CreateDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateDownloadTaskResponseMultiError, or nil if none found.
+func (m *CreateDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDownloadTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateDownloadTaskResponseValidationError{
+				field:  "DownloadTask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CallbackSecret
+
+	if len(errors) > 0 {
+		return CreateDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateDownloadTaskResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// CreateDownloadTaskResponseValidationError is the validation error returned
+// by CreateDownloadTaskResponse.Validate if the designated constraints aren't met.
+type CreateDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateDownloadTaskResponseValidationError) ErrorName() string {
+	return "CreateDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on GetDownloadTaskListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskListRequestMultiError, or nil if none found.
+func (m *GetDownloadTaskListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDownloadTaskListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDownloadTaskListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDownloadTaskListRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SortKey
+
+	// no validation rules for SortDirection
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return GetDownloadTaskListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskListRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDownloadTaskListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetDownloadTaskListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskListRequestMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskListRequestValidationError is the validation error returned
+// by GetDownloadTaskListRequest.Validate if the designated constraints aren't met.
+type GetDownloadTaskListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskListRequestValidationError) ErrorName() string {
+	return "GetDownloadTaskListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskListRequestValidationError{}
+
+// Validate checks the field values on GetDownloadTaskListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskListResponseMultiError, or nil if none found.
+func (m *GetDownloadTaskListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDownloadTaskList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDownloadTaskListResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDownloadTaskListResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDownloadTaskListResponseValidationError{
+					field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalDownloadTaskCount
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return GetDownloadTaskListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskListResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDownloadTaskListResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetDownloadTaskListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskListResponseMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskListResponseValidationError is the validation error returned
+// by GetDownloadTaskListResponse.Validate if the designated constraints
+// aren't met.
+type GetDownloadTaskListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskListResponseValidationError) ErrorName() string {
+	return "GetDownloadTaskListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskListResponseValidationError{}
+
+// Validate checks the field values on UpdateDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateDownloadTaskRequestMultiError, or nil if none found.
+func (m *UpdateDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	// no validation rules for Url
+
+	if all {
+		switch v := interface{}(m.GetTags()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateDownloadTaskRequestValidationError{
+					field:  "Tags",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateDownloadTaskRequestValidationError{
+					field:  "Tags",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTags()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateDownloadTaskRequestValidationError{
+				field:  "Tags",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLabels()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateDownloadTaskRequestValidationError{
+					field:  "Labels",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateDownloadTaskRequestValidationError{
+					field:  "Labels",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLabels()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateDownloadTaskRequestValidationError{
+				field:  "Labels",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateDownloadTaskRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDownloadTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// UpdateDownloadTaskRequestValidationError is the validation error returned by
+// UpdateDownloadTaskRequest.Validate if the designated constraints aren't met.
+type UpdateDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDownloadTaskRequestValidationError) ErrorName() string {
+	return "UpdateDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on UpdateDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateDownloadTaskResponseMultiError, or nil if none found.
+func (m *UpdateDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDownloadTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateDownloadTaskResponseValidationError{
+				field:  "DownloadTask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDownloadTaskResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// UpdateDownloadTaskResponseValidationError is the validation error returned
+// by UpdateDownloadTaskResponse.Validate if the designated constraints aren't met.
+type UpdateDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDownloadTaskResponseValidationError) ErrorName() string {
+	return "UpdateDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on DeleteDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteDownloadTaskRequestMultiError, or nil if none found.
+func (m *DeleteDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if len(errors) > 0 {
+		return DeleteDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteDownloadTaskRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteDownloadTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// DeleteDownloadTaskRequestValidationError is the validation error returned by
+// DeleteDownloadTaskRequest.Validate if the designated constraints aren't met.
+type DeleteDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteDownloadTaskRequestValidationError) ErrorName() string {
+	return "DeleteDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on DeleteDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteDownloadTaskResponseMultiError, or nil if none found.
+func (m *DeleteDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteDownloadTaskResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// DeleteDownloadTaskResponseValidationError is the validation error returned
+// by DeleteDownloadTaskResponse.Validate if the designated constraints aren't met.
+type DeleteDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteDownloadTaskResponseValidationError) ErrorName() string {
+	return "DeleteDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on GetDownloadTaskFileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskFileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskFileRequestMultiError, or nil if none found.
+func (m *GetDownloadTaskFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if len(errors) > 0 {
+		return GetDownloadTaskFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskFileRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDownloadTaskFileRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetDownloadTaskFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskFileRequestMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskFileRequestValidationError is the validation error returned
+// by GetDownloadTaskFileRequest.Validate if the designated constraints aren't met.
+type GetDownloadTaskFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskFileRequestValidationError) ErrorName() string {
+	return "GetDownloadTaskFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskFileRequestValidationError{}
+
+// Validate checks the field values on GetDownloadTaskFileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskFileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskFileResponseMultiError, or nil if none found.
+func (m *GetDownloadTaskFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return GetDownloadTaskFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskFileResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDownloadTaskFileResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetDownloadTaskFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskFileResponseMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskFileResponseValidationError is the validation error returned
+// by GetDownloadTaskFileResponse.Validate if the designated constraints
+// aren't met.
+type GetDownloadTaskFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskFileResponseValidationError) ErrorName() string {
+	return "GetDownloadTaskFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskFileResponseValidationError{}
+
+// Validate checks the field values on GetDownloadTaskHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskHistoryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskHistoryRequestMultiError, or nil if none found.
+func (m *GetDownloadTaskHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if len(errors) > 0 {
+		return GetDownloadTaskHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskHistoryRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDownloadTaskHistoryRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetDownloadTaskHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskHistoryRequestValidationError is the validation error
+// returned by GetDownloadTaskHistoryRequest.Validate if the designated
+// constraints aren't met.
+type GetDownloadTaskHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskHistoryRequestValidationError) ErrorName() string {
+	return "GetDownloadTaskHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskHistoryRequestValidationError{}
+
+// Validate checks the field values on GetDownloadTaskHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskHistoryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskHistoryResponseMultiError, or nil if none found.
+func (m *GetDownloadTaskHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDownloadTaskEventList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDownloadTaskHistoryResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskEventList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDownloadTaskHistoryResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskEventList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDownloadTaskHistoryResponseValidationError{
+					field:  fmt.Sprintf("DownloadTaskEventList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetDownloadTaskHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskHistoryResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDownloadTaskHistoryResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetDownloadTaskHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskHistoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskHistoryResponseValidationError is the validation error
+// returned by GetDownloadTaskHistoryResponse.Validate if the designated
+// constraints aren't met.
+type GetDownloadTaskHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskHistoryResponseValidationError) ErrorName() string {
+	return "GetDownloadTaskHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskHistoryResponseValidationError{}
+
+// Validate checks the field values on RetryDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RetryDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetryDownloadTaskRequestMultiError, or nil if none found.
+func (m *RetryDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if len(errors) > 0 {
+		return RetryDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetryDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by RetryDownloadTaskRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RetryDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryDownloadTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// RetryDownloadTaskRequestValidationError is the validation error returned by
+// RetryDownloadTaskRequest.Validate if the designated constraints aren't met.
+type RetryDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryDownloadTaskRequestValidationError) ErrorName() string {
+	return "RetryDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetryDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on RetryDownloadTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RetryDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetryDownloadTaskResponseMultiError, or nil if none found.
+func (m *RetryDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDownloadTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RetryDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RetryDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RetryDownloadTaskResponseValidationError{
+				field:  "DownloadTask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RetryDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetryDownloadTaskResponseMultiError is an error wrapping multiple validation
+// errors returned by RetryDownloadTaskResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RetryDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryDownloadTaskResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// RetryDownloadTaskResponseValidationError is the validation error returned by
+// RetryDownloadTaskResponse.Validate if the designated constraints aren't met.
+type RetryDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryDownloadTaskResponseValidationError) ErrorName() string {
+	return "RetryDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetryDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on CloneDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloneDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloneDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloneDownloadTaskRequestMultiError, or nil if none found.
+func (m *CloneDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloneDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if len(errors) > 0 {
+		return CloneDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloneDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by CloneDownloadTaskRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CloneDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloneDownloadTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloneDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// CloneDownloadTaskRequestValidationError is the validation error returned by
+// CloneDownloadTaskRequest.Validate if the designated constraints aren't met.
+type CloneDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloneDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloneDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloneDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloneDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloneDownloadTaskRequestValidationError) ErrorName() string {
+	return "CloneDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloneDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloneDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloneDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloneDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on CloneDownloadTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloneDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloneDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloneDownloadTaskResponseMultiError, or nil if none found.
+func (m *CloneDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloneDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDownloadTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CloneDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CloneDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CloneDownloadTaskResponseValidationError{
+				field:  "DownloadTask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CloneDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloneDownloadTaskResponseMultiError is an error wrapping multiple validation
+// errors returned by CloneDownloadTaskResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CloneDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloneDownloadTaskResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloneDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// CloneDownloadTaskResponseValidationError is the validation error returned by
+// CloneDownloadTaskResponse.Validate if the designated constraints aren't met.
+type CloneDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloneDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloneDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloneDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloneDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloneDownloadTaskResponseValidationError) ErrorName() string {
+	return "CloneDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloneDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloneDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloneDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloneDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on BulkDeleteDownloadTasksRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkDeleteDownloadTasksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkDeleteDownloadTasksRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BulkDeleteDownloadTasksRequestMultiError, or nil if none found.
+func (m *BulkDeleteDownloadTasksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkDeleteDownloadTasksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BulkDeleteDownloadTasksRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BulkDeleteDownloadTasksRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BulkDeleteDownloadTasksRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BulkDeleteDownloadTasksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkDeleteDownloadTasksRequestMultiError is an error wrapping multiple
+// validation errors returned by BulkDeleteDownloadTasksRequest.ValidateAll()
+// if the designated constraints aren't met.
+type BulkDeleteDownloadTasksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkDeleteDownloadTasksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkDeleteDownloadTasksRequestMultiError) AllErrors() []error { return m }
+
+// BulkDeleteDownloadTasksRequestValidationError is the validation error
+// returned by BulkDeleteDownloadTasksRequest.Validate if the designated
+// constraints aren't met.
+type BulkDeleteDownloadTasksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkDeleteDownloadTasksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkDeleteDownloadTasksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkDeleteDownloadTasksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkDeleteDownloadTasksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkDeleteDownloadTasksRequestValidationError) ErrorName() string {
+	return "BulkDeleteDownloadTasksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkDeleteDownloadTasksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkDeleteDownloadTasksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkDeleteDownloadTasksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkDeleteDownloadTasksRequestValidationError{}
+
+// Validate checks the field values on BulkDeleteDownloadTasksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkDeleteDownloadTasksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkDeleteDownloadTasksResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BulkDeleteDownloadTasksResponseMultiError, or nil if none found.
+func (m *BulkDeleteDownloadTasksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkDeleteDownloadTasksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResultList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BulkDeleteDownloadTasksResponseValidationError{
+						field:  fmt.Sprintf("ResultList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BulkDeleteDownloadTasksResponseValidationError{
+						field:  fmt.Sprintf("ResultList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BulkDeleteDownloadTasksResponseValidationError{
+					field:  fmt.Sprintf("ResultList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BulkDeleteDownloadTasksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkDeleteDownloadTasksResponseMultiError is an error wrapping multiple
+// validation errors returned by BulkDeleteDownloadTasksResponse.ValidateAll()
+// if the designated constraints aren't met.
+type BulkDeleteDownloadTasksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkDeleteDownloadTasksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkDeleteDownloadTasksResponseMultiError) AllErrors() []error { return m }
+
+// BulkDeleteDownloadTasksResponseValidationError is the validation error
+// returned by BulkDeleteDownloadTasksResponse.Validate if the designated
+// constraints aren't met.
+type BulkDeleteDownloadTasksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkDeleteDownloadTasksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkDeleteDownloadTasksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkDeleteDownloadTasksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkDeleteDownloadTasksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkDeleteDownloadTasksResponseValidationError) ErrorName() string {
+	return "BulkDeleteDownloadTasksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkDeleteDownloadTasksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkDeleteDownloadTasksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkDeleteDownloadTasksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkDeleteDownloadTasksResponseValidationError{}
+
+// Validate checks the field values on BulkRetryDownloadTasksRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkRetryDownloadTasksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkRetryDownloadTasksRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BulkRetryDownloadTasksRequestMultiError, or nil if none found.
+func (m *BulkRetryDownloadTasksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkRetryDownloadTasksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BulkRetryDownloadTasksRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BulkRetryDownloadTasksRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BulkRetryDownloadTasksRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BulkRetryDownloadTasksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkRetryDownloadTasksRequestMultiError is an error wrapping multiple
+// validation errors returned by BulkRetryDownloadTasksRequest.ValidateAll()
+// if the designated constraints aren't met.
+type BulkRetryDownloadTasksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkRetryDownloadTasksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkRetryDownloadTasksRequestMultiError) AllErrors() []error { return m }
+
+// BulkRetryDownloadTasksRequestValidationError is the validation error
+// returned by BulkRetryDownloadTasksRequest.Validate if the designated
+// constraints aren't met.
+type BulkRetryDownloadTasksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkRetryDownloadTasksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkRetryDownloadTasksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkRetryDownloadTasksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkRetryDownloadTasksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkRetryDownloadTasksRequestValidationError) ErrorName() string {
+	return "BulkRetryDownloadTasksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkRetryDownloadTasksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkRetryDownloadTasksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkRetryDownloadTasksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkRetryDownloadTasksRequestValidationError{}
+
+// Validate checks the field values on BulkRetryDownloadTasksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkRetryDownloadTasksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkRetryDownloadTasksResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BulkRetryDownloadTasksResponseMultiError, or nil if none found.
+func (m *BulkRetryDownloadTasksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkRetryDownloadTasksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResultList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BulkRetryDownloadTasksResponseValidationError{
+						field:  fmt.Sprintf("ResultList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BulkRetryDownloadTasksResponseValidationError{
+						field:  fmt.Sprintf("ResultList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BulkRetryDownloadTasksResponseValidationError{
+					field:  fmt.Sprintf("ResultList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BulkRetryDownloadTasksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkRetryDownloadTasksResponseMultiError is an error wrapping multiple
+// validation errors returned by BulkRetryDownloadTasksResponse.ValidateAll()
+// if the designated constraints aren't met.
+type BulkRetryDownloadTasksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkRetryDownloadTasksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkRetryDownloadTasksResponseMultiError) AllErrors() []error { return m }
+
+// BulkRetryDownloadTasksResponseValidationError is the validation error
+// returned by BulkRetryDownloadTasksResponse.Validate if the designated
+// constraints aren't met.
+type BulkRetryDownloadTasksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkRetryDownloadTasksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkRetryDownloadTasksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkRetryDownloadTasksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkRetryDownloadTasksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkRetryDownloadTasksResponseValidationError) ErrorName() string {
+	return "BulkRetryDownloadTasksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkRetryDownloadTasksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkRetryDownloadTasksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkRetryDownloadTasksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkRetryDownloadTasksResponseValidationError{}
+
+// Validate checks the field values on BulkCancelDownloadTasksRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkCancelDownloadTasksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkCancelDownloadTasksRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BulkCancelDownloadTasksRequestMultiError, or nil if none found.
+func (m *BulkCancelDownloadTasksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkCancelDownloadTasksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BulkCancelDownloadTasksRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BulkCancelDownloadTasksRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BulkCancelDownloadTasksRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BulkCancelDownloadTasksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkCancelDownloadTasksRequestMultiError is an error wrapping multiple
+// validation errors returned by BulkCancelDownloadTasksRequest.ValidateAll()
+// if the designated constraints aren't met.
+type BulkCancelDownloadTasksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkCancelDownloadTasksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkCancelDownloadTasksRequestMultiError) AllErrors() []error { return m }
+
+// BulkCancelDownloadTasksRequestValidationError is the validation error
+// returned by BulkCancelDownloadTasksRequest.Validate if the designated
+// constraints aren't met.
+type BulkCancelDownloadTasksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkCancelDownloadTasksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkCancelDownloadTasksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkCancelDownloadTasksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkCancelDownloadTasksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkCancelDownloadTasksRequestValidationError) ErrorName() string {
+	return "BulkCancelDownloadTasksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkCancelDownloadTasksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkCancelDownloadTasksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkCancelDownloadTasksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkCancelDownloadTasksRequestValidationError{}
+
+// Validate checks the field values on BulkCancelDownloadTasksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BulkCancelDownloadTasksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BulkCancelDownloadTasksResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BulkCancelDownloadTasksResponseMultiError, or nil if none found.
+func (m *BulkCancelDownloadTasksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BulkCancelDownloadTasksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResultList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BulkCancelDownloadTasksResponseValidationError{
+						field:  fmt.Sprintf("ResultList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BulkCancelDownloadTasksResponseValidationError{
+						field:  fmt.Sprintf("ResultList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BulkCancelDownloadTasksResponseValidationError{
+					field:  fmt.Sprintf("ResultList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BulkCancelDownloadTasksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BulkCancelDownloadTasksResponseMultiError is an error wrapping multiple
+// validation errors returned by BulkCancelDownloadTasksResponse.ValidateAll()
+// if the designated constraints aren't met.
+type BulkCancelDownloadTasksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BulkCancelDownloadTasksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BulkCancelDownloadTasksResponseMultiError) AllErrors() []error { return m }
+
+// BulkCancelDownloadTasksResponseValidationError is the validation error
+// returned by BulkCancelDownloadTasksResponse.Validate if the designated
+// constraints aren't met.
+type BulkCancelDownloadTasksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BulkCancelDownloadTasksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BulkCancelDownloadTasksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BulkCancelDownloadTasksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BulkCancelDownloadTasksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BulkCancelDownloadTasksResponseValidationError) ErrorName() string {
+	return "BulkCancelDownloadTasksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BulkCancelDownloadTasksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBulkCancelDownloadTasksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BulkCancelDownloadTasksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BulkCancelDownloadTasksResponseValidationError{}
+
+// Validate checks the field values on CreateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWebhookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWebhookRequestMultiError, or nil if none found.
+func (m *CreateWebhookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWebhookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	if len(errors) > 0 {
+		return CreateWebhookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWebhookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWebhookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWebhookRequestMultiError) AllErrors() []error { return m }
+
+// CreateWebhookRequestValidationError is the validation error returned by
+// CreateWebhookRequest.Validate if the designated constraints aren't met.
+type CreateWebhookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookRequestValidationError) ErrorName() string {
+	return "CreateWebhookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookRequestValidationError{}
+
+// Validate checks the field values on CreateWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWebhookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWebhookResponseMultiError, or nil if none found.
+func (m *CreateWebhookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWebhookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWebhook()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateWebhookResponseValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateWebhookResponseValidationError{
+					field:  "Webhook",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebhook()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateWebhookResponseValidationError{
+				field:  "Webhook",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return CreateWebhookResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWebhookResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateWebhookResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWebhookResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWebhookResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWebhookResponseMultiError) AllErrors() []error { return m }
+
+// CreateWebhookResponseValidationError is the validation error returned by
+// CreateWebhookResponse.Validate if the designated constraints aren't met.
+type CreateWebhookResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookResponseValidationError) ErrorName() string {
+	return "CreateWebhookResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookResponseValidationError{}
+
+// Validate checks the field values on GetWebhookListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWebhookListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWebhookListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWebhookListRequestMultiError, or nil if none found.
+func (m *GetWebhookListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWebhookListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetWebhookListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWebhookListRequestMultiError is an error wrapping multiple validation
+// errors returned by GetWebhookListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetWebhookListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWebhookListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWebhookListRequestMultiError) AllErrors() []error { return m }
+
+// GetWebhookListRequestValidationError is the validation error returned by
+// GetWebhookListRequest.Validate if the designated constraints aren't met.
+type GetWebhookListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWebhookListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWebhookListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWebhookListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWebhookListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWebhookListRequestValidationError) ErrorName() string {
+	return "GetWebhookListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWebhookListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWebhookListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWebhookListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWebhookListRequestValidationError{}
+
+// Validate checks the field values on GetWebhookListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWebhookListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWebhookListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWebhookListResponseMultiError, or nil if none found.
+func (m *GetWebhookListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWebhookListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWebhookList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetWebhookListResponseValidationError{
+						field:  fmt.Sprintf("WebhookList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetWebhookListResponseValidationError{
+						field:  fmt.Sprintf("WebhookList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetWebhookListResponseValidationError{
+					field:  fmt.Sprintf("WebhookList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetWebhookListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWebhookListResponseMultiError is an error wrapping multiple validation
+// errors returned by GetWebhookListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetWebhookListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWebhookListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWebhookListResponseMultiError) AllErrors() []error { return m }
+
+// GetWebhookListResponseValidationError is the validation error returned by
+// GetWebhookListResponse.Validate if the designated constraints aren't met.
+type GetWebhookListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWebhookListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWebhookListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWebhookListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWebhookListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWebhookListResponseValidationError) ErrorName() string {
+	return "GetWebhookListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWebhookListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWebhookListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWebhookListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWebhookListResponseValidationError{}
+
+// Validate checks the field values on DeleteWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWebhookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWebhookRequestMultiError, or nil if none found.
+func (m *DeleteWebhookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWebhookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WebhookId
+
+	if len(errors) > 0 {
+		return DeleteWebhookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWebhookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWebhookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWebhookRequestMultiError) AllErrors() []error { return m }
+
+// DeleteWebhookRequestValidationError is the validation error returned by
+// DeleteWebhookRequest.Validate if the designated constraints aren't met.
+type DeleteWebhookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWebhookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWebhookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWebhookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWebhookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWebhookRequestValidationError) ErrorName() string {
+	return "DeleteWebhookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWebhookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWebhookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWebhookRequestValidationError{}
+
+// Validate checks the field values on DeleteWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWebhookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWebhookResponseMultiError, or nil if none found.
+func (m *DeleteWebhookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWebhookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteWebhookResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWebhookResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteWebhookResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWebhookResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWebhookResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWebhookResponseMultiError) AllErrors() []error { return m }
+
+// DeleteWebhookResponseValidationError is the validation error returned by
+// DeleteWebhookResponse.Validate if the designated constraints aren't met.
+type DeleteWebhookResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWebhookResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWebhookResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWebhookResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWebhookResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWebhookResponseValidationError) ErrorName() string {
+	return "DeleteWebhookResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWebhookResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWebhookResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWebhookResponseValidationError{}
+
+// Validate checks the field values on TestWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TestWebhookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TestWebhookRequestMultiError, or nil if none found.
+func (m *TestWebhookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestWebhookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WebhookId
+
+	if len(errors) > 0 {
+		return TestWebhookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestWebhookRequestMultiError is an error wrapping multiple validation errors
+// returned by TestWebhookRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TestWebhookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestWebhookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestWebhookRequestMultiError) AllErrors() []error { return m }
+
+// TestWebhookRequestValidationError is the validation error returned by
+// TestWebhookRequest.Validate if the designated constraints aren't met.
+type TestWebhookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestWebhookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestWebhookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestWebhookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestWebhookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestWebhookRequestValidationError) ErrorName() string {
+	return "TestWebhookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TestWebhookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestWebhookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestWebhookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestWebhookRequestValidationError{}
+
+// Validate checks the field values on TestWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TestWebhookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TestWebhookResponseMultiError, or nil if none found.
+func (m *TestWebhookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestWebhookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWebhookDelivery()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TestWebhookResponseValidationError{
+					field:  "WebhookDelivery",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TestWebhookResponseValidationError{
+					field:  "WebhookDelivery",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWebhookDelivery()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TestWebhookResponseValidationError{
+				field:  "WebhookDelivery",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TestWebhookResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestWebhookResponseMultiError is an error wrapping multiple validation
+// errors returned by TestWebhookResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TestWebhookResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestWebhookResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestWebhookResponseMultiError) AllErrors() []error { return m }
+
+// TestWebhookResponseValidationError is the validation error returned by
+// TestWebhookResponse.Validate if the designated constraints aren't met.
+type TestWebhookResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestWebhookResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestWebhookResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestWebhookResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestWebhookResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestWebhookResponseValidationError) ErrorName() string {
+	return "TestWebhookResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TestWebhookResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestWebhookResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestWebhookResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestWebhookResponseValidationError{}
+
+// Validate checks the field values on GetWebhookDeliveryListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWebhookDeliveryListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWebhookDeliveryListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetWebhookDeliveryListRequestMultiError, or nil if none found.
+func (m *GetWebhookDeliveryListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWebhookDeliveryListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WebhookId
+
+	// no validation rules for DownloadTaskId
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return GetWebhookDeliveryListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWebhookDeliveryListRequestMultiError is an error wrapping multiple
+// validation errors returned by GetWebhookDeliveryListRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetWebhookDeliveryListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWebhookDeliveryListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWebhookDeliveryListRequestMultiError) AllErrors() []error { return m }
+
+// GetWebhookDeliveryListRequestValidationError is the validation error
+// returned by GetWebhookDeliveryListRequest.Validate if the designated
+// constraints aren't met.
+type GetWebhookDeliveryListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWebhookDeliveryListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWebhookDeliveryListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWebhookDeliveryListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWebhookDeliveryListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWebhookDeliveryListRequestValidationError) ErrorName() string {
+	return "GetWebhookDeliveryListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWebhookDeliveryListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWebhookDeliveryListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWebhookDeliveryListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWebhookDeliveryListRequestValidationError{}
+
+// Validate checks the field values on GetWebhookDeliveryListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWebhookDeliveryListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWebhookDeliveryListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetWebhookDeliveryListResponseMultiError, or nil if none found.
+func (m *GetWebhookDeliveryListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWebhookDeliveryListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWebhookDeliveryList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetWebhookDeliveryListResponseValidationError{
+						field:  fmt.Sprintf("WebhookDeliveryList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetWebhookDeliveryListResponseValidationError{
+						field:  fmt.Sprintf("WebhookDeliveryList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetWebhookDeliveryListResponseValidationError{
+					field:  fmt.Sprintf("WebhookDeliveryList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetWebhookDeliveryListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWebhookDeliveryListResponseMultiError is an error wrapping multiple
+// validation errors returned by GetWebhookDeliveryListResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetWebhookDeliveryListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWebhookDeliveryListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWebhookDeliveryListResponseMultiError) AllErrors() []error { return m }
+
+// GetWebhookDeliveryListResponseValidationError is the validation error
+// returned by GetWebhookDeliveryListResponse.Validate if the designated
+// constraints aren't met.
+type GetWebhookDeliveryListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWebhookDeliveryListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWebhookDeliveryListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWebhookDeliveryListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWebhookDeliveryListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWebhookDeliveryListResponseValidationError) ErrorName() string {
+	return "GetWebhookDeliveryListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWebhookDeliveryListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWebhookDeliveryListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWebhookDeliveryListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWebhookDeliveryListResponseValidationError{}
+
+// Validate checks the field values on CreateApiKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateApiKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateApiKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateApiKeyRequestMultiError, or nil if none found.
+func (m *CreateApiKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateApiKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateApiKeyRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateApiKeyRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateApiKeyRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateApiKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateApiKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateApiKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateApiKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateApiKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateApiKeyRequestMultiError) AllErrors() []error { return m }
+
+// CreateApiKeyRequestValidationError is the validation error returned by
+// CreateApiKeyRequest.Validate if the designated constraints aren't met.
+type CreateApiKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateApiKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateApiKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateApiKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateApiKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateApiKeyRequestValidationError) ErrorName() string {
+	return "CreateApiKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateApiKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateApiKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateApiKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateApiKeyRequestValidationError{}
+
+// Validate checks the field values on CreateApiKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateApiKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateApiKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateApiKeyResponseMultiError, or nil if none found.
+func (m *CreateApiKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateApiKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetApiKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateApiKeyResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateApiKeyResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApiKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateApiKeyResponseValidationError{
+				field:  "ApiKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return CreateApiKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateApiKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateApiKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateApiKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateApiKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateApiKeyResponseMultiError) AllErrors() []error { return m }
+
+// CreateApiKeyResponseValidationError is the validation error returned by
+// CreateApiKeyResponse.Validate if the designated constraints aren't met.
+type CreateApiKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateApiKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateApiKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateApiKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateApiKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateApiKeyResponseValidationError) ErrorName() string {
+	return "CreateApiKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateApiKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateApiKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateApiKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateApiKeyResponseValidationError{}
+
+// Validate checks the field values on ListApiKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListApiKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListApiKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListApiKeysRequestMultiError, or nil if none found.
+func (m *ListApiKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListApiKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListApiKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListApiKeysRequestMultiError is an error wrapping multiple validation errors
+// returned by ListApiKeysRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListApiKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListApiKeysRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListApiKeysRequestMultiError) AllErrors() []error { return m }
+
+// ListApiKeysRequestValidationError is the validation error returned by
+// ListApiKeysRequest.Validate if the designated constraints aren't met.
+type ListApiKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApiKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApiKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApiKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApiKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApiKeysRequestValidationError) ErrorName() string {
+	return "ListApiKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApiKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApiKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApiKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApiKeysRequestValidationError{}
+
+// Validate checks the field values on ListApiKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListApiKeysResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListApiKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListApiKeysResponseMultiError, or nil if none found.
+func (m *ListApiKeysResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListApiKeysResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetApiKeyList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListApiKeysResponseValidationError{
+						field:  fmt.Sprintf("ApiKeyList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListApiKeysResponseValidationError{
+						field:  fmt.Sprintf("ApiKeyList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListApiKeysResponseValidationError{
+					field:  fmt.Sprintf("ApiKeyList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListApiKeysResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListApiKeysResponseMultiError is an error wrapping multiple validation
+// errors returned by ListApiKeysResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListApiKeysResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListApiKeysResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListApiKeysResponseMultiError) AllErrors() []error { return m }
+
+// ListApiKeysResponseValidationError is the validation error returned by
+// ListApiKeysResponse.Validate if the designated constraints aren't met.
+type ListApiKeysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApiKeysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApiKeysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApiKeysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApiKeysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApiKeysResponseValidationError) ErrorName() string {
+	return "ListApiKeysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApiKeysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApiKeysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApiKeysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApiKeysResponseValidationError{}
+
+// Validate checks the field values on RevokeApiKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeApiKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeApiKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeApiKeyRequestMultiError, or nil if none found.
+func (m *RevokeApiKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeApiKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ApiKeyId
+
+	if len(errors) > 0 {
+		return RevokeApiKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeApiKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeApiKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeApiKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeApiKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeApiKeyRequestMultiError) AllErrors() []error { return m }
+
+// RevokeApiKeyRequestValidationError is the validation error returned by
+// RevokeApiKeyRequest.Validate if the designated constraints aren't met.
+type RevokeApiKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeApiKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeApiKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeApiKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeApiKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeApiKeyRequestValidationError) ErrorName() string {
+	return "RevokeApiKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeApiKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeApiKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeApiKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeApiKeyRequestValidationError{}
+
+// Validate checks the field values on RevokeApiKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeApiKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeApiKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeApiKeyResponseMultiError, or nil if none found.
+func (m *RevokeApiKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeApiKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeApiKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeApiKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeApiKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeApiKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeApiKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeApiKeyResponseMultiError) AllErrors() []error { return m }
+
+// RevokeApiKeyResponseValidationError is the validation error returned by
+// RevokeApiKeyResponse.Validate if the designated constraints aren't met.
+type RevokeApiKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeApiKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeApiKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeApiKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeApiKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeApiKeyResponseValidationError) ErrorName() string {
+	return "RevokeApiKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeApiKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeApiKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeApiKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeApiKeyResponseValidationError{}
+
+// Validate checks the field values on CreateShareLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateShareLinkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateShareLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateShareLinkRequestMultiError, or nil if none found.
+func (m *CreateShareLinkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateShareLinkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateShareLinkRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateShareLinkRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateShareLinkRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Password
+
+	// no validation rules for MaxDownloadCount
+
+	if len(errors) > 0 {
+		return CreateShareLinkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateShareLinkRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateShareLinkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateShareLinkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateShareLinkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateShareLinkRequestMultiError) AllErrors() []error { return m }
+
+// CreateShareLinkRequestValidationError is the validation error returned by
+// CreateShareLinkRequest.Validate if the designated constraints aren't met.
+type CreateShareLinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateShareLinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateShareLinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateShareLinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateShareLinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateShareLinkRequestValidationError) ErrorName() string {
+	return "CreateShareLinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateShareLinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateShareLinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateShareLinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateShareLinkRequestValidationError{}
+
+// Validate checks the field values on CreateShareLinkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateShareLinkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateShareLinkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateShareLinkResponseMultiError, or nil if none found.
+func (m *CreateShareLinkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateShareLinkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetShareLink()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateShareLinkResponseValidationError{
+					field:  "ShareLink",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateShareLinkResponseValidationError{
+					field:  "ShareLink",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetShareLink()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateShareLinkResponseValidationError{
+				field:  "ShareLink",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateShareLinkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateShareLinkResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateShareLinkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateShareLinkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateShareLinkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateShareLinkResponseMultiError) AllErrors() []error { return m }
+
+// CreateShareLinkResponseValidationError is the validation error returned by
+// CreateShareLinkResponse.Validate if the designated constraints aren't met.
+type CreateShareLinkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateShareLinkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateShareLinkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateShareLinkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateShareLinkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateShareLinkResponseValidationError) ErrorName() string {
+	return "CreateShareLinkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateShareLinkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateShareLinkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateShareLinkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateShareLinkResponseValidationError{}
+
+// Validate checks the field values on ListShareLinksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListShareLinksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListShareLinksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListShareLinksRequestMultiError, or nil if none found.
+func (m *ListShareLinksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListShareLinksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListShareLinksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListShareLinksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListShareLinksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListShareLinksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListShareLinksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListShareLinksRequestMultiError) AllErrors() []error { return m }
+
+// ListShareLinksRequestValidationError is the validation error returned by
+// ListShareLinksRequest.Validate if the designated constraints aren't met.
+type ListShareLinksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListShareLinksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListShareLinksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListShareLinksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListShareLinksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListShareLinksRequestValidationError) ErrorName() string {
+	return "ListShareLinksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListShareLinksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListShareLinksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListShareLinksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListShareLinksRequestValidationError{}
+
+// Validate checks the field values on ListShareLinksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListShareLinksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListShareLinksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListShareLinksResponseMultiError, or nil if none found.
+func (m *ListShareLinksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListShareLinksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetShareLinkList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListShareLinksResponseValidationError{
+						field:  fmt.Sprintf("ShareLinkList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListShareLinksResponseValidationError{
+						field:  fmt.Sprintf("ShareLinkList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListShareLinksResponseValidationError{
+					field:  fmt.Sprintf("ShareLinkList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListShareLinksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListShareLinksResponseMultiError is an error wrapping multiple validation
+// errors returned by ListShareLinksResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListShareLinksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListShareLinksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListShareLinksResponseMultiError) AllErrors() []error { return m }
+
+// ListShareLinksResponseValidationError is the validation error returned by
+// ListShareLinksResponse.Validate if the designated constraints aren't met.
+type ListShareLinksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListShareLinksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListShareLinksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListShareLinksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListShareLinksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListShareLinksResponseValidationError) ErrorName() string {
+	return "ListShareLinksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListShareLinksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListShareLinksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListShareLinksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListShareLinksResponseValidationError{}
+
+// Validate checks the field values on RevokeShareLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeShareLinkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeShareLinkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeShareLinkRequestMultiError, or nil if none found.
+func (m *RevokeShareLinkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeShareLinkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShareLinkId
+
+	if len(errors) > 0 {
+		return RevokeShareLinkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeShareLinkRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeShareLinkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeShareLinkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeShareLinkRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeShareLinkRequestMultiError) AllErrors() []error { return m }
+
+// RevokeShareLinkRequestValidationError is the validation error returned by
+// RevokeShareLinkRequest.Validate if the designated constraints aren't met.
+type RevokeShareLinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeShareLinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeShareLinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeShareLinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeShareLinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeShareLinkRequestValidationError) ErrorName() string {
+	return "RevokeShareLinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeShareLinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeShareLinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeShareLinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeShareLinkRequestValidationError{}
+
+// Validate checks the field values on RevokeShareLinkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeShareLinkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeShareLinkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeShareLinkResponseMultiError, or nil if none found.
+func (m *RevokeShareLinkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeShareLinkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeShareLinkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeShareLinkResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeShareLinkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeShareLinkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeShareLinkResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeShareLinkResponseMultiError) AllErrors() []error { return m }
+
+// RevokeShareLinkResponseValidationError is the validation error returned by
+// RevokeShareLinkResponse.Validate if the designated constraints aren't met.
+type RevokeShareLinkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeShareLinkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeShareLinkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeShareLinkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeShareLinkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeShareLinkResponseValidationError) ErrorName() string {
+	return "RevokeShareLinkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeShareLinkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeShareLinkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeShareLinkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeShareLinkResponseValidationError{}
+
+// Validate checks the field values on CreateWorkspaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWorkspaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWorkspaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWorkspaceRequestMultiError, or nil if none found.
+func (m *CreateWorkspaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWorkspaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DisplayName
+
+	if len(errors) > 0 {
+		return CreateWorkspaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWorkspaceRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateWorkspaceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWorkspaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWorkspaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWorkspaceRequestMultiError) AllErrors() []error { return m }
+
+// CreateWorkspaceRequestValidationError is the validation error returned by
+// CreateWorkspaceRequest.Validate if the designated constraints aren't met.
+type CreateWorkspaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWorkspaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWorkspaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWorkspaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWorkspaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWorkspaceRequestValidationError) ErrorName() string {
+	return "CreateWorkspaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWorkspaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWorkspaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWorkspaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWorkspaceRequestValidationError{}
+
+// Validate checks the field values on CreateWorkspaceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWorkspaceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWorkspaceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWorkspaceResponseMultiError, or nil if none found.
+func (m *CreateWorkspaceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateAccountResponse) validate(all bool) error {
+func (m *CreateWorkspaceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AccountId
+	if all {
+		switch v := interface{}(m.GetWorkspace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateWorkspaceResponseValidationError{
+					field:  "Workspace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateWorkspaceResponseValidationError{
+					field:  "Workspace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkspace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateWorkspaceResponseValidationError{
+				field:  "Workspace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return CreateAccountResponseMultiError(errors)
+		return CreateWorkspaceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateAccountResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateAccountResponse.ValidateAll() if the designated
+// CreateWorkspaceResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateWorkspaceResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateAccountResponseMultiError []error
+type CreateWorkspaceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateAccountResponseMultiError) Error() string {
+func (m CreateWorkspaceResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -425,11 +10560,11 @@ func (m CreateAccountResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateAccountResponseMultiError) AllErrors() []error { return m }
+func (m CreateWorkspaceResponseMultiError) AllErrors() []error { return m }
 
-// CreateAccountResponseValidationError is the validation error returned by
-// CreateAccountResponse.Validate if the designated constraints aren't met.
-type CreateAccountResponseValidationError struct {
+// CreateWorkspaceResponseValidationError is the validation error returned by
+// CreateWorkspaceResponse.Validate if the designated constraints aren't met.
+type CreateWorkspaceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -437,24 +10572,24 @@ type CreateAccountResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateAccountResponseValidationError) Field() string { return e.field }
+func (e CreateWorkspaceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateAccountResponseValidationError) Reason() string { return e.reason }
+func (e CreateWorkspaceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateAccountResponseValidationError) Cause() error { return e.cause }
+func (e CreateWorkspaceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAccountResponseValidationError) Key() bool { return e.key }
+func (e CreateWorkspaceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAccountResponseValidationError) ErrorName() string {
-	return "CreateAccountResponseValidationError"
+func (e CreateWorkspaceResponseValidationError) ErrorName() string {
+	return "CreateWorkspaceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateAccountResponseValidationError) Error() string {
+func (e CreateWorkspaceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -466,14 +10601,14 @@ func (e CreateAccountResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAccountResponse.%s: %s%s",
+		"invalid %sCreateWorkspaceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAccountResponseValidationError{}
+var _ error = CreateWorkspaceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -481,48 +10616,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAccountResponseValidationError{}
+} = CreateWorkspaceResponseValidationError{}
 
-// Validate checks the field values on CreateSessionRequest with the rules
+// Validate checks the field values on GetWorkspaceListRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSessionRequest) Validate() error {
+func (m *GetWorkspaceListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSessionRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetWorkspaceListRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateSessionRequestMultiError, or nil if none found.
-func (m *CreateSessionRequest) ValidateAll() error {
+// GetWorkspaceListRequestMultiError, or nil if none found.
+func (m *GetWorkspaceListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSessionRequest) validate(all bool) error {
+func (m *GetWorkspaceListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AccountName
-
-	// no validation rules for Password
-
 	if len(errors) > 0 {
-		return CreateSessionRequestMultiError(errors)
+		return GetWorkspaceListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateSessionRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateSessionRequest.ValidateAll() if the designated
+// GetWorkspaceListRequestMultiError is an error wrapping multiple validation
+// errors returned by GetWorkspaceListRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CreateSessionRequestMultiError []error
+type GetWorkspaceListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSessionRequestMultiError) Error() string {
+func (m GetWorkspaceListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -531,11 +10662,11 @@ func (m CreateSessionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSessionRequestMultiError) AllErrors() []error { return m }
+func (m GetWorkspaceListRequestMultiError) AllErrors() []error { return m }
 
-// CreateSessionRequestValidationError is the validation error returned by
-// CreateSessionRequest.Validate if the designated constraints aren't met.
-type CreateSessionRequestValidationError struct {
+// GetWorkspaceListRequestValidationError is the validation error returned by
+// GetWorkspaceListRequest.Validate if the designated constraints aren't met.
+type GetWorkspaceListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -543,24 +10674,24 @@ type CreateSessionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSessionRequestValidationError) Field() string { return e.field }
+func (e GetWorkspaceListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSessionRequestValidationError) Reason() string { return e.reason }
+func (e GetWorkspaceListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSessionRequestValidationError) Cause() error { return e.cause }
+func (e GetWorkspaceListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSessionRequestValidationError) Key() bool { return e.key }
+func (e GetWorkspaceListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSessionRequestValidationError) ErrorName() string {
-	return "CreateSessionRequestValidationError"
+func (e GetWorkspaceListRequestValidationError) ErrorName() string {
+	return "GetWorkspaceListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSessionRequestValidationError) Error() string {
+func (e GetWorkspaceListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -572,14 +10703,14 @@ func (e CreateSessionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSessionRequest.%s: %s%s",
+		"invalid %sGetWorkspaceListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSessionRequestValidationError{}
+var _ error = GetWorkspaceListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -587,73 +10718,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSessionRequestValidationError{}
+} = GetWorkspaceListRequestValidationError{}
 
-// Validate checks the field values on CreateSessionResponse with the rules
+// Validate checks the field values on GetWorkspaceListResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateSessionResponse) Validate() error {
+func (m *GetWorkspaceListResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateSessionResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetWorkspaceListResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateSessionResponseMultiError, or nil if none found.
-func (m *CreateSessionResponse) ValidateAll() error {
+// GetWorkspaceListResponseMultiError, or nil if none found.
+func (m *GetWorkspaceListResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateSessionResponse) validate(all bool) error {
+func (m *GetWorkspaceListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetAccount()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateSessionResponseValidationError{
-					field:  "Account",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetWorkspaceList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetWorkspaceListResponseValidationError{
+						field:  fmt.Sprintf("WorkspaceList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetWorkspaceListResponseValidationError{
+						field:  fmt.Sprintf("WorkspaceList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateSessionResponseValidationError{
-					field:  "Account",
+				return GetWorkspaceListResponseValidationError{
+					field:  fmt.Sprintf("WorkspaceList[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateSessionResponseValidationError{
-				field:  "Account",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return CreateSessionResponseMultiError(errors)
+		return GetWorkspaceListResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateSessionResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateSessionResponse.ValidateAll() if the designated
+// GetWorkspaceListResponseMultiError is an error wrapping multiple validation
+// errors returned by GetWorkspaceListResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateSessionResponseMultiError []error
+type GetWorkspaceListResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateSessionResponseMultiError) Error() string {
+func (m GetWorkspaceListResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -662,11 +10798,11 @@ func (m CreateSessionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateSessionResponseMultiError) AllErrors() []error { return m }
+func (m GetWorkspaceListResponseMultiError) AllErrors() []error { return m }
 
-// CreateSessionResponseValidationError is the validation error returned by
-// CreateSessionResponse.Validate if the designated constraints aren't met.
-type CreateSessionResponseValidationError struct {
+// GetWorkspaceListResponseValidationError is the validation error returned by
+// GetWorkspaceListResponse.Validate if the designated constraints aren't met.
+type GetWorkspaceListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -674,24 +10810,24 @@ type CreateSessionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateSessionResponseValidationError) Field() string { return e.field }
+func (e GetWorkspaceListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateSessionResponseValidationError) Reason() string { return e.reason }
+func (e GetWorkspaceListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateSessionResponseValidationError) Cause() error { return e.cause }
+func (e GetWorkspaceListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateSessionResponseValidationError) Key() bool { return e.key }
+func (e GetWorkspaceListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateSessionResponseValidationError) ErrorName() string {
-	return "CreateSessionResponseValidationError"
+func (e GetWorkspaceListResponseValidationError) ErrorName() string {
+	return "GetWorkspaceListResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateSessionResponseValidationError) Error() string {
+func (e GetWorkspaceListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -703,14 +10839,14 @@ func (e CreateSessionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateSessionResponse.%s: %s%s",
+		"invalid %sGetWorkspaceListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateSessionResponseValidationError{}
+var _ error = GetWorkspaceListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -718,48 +10854,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateSessionResponseValidationError{}
+} = GetWorkspaceListResponseValidationError{}
 
-// Validate checks the field values on CreateDownloadTaskRequest with the rules
+// Validate checks the field values on DeleteWorkspaceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateDownloadTaskRequest) Validate() error {
+func (m *DeleteWorkspaceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateDownloadTaskRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteWorkspaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateDownloadTaskRequestMultiError, or nil if none found.
-func (m *CreateDownloadTaskRequest) ValidateAll() error {
+// DeleteWorkspaceRequestMultiError, or nil if none found.
+func (m *DeleteWorkspaceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateDownloadTaskRequest) validate(all bool) error {
+func (m *DeleteWorkspaceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DownloadType
-
-	// no validation rules for Url
+	// no validation rules for WorkspaceId
 
 	if len(errors) > 0 {
-		return CreateDownloadTaskRequestMultiError(errors)
+		return DeleteWorkspaceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateDownloadTaskRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateDownloadTaskRequest.ValidateAll() if the
-// designated constraints aren't met.
-type CreateDownloadTaskRequestMultiError []error
+// DeleteWorkspaceRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteWorkspaceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWorkspaceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateDownloadTaskRequestMultiError) Error() string {
+func (m DeleteWorkspaceRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -768,11 +10902,11 @@ func (m CreateDownloadTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateDownloadTaskRequestMultiError) AllErrors() []error { return m }
+func (m DeleteWorkspaceRequestMultiError) AllErrors() []error { return m }
 
-// CreateDownloadTaskRequestValidationError is the validation error returned by
-// CreateDownloadTaskRequest.Validate if the designated constraints aren't met.
-type CreateDownloadTaskRequestValidationError struct {
+// DeleteWorkspaceRequestValidationError is the validation error returned by
+// DeleteWorkspaceRequest.Validate if the designated constraints aren't met.
+type DeleteWorkspaceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -780,24 +10914,24 @@ type CreateDownloadTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateDownloadTaskRequestValidationError) Field() string { return e.field }
+func (e DeleteWorkspaceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateDownloadTaskRequestValidationError) Reason() string { return e.reason }
+func (e DeleteWorkspaceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateDownloadTaskRequestValidationError) Cause() error { return e.cause }
+func (e DeleteWorkspaceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateDownloadTaskRequestValidationError) Key() bool { return e.key }
+func (e DeleteWorkspaceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateDownloadTaskRequestValidationError) ErrorName() string {
-	return "CreateDownloadTaskRequestValidationError"
+func (e DeleteWorkspaceRequestValidationError) ErrorName() string {
+	return "DeleteWorkspaceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateDownloadTaskRequestValidationError) Error() string {
+func (e DeleteWorkspaceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -809,14 +10943,14 @@ func (e CreateDownloadTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateDownloadTaskRequest.%s: %s%s",
+		"invalid %sDeleteWorkspaceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateDownloadTaskRequestValidationError{}
+var _ error = DeleteWorkspaceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -824,73 +10958,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateDownloadTaskRequestValidationError{}
+} = DeleteWorkspaceRequestValidationError{}
 
-// Validate checks the field values on CreateDownloadTaskResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteWorkspaceResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateDownloadTaskResponse) Validate() error {
+func (m *DeleteWorkspaceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateDownloadTaskResponse with the
+// ValidateAll checks the field values on DeleteWorkspaceResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateDownloadTaskResponseMultiError, or nil if none found.
-func (m *CreateDownloadTaskResponse) ValidateAll() error {
+// DeleteWorkspaceResponseMultiError, or nil if none found.
+func (m *DeleteWorkspaceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateDownloadTaskResponse) validate(all bool) error {
+func (m *DeleteWorkspaceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetDownloadTask()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateDownloadTaskResponseValidationError{
-					field:  "DownloadTask",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateDownloadTaskResponseValidationError{
-					field:  "DownloadTask",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreateDownloadTaskResponseValidationError{
-				field:  "DownloadTask",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return CreateDownloadTaskResponseMultiError(errors)
+		return DeleteWorkspaceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateDownloadTaskResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateDownloadTaskResponse.ValidateAll() if
-// the designated constraints aren't met.
-type CreateDownloadTaskResponseMultiError []error
+// DeleteWorkspaceResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteWorkspaceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWorkspaceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateDownloadTaskResponseMultiError) Error() string {
+func (m DeleteWorkspaceResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -899,11 +11004,11 @@ func (m CreateDownloadTaskResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateDownloadTaskResponseMultiError) AllErrors() []error { return m }
+func (m DeleteWorkspaceResponseMultiError) AllErrors() []error { return m }
 
-// CreateDownloadTaskResponseValidationError is the validation error returned
-// by CreateDownloadTaskResponse.Validate if the designated constraints aren't met.
-type CreateDownloadTaskResponseValidationError struct {
+// DeleteWorkspaceResponseValidationError is the validation error returned by
+// DeleteWorkspaceResponse.Validate if the designated constraints aren't met.
+type DeleteWorkspaceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -911,24 +11016,24 @@ type CreateDownloadTaskResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateDownloadTaskResponseValidationError) Field() string { return e.field }
+func (e DeleteWorkspaceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateDownloadTaskResponseValidationError) Reason() string { return e.reason }
+func (e DeleteWorkspaceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateDownloadTaskResponseValidationError) Cause() error { return e.cause }
+func (e DeleteWorkspaceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateDownloadTaskResponseValidationError) Key() bool { return e.key }
+func (e DeleteWorkspaceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateDownloadTaskResponseValidationError) ErrorName() string {
-	return "CreateDownloadTaskResponseValidationError"
+func (e DeleteWorkspaceResponseValidationError) ErrorName() string {
+	return "DeleteWorkspaceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateDownloadTaskResponseValidationError) Error() string {
+func (e DeleteWorkspaceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -940,14 +11045,14 @@ func (e CreateDownloadTaskResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateDownloadTaskResponse.%s: %s%s",
+		"invalid %sDeleteWorkspaceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateDownloadTaskResponseValidationError{}
+var _ error = DeleteWorkspaceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -955,48 +11060,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateDownloadTaskResponseValidationError{}
+} = DeleteWorkspaceResponseValidationError{}
 
-// Validate checks the field values on GetDownloadTaskListRequest with the
+// Validate checks the field values on GetWorkspaceMemberListRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDownloadTaskListRequest) Validate() error {
+func (m *GetWorkspaceMemberListRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDownloadTaskListRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetDownloadTaskListRequestMultiError, or nil if none found.
-func (m *GetDownloadTaskListRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetWorkspaceMemberListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetWorkspaceMemberListRequestMultiError, or nil if none found.
+func (m *GetWorkspaceMemberListRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDownloadTaskListRequest) validate(all bool) error {
+func (m *GetWorkspaceMemberListRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Offset
-
-	// no validation rules for Limit
+	// no validation rules for WorkspaceId
 
 	if len(errors) > 0 {
-		return GetDownloadTaskListRequestMultiError(errors)
+		return GetWorkspaceMemberListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDownloadTaskListRequestMultiError is an error wrapping multiple
-// validation errors returned by GetDownloadTaskListRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetDownloadTaskListRequestMultiError []error
+// GetWorkspaceMemberListRequestMultiError is an error wrapping multiple
+// validation errors returned by GetWorkspaceMemberListRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetWorkspaceMemberListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDownloadTaskListRequestMultiError) Error() string {
+func (m GetWorkspaceMemberListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1005,11 +11108,12 @@ func (m GetDownloadTaskListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDownloadTaskListRequestMultiError) AllErrors() []error { return m }
+func (m GetWorkspaceMemberListRequestMultiError) AllErrors() []error { return m }
 
-// GetDownloadTaskListRequestValidationError is the validation error returned
-// by GetDownloadTaskListRequest.Validate if the designated constraints aren't met.
-type GetDownloadTaskListRequestValidationError struct {
+// GetWorkspaceMemberListRequestValidationError is the validation error
+// returned by GetWorkspaceMemberListRequest.Validate if the designated
+// constraints aren't met.
+type GetWorkspaceMemberListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1017,24 +11121,24 @@ type GetDownloadTaskListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDownloadTaskListRequestValidationError) Field() string { return e.field }
+func (e GetWorkspaceMemberListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDownloadTaskListRequestValidationError) Reason() string { return e.reason }
+func (e GetWorkspaceMemberListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDownloadTaskListRequestValidationError) Cause() error { return e.cause }
+func (e GetWorkspaceMemberListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDownloadTaskListRequestValidationError) Key() bool { return e.key }
+func (e GetWorkspaceMemberListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDownloadTaskListRequestValidationError) ErrorName() string {
-	return "GetDownloadTaskListRequestValidationError"
+func (e GetWorkspaceMemberListRequestValidationError) ErrorName() string {
+	return "GetWorkspaceMemberListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDownloadTaskListRequestValidationError) Error() string {
+func (e GetWorkspaceMemberListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1046,14 +11150,14 @@ func (e GetDownloadTaskListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDownloadTaskListRequest.%s: %s%s",
+		"invalid %sGetWorkspaceMemberListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDownloadTaskListRequestValidationError{}
+var _ error = GetWorkspaceMemberListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1061,47 +11165,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDownloadTaskListRequestValidationError{}
+} = GetWorkspaceMemberListRequestValidationError{}
 
-// Validate checks the field values on GetDownloadTaskListResponse with the
+// Validate checks the field values on GetWorkspaceMemberListResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDownloadTaskListResponse) Validate() error {
+func (m *GetWorkspaceMemberListResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDownloadTaskListResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetDownloadTaskListResponseMultiError, or nil if none found.
-func (m *GetDownloadTaskListResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetWorkspaceMemberListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetWorkspaceMemberListResponseMultiError, or nil if none found.
+func (m *GetWorkspaceMemberListResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDownloadTaskListResponse) validate(all bool) error {
+func (m *GetWorkspaceMemberListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDownloadTaskList() {
+	for idx, item := range m.GetWorkspaceMemberList() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetDownloadTaskListResponseValidationError{
-						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+					errors = append(errors, GetWorkspaceMemberListResponseValidationError{
+						field:  fmt.Sprintf("WorkspaceMemberList[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetDownloadTaskListResponseValidationError{
-						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+					errors = append(errors, GetWorkspaceMemberListResponseValidationError{
+						field:  fmt.Sprintf("WorkspaceMemberList[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1109,8 +11213,8 @@ func (m *GetDownloadTaskListResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetDownloadTaskListResponseValidationError{
-					field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+				return GetWorkspaceMemberListResponseValidationError{
+					field:  fmt.Sprintf("WorkspaceMemberList[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1119,22 +11223,20 @@ func (m *GetDownloadTaskListResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for TotalDownloadTaskCount
-
 	if len(errors) > 0 {
-		return GetDownloadTaskListResponseMultiError(errors)
+		return GetWorkspaceMemberListResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDownloadTaskListResponseMultiError is an error wrapping multiple
-// validation errors returned by GetDownloadTaskListResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetDownloadTaskListResponseMultiError []error
+// GetWorkspaceMemberListResponseMultiError is an error wrapping multiple
+// validation errors returned by GetWorkspaceMemberListResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetWorkspaceMemberListResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDownloadTaskListResponseMultiError) Error() string {
+func (m GetWorkspaceMemberListResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1143,12 +11245,12 @@ func (m GetDownloadTaskListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDownloadTaskListResponseMultiError) AllErrors() []error { return m }
+func (m GetWorkspaceMemberListResponseMultiError) AllErrors() []error { return m }
 
-// GetDownloadTaskListResponseValidationError is the validation error returned
-// by GetDownloadTaskListResponse.Validate if the designated constraints
-// aren't met.
-type GetDownloadTaskListResponseValidationError struct {
+// GetWorkspaceMemberListResponseValidationError is the validation error
+// returned by GetWorkspaceMemberListResponse.Validate if the designated
+// constraints aren't met.
+type GetWorkspaceMemberListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1156,24 +11258,24 @@ type GetDownloadTaskListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDownloadTaskListResponseValidationError) Field() string { return e.field }
+func (e GetWorkspaceMemberListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDownloadTaskListResponseValidationError) Reason() string { return e.reason }
+func (e GetWorkspaceMemberListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDownloadTaskListResponseValidationError) Cause() error { return e.cause }
+func (e GetWorkspaceMemberListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDownloadTaskListResponseValidationError) Key() bool { return e.key }
+func (e GetWorkspaceMemberListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDownloadTaskListResponseValidationError) ErrorName() string {
-	return "GetDownloadTaskListResponseValidationError"
+func (e GetWorkspaceMemberListResponseValidationError) ErrorName() string {
+	return "GetWorkspaceMemberListResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDownloadTaskListResponseValidationError) Error() string {
+func (e GetWorkspaceMemberListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1185,14 +11287,14 @@ func (e GetDownloadTaskListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDownloadTaskListResponse.%s: %s%s",
+		"invalid %sGetWorkspaceMemberListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDownloadTaskListResponseValidationError{}
+var _ error = GetWorkspaceMemberListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1200,48 +11302,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDownloadTaskListResponseValidationError{}
+} = GetWorkspaceMemberListResponseValidationError{}
 
-// Validate checks the field values on UpdateDownloadTaskRequest with the rules
+// Validate checks the field values on AddWorkspaceMemberRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateDownloadTaskRequest) Validate() error {
+func (m *AddWorkspaceMemberRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateDownloadTaskRequest with the
+// ValidateAll checks the field values on AddWorkspaceMemberRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateDownloadTaskRequestMultiError, or nil if none found.
-func (m *UpdateDownloadTaskRequest) ValidateAll() error {
+// AddWorkspaceMemberRequestMultiError, or nil if none found.
+func (m *AddWorkspaceMemberRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateDownloadTaskRequest) validate(all bool) error {
+func (m *AddWorkspaceMemberRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DownloadTaskId
+	// no validation rules for WorkspaceId
 
-	// no validation rules for Url
+	// no validation rules for AccountName
+
+	// no validation rules for Role
 
 	if len(errors) > 0 {
-		return UpdateDownloadTaskRequestMultiError(errors)
+		return AddWorkspaceMemberRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateDownloadTaskRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateDownloadTaskRequest.ValidateAll() if the
+// AddWorkspaceMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by AddWorkspaceMemberRequest.ValidateAll() if the
 // designated constraints aren't met.
-type UpdateDownloadTaskRequestMultiError []error
+type AddWorkspaceMemberRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateDownloadTaskRequestMultiError) Error() string {
+func (m AddWorkspaceMemberRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1250,11 +11354,11 @@ func (m UpdateDownloadTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateDownloadTaskRequestMultiError) AllErrors() []error { return m }
+func (m AddWorkspaceMemberRequestMultiError) AllErrors() []error { return m }
 
-// UpdateDownloadTaskRequestValidationError is the validation error returned by
-// UpdateDownloadTaskRequest.Validate if the designated constraints aren't met.
-type UpdateDownloadTaskRequestValidationError struct {
+// AddWorkspaceMemberRequestValidationError is the validation error returned by
+// AddWorkspaceMemberRequest.Validate if the designated constraints aren't met.
+type AddWorkspaceMemberRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1262,24 +11366,24 @@ type UpdateDownloadTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateDownloadTaskRequestValidationError) Field() string { return e.field }
+func (e AddWorkspaceMemberRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateDownloadTaskRequestValidationError) Reason() string { return e.reason }
+func (e AddWorkspaceMemberRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateDownloadTaskRequestValidationError) Cause() error { return e.cause }
+func (e AddWorkspaceMemberRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateDownloadTaskRequestValidationError) Key() bool { return e.key }
+func (e AddWorkspaceMemberRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateDownloadTaskRequestValidationError) ErrorName() string {
-	return "UpdateDownloadTaskRequestValidationError"
+func (e AddWorkspaceMemberRequestValidationError) ErrorName() string {
+	return "AddWorkspaceMemberRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateDownloadTaskRequestValidationError) Error() string {
+func (e AddWorkspaceMemberRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1291,14 +11395,14 @@ func (e UpdateDownloadTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateDownloadTaskRequest.%s: %s%s",
+		"invalid %sAddWorkspaceMemberRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateDownloadTaskRequestValidationError{}
+var _ error = AddWorkspaceMemberRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1306,24 +11410,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateDownloadTaskRequestValidationError{}
+} = AddWorkspaceMemberRequestValidationError{}
 
-// Validate checks the field values on UpdateDownloadTaskResponse with the
+// Validate checks the field values on AddWorkspaceMemberResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateDownloadTaskResponse) Validate() error {
+func (m *AddWorkspaceMemberResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateDownloadTaskResponse with the
+// ValidateAll checks the field values on AddWorkspaceMemberResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateDownloadTaskResponseMultiError, or nil if none found.
-func (m *UpdateDownloadTaskResponse) ValidateAll() error {
+// AddWorkspaceMemberResponseMultiError, or nil if none found.
+func (m *AddWorkspaceMemberResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateDownloadTaskResponse) validate(all bool) error {
+func (m *AddWorkspaceMemberResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1331,28 +11435,28 @@ func (m *UpdateDownloadTaskResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetDownloadTask()).(type) {
+		switch v := interface{}(m.GetWorkspaceMember()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateDownloadTaskResponseValidationError{
-					field:  "DownloadTask",
+				errors = append(errors, AddWorkspaceMemberResponseValidationError{
+					field:  "WorkspaceMember",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateDownloadTaskResponseValidationError{
-					field:  "DownloadTask",
+				errors = append(errors, AddWorkspaceMemberResponseValidationError{
+					field:  "WorkspaceMember",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetWorkspaceMember()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateDownloadTaskResponseValidationError{
-				field:  "DownloadTask",
+			return AddWorkspaceMemberResponseValidationError{
+				field:  "WorkspaceMember",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1360,19 +11464,19 @@ func (m *UpdateDownloadTaskResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateDownloadTaskResponseMultiError(errors)
+		return AddWorkspaceMemberResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateDownloadTaskResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateDownloadTaskResponse.ValidateAll() if
+// AddWorkspaceMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by AddWorkspaceMemberResponse.ValidateAll() if
 // the designated constraints aren't met.
-type UpdateDownloadTaskResponseMultiError []error
+type AddWorkspaceMemberResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateDownloadTaskResponseMultiError) Error() string {
+func (m AddWorkspaceMemberResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1381,11 +11485,11 @@ func (m UpdateDownloadTaskResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateDownloadTaskResponseMultiError) AllErrors() []error { return m }
+func (m AddWorkspaceMemberResponseMultiError) AllErrors() []error { return m }
 
-// UpdateDownloadTaskResponseValidationError is the validation error returned
-// by UpdateDownloadTaskResponse.Validate if the designated constraints aren't met.
-type UpdateDownloadTaskResponseValidationError struct {
+// AddWorkspaceMemberResponseValidationError is the validation error returned
+// by AddWorkspaceMemberResponse.Validate if the designated constraints aren't met.
+type AddWorkspaceMemberResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1393,24 +11497,24 @@ type UpdateDownloadTaskResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateDownloadTaskResponseValidationError) Field() string { return e.field }
+func (e AddWorkspaceMemberResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateDownloadTaskResponseValidationError) Reason() string { return e.reason }
+func (e AddWorkspaceMemberResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateDownloadTaskResponseValidationError) Cause() error { return e.cause }
+func (e AddWorkspaceMemberResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateDownloadTaskResponseValidationError) Key() bool { return e.key }
+func (e AddWorkspaceMemberResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateDownloadTaskResponseValidationError) ErrorName() string {
-	return "UpdateDownloadTaskResponseValidationError"
+func (e AddWorkspaceMemberResponseValidationError) ErrorName() string {
+	return "AddWorkspaceMemberResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateDownloadTaskResponseValidationError) Error() string {
+func (e AddWorkspaceMemberResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1422,14 +11526,14 @@ func (e UpdateDownloadTaskResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateDownloadTaskResponse.%s: %s%s",
+		"invalid %sAddWorkspaceMemberResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateDownloadTaskResponseValidationError{}
+var _ error = AddWorkspaceMemberResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1437,46 +11541,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateDownloadTaskResponseValidationError{}
+} = AddWorkspaceMemberResponseValidationError{}
 
-// Validate checks the field values on DeleteDownloadTaskRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateWorkspaceMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteDownloadTaskRequest) Validate() error {
+func (m *UpdateWorkspaceMemberRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteDownloadTaskRequest with the
+// ValidateAll checks the field values on UpdateWorkspaceMemberRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteDownloadTaskRequestMultiError, or nil if none found.
-func (m *DeleteDownloadTaskRequest) ValidateAll() error {
+// UpdateWorkspaceMemberRequestMultiError, or nil if none found.
+func (m *UpdateWorkspaceMemberRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteDownloadTaskRequest) validate(all bool) error {
+func (m *UpdateWorkspaceMemberRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DownloadTaskId
+	// no validation rules for WorkspaceId
+
+	// no validation rules for AccountId
+
+	// no validation rules for Role
 
 	if len(errors) > 0 {
-		return DeleteDownloadTaskRequestMultiError(errors)
+		return UpdateWorkspaceMemberRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteDownloadTaskRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteDownloadTaskRequest.ValidateAll() if the
-// designated constraints aren't met.
-type DeleteDownloadTaskRequestMultiError []error
+// UpdateWorkspaceMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateWorkspaceMemberRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateWorkspaceMemberRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteDownloadTaskRequestMultiError) Error() string {
+func (m UpdateWorkspaceMemberRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1485,11 +11593,12 @@ func (m DeleteDownloadTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteDownloadTaskRequestMultiError) AllErrors() []error { return m }
+func (m UpdateWorkspaceMemberRequestMultiError) AllErrors() []error { return m }
 
-// DeleteDownloadTaskRequestValidationError is the validation error returned by
-// DeleteDownloadTaskRequest.Validate if the designated constraints aren't met.
-type DeleteDownloadTaskRequestValidationError struct {
+// UpdateWorkspaceMemberRequestValidationError is the validation error returned
+// by UpdateWorkspaceMemberRequest.Validate if the designated constraints
+// aren't met.
+type UpdateWorkspaceMemberRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1497,24 +11606,24 @@ type DeleteDownloadTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteDownloadTaskRequestValidationError) Field() string { return e.field }
+func (e UpdateWorkspaceMemberRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteDownloadTaskRequestValidationError) Reason() string { return e.reason }
+func (e UpdateWorkspaceMemberRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteDownloadTaskRequestValidationError) Cause() error { return e.cause }
+func (e UpdateWorkspaceMemberRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteDownloadTaskRequestValidationError) Key() bool { return e.key }
+func (e UpdateWorkspaceMemberRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteDownloadTaskRequestValidationError) ErrorName() string {
-	return "DeleteDownloadTaskRequestValidationError"
+func (e UpdateWorkspaceMemberRequestValidationError) ErrorName() string {
+	return "UpdateWorkspaceMemberRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteDownloadTaskRequestValidationError) Error() string {
+func (e UpdateWorkspaceMemberRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1526,14 +11635,14 @@ func (e DeleteDownloadTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteDownloadTaskRequest.%s: %s%s",
+		"invalid %sUpdateWorkspaceMemberRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteDownloadTaskRequestValidationError{}
+var _ error = UpdateWorkspaceMemberRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1541,44 +11650,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteDownloadTaskRequestValidationError{}
+} = UpdateWorkspaceMemberRequestValidationError{}
 
-// Validate checks the field values on DeleteDownloadTaskResponse with the
+// Validate checks the field values on UpdateWorkspaceMemberResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteDownloadTaskResponse) Validate() error {
+func (m *UpdateWorkspaceMemberResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteDownloadTaskResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteDownloadTaskResponseMultiError, or nil if none found.
-func (m *DeleteDownloadTaskResponse) ValidateAll() error {
+// ValidateAll checks the field values on UpdateWorkspaceMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateWorkspaceMemberResponseMultiError, or nil if none found.
+func (m *UpdateWorkspaceMemberResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteDownloadTaskResponse) validate(all bool) error {
+func (m *UpdateWorkspaceMemberResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetWorkspaceMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateWorkspaceMemberResponseValidationError{
+					field:  "WorkspaceMember",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateWorkspaceMemberResponseValidationError{
+					field:  "WorkspaceMember",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkspaceMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateWorkspaceMemberResponseValidationError{
+				field:  "WorkspaceMember",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DeleteDownloadTaskResponseMultiError(errors)
+		return UpdateWorkspaceMemberResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteDownloadTaskResponseMultiError is an error wrapping multiple
-// validation errors returned by DeleteDownloadTaskResponse.ValidateAll() if
-// the designated constraints aren't met.
-type DeleteDownloadTaskResponseMultiError []error
+// UpdateWorkspaceMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateWorkspaceMemberResponse.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateWorkspaceMemberResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteDownloadTaskResponseMultiError) Error() string {
+func (m UpdateWorkspaceMemberResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1587,11 +11725,12 @@ func (m DeleteDownloadTaskResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteDownloadTaskResponseMultiError) AllErrors() []error { return m }
+func (m UpdateWorkspaceMemberResponseMultiError) AllErrors() []error { return m }
 
-// DeleteDownloadTaskResponseValidationError is the validation error returned
-// by DeleteDownloadTaskResponse.Validate if the designated constraints aren't met.
-type DeleteDownloadTaskResponseValidationError struct {
+// UpdateWorkspaceMemberResponseValidationError is the validation error
+// returned by UpdateWorkspaceMemberResponse.Validate if the designated
+// constraints aren't met.
+type UpdateWorkspaceMemberResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1599,24 +11738,24 @@ type DeleteDownloadTaskResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteDownloadTaskResponseValidationError) Field() string { return e.field }
+func (e UpdateWorkspaceMemberResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteDownloadTaskResponseValidationError) Reason() string { return e.reason }
+func (e UpdateWorkspaceMemberResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteDownloadTaskResponseValidationError) Cause() error { return e.cause }
+func (e UpdateWorkspaceMemberResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteDownloadTaskResponseValidationError) Key() bool { return e.key }
+func (e UpdateWorkspaceMemberResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteDownloadTaskResponseValidationError) ErrorName() string {
-	return "DeleteDownloadTaskResponseValidationError"
+func (e UpdateWorkspaceMemberResponseValidationError) ErrorName() string {
+	return "UpdateWorkspaceMemberResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteDownloadTaskResponseValidationError) Error() string {
+func (e UpdateWorkspaceMemberResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1628,14 +11767,14 @@ func (e DeleteDownloadTaskResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteDownloadTaskResponse.%s: %s%s",
+		"invalid %sUpdateWorkspaceMemberResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteDownloadTaskResponseValidationError{}
+var _ error = UpdateWorkspaceMemberResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1643,46 +11782,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteDownloadTaskResponseValidationError{}
+} = UpdateWorkspaceMemberResponseValidationError{}
 
-// Validate checks the field values on GetDownloadTaskFileRequest with the
+// Validate checks the field values on RemoveWorkspaceMemberRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDownloadTaskFileRequest) Validate() error {
+func (m *RemoveWorkspaceMemberRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDownloadTaskFileRequest with the
+// ValidateAll checks the field values on RemoveWorkspaceMemberRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDownloadTaskFileRequestMultiError, or nil if none found.
-func (m *GetDownloadTaskFileRequest) ValidateAll() error {
+// RemoveWorkspaceMemberRequestMultiError, or nil if none found.
+func (m *RemoveWorkspaceMemberRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDownloadTaskFileRequest) validate(all bool) error {
+func (m *RemoveWorkspaceMemberRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DownloadTaskId
+	// no validation rules for WorkspaceId
+
+	// no validation rules for AccountId
 
 	if len(errors) > 0 {
-		return GetDownloadTaskFileRequestMultiError(errors)
+		return RemoveWorkspaceMemberRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDownloadTaskFileRequestMultiError is an error wrapping multiple
-// validation errors returned by GetDownloadTaskFileRequest.ValidateAll() if
+// RemoveWorkspaceMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveWorkspaceMemberRequest.ValidateAll() if
 // the designated constraints aren't met.
-type GetDownloadTaskFileRequestMultiError []error
+type RemoveWorkspaceMemberRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDownloadTaskFileRequestMultiError) Error() string {
+func (m RemoveWorkspaceMemberRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1691,11 +11832,12 @@ func (m GetDownloadTaskFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDownloadTaskFileRequestMultiError) AllErrors() []error { return m }
+func (m RemoveWorkspaceMemberRequestMultiError) AllErrors() []error { return m }
 
-// GetDownloadTaskFileRequestValidationError is the validation error returned
-// by GetDownloadTaskFileRequest.Validate if the designated constraints aren't met.
-type GetDownloadTaskFileRequestValidationError struct {
+// RemoveWorkspaceMemberRequestValidationError is the validation error returned
+// by RemoveWorkspaceMemberRequest.Validate if the designated constraints
+// aren't met.
+type RemoveWorkspaceMemberRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1703,24 +11845,24 @@ type GetDownloadTaskFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDownloadTaskFileRequestValidationError) Field() string { return e.field }
+func (e RemoveWorkspaceMemberRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDownloadTaskFileRequestValidationError) Reason() string { return e.reason }
+func (e RemoveWorkspaceMemberRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDownloadTaskFileRequestValidationError) Cause() error { return e.cause }
+func (e RemoveWorkspaceMemberRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDownloadTaskFileRequestValidationError) Key() bool { return e.key }
+func (e RemoveWorkspaceMemberRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDownloadTaskFileRequestValidationError) ErrorName() string {
-	return "GetDownloadTaskFileRequestValidationError"
+func (e RemoveWorkspaceMemberRequestValidationError) ErrorName() string {
+	return "RemoveWorkspaceMemberRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDownloadTaskFileRequestValidationError) Error() string {
+func (e RemoveWorkspaceMemberRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1732,14 +11874,14 @@ func (e GetDownloadTaskFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDownloadTaskFileRequest.%s: %s%s",
+		"invalid %sRemoveWorkspaceMemberRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDownloadTaskFileRequestValidationError{}
+var _ error = RemoveWorkspaceMemberRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1747,46 +11889,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDownloadTaskFileRequestValidationError{}
+} = RemoveWorkspaceMemberRequestValidationError{}
 
-// Validate checks the field values on GetDownloadTaskFileResponse with the
+// Validate checks the field values on RemoveWorkspaceMemberResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDownloadTaskFileResponse) Validate() error {
+func (m *RemoveWorkspaceMemberResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDownloadTaskFileResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetDownloadTaskFileResponseMultiError, or nil if none found.
-func (m *GetDownloadTaskFileResponse) ValidateAll() error {
+// ValidateAll checks the field values on RemoveWorkspaceMemberResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveWorkspaceMemberResponseMultiError, or nil if none found.
+func (m *RemoveWorkspaceMemberResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDownloadTaskFileResponse) validate(all bool) error {
+func (m *RemoveWorkspaceMemberResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Data
-
 	if len(errors) > 0 {
-		return GetDownloadTaskFileResponseMultiError(errors)
+		return RemoveWorkspaceMemberResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDownloadTaskFileResponseMultiError is an error wrapping multiple
-// validation errors returned by GetDownloadTaskFileResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetDownloadTaskFileResponseMultiError []error
+// RemoveWorkspaceMemberResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveWorkspaceMemberResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveWorkspaceMemberResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDownloadTaskFileResponseMultiError) Error() string {
+func (m RemoveWorkspaceMemberResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1795,12 +11935,12 @@ func (m GetDownloadTaskFileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDownloadTaskFileResponseMultiError) AllErrors() []error { return m }
+func (m RemoveWorkspaceMemberResponseMultiError) AllErrors() []error { return m }
 
-// GetDownloadTaskFileResponseValidationError is the validation error returned
-// by GetDownloadTaskFileResponse.Validate if the designated constraints
-// aren't met.
-type GetDownloadTaskFileResponseValidationError struct {
+// RemoveWorkspaceMemberResponseValidationError is the validation error
+// returned by RemoveWorkspaceMemberResponse.Validate if the designated
+// constraints aren't met.
+type RemoveWorkspaceMemberResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1808,24 +11948,24 @@ type GetDownloadTaskFileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDownloadTaskFileResponseValidationError) Field() string { return e.field }
+func (e RemoveWorkspaceMemberResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDownloadTaskFileResponseValidationError) Reason() string { return e.reason }
+func (e RemoveWorkspaceMemberResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDownloadTaskFileResponseValidationError) Cause() error { return e.cause }
+func (e RemoveWorkspaceMemberResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDownloadTaskFileResponseValidationError) Key() bool { return e.key }
+func (e RemoveWorkspaceMemberResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDownloadTaskFileResponseValidationError) ErrorName() string {
-	return "GetDownloadTaskFileResponseValidationError"
+func (e RemoveWorkspaceMemberResponseValidationError) ErrorName() string {
+	return "RemoveWorkspaceMemberResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDownloadTaskFileResponseValidationError) Error() string {
+func (e RemoveWorkspaceMemberResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1837,14 +11977,14 @@ func (e GetDownloadTaskFileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDownloadTaskFileResponse.%s: %s%s",
+		"invalid %sRemoveWorkspaceMemberResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDownloadTaskFileResponseValidationError{}
+var _ error = RemoveWorkspaceMemberResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1852,4 +11992,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDownloadTaskFileResponseValidationError{}
+} = RemoveWorkspaceMemberResponseValidationError{}
@@ -0,0 +1,1658 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: downloadClient/v1/go_load_admin.proto
+
+package go_loadv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AccountQuota is also the quota of a workspace. Fields that are not set fall back to the configured default, 0
+// means unlimited.
+type AccountQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxDownloadTaskCount       *uint64 `protobuf:"varint,1,opt,name=max_download_task_count,json=maxDownloadTaskCount,proto3,oneof" json:"max_download_task_count,omitempty"`
+	MaxActiveDownloadTaskCount *uint64 `protobuf:"varint,2,opt,name=max_active_download_task_count,json=maxActiveDownloadTaskCount,proto3,oneof" json:"max_active_download_task_count,omitempty"`
+}
+
+func (x *AccountQuota) Reset() {
+	*x = AccountQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountQuota) ProtoMessage() {}
+
+func (x *AccountQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountQuota.ProtoReflect.Descriptor instead.
+func (*AccountQuota) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AccountQuota) GetMaxDownloadTaskCount() uint64 {
+	if x != nil && x.MaxDownloadTaskCount != nil {
+		return *x.MaxDownloadTaskCount
+	}
+	return 0
+}
+
+func (x *AccountQuota) GetMaxActiveDownloadTaskCount() uint64 {
+	if x != nil && x.MaxActiveDownloadTaskCount != nil {
+		return *x.MaxActiveDownloadTaskCount
+	}
+	return 0
+}
+
+type AdminAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account    *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	DisabledAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=disabled_at,json=disabledAt,proto3" json:"disabled_at,omitempty"`
+	// quota only has the fields overridden for this account.
+	Quota *AccountQuota `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	// effective_quota is the quota that is enforced, with the defaults filled in.
+	EffectiveQuota *AccountQuota `protobuf:"bytes,4,opt,name=effective_quota,json=effectiveQuota,proto3" json:"effective_quota,omitempty"`
+}
+
+func (x *AdminAccount) Reset() {
+	*x = AdminAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAccount) ProtoMessage() {}
+
+func (x *AdminAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAccount.ProtoReflect.Descriptor instead.
+func (*AdminAccount) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdminAccount) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AdminAccount) GetDisabledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DisabledAt
+	}
+	return nil
+}
+
+func (x *AdminAccount) GetQuota() *AccountQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *AdminAccount) GetEffectiveQuota() *AccountQuota {
+	if x != nil {
+		return x.EffectiveQuota
+	}
+	return nil
+}
+
+type GetAccountListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset uint64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetAccountListRequest) Reset() {
+	*x = GetAccountListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountListRequest) ProtoMessage() {}
+
+func (x *GetAccountListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountListRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountListRequest) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAccountListRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetAccountListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetAccountListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountList       []*AdminAccount `protobuf:"bytes,1,rep,name=account_list,json=accountList,proto3" json:"account_list,omitempty"`
+	TotalAccountCount uint64          `protobuf:"varint,2,opt,name=total_account_count,json=totalAccountCount,proto3" json:"total_account_count,omitempty"`
+}
+
+func (x *GetAccountListResponse) Reset() {
+	*x = GetAccountListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountListResponse) ProtoMessage() {}
+
+func (x *GetAccountListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountListResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountListResponse) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAccountListResponse) GetAccountList() []*AdminAccount {
+	if x != nil {
+		return x.AccountList
+	}
+	return nil
+}
+
+func (x *GetAccountListResponse) GetTotalAccountCount() uint64 {
+	if x != nil {
+		return x.TotalAccountCount
+	}
+	return 0
+}
+
+type UpdateAccountRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Role      Role   `protobuf:"varint,2,opt,name=role,proto3,enum=go_load.v1.Role" json:"role,omitempty"`
+}
+
+func (x *UpdateAccountRoleRequest) Reset() {
+	*x = UpdateAccountRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAccountRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountRoleRequest) ProtoMessage() {}
+
+func (x *UpdateAccountRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountRoleRequest) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateAccountRoleRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *UpdateAccountRoleRequest) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+type UpdateAccountRoleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *AdminAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *UpdateAccountRoleResponse) Reset() {
+	*x = UpdateAccountRoleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAccountRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountRoleResponse) ProtoMessage() {}
+
+func (x *UpdateAccountRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAccountRoleResponse) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateAccountRoleResponse) GetAccount() *AdminAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type DisableAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (x *DisableAccountRequest) Reset() {
+	*x = DisableAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableAccountRequest) ProtoMessage() {}
+
+func (x *DisableAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableAccountRequest.ProtoReflect.Descriptor instead.
+func (*DisableAccountRequest) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DisableAccountRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type DisableAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *AdminAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *DisableAccountResponse) Reset() {
+	*x = DisableAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableAccountResponse) ProtoMessage() {}
+
+func (x *DisableAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableAccountResponse.ProtoReflect.Descriptor instead.
+func (*DisableAccountResponse) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DisableAccountResponse) GetAccount() *AdminAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type EnableAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+}
+
+func (x *EnableAccountRequest) Reset() {
+	*x = EnableAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableAccountRequest) ProtoMessage() {}
+
+func (x *EnableAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableAccountRequest.ProtoReflect.Descriptor instead.
+func (*EnableAccountRequest) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EnableAccountRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type EnableAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *AdminAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *EnableAccountResponse) Reset() {
+	*x = EnableAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableAccountResponse) ProtoMessage() {}
+
+func (x *EnableAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableAccountResponse.ProtoReflect.Descriptor instead.
+func (*EnableAccountResponse) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EnableAccountResponse) GetAccount() *AdminAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type UpdateAccountQuotaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId uint64 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// quota replaces the overrides of the account, unset fields go back to the default.
+	Quota *AccountQuota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (x *UpdateAccountQuotaRequest) Reset() {
+	*x = UpdateAccountQuotaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAccountQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountQuotaRequest) ProtoMessage() {}
+
+func (x *UpdateAccountQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountQuotaRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateAccountQuotaRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *UpdateAccountQuotaRequest) GetQuota() *AccountQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type UpdateAccountQuotaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *AdminAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *UpdateAccountQuotaResponse) Reset() {
+	*x = UpdateAccountQuotaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAccountQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountQuotaResponse) ProtoMessage() {}
+
+func (x *UpdateAccountQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountQuotaResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAccountQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateAccountQuotaResponse) GetAccount() *AdminAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type GetAccountDownloadTaskListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId     uint64              `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Offset        uint64              `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         uint64              `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Filter        *DownloadTaskFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	SortKey       DownloadTaskSortKey `protobuf:"varint,5,opt,name=sort_key,json=sortKey,proto3,enum=go_load.v1.DownloadTaskSortKey" json:"sort_key,omitempty"`
+	SortDirection SortDirection       `protobuf:"varint,6,opt,name=sort_direction,json=sortDirection,proto3,enum=go_load.v1.SortDirection" json:"sort_direction,omitempty"`
+	Cursor        string              `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *GetAccountDownloadTaskListRequest) Reset() {
+	*x = GetAccountDownloadTaskListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountDownloadTaskListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountDownloadTaskListRequest) ProtoMessage() {}
+
+func (x *GetAccountDownloadTaskListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountDownloadTaskListRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountDownloadTaskListRequest) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetAccountDownloadTaskListRequest) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *GetAccountDownloadTaskListRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetAccountDownloadTaskListRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAccountDownloadTaskListRequest) GetFilter() *DownloadTaskFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetAccountDownloadTaskListRequest) GetSortKey() DownloadTaskSortKey {
+	if x != nil {
+		return x.SortKey
+	}
+	return DownloadTaskSortKey_DOWNLOAD_TASK_SORT_KEY_UNSPECIFIED
+}
+
+func (x *GetAccountDownloadTaskListRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+func (x *GetAccountDownloadTaskListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetAccountDownloadTaskListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DownloadTaskList       []*DownloadTask `protobuf:"bytes,1,rep,name=download_task_list,json=downloadTaskList,proto3" json:"download_task_list,omitempty"`
+	TotalDownloadTaskCount uint64          `protobuf:"varint,2,opt,name=total_download_task_count,json=totalDownloadTaskCount,proto3" json:"total_download_task_count,omitempty"`
+	NextCursor             string          `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *GetAccountDownloadTaskListResponse) Reset() {
+	*x = GetAccountDownloadTaskListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountDownloadTaskListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountDownloadTaskListResponse) ProtoMessage() {}
+
+func (x *GetAccountDownloadTaskListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountDownloadTaskListResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountDownloadTaskListResponse) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetAccountDownloadTaskListResponse) GetDownloadTaskList() []*DownloadTask {
+	if x != nil {
+		return x.DownloadTaskList
+	}
+	return nil
+}
+
+func (x *GetAccountDownloadTaskListResponse) GetTotalDownloadTaskCount() uint64 {
+	if x != nil {
+		return x.TotalDownloadTaskCount
+	}
+	return 0
+}
+
+func (x *GetAccountDownloadTaskListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CancelDownloadTaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DownloadTaskId uint64 `protobuf:"varint,1,opt,name=download_task_id,json=downloadTaskId,proto3" json:"download_task_id,omitempty"`
+	Reason         string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CancelDownloadTaskRequest) Reset() {
+	*x = CancelDownloadTaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelDownloadTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDownloadTaskRequest) ProtoMessage() {}
+
+func (x *CancelDownloadTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDownloadTaskRequest.ProtoReflect.Descriptor instead.
+func (*CancelDownloadTaskRequest) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CancelDownloadTaskRequest) GetDownloadTaskId() uint64 {
+	if x != nil {
+		return x.DownloadTaskId
+	}
+	return 0
+}
+
+func (x *CancelDownloadTaskRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelDownloadTaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DownloadTask *DownloadTask `protobuf:"bytes,1,opt,name=download_task,json=downloadTask,proto3" json:"download_task,omitempty"`
+}
+
+func (x *CancelDownloadTaskResponse) Reset() {
+	*x = CancelDownloadTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelDownloadTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelDownloadTaskResponse) ProtoMessage() {}
+
+func (x *CancelDownloadTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDownloadTaskResponse.ProtoReflect.Descriptor instead.
+func (*CancelDownloadTaskResponse) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelDownloadTaskResponse) GetDownloadTask() *DownloadTask {
+	if x != nil {
+		return x.DownloadTask
+	}
+	return nil
+}
+
+// AdminWorkspace is a workspace as seen by admins, its role is not set.
+type AdminWorkspace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workspace *Workspace    `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+	Quota     *AccountQuota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+	// effective_quota is the quota that is enforced, with the defaults filled in.
+	EffectiveQuota *AccountQuota `protobuf:"bytes,3,opt,name=effective_quota,json=effectiveQuota,proto3" json:"effective_quota,omitempty"`
+}
+
+func (x *AdminWorkspace) Reset() {
+	*x = AdminWorkspace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminWorkspace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminWorkspace) ProtoMessage() {}
+
+func (x *AdminWorkspace) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminWorkspace.ProtoReflect.Descriptor instead.
+func (*AdminWorkspace) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AdminWorkspace) GetWorkspace() *Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+func (x *AdminWorkspace) GetQuota() *AccountQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *AdminWorkspace) GetEffectiveQuota() *AccountQuota {
+	if x != nil {
+		return x.EffectiveQuota
+	}
+	return nil
+}
+
+type UpdateWorkspaceQuotaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkspaceId uint64 `protobuf:"varint,1,opt,name=workspace_id,json=workspaceId,proto3" json:"workspace_id,omitempty"`
+	// quota replaces the overrides of the workspace, unset fields go back to the default.
+	Quota *AccountQuota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (x *UpdateWorkspaceQuotaRequest) Reset() {
+	*x = UpdateWorkspaceQuotaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWorkspaceQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkspaceQuotaRequest) ProtoMessage() {}
+
+func (x *UpdateWorkspaceQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkspaceQuotaRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateWorkspaceQuotaRequest) GetWorkspaceId() uint64 {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return 0
+}
+
+func (x *UpdateWorkspaceQuotaRequest) GetQuota() *AccountQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type UpdateWorkspaceQuotaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workspace *AdminWorkspace `protobuf:"bytes,1,opt,name=workspace,proto3" json:"workspace,omitempty"`
+}
+
+func (x *UpdateWorkspaceQuotaResponse) Reset() {
+	*x = UpdateWorkspaceQuotaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWorkspaceQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkspaceQuotaResponse) ProtoMessage() {}
+
+func (x *UpdateWorkspaceQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_admin_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkspaceQuotaResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateWorkspaceQuotaResponse) GetWorkspace() *AdminWorkspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+var File_downloadClient_v1_go_load_admin_proto protoreflect.FileDescriptor
+
+var file_downloadClient_v1_go_load_admin_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xd2, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x12, 0x3a, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x47, 0x0a, 0x1e, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x1a, 0x6d, 0x61, 0x78, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x21, 0x0a, 0x1f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x61, 0x73,
+	0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xed, 0x01, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x5f, 0x6c,
+	0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x12, 0x41, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x4e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xba, 0x48, 0x04, 0x32, 0x02, 0x18, 0x64,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x6c, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x82,
+	0x01, 0x05, 0x10, 0x01, 0x22, 0x01, 0x00, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x4f, 0x0a,
+	0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f,
+	0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x36,
+	0x0a, 0x15, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x16, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x14, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x15, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6a, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x22, 0x50, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd1, 0x02, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73,
+	0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x07, 0xba, 0x48, 0x04, 0x32, 0x02, 0x18, 0x64, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x08, 0x73, 0x6f,
+	0x72, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x67,
+	0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x07, 0x73,
+	0x6f, 0x72, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18,
+	0x80, 0x04, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xc8, 0x01, 0x0a, 0x22, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x46, 0x0a, 0x12, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x61,
+	0x73, 0x6b, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x67, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x5b,
+	0x0a, 0x1a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x0c, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x22, 0xb8, 0x01, 0x0a, 0x0e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x33,
+	0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x12, 0x41, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67,
+	0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x70, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x58, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x32, 0xc0, 0x06, 0x0a, 0x12, 0x47, 0x6f, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x67, 0x6f,
+	0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x67, 0x6f, 0x5f, 0x6c,
+	0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x5f,
+	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0d, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x12, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x12, 0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x6b, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x27, 0x2e, 0x67, 0x6f, 0x5f,
+	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x7d, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x2e,
+	0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73,
+	0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x67,
+	0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65,
+	0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x54, 0x61, 0x73, 0x6b, 0x12, 0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f,
+	0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x91, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x6f,
+	0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x47, 0x6f, 0x4c, 0x6f, 0x61, 0x64,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67, 0x72,
+	0x70, 0x63, 0x2f, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x6f, 0x5f,
+	0x6c, 0x6f, 0x61, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x47, 0x58, 0x58, 0xaa, 0x02, 0x09, 0x47,
+	0x6f, 0x4c, 0x6f, 0x61, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x09, 0x47, 0x6f, 0x4c, 0x6f, 0x61,
+	0x64, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x15, 0x47, 0x6f, 0x4c, 0x6f, 0x61, 0x64, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x47,
+	0x6f, 0x4c, 0x6f, 0x61, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_downloadClient_v1_go_load_admin_proto_rawDescOnce sync.Once
+	file_downloadClient_v1_go_load_admin_proto_rawDescData = file_downloadClient_v1_go_load_admin_proto_rawDesc
+)
+
+func file_downloadClient_v1_go_load_admin_proto_rawDescGZIP() []byte {
+	file_downloadClient_v1_go_load_admin_proto_rawDescOnce.Do(func() {
+		file_downloadClient_v1_go_load_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_downloadClient_v1_go_load_admin_proto_rawDescData)
+	})
+	return file_downloadClient_v1_go_load_admin_proto_rawDescData
+}
+
+var file_downloadClient_v1_go_load_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_downloadClient_v1_go_load_admin_proto_goTypes = []any{
+	(*AccountQuota)(nil),                       // 0: go_load.v1.AccountQuota
+	(*AdminAccount)(nil),                       // 1: go_load.v1.AdminAccount
+	(*GetAccountListRequest)(nil),              // 2: go_load.v1.GetAccountListRequest
+	(*GetAccountListResponse)(nil),             // 3: go_load.v1.GetAccountListResponse
+	(*UpdateAccountRoleRequest)(nil),           // 4: go_load.v1.UpdateAccountRoleRequest
+	(*UpdateAccountRoleResponse)(nil),          // 5: go_load.v1.UpdateAccountRoleResponse
+	(*DisableAccountRequest)(nil),              // 6: go_load.v1.DisableAccountRequest
+	(*DisableAccountResponse)(nil),             // 7: go_load.v1.DisableAccountResponse
+	(*EnableAccountRequest)(nil),               // 8: go_load.v1.EnableAccountRequest
+	(*EnableAccountResponse)(nil),              // 9: go_load.v1.EnableAccountResponse
+	(*UpdateAccountQuotaRequest)(nil),          // 10: go_load.v1.UpdateAccountQuotaRequest
+	(*UpdateAccountQuotaResponse)(nil),         // 11: go_load.v1.UpdateAccountQuotaResponse
+	(*GetAccountDownloadTaskListRequest)(nil),  // 12: go_load.v1.GetAccountDownloadTaskListRequest
+	(*GetAccountDownloadTaskListResponse)(nil), // 13: go_load.v1.GetAccountDownloadTaskListResponse
+	(*CancelDownloadTaskRequest)(nil),          // 14: go_load.v1.CancelDownloadTaskRequest
+	(*CancelDownloadTaskResponse)(nil),         // 15: go_load.v1.CancelDownloadTaskResponse
+	(*AdminWorkspace)(nil),                     // 16: go_load.v1.AdminWorkspace
+	(*UpdateWorkspaceQuotaRequest)(nil),        // 17: go_load.v1.UpdateWorkspaceQuotaRequest
+	(*UpdateWorkspaceQuotaResponse)(nil),       // 18: go_load.v1.UpdateWorkspaceQuotaResponse
+	(*Account)(nil),                            // 19: go_load.v1.Account
+	(*timestamppb.Timestamp)(nil),              // 20: google.protobuf.Timestamp
+	(Role)(0),                                  // 21: go_load.v1.Role
+	(*DownloadTaskFilter)(nil),                 // 22: go_load.v1.DownloadTaskFilter
+	(DownloadTaskSortKey)(0),                   // 23: go_load.v1.DownloadTaskSortKey
+	(SortDirection)(0),                         // 24: go_load.v1.SortDirection
+	(*DownloadTask)(nil),                       // 25: go_load.v1.DownloadTask
+	(*Workspace)(nil),                          // 26: go_load.v1.Workspace
+}
+var file_downloadClient_v1_go_load_admin_proto_depIdxs = []int32{
+	19, // 0: go_load.v1.AdminAccount.account:type_name -> go_load.v1.Account
+	20, // 1: go_load.v1.AdminAccount.disabled_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: go_load.v1.AdminAccount.quota:type_name -> go_load.v1.AccountQuota
+	0,  // 3: go_load.v1.AdminAccount.effective_quota:type_name -> go_load.v1.AccountQuota
+	1,  // 4: go_load.v1.GetAccountListResponse.account_list:type_name -> go_load.v1.AdminAccount
+	21, // 5: go_load.v1.UpdateAccountRoleRequest.role:type_name -> go_load.v1.Role
+	1,  // 6: go_load.v1.UpdateAccountRoleResponse.account:type_name -> go_load.v1.AdminAccount
+	1,  // 7: go_load.v1.DisableAccountResponse.account:type_name -> go_load.v1.AdminAccount
+	1,  // 8: go_load.v1.EnableAccountResponse.account:type_name -> go_load.v1.AdminAccount
+	0,  // 9: go_load.v1.UpdateAccountQuotaRequest.quota:type_name -> go_load.v1.AccountQuota
+	1,  // 10: go_load.v1.UpdateAccountQuotaResponse.account:type_name -> go_load.v1.AdminAccount
+	22, // 11: go_load.v1.GetAccountDownloadTaskListRequest.filter:type_name -> go_load.v1.DownloadTaskFilter
+	23, // 12: go_load.v1.GetAccountDownloadTaskListRequest.sort_key:type_name -> go_load.v1.DownloadTaskSortKey
+	24, // 13: go_load.v1.GetAccountDownloadTaskListRequest.sort_direction:type_name -> go_load.v1.SortDirection
+	25, // 14: go_load.v1.GetAccountDownloadTaskListResponse.download_task_list:type_name -> go_load.v1.DownloadTask
+	25, // 15: go_load.v1.CancelDownloadTaskResponse.download_task:type_name -> go_load.v1.DownloadTask
+	26, // 16: go_load.v1.AdminWorkspace.workspace:type_name -> go_load.v1.Workspace
+	0,  // 17: go_load.v1.AdminWorkspace.quota:type_name -> go_load.v1.AccountQuota
+	0,  // 18: go_load.v1.AdminWorkspace.effective_quota:type_name -> go_load.v1.AccountQuota
+	0,  // 19: go_load.v1.UpdateWorkspaceQuotaRequest.quota:type_name -> go_load.v1.AccountQuota
+	16, // 20: go_load.v1.UpdateWorkspaceQuotaResponse.workspace:type_name -> go_load.v1.AdminWorkspace
+	2,  // 21: go_load.v1.GoLoadAdminService.GetAccountList:input_type -> go_load.v1.GetAccountListRequest
+	4,  // 22: go_load.v1.GoLoadAdminService.UpdateAccountRole:input_type -> go_load.v1.UpdateAccountRoleRequest
+	6,  // 23: go_load.v1.GoLoadAdminService.DisableAccount:input_type -> go_load.v1.DisableAccountRequest
+	8,  // 24: go_load.v1.GoLoadAdminService.EnableAccount:input_type -> go_load.v1.EnableAccountRequest
+	10, // 25: go_load.v1.GoLoadAdminService.UpdateAccountQuota:input_type -> go_load.v1.UpdateAccountQuotaRequest
+	17, // 26: go_load.v1.GoLoadAdminService.UpdateWorkspaceQuota:input_type -> go_load.v1.UpdateWorkspaceQuotaRequest
+	12, // 27: go_load.v1.GoLoadAdminService.GetAccountDownloadTaskList:input_type -> go_load.v1.GetAccountDownloadTaskListRequest
+	14, // 28: go_load.v1.GoLoadAdminService.CancelDownloadTask:input_type -> go_load.v1.CancelDownloadTaskRequest
+	3,  // 29: go_load.v1.GoLoadAdminService.GetAccountList:output_type -> go_load.v1.GetAccountListResponse
+	5,  // 30: go_load.v1.GoLoadAdminService.UpdateAccountRole:output_type -> go_load.v1.UpdateAccountRoleResponse
+	7,  // 31: go_load.v1.GoLoadAdminService.DisableAccount:output_type -> go_load.v1.DisableAccountResponse
+	9,  // 32: go_load.v1.GoLoadAdminService.EnableAccount:output_type -> go_load.v1.EnableAccountResponse
+	11, // 33: go_load.v1.GoLoadAdminService.UpdateAccountQuota:output_type -> go_load.v1.UpdateAccountQuotaResponse
+	18, // 34: go_load.v1.GoLoadAdminService.UpdateWorkspaceQuota:output_type -> go_load.v1.UpdateWorkspaceQuotaResponse
+	13, // 35: go_load.v1.GoLoadAdminService.GetAccountDownloadTaskList:output_type -> go_load.v1.GetAccountDownloadTaskListResponse
+	15, // 36: go_load.v1.GoLoadAdminService.CancelDownloadTask:output_type -> go_load.v1.CancelDownloadTaskResponse
+	29, // [29:37] is the sub-list for method output_type
+	21, // [21:29] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_downloadClient_v1_go_load_admin_proto_init() }
+func file_downloadClient_v1_go_load_admin_proto_init() {
+	if File_downloadClient_v1_go_load_admin_proto != nil {
+		return
+	}
+	file_downloadClient_v1_go_load_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*AdminAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAccountListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAccountListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateAccountRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateAccountRoleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DisableAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*DisableAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*EnableAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*EnableAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateAccountQuotaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateAccountQuotaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAccountDownloadTaskListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAccountDownloadTaskListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelDownloadTaskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelDownloadTaskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*AdminWorkspace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateWorkspaceQuotaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_admin_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateWorkspaceQuotaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_downloadClient_v1_go_load_admin_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_downloadClient_v1_go_load_admin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_downloadClient_v1_go_load_admin_proto_goTypes,
+		DependencyIndexes: file_downloadClient_v1_go_load_admin_proto_depIdxs,
+		MessageInfos:      file_downloadClient_v1_go_load_admin_proto_msgTypes,
+	}.Build()
+	File_downloadClient_v1_go_load_admin_proto = out.File
+	file_downloadClient_v1_go_load_admin_proto_rawDesc = nil
+	file_downloadClient_v1_go_load_admin_proto_goTypes = nil
+	file_downloadClient_v1_go_load_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,2552 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: downloadClient/v1/go_load_admin.proto
+
+package go_loadv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on AccountQuota with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AccountQuota) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AccountQuota with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AccountQuotaMultiError, or
+// nil if none found.
+func (m *AccountQuota) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AccountQuota) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.MaxDownloadTaskCount != nil {
+		// no validation rules for MaxDownloadTaskCount
+	}
+
+	if m.MaxActiveDownloadTaskCount != nil {
+		// no validation rules for MaxActiveDownloadTaskCount
+	}
+
+	if len(errors) > 0 {
+		return AccountQuotaMultiError(errors)
+	}
+
+	return nil
+}
+
+// AccountQuotaMultiError is an error wrapping multiple validation errors
+// returned by AccountQuota.ValidateAll() if the designated constraints aren't met.
+type AccountQuotaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AccountQuotaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AccountQuotaMultiError) AllErrors() []error { return m }
+
+// AccountQuotaValidationError is the validation error returned by
+// AccountQuota.Validate if the designated constraints aren't met.
+type AccountQuotaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AccountQuotaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AccountQuotaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AccountQuotaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AccountQuotaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AccountQuotaValidationError) ErrorName() string { return "AccountQuotaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AccountQuotaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAccountQuota.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AccountQuotaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AccountQuotaValidationError{}
+
+// Validate checks the field values on AdminAccount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminAccount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminAccount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminAccountMultiError, or
+// nil if none found.
+func (m *AdminAccount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminAccount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminAccountValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminAccountValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminAccountValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDisabledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminAccountValidationError{
+					field:  "DisabledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminAccountValidationError{
+					field:  "DisabledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDisabledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminAccountValidationError{
+				field:  "DisabledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminAccountValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminAccountValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminAccountValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEffectiveQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminAccountValidationError{
+					field:  "EffectiveQuota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminAccountValidationError{
+					field:  "EffectiveQuota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEffectiveQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminAccountValidationError{
+				field:  "EffectiveQuota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminAccountMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminAccountMultiError is an error wrapping multiple validation errors
+// returned by AdminAccount.ValidateAll() if the designated constraints aren't met.
+type AdminAccountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminAccountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminAccountMultiError) AllErrors() []error { return m }
+
+// AdminAccountValidationError is the validation error returned by
+// AdminAccount.Validate if the designated constraints aren't met.
+type AdminAccountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminAccountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminAccountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminAccountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminAccountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminAccountValidationError) ErrorName() string { return "AdminAccountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminAccountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminAccount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminAccountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminAccountValidationError{}
+
+// Validate checks the field values on GetAccountListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccountListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccountListRequestMultiError, or nil if none found.
+func (m *GetAccountListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return GetAccountListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountListRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAccountListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAccountListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountListRequestMultiError) AllErrors() []error { return m }
+
+// GetAccountListRequestValidationError is the validation error returned by
+// GetAccountListRequest.Validate if the designated constraints aren't met.
+type GetAccountListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountListRequestValidationError) ErrorName() string {
+	return "GetAccountListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountListRequestValidationError{}
+
+// Validate checks the field values on GetAccountListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAccountListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAccountListResponseMultiError, or nil if none found.
+func (m *GetAccountListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAccountList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAccountListResponseValidationError{
+						field:  fmt.Sprintf("AccountList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAccountListResponseValidationError{
+						field:  fmt.Sprintf("AccountList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAccountListResponseValidationError{
+					field:  fmt.Sprintf("AccountList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalAccountCount
+
+	if len(errors) > 0 {
+		return GetAccountListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountListResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAccountListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAccountListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountListResponseMultiError) AllErrors() []error { return m }
+
+// GetAccountListResponseValidationError is the validation error returned by
+// GetAccountListResponse.Validate if the designated constraints aren't met.
+type GetAccountListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountListResponseValidationError) ErrorName() string {
+	return "GetAccountListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountListResponseValidationError{}
+
+// Validate checks the field values on UpdateAccountRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAccountRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAccountRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAccountRoleRequestMultiError, or nil if none found.
+func (m *UpdateAccountRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAccountRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return UpdateAccountRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAccountRoleRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateAccountRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAccountRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAccountRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAccountRoleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateAccountRoleRequestValidationError is the validation error returned by
+// UpdateAccountRoleRequest.Validate if the designated constraints aren't met.
+type UpdateAccountRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAccountRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAccountRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAccountRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAccountRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAccountRoleRequestValidationError) ErrorName() string {
+	return "UpdateAccountRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAccountRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAccountRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAccountRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAccountRoleRequestValidationError{}
+
+// Validate checks the field values on UpdateAccountRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAccountRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAccountRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAccountRoleResponseMultiError, or nil if none found.
+func (m *UpdateAccountRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAccountRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAccountRoleResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAccountRoleResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAccountRoleResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAccountRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAccountRoleResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateAccountRoleResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateAccountRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAccountRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAccountRoleResponseMultiError) AllErrors() []error { return m }
+
+// UpdateAccountRoleResponseValidationError is the validation error returned by
+// UpdateAccountRoleResponse.Validate if the designated constraints aren't met.
+type UpdateAccountRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAccountRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAccountRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAccountRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAccountRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAccountRoleResponseValidationError) ErrorName() string {
+	return "UpdateAccountRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAccountRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAccountRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAccountRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAccountRoleResponseValidationError{}
+
+// Validate checks the field values on DisableAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableAccountRequestMultiError, or nil if none found.
+func (m *DisableAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return DisableAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by DisableAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DisableAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableAccountRequestMultiError) AllErrors() []error { return m }
+
+// DisableAccountRequestValidationError is the validation error returned by
+// DisableAccountRequest.Validate if the designated constraints aren't met.
+type DisableAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableAccountRequestValidationError) ErrorName() string {
+	return "DisableAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableAccountRequestValidationError{}
+
+// Validate checks the field values on DisableAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableAccountResponseMultiError, or nil if none found.
+func (m *DisableAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DisableAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DisableAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DisableAccountResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DisableAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableAccountResponseMultiError is an error wrapping multiple validation
+// errors returned by DisableAccountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DisableAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableAccountResponseMultiError) AllErrors() []error { return m }
+
+// DisableAccountResponseValidationError is the validation error returned by
+// DisableAccountResponse.Validate if the designated constraints aren't met.
+type DisableAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableAccountResponseValidationError) ErrorName() string {
+	return "DisableAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableAccountResponseValidationError{}
+
+// Validate checks the field values on EnableAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnableAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnableAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnableAccountRequestMultiError, or nil if none found.
+func (m *EnableAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnableAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return EnableAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnableAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by EnableAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EnableAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnableAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnableAccountRequestMultiError) AllErrors() []error { return m }
+
+// EnableAccountRequestValidationError is the validation error returned by
+// EnableAccountRequest.Validate if the designated constraints aren't met.
+type EnableAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnableAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnableAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnableAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnableAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnableAccountRequestValidationError) ErrorName() string {
+	return "EnableAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnableAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnableAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnableAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnableAccountRequestValidationError{}
+
+// Validate checks the field values on EnableAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnableAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnableAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnableAccountResponseMultiError, or nil if none found.
+func (m *EnableAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnableAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EnableAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EnableAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EnableAccountResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EnableAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnableAccountResponseMultiError is an error wrapping multiple validation
+// errors returned by EnableAccountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EnableAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnableAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnableAccountResponseMultiError) AllErrors() []error { return m }
+
+// EnableAccountResponseValidationError is the validation error returned by
+// EnableAccountResponse.Validate if the designated constraints aren't met.
+type EnableAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnableAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnableAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnableAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnableAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnableAccountResponseValidationError) ErrorName() string {
+	return "EnableAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnableAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnableAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnableAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnableAccountResponseValidationError{}
+
+// Validate checks the field values on UpdateAccountQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAccountQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAccountQuotaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAccountQuotaRequestMultiError, or nil if none found.
+func (m *UpdateAccountQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAccountQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAccountQuotaRequestValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAccountQuotaRequestValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAccountQuotaRequestValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAccountQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAccountQuotaRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateAccountQuotaRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateAccountQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAccountQuotaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAccountQuotaRequestMultiError) AllErrors() []error { return m }
+
+// UpdateAccountQuotaRequestValidationError is the validation error returned by
+// UpdateAccountQuotaRequest.Validate if the designated constraints aren't met.
+type UpdateAccountQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAccountQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAccountQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAccountQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAccountQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAccountQuotaRequestValidationError) ErrorName() string {
+	return "UpdateAccountQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAccountQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAccountQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAccountQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAccountQuotaRequestValidationError{}
+
+// Validate checks the field values on UpdateAccountQuotaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAccountQuotaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAccountQuotaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAccountQuotaResponseMultiError, or nil if none found.
+func (m *UpdateAccountQuotaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAccountQuotaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAccountQuotaResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAccountQuotaResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAccountQuotaResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAccountQuotaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAccountQuotaResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateAccountQuotaResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateAccountQuotaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAccountQuotaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAccountQuotaResponseMultiError) AllErrors() []error { return m }
+
+// UpdateAccountQuotaResponseValidationError is the validation error returned
+// by UpdateAccountQuotaResponse.Validate if the designated constraints aren't met.
+type UpdateAccountQuotaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAccountQuotaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAccountQuotaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAccountQuotaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAccountQuotaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAccountQuotaResponseValidationError) ErrorName() string {
+	return "UpdateAccountQuotaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAccountQuotaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAccountQuotaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAccountQuotaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAccountQuotaResponseValidationError{}
+
+// Validate checks the field values on GetAccountDownloadTaskListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetAccountDownloadTaskListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountDownloadTaskListRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetAccountDownloadTaskListRequestMultiError, or nil if none found.
+func (m *GetAccountDownloadTaskListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountDownloadTaskListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAccountDownloadTaskListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAccountDownloadTaskListRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAccountDownloadTaskListRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SortKey
+
+	// no validation rules for SortDirection
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return GetAccountDownloadTaskListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountDownloadTaskListRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetAccountDownloadTaskListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAccountDownloadTaskListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountDownloadTaskListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountDownloadTaskListRequestMultiError) AllErrors() []error { return m }
+
+// GetAccountDownloadTaskListRequestValidationError is the validation error
+// returned by GetAccountDownloadTaskListRequest.Validate if the designated
+// constraints aren't met.
+type GetAccountDownloadTaskListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountDownloadTaskListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountDownloadTaskListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountDownloadTaskListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountDownloadTaskListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountDownloadTaskListRequestValidationError) ErrorName() string {
+	return "GetAccountDownloadTaskListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountDownloadTaskListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountDownloadTaskListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountDownloadTaskListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountDownloadTaskListRequestValidationError{}
+
+// Validate checks the field values on GetAccountDownloadTaskListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetAccountDownloadTaskListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAccountDownloadTaskListResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetAccountDownloadTaskListResponseMultiError, or nil if none found.
+func (m *GetAccountDownloadTaskListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAccountDownloadTaskListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDownloadTaskList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAccountDownloadTaskListResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAccountDownloadTaskListResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAccountDownloadTaskListResponseValidationError{
+					field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalDownloadTaskCount
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return GetAccountDownloadTaskListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAccountDownloadTaskListResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetAccountDownloadTaskListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAccountDownloadTaskListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAccountDownloadTaskListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAccountDownloadTaskListResponseMultiError) AllErrors() []error { return m }
+
+// GetAccountDownloadTaskListResponseValidationError is the validation error
+// returned by GetAccountDownloadTaskListResponse.Validate if the designated
+// constraints aren't met.
+type GetAccountDownloadTaskListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAccountDownloadTaskListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAccountDownloadTaskListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAccountDownloadTaskListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAccountDownloadTaskListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAccountDownloadTaskListResponseValidationError) ErrorName() string {
+	return "GetAccountDownloadTaskListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAccountDownloadTaskListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAccountDownloadTaskListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAccountDownloadTaskListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAccountDownloadTaskListResponseValidationError{}
+
+// Validate checks the field values on CancelDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelDownloadTaskRequestMultiError, or nil if none found.
+func (m *CancelDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return CancelDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelDownloadTaskRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CancelDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelDownloadTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// CancelDownloadTaskRequestValidationError is the validation error returned by
+// CancelDownloadTaskRequest.Validate if the designated constraints aren't met.
+type CancelDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelDownloadTaskRequestValidationError) ErrorName() string {
+	return "CancelDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on CancelDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelDownloadTaskResponseMultiError, or nil if none found.
+func (m *CancelDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDownloadTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelDownloadTaskResponseValidationError{
+				field:  "DownloadTask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CancelDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by CancelDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CancelDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelDownloadTaskResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// CancelDownloadTaskResponseValidationError is the validation error returned
+// by CancelDownloadTaskResponse.Validate if the designated constraints aren't met.
+type CancelDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelDownloadTaskResponseValidationError) ErrorName() string {
+	return "CancelDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on AdminWorkspace with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AdminWorkspace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdminWorkspace with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AdminWorkspaceMultiError,
+// or nil if none found.
+func (m *AdminWorkspace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdminWorkspace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWorkspace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWorkspaceValidationError{
+					field:  "Workspace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWorkspaceValidationError{
+					field:  "Workspace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkspace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWorkspaceValidationError{
+				field:  "Workspace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWorkspaceValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWorkspaceValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWorkspaceValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEffectiveQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AdminWorkspaceValidationError{
+					field:  "EffectiveQuota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AdminWorkspaceValidationError{
+					field:  "EffectiveQuota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEffectiveQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AdminWorkspaceValidationError{
+				field:  "EffectiveQuota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AdminWorkspaceMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdminWorkspaceMultiError is an error wrapping multiple validation errors
+// returned by AdminWorkspace.ValidateAll() if the designated constraints
+// aren't met.
+type AdminWorkspaceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdminWorkspaceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdminWorkspaceMultiError) AllErrors() []error { return m }
+
+// AdminWorkspaceValidationError is the validation error returned by
+// AdminWorkspace.Validate if the designated constraints aren't met.
+type AdminWorkspaceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdminWorkspaceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdminWorkspaceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdminWorkspaceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdminWorkspaceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdminWorkspaceValidationError) ErrorName() string { return "AdminWorkspaceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdminWorkspaceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdminWorkspace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdminWorkspaceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdminWorkspaceValidationError{}
+
+// Validate checks the field values on UpdateWorkspaceQuotaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateWorkspaceQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateWorkspaceQuotaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateWorkspaceQuotaRequestMultiError, or nil if none found.
+func (m *UpdateWorkspaceQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateWorkspaceQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkspaceId
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateWorkspaceQuotaRequestValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateWorkspaceQuotaRequestValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateWorkspaceQuotaRequestValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateWorkspaceQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateWorkspaceQuotaRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateWorkspaceQuotaRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateWorkspaceQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateWorkspaceQuotaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateWorkspaceQuotaRequestMultiError) AllErrors() []error { return m }
+
+// UpdateWorkspaceQuotaRequestValidationError is the validation error returned
+// by UpdateWorkspaceQuotaRequest.Validate if the designated constraints
+// aren't met.
+type UpdateWorkspaceQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateWorkspaceQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateWorkspaceQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateWorkspaceQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateWorkspaceQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateWorkspaceQuotaRequestValidationError) ErrorName() string {
+	return "UpdateWorkspaceQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateWorkspaceQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateWorkspaceQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateWorkspaceQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateWorkspaceQuotaRequestValidationError{}
+
+// Validate checks the field values on UpdateWorkspaceQuotaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateWorkspaceQuotaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateWorkspaceQuotaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateWorkspaceQuotaResponseMultiError, or nil if none found.
+func (m *UpdateWorkspaceQuotaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateWorkspaceQuotaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWorkspace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateWorkspaceQuotaResponseValidationError{
+					field:  "Workspace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateWorkspaceQuotaResponseValidationError{
+					field:  "Workspace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkspace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateWorkspaceQuotaResponseValidationError{
+				field:  "Workspace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateWorkspaceQuotaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateWorkspaceQuotaResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateWorkspaceQuotaResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateWorkspaceQuotaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateWorkspaceQuotaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateWorkspaceQuotaResponseMultiError) AllErrors() []error { return m }
+
+// UpdateWorkspaceQuotaResponseValidationError is the validation error returned
+// by UpdateWorkspaceQuotaResponse.Validate if the designated constraints
+// aren't met.
+type UpdateWorkspaceQuotaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateWorkspaceQuotaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateWorkspaceQuotaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateWorkspaceQuotaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateWorkspaceQuotaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateWorkspaceQuotaResponseValidationError) ErrorName() string {
+	return "UpdateWorkspaceQuotaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateWorkspaceQuotaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateWorkspaceQuotaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateWorkspaceQuotaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateWorkspaceQuotaResponseValidationError{}
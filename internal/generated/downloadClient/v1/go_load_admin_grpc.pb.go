@@ -0,0 +1,382 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: downloadClient/v1/go_load_admin.proto
+
+package go_loadv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	GoLoadAdminService_GetAccountList_FullMethodName             = "/go_load.v1.GoLoadAdminService/GetAccountList"
+	GoLoadAdminService_UpdateAccountRole_FullMethodName          = "/go_load.v1.GoLoadAdminService/UpdateAccountRole"
+	GoLoadAdminService_DisableAccount_FullMethodName             = "/go_load.v1.GoLoadAdminService/DisableAccount"
+	GoLoadAdminService_EnableAccount_FullMethodName              = "/go_load.v1.GoLoadAdminService/EnableAccount"
+	GoLoadAdminService_UpdateAccountQuota_FullMethodName         = "/go_load.v1.GoLoadAdminService/UpdateAccountQuota"
+	GoLoadAdminService_UpdateWorkspaceQuota_FullMethodName       = "/go_load.v1.GoLoadAdminService/UpdateWorkspaceQuota"
+	GoLoadAdminService_GetAccountDownloadTaskList_FullMethodName = "/go_load.v1.GoLoadAdminService/GetAccountDownloadTaskList"
+	GoLoadAdminService_CancelDownloadTask_FullMethodName         = "/go_load.v1.GoLoadAdminService/CancelDownloadTask"
+)
+
+// GoLoadAdminServiceClient is the client API for GoLoadAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// GoLoadAdminService manages accounts and their download tasks. Viewing accounts and download tasks and cancelling
+// download tasks requires ROLE_OPERATOR, everything else requires ROLE_ADMIN.
+type GoLoadAdminServiceClient interface {
+	GetAccountList(ctx context.Context, in *GetAccountListRequest, opts ...grpc.CallOption) (*GetAccountListResponse, error)
+	UpdateAccountRole(ctx context.Context, in *UpdateAccountRoleRequest, opts ...grpc.CallOption) (*UpdateAccountRoleResponse, error)
+	DisableAccount(ctx context.Context, in *DisableAccountRequest, opts ...grpc.CallOption) (*DisableAccountResponse, error)
+	EnableAccount(ctx context.Context, in *EnableAccountRequest, opts ...grpc.CallOption) (*EnableAccountResponse, error)
+	UpdateAccountQuota(ctx context.Context, in *UpdateAccountQuotaRequest, opts ...grpc.CallOption) (*UpdateAccountQuotaResponse, error)
+	UpdateWorkspaceQuota(ctx context.Context, in *UpdateWorkspaceQuotaRequest, opts ...grpc.CallOption) (*UpdateWorkspaceQuotaResponse, error)
+	GetAccountDownloadTaskList(ctx context.Context, in *GetAccountDownloadTaskListRequest, opts ...grpc.CallOption) (*GetAccountDownloadTaskListResponse, error)
+	CancelDownloadTask(ctx context.Context, in *CancelDownloadTaskRequest, opts ...grpc.CallOption) (*CancelDownloadTaskResponse, error)
+}
+
+type goLoadAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGoLoadAdminServiceClient(cc grpc.ClientConnInterface) GoLoadAdminServiceClient {
+	return &goLoadAdminServiceClient{cc}
+}
+
+func (c *goLoadAdminServiceClient) GetAccountList(ctx context.Context, in *GetAccountListRequest, opts ...grpc.CallOption) (*GetAccountListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountListResponse)
+	err := c.cc.Invoke(ctx, GoLoadAdminService_GetAccountList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadAdminServiceClient) UpdateAccountRole(ctx context.Context, in *UpdateAccountRoleRequest, opts ...grpc.CallOption) (*UpdateAccountRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAccountRoleResponse)
+	err := c.cc.Invoke(ctx, GoLoadAdminService_UpdateAccountRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadAdminServiceClient) DisableAccount(ctx context.Context, in *DisableAccountRequest, opts ...grpc.CallOption) (*DisableAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableAccountResponse)
+	err := c.cc.Invoke(ctx, GoLoadAdminService_DisableAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadAdminServiceClient) EnableAccount(ctx context.Context, in *EnableAccountRequest, opts ...grpc.CallOption) (*EnableAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnableAccountResponse)
+	err := c.cc.Invoke(ctx, GoLoadAdminService_EnableAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadAdminServiceClient) UpdateAccountQuota(ctx context.Context, in *UpdateAccountQuotaRequest, opts ...grpc.CallOption) (*UpdateAccountQuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAccountQuotaResponse)
+	err := c.cc.Invoke(ctx, GoLoadAdminService_UpdateAccountQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadAdminServiceClient) UpdateWorkspaceQuota(ctx context.Context, in *UpdateWorkspaceQuotaRequest, opts ...grpc.CallOption) (*UpdateWorkspaceQuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateWorkspaceQuotaResponse)
+	err := c.cc.Invoke(ctx, GoLoadAdminService_UpdateWorkspaceQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadAdminServiceClient) GetAccountDownloadTaskList(ctx context.Context, in *GetAccountDownloadTaskListRequest, opts ...grpc.CallOption) (*GetAccountDownloadTaskListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountDownloadTaskListResponse)
+	err := c.cc.Invoke(ctx, GoLoadAdminService_GetAccountDownloadTaskList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadAdminServiceClient) CancelDownloadTask(ctx context.Context, in *CancelDownloadTaskRequest, opts ...grpc.CallOption) (*CancelDownloadTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, GoLoadAdminService_CancelDownloadTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GoLoadAdminServiceServer is the server API for GoLoadAdminService service.
+// All implementations must embed UnimplementedGoLoadAdminServiceServer
+// for forward compatibility
+//
+// GoLoadAdminService manages accounts and their download tasks. Viewing accounts and download tasks and cancelling
+// download tasks requires ROLE_OPERATOR, everything else requires ROLE_ADMIN.
+type GoLoadAdminServiceServer interface {
+	GetAccountList(context.Context, *GetAccountListRequest) (*GetAccountListResponse, error)
+	UpdateAccountRole(context.Context, *UpdateAccountRoleRequest) (*UpdateAccountRoleResponse, error)
+	DisableAccount(context.Context, *DisableAccountRequest) (*DisableAccountResponse, error)
+	EnableAccount(context.Context, *EnableAccountRequest) (*EnableAccountResponse, error)
+	UpdateAccountQuota(context.Context, *UpdateAccountQuotaRequest) (*UpdateAccountQuotaResponse, error)
+	UpdateWorkspaceQuota(context.Context, *UpdateWorkspaceQuotaRequest) (*UpdateWorkspaceQuotaResponse, error)
+	GetAccountDownloadTaskList(context.Context, *GetAccountDownloadTaskListRequest) (*GetAccountDownloadTaskListResponse, error)
+	CancelDownloadTask(context.Context, *CancelDownloadTaskRequest) (*CancelDownloadTaskResponse, error)
+	mustEmbedUnimplementedGoLoadAdminServiceServer()
+}
+
+// UnimplementedGoLoadAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGoLoadAdminServiceServer struct {
+}
+
+func (UnimplementedGoLoadAdminServiceServer) GetAccountList(context.Context, *GetAccountListRequest) (*GetAccountListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountList not implemented")
+}
+func (UnimplementedGoLoadAdminServiceServer) UpdateAccountRole(context.Context, *UpdateAccountRoleRequest) (*UpdateAccountRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountRole not implemented")
+}
+func (UnimplementedGoLoadAdminServiceServer) DisableAccount(context.Context, *DisableAccountRequest) (*DisableAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableAccount not implemented")
+}
+func (UnimplementedGoLoadAdminServiceServer) EnableAccount(context.Context, *EnableAccountRequest) (*EnableAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableAccount not implemented")
+}
+func (UnimplementedGoLoadAdminServiceServer) UpdateAccountQuota(context.Context, *UpdateAccountQuotaRequest) (*UpdateAccountQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountQuota not implemented")
+}
+func (UnimplementedGoLoadAdminServiceServer) UpdateWorkspaceQuota(context.Context, *UpdateWorkspaceQuotaRequest) (*UpdateWorkspaceQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkspaceQuota not implemented")
+}
+func (UnimplementedGoLoadAdminServiceServer) GetAccountDownloadTaskList(context.Context, *GetAccountDownloadTaskListRequest) (*GetAccountDownloadTaskListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountDownloadTaskList not implemented")
+}
+func (UnimplementedGoLoadAdminServiceServer) CancelDownloadTask(context.Context, *CancelDownloadTaskRequest) (*CancelDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDownloadTask not implemented")
+}
+func (UnimplementedGoLoadAdminServiceServer) mustEmbedUnimplementedGoLoadAdminServiceServer() {}
+
+// UnsafeGoLoadAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GoLoadAdminServiceServer will
+// result in compilation errors.
+type UnsafeGoLoadAdminServiceServer interface {
+	mustEmbedUnimplementedGoLoadAdminServiceServer()
+}
+
+func RegisterGoLoadAdminServiceServer(s grpc.ServiceRegistrar, srv GoLoadAdminServiceServer) {
+	s.RegisterService(&GoLoadAdminService_ServiceDesc, srv)
+}
+
+func _GoLoadAdminService_GetAccountList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadAdminServiceServer).GetAccountList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadAdminService_GetAccountList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadAdminServiceServer).GetAccountList(ctx, req.(*GetAccountListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadAdminService_UpdateAccountRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadAdminServiceServer).UpdateAccountRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadAdminService_UpdateAccountRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadAdminServiceServer).UpdateAccountRole(ctx, req.(*UpdateAccountRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadAdminService_DisableAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadAdminServiceServer).DisableAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadAdminService_DisableAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadAdminServiceServer).DisableAccount(ctx, req.(*DisableAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadAdminService_EnableAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadAdminServiceServer).EnableAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadAdminService_EnableAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadAdminServiceServer).EnableAccount(ctx, req.(*EnableAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadAdminService_UpdateAccountQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadAdminServiceServer).UpdateAccountQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadAdminService_UpdateAccountQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadAdminServiceServer).UpdateAccountQuota(ctx, req.(*UpdateAccountQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadAdminService_UpdateWorkspaceQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkspaceQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadAdminServiceServer).UpdateWorkspaceQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadAdminService_UpdateWorkspaceQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadAdminServiceServer).UpdateWorkspaceQuota(ctx, req.(*UpdateWorkspaceQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadAdminService_GetAccountDownloadTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountDownloadTaskListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadAdminServiceServer).GetAccountDownloadTaskList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadAdminService_GetAccountDownloadTaskList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadAdminServiceServer).GetAccountDownloadTaskList(ctx, req.(*GetAccountDownloadTaskListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadAdminService_CancelDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadAdminServiceServer).CancelDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadAdminService_CancelDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadAdminServiceServer).CancelDownloadTask(ctx, req.(*CancelDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GoLoadAdminService_ServiceDesc is the grpc.ServiceDesc for GoLoadAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GoLoadAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "go_load.v1.GoLoadAdminService",
+	HandlerType: (*GoLoadAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAccountList",
+			Handler:    _GoLoadAdminService_GetAccountList_Handler,
+		},
+		{
+			MethodName: "UpdateAccountRole",
+			Handler:    _GoLoadAdminService_UpdateAccountRole_Handler,
+		},
+		{
+			MethodName: "DisableAccount",
+			Handler:    _GoLoadAdminService_DisableAccount_Handler,
+		},
+		{
+			MethodName: "EnableAccount",
+			Handler:    _GoLoadAdminService_EnableAccount_Handler,
+		},
+		{
+			MethodName: "UpdateAccountQuota",
+			Handler:    _GoLoadAdminService_UpdateAccountQuota_Handler,
+		},
+		{
+			MethodName: "UpdateWorkspaceQuota",
+			Handler:    _GoLoadAdminService_UpdateWorkspaceQuota_Handler,
+		},
+		{
+			MethodName: "GetAccountDownloadTaskList",
+			Handler:    _GoLoadAdminService_GetAccountDownloadTaskList_Handler,
+		},
+		{
+			MethodName: "CancelDownloadTask",
+			Handler:    _GoLoadAdminService_CancelDownloadTask_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "downloadClient/v1/go_load_admin.proto",
+}
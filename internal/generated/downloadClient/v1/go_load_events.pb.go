@@ -0,0 +1,844 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: downloadClient/v1/go_load_events.proto
+
+package go_loadv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DownloadTaskLifecycleEvent is published for lifecycle changes of download tasks, one topic per event type. The
+// payload on the message queue is the protojson encoding of this message.
+type DownloadTaskLifecycleEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// schema_version is increased on incompatible changes of this message.
+	SchemaVersion uint32 `protobuf:"varint,1,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	EventId       string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// correlation_id is shared by all events caused by the same request, including the ones produced later by
+	// consumers of the messages it produced.
+	CorrelationId string `protobuf:"bytes,3,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	AccountId     uint64 `protobuf:"varint,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// download_task is the snapshot of the task right after the change.
+	DownloadTask *DownloadTask          `protobuf:"bytes,5,opt,name=download_task,json=downloadTask,proto3" json:"download_task,omitempty"`
+	OccurredAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Types that are assignable to Event:
+	//	*DownloadTaskLifecycleEvent_Started
+	//	*DownloadTaskLifecycleEvent_Progress
+	//	*DownloadTaskLifecycleEvent_Succeeded
+	//	*DownloadTaskLifecycleEvent_Failed
+	//	*DownloadTaskLifecycleEvent_Cancelled
+	//	*DownloadTaskLifecycleEvent_Deleted
+	Event isDownloadTaskLifecycleEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *DownloadTaskLifecycleEvent) Reset() {
+	*x = DownloadTaskLifecycleEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadTaskLifecycleEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTaskLifecycleEvent) ProtoMessage() {}
+
+func (x *DownloadTaskLifecycleEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTaskLifecycleEvent.ProtoReflect.Descriptor instead.
+func (*DownloadTaskLifecycleEvent) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DownloadTaskLifecycleEvent) GetSchemaVersion() uint32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *DownloadTaskLifecycleEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *DownloadTaskLifecycleEvent) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *DownloadTaskLifecycleEvent) GetAccountId() uint64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *DownloadTaskLifecycleEvent) GetDownloadTask() *DownloadTask {
+	if x != nil {
+		return x.DownloadTask
+	}
+	return nil
+}
+
+func (x *DownloadTaskLifecycleEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (m *DownloadTaskLifecycleEvent) GetEvent() isDownloadTaskLifecycleEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *DownloadTaskLifecycleEvent) GetStarted() *DownloadTaskStarted {
+	if x, ok := x.GetEvent().(*DownloadTaskLifecycleEvent_Started); ok {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *DownloadTaskLifecycleEvent) GetProgress() *DownloadTaskProgress {
+	if x, ok := x.GetEvent().(*DownloadTaskLifecycleEvent_Progress); ok {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *DownloadTaskLifecycleEvent) GetSucceeded() *DownloadTaskSucceeded {
+	if x, ok := x.GetEvent().(*DownloadTaskLifecycleEvent_Succeeded); ok {
+		return x.Succeeded
+	}
+	return nil
+}
+
+func (x *DownloadTaskLifecycleEvent) GetFailed() *DownloadTaskFailed {
+	if x, ok := x.GetEvent().(*DownloadTaskLifecycleEvent_Failed); ok {
+		return x.Failed
+	}
+	return nil
+}
+
+func (x *DownloadTaskLifecycleEvent) GetCancelled() *DownloadTaskCancelled {
+	if x, ok := x.GetEvent().(*DownloadTaskLifecycleEvent_Cancelled); ok {
+		return x.Cancelled
+	}
+	return nil
+}
+
+func (x *DownloadTaskLifecycleEvent) GetDeleted() *DownloadTaskDeleted {
+	if x, ok := x.GetEvent().(*DownloadTaskLifecycleEvent_Deleted); ok {
+		return x.Deleted
+	}
+	return nil
+}
+
+type isDownloadTaskLifecycleEvent_Event interface {
+	isDownloadTaskLifecycleEvent_Event()
+}
+
+type DownloadTaskLifecycleEvent_Started struct {
+	Started *DownloadTaskStarted `protobuf:"bytes,10,opt,name=started,proto3,oneof"`
+}
+
+type DownloadTaskLifecycleEvent_Progress struct {
+	Progress *DownloadTaskProgress `protobuf:"bytes,11,opt,name=progress,proto3,oneof"`
+}
+
+type DownloadTaskLifecycleEvent_Succeeded struct {
+	Succeeded *DownloadTaskSucceeded `protobuf:"bytes,12,opt,name=succeeded,proto3,oneof"`
+}
+
+type DownloadTaskLifecycleEvent_Failed struct {
+	Failed *DownloadTaskFailed `protobuf:"bytes,13,opt,name=failed,proto3,oneof"`
+}
+
+type DownloadTaskLifecycleEvent_Cancelled struct {
+	Cancelled *DownloadTaskCancelled `protobuf:"bytes,14,opt,name=cancelled,proto3,oneof"`
+}
+
+type DownloadTaskLifecycleEvent_Deleted struct {
+	Deleted *DownloadTaskDeleted `protobuf:"bytes,15,opt,name=deleted,proto3,oneof"`
+}
+
+func (*DownloadTaskLifecycleEvent_Started) isDownloadTaskLifecycleEvent_Event() {}
+
+func (*DownloadTaskLifecycleEvent_Progress) isDownloadTaskLifecycleEvent_Event() {}
+
+func (*DownloadTaskLifecycleEvent_Succeeded) isDownloadTaskLifecycleEvent_Event() {}
+
+func (*DownloadTaskLifecycleEvent_Failed) isDownloadTaskLifecycleEvent_Event() {}
+
+func (*DownloadTaskLifecycleEvent_Cancelled) isDownloadTaskLifecycleEvent_Event() {}
+
+func (*DownloadTaskLifecycleEvent_Deleted) isDownloadTaskLifecycleEvent_Event() {}
+
+type DownloadTaskStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attempt uint32 `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+}
+
+func (x *DownloadTaskStarted) Reset() {
+	*x = DownloadTaskStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadTaskStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTaskStarted) ProtoMessage() {}
+
+func (x *DownloadTaskStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTaskStarted.ProtoReflect.Descriptor instead.
+func (*DownloadTaskStarted) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DownloadTaskStarted) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+// DownloadTaskProgress is published when a download crosses 25%, 50% and 75%, only if its size is known upfront.
+type DownloadTaskProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Percent         uint32 `protobuf:"varint,1,opt,name=percent,proto3" json:"percent,omitempty"`
+	DownloadedBytes uint64 `protobuf:"varint,2,opt,name=downloaded_bytes,json=downloadedBytes,proto3" json:"downloaded_bytes,omitempty"`
+	TotalBytes      uint64 `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+}
+
+func (x *DownloadTaskProgress) Reset() {
+	*x = DownloadTaskProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadTaskProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTaskProgress) ProtoMessage() {}
+
+func (x *DownloadTaskProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTaskProgress.ProtoReflect.Descriptor instead.
+func (*DownloadTaskProgress) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DownloadTaskProgress) GetPercent() uint32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *DownloadTaskProgress) GetDownloadedBytes() uint64 {
+	if x != nil {
+		return x.DownloadedBytes
+	}
+	return 0
+}
+
+func (x *DownloadTaskProgress) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+type DownloadTaskSucceeded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DownloadTaskSucceeded) Reset() {
+	*x = DownloadTaskSucceeded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadTaskSucceeded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTaskSucceeded) ProtoMessage() {}
+
+func (x *DownloadTaskSucceeded) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTaskSucceeded.ProtoReflect.Descriptor instead.
+func (*DownloadTaskSucceeded) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_events_proto_rawDescGZIP(), []int{3}
+}
+
+type DownloadTaskFailed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *DownloadTaskFailed) Reset() {
+	*x = DownloadTaskFailed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadTaskFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTaskFailed) ProtoMessage() {}
+
+func (x *DownloadTaskFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTaskFailed.ProtoReflect.Descriptor instead.
+func (*DownloadTaskFailed) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DownloadTaskFailed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DownloadTaskCancelled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actor  string `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *DownloadTaskCancelled) Reset() {
+	*x = DownloadTaskCancelled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadTaskCancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTaskCancelled) ProtoMessage() {}
+
+func (x *DownloadTaskCancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTaskCancelled.ProtoReflect.Descriptor instead.
+func (*DownloadTaskCancelled) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DownloadTaskCancelled) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *DownloadTaskCancelled) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DownloadTaskDeleted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actor string `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+}
+
+func (x *DownloadTaskDeleted) Reset() {
+	*x = DownloadTaskDeleted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadTaskDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadTaskDeleted) ProtoMessage() {}
+
+func (x *DownloadTaskDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadTaskDeleted.ProtoReflect.Descriptor instead.
+func (*DownloadTaskDeleted) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DownloadTaskDeleted) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+// WebhookTestEvent is delivered by TestWebhook.
+type WebhookTestEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId    string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	WebhookId  uint64                 `protobuf:"varint,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+}
+
+func (x *WebhookTestEvent) Reset() {
+	*x = WebhookTestEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_downloadClient_v1_go_load_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookTestEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookTestEvent) ProtoMessage() {}
+
+func (x *WebhookTestEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_downloadClient_v1_go_load_events_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookTestEvent.ProtoReflect.Descriptor instead.
+func (*WebhookTestEvent) Descriptor() ([]byte, []int) {
+	return file_downloadClient_v1_go_load_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WebhookTestEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookTestEvent) GetWebhookId() uint64 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *WebhookTestEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+var File_downloadClient_v1_go_load_events_proto protoreflect.FileDescriptor
+
+var file_downloadClient_v1_go_load_events_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa3, 0x05, 0x0a, 0x1a, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a,
+	0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x0c,
+	0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3b, 0x0a, 0x0b,
+	0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f,
+	0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x5f,
+	0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61,
+	0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x41, 0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65,
+	0x64, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x5f, 0x6c,
+	0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54,
+	0x61, 0x73, 0x6b, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x6c,
+	0x6f, 0x61, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54,
+	0x61, 0x73, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73,
+	0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x13,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x22, 0x7c, 0x0a,
+	0x14, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12,
+	0x29, 0x0a, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x65, 0x64, 0x65, 0x64, 0x22, 0x2c, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x54, 0x61, 0x73, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x45, 0x0a, 0x15, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61,
+	0x73, 0x6b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x2b, 0x0a, 0x13, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x89, 0x01, 0x0a, 0x10, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x54, 0x65, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64,
+	0x41, 0x74, 0x42, 0x92, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x6f, 0x5f, 0x6c, 0x6f,
+	0x61, 0x64, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x47, 0x6f, 0x4c, 0x6f, 0x61, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x67, 0x72, 0x70, 0x63,
+	0x2f, 0x67, 0x6f, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x2f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x6f, 0x5f, 0x6c, 0x6f,
+	0x61, 0x64, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x47, 0x58, 0x58, 0xaa, 0x02, 0x09, 0x47, 0x6f, 0x4c,
+	0x6f, 0x61, 0x64, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x09, 0x47, 0x6f, 0x4c, 0x6f, 0x61, 0x64, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x15, 0x47, 0x6f, 0x4c, 0x6f, 0x61, 0x64, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x47, 0x6f, 0x4c,
+	0x6f, 0x61, 0x64, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_downloadClient_v1_go_load_events_proto_rawDescOnce sync.Once
+	file_downloadClient_v1_go_load_events_proto_rawDescData = file_downloadClient_v1_go_load_events_proto_rawDesc
+)
+
+func file_downloadClient_v1_go_load_events_proto_rawDescGZIP() []byte {
+	file_downloadClient_v1_go_load_events_proto_rawDescOnce.Do(func() {
+		file_downloadClient_v1_go_load_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_downloadClient_v1_go_load_events_proto_rawDescData)
+	})
+	return file_downloadClient_v1_go_load_events_proto_rawDescData
+}
+
+var file_downloadClient_v1_go_load_events_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_downloadClient_v1_go_load_events_proto_goTypes = []any{
+	(*DownloadTaskLifecycleEvent)(nil), // 0: go_load.v1.DownloadTaskLifecycleEvent
+	(*DownloadTaskStarted)(nil),        // 1: go_load.v1.DownloadTaskStarted
+	(*DownloadTaskProgress)(nil),       // 2: go_load.v1.DownloadTaskProgress
+	(*DownloadTaskSucceeded)(nil),      // 3: go_load.v1.DownloadTaskSucceeded
+	(*DownloadTaskFailed)(nil),         // 4: go_load.v1.DownloadTaskFailed
+	(*DownloadTaskCancelled)(nil),      // 5: go_load.v1.DownloadTaskCancelled
+	(*DownloadTaskDeleted)(nil),        // 6: go_load.v1.DownloadTaskDeleted
+	(*WebhookTestEvent)(nil),           // 7: go_load.v1.WebhookTestEvent
+	(*DownloadTask)(nil),               // 8: go_load.v1.DownloadTask
+	(*timestamppb.Timestamp)(nil),      // 9: google.protobuf.Timestamp
+}
+var file_downloadClient_v1_go_load_events_proto_depIdxs = []int32{
+	8, // 0: go_load.v1.DownloadTaskLifecycleEvent.download_task:type_name -> go_load.v1.DownloadTask
+	9, // 1: go_load.v1.DownloadTaskLifecycleEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	1, // 2: go_load.v1.DownloadTaskLifecycleEvent.started:type_name -> go_load.v1.DownloadTaskStarted
+	2, // 3: go_load.v1.DownloadTaskLifecycleEvent.progress:type_name -> go_load.v1.DownloadTaskProgress
+	3, // 4: go_load.v1.DownloadTaskLifecycleEvent.succeeded:type_name -> go_load.v1.DownloadTaskSucceeded
+	4, // 5: go_load.v1.DownloadTaskLifecycleEvent.failed:type_name -> go_load.v1.DownloadTaskFailed
+	5, // 6: go_load.v1.DownloadTaskLifecycleEvent.cancelled:type_name -> go_load.v1.DownloadTaskCancelled
+	6, // 7: go_load.v1.DownloadTaskLifecycleEvent.deleted:type_name -> go_load.v1.DownloadTaskDeleted
+	9, // 8: go_load.v1.WebhookTestEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_downloadClient_v1_go_load_events_proto_init() }
+func file_downloadClient_v1_go_load_events_proto_init() {
+	if File_downloadClient_v1_go_load_events_proto != nil {
+		return
+	}
+	file_downloadClient_v1_go_load_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_downloadClient_v1_go_load_events_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*DownloadTaskLifecycleEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_events_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*DownloadTaskStarted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_events_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*DownloadTaskProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_events_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*DownloadTaskSucceeded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_events_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*DownloadTaskFailed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_events_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*DownloadTaskCancelled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_events_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*DownloadTaskDeleted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_downloadClient_v1_go_load_events_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*WebhookTestEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_downloadClient_v1_go_load_events_proto_msgTypes[0].OneofWrappers = []any{
+		(*DownloadTaskLifecycleEvent_Started)(nil),
+		(*DownloadTaskLifecycleEvent_Progress)(nil),
+		(*DownloadTaskLifecycleEvent_Succeeded)(nil),
+		(*DownloadTaskLifecycleEvent_Failed)(nil),
+		(*DownloadTaskLifecycleEvent_Cancelled)(nil),
+		(*DownloadTaskLifecycleEvent_Deleted)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_downloadClient_v1_go_load_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_downloadClient_v1_go_load_events_proto_goTypes,
+		DependencyIndexes: file_downloadClient_v1_go_load_events_proto_depIdxs,
+		MessageInfos:      file_downloadClient_v1_go_load_events_proto_msgTypes,
+	}.Build()
+	File_downloadClient_v1_go_load_events_proto = out.File
+	file_downloadClient_v1_go_load_events_proto_rawDesc = nil
+	file_downloadClient_v1_go_load_events_proto_goTypes = nil
+	file_downloadClient_v1_go_load_events_proto_depIdxs = nil
+}
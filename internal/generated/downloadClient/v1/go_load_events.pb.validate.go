@@ -0,0 +1,1216 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: downloadClient/v1/go_load_events.proto
+
+package go_loadv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on DownloadTaskLifecycleEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskLifecycleEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskLifecycleEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskLifecycleEventMultiError, or nil if none found.
+func (m *DownloadTaskLifecycleEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskLifecycleEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SchemaVersion
+
+	// no validation rules for EventId
+
+	// no validation rules for CorrelationId
+
+	// no validation rules for AccountId
+
+	if all {
+		switch v := interface{}(m.GetDownloadTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskLifecycleEventValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskLifecycleEventValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskLifecycleEventValidationError{
+				field:  "DownloadTask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskLifecycleEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskLifecycleEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskLifecycleEventValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Event.(type) {
+	case *DownloadTaskLifecycleEvent_Started:
+		if v == nil {
+			err := DownloadTaskLifecycleEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetStarted()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadTaskLifecycleEventValidationError{
+						field:  "Started",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadTaskLifecycleEventValidationError{
+						field:  "Started",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStarted()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadTaskLifecycleEventValidationError{
+					field:  "Started",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DownloadTaskLifecycleEvent_Progress:
+		if v == nil {
+			err := DownloadTaskLifecycleEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetProgress()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadTaskLifecycleEventValidationError{
+						field:  "Progress",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadTaskLifecycleEventValidationError{
+						field:  "Progress",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetProgress()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadTaskLifecycleEventValidationError{
+					field:  "Progress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DownloadTaskLifecycleEvent_Succeeded:
+		if v == nil {
+			err := DownloadTaskLifecycleEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSucceeded()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadTaskLifecycleEventValidationError{
+						field:  "Succeeded",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadTaskLifecycleEventValidationError{
+						field:  "Succeeded",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSucceeded()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadTaskLifecycleEventValidationError{
+					field:  "Succeeded",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DownloadTaskLifecycleEvent_Failed:
+		if v == nil {
+			err := DownloadTaskLifecycleEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFailed()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadTaskLifecycleEventValidationError{
+						field:  "Failed",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadTaskLifecycleEventValidationError{
+						field:  "Failed",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFailed()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadTaskLifecycleEventValidationError{
+					field:  "Failed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DownloadTaskLifecycleEvent_Cancelled:
+		if v == nil {
+			err := DownloadTaskLifecycleEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCancelled()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadTaskLifecycleEventValidationError{
+						field:  "Cancelled",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadTaskLifecycleEventValidationError{
+						field:  "Cancelled",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCancelled()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadTaskLifecycleEventValidationError{
+					field:  "Cancelled",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DownloadTaskLifecycleEvent_Deleted:
+		if v == nil {
+			err := DownloadTaskLifecycleEventValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDeleted()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadTaskLifecycleEventValidationError{
+						field:  "Deleted",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadTaskLifecycleEventValidationError{
+						field:  "Deleted",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeleted()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadTaskLifecycleEventValidationError{
+					field:  "Deleted",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return DownloadTaskLifecycleEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskLifecycleEventMultiError is an error wrapping multiple
+// validation errors returned by DownloadTaskLifecycleEvent.ValidateAll() if
+// the designated constraints aren't met.
+type DownloadTaskLifecycleEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskLifecycleEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskLifecycleEventMultiError) AllErrors() []error { return m }
+
+// DownloadTaskLifecycleEventValidationError is the validation error returned
+// by DownloadTaskLifecycleEvent.Validate if the designated constraints aren't met.
+type DownloadTaskLifecycleEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskLifecycleEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskLifecycleEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskLifecycleEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskLifecycleEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskLifecycleEventValidationError) ErrorName() string {
+	return "DownloadTaskLifecycleEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskLifecycleEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskLifecycleEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskLifecycleEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskLifecycleEventValidationError{}
+
+// Validate checks the field values on DownloadTaskStarted with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskStarted) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskStarted with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskStartedMultiError, or nil if none found.
+func (m *DownloadTaskStarted) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskStarted) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Attempt
+
+	if len(errors) > 0 {
+		return DownloadTaskStartedMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskStartedMultiError is an error wrapping multiple validation
+// errors returned by DownloadTaskStarted.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadTaskStartedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskStartedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskStartedMultiError) AllErrors() []error { return m }
+
+// DownloadTaskStartedValidationError is the validation error returned by
+// DownloadTaskStarted.Validate if the designated constraints aren't met.
+type DownloadTaskStartedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskStartedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskStartedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskStartedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskStartedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskStartedValidationError) ErrorName() string {
+	return "DownloadTaskStartedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskStartedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskStarted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskStartedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskStartedValidationError{}
+
+// Validate checks the field values on DownloadTaskProgress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskProgress) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskProgress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskProgressMultiError, or nil if none found.
+func (m *DownloadTaskProgress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskProgress) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Percent
+
+	// no validation rules for DownloadedBytes
+
+	// no validation rules for TotalBytes
+
+	if len(errors) > 0 {
+		return DownloadTaskProgressMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskProgressMultiError is an error wrapping multiple validation
+// errors returned by DownloadTaskProgress.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadTaskProgressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskProgressMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskProgressMultiError) AllErrors() []error { return m }
+
+// DownloadTaskProgressValidationError is the validation error returned by
+// DownloadTaskProgress.Validate if the designated constraints aren't met.
+type DownloadTaskProgressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskProgressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskProgressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskProgressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskProgressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskProgressValidationError) ErrorName() string {
+	return "DownloadTaskProgressValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskProgressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskProgress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskProgressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskProgressValidationError{}
+
+// Validate checks the field values on DownloadTaskSucceeded with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskSucceeded) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskSucceeded with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskSucceededMultiError, or nil if none found.
+func (m *DownloadTaskSucceeded) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskSucceeded) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DownloadTaskSucceededMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskSucceededMultiError is an error wrapping multiple validation
+// errors returned by DownloadTaskSucceeded.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadTaskSucceededMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskSucceededMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskSucceededMultiError) AllErrors() []error { return m }
+
+// DownloadTaskSucceededValidationError is the validation error returned by
+// DownloadTaskSucceeded.Validate if the designated constraints aren't met.
+type DownloadTaskSucceededValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskSucceededValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskSucceededValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskSucceededValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskSucceededValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskSucceededValidationError) ErrorName() string {
+	return "DownloadTaskSucceededValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskSucceededValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskSucceeded.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskSucceededValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskSucceededValidationError{}
+
+// Validate checks the field values on DownloadTaskFailed with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskFailed) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskFailed with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskFailedMultiError, or nil if none found.
+func (m *DownloadTaskFailed) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskFailed) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return DownloadTaskFailedMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskFailedMultiError is an error wrapping multiple validation errors
+// returned by DownloadTaskFailed.ValidateAll() if the designated constraints
+// aren't met.
+type DownloadTaskFailedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskFailedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskFailedMultiError) AllErrors() []error { return m }
+
+// DownloadTaskFailedValidationError is the validation error returned by
+// DownloadTaskFailed.Validate if the designated constraints aren't met.
+type DownloadTaskFailedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskFailedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskFailedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskFailedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskFailedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskFailedValidationError) ErrorName() string {
+	return "DownloadTaskFailedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskFailedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskFailed.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskFailedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskFailedValidationError{}
+
+// Validate checks the field values on DownloadTaskCancelled with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskCancelled) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskCancelled with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskCancelledMultiError, or nil if none found.
+func (m *DownloadTaskCancelled) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskCancelled) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Actor
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return DownloadTaskCancelledMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskCancelledMultiError is an error wrapping multiple validation
+// errors returned by DownloadTaskCancelled.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadTaskCancelledMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskCancelledMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskCancelledMultiError) AllErrors() []error { return m }
+
+// DownloadTaskCancelledValidationError is the validation error returned by
+// DownloadTaskCancelled.Validate if the designated constraints aren't met.
+type DownloadTaskCancelledValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskCancelledValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskCancelledValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskCancelledValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskCancelledValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskCancelledValidationError) ErrorName() string {
+	return "DownloadTaskCancelledValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskCancelledValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskCancelled.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskCancelledValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskCancelledValidationError{}
+
+// Validate checks the field values on DownloadTaskDeleted with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskDeleted) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskDeleted with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskDeletedMultiError, or nil if none found.
+func (m *DownloadTaskDeleted) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskDeleted) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Actor
+
+	if len(errors) > 0 {
+		return DownloadTaskDeletedMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskDeletedMultiError is an error wrapping multiple validation
+// errors returned by DownloadTaskDeleted.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadTaskDeletedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskDeletedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskDeletedMultiError) AllErrors() []error { return m }
+
+// DownloadTaskDeletedValidationError is the validation error returned by
+// DownloadTaskDeleted.Validate if the designated constraints aren't met.
+type DownloadTaskDeletedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskDeletedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskDeletedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskDeletedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskDeletedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskDeletedValidationError) ErrorName() string {
+	return "DownloadTaskDeletedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskDeletedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskDeleted.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskDeletedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskDeletedValidationError{}
+
+// Validate checks the field values on WebhookTestEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WebhookTestEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookTestEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookTestEventMultiError, or nil if none found.
+func (m *WebhookTestEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookTestEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	// no validation rules for WebhookId
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookTestEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookTestEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookTestEventValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebhookTestEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookTestEventMultiError is an error wrapping multiple validation errors
+// returned by WebhookTestEvent.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookTestEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookTestEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookTestEventMultiError) AllErrors() []error { return m }
+
+// WebhookTestEventValidationError is the validation error returned by
+// WebhookTestEvent.Validate if the designated constraints aren't met.
+type WebhookTestEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookTestEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookTestEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookTestEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookTestEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookTestEventValidationError) ErrorName() string { return "WebhookTestEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookTestEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookTestEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookTestEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookTestEventValidationError{}
@@ -19,13 +19,47 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	GoLoadService_CreateAccount_FullMethodName       = "/go_load.v1.GoLoadService/CreateAccount"
-	GoLoadService_CreateSession_FullMethodName       = "/go_load.v1.GoLoadService/CreateSession"
-	GoLoadService_CreateDownloadTask_FullMethodName  = "/go_load.v1.GoLoadService/CreateDownloadTask"
-	GoLoadService_GetDownloadTaskList_FullMethodName = "/go_load.v1.GoLoadService/GetDownloadTaskList"
-	GoLoadService_UpdateDownloadTask_FullMethodName  = "/go_load.v1.GoLoadService/UpdateDownloadTask"
-	GoLoadService_DeleteDownloadTask_FullMethodName  = "/go_load.v1.GoLoadService/DeleteDownloadTask"
-	GoLoadService_GetDownloadTaskFile_FullMethodName = "/go_load.v1.GoLoadService/GetDownloadTaskFile"
+	GoLoadService_CreateAccount_FullMethodName           = "/go_load.v1.GoLoadService/CreateAccount"
+	GoLoadService_CreateSession_FullMethodName           = "/go_load.v1.GoLoadService/CreateSession"
+	GoLoadService_RefreshSession_FullMethodName          = "/go_load.v1.GoLoadService/RefreshSession"
+	GoLoadService_DeleteSession_FullMethodName           = "/go_load.v1.GoLoadService/DeleteSession"
+	GoLoadService_DeleteAllSessions_FullMethodName       = "/go_load.v1.GoLoadService/DeleteAllSessions"
+	GoLoadService_GetAccount_FullMethodName              = "/go_load.v1.GoLoadService/GetAccount"
+	GoLoadService_ChangePassword_FullMethodName          = "/go_load.v1.GoLoadService/ChangePassword"
+	GoLoadService_DeleteAccount_FullMethodName           = "/go_load.v1.GoLoadService/DeleteAccount"
+	GoLoadService_EnrollTwoFactor_FullMethodName         = "/go_load.v1.GoLoadService/EnrollTwoFactor"
+	GoLoadService_ConfirmTwoFactor_FullMethodName        = "/go_load.v1.GoLoadService/ConfirmTwoFactor"
+	GoLoadService_DisableTwoFactor_FullMethodName        = "/go_load.v1.GoLoadService/DisableTwoFactor"
+	GoLoadService_RegenerateRecoveryCodes_FullMethodName = "/go_load.v1.GoLoadService/RegenerateRecoveryCodes"
+	GoLoadService_CreateDownloadTask_FullMethodName      = "/go_load.v1.GoLoadService/CreateDownloadTask"
+	GoLoadService_GetDownloadTaskList_FullMethodName     = "/go_load.v1.GoLoadService/GetDownloadTaskList"
+	GoLoadService_UpdateDownloadTask_FullMethodName      = "/go_load.v1.GoLoadService/UpdateDownloadTask"
+	GoLoadService_DeleteDownloadTask_FullMethodName      = "/go_load.v1.GoLoadService/DeleteDownloadTask"
+	GoLoadService_GetDownloadTaskFile_FullMethodName     = "/go_load.v1.GoLoadService/GetDownloadTaskFile"
+	GoLoadService_GetDownloadTaskHistory_FullMethodName  = "/go_load.v1.GoLoadService/GetDownloadTaskHistory"
+	GoLoadService_RetryDownloadTask_FullMethodName       = "/go_load.v1.GoLoadService/RetryDownloadTask"
+	GoLoadService_CloneDownloadTask_FullMethodName       = "/go_load.v1.GoLoadService/CloneDownloadTask"
+	GoLoadService_BulkDeleteDownloadTasks_FullMethodName = "/go_load.v1.GoLoadService/BulkDeleteDownloadTasks"
+	GoLoadService_BulkRetryDownloadTasks_FullMethodName  = "/go_load.v1.GoLoadService/BulkRetryDownloadTasks"
+	GoLoadService_BulkCancelDownloadTasks_FullMethodName = "/go_load.v1.GoLoadService/BulkCancelDownloadTasks"
+	GoLoadService_CreateWebhook_FullMethodName           = "/go_load.v1.GoLoadService/CreateWebhook"
+	GoLoadService_GetWebhookList_FullMethodName          = "/go_load.v1.GoLoadService/GetWebhookList"
+	GoLoadService_DeleteWebhook_FullMethodName           = "/go_load.v1.GoLoadService/DeleteWebhook"
+	GoLoadService_TestWebhook_FullMethodName             = "/go_load.v1.GoLoadService/TestWebhook"
+	GoLoadService_GetWebhookDeliveryList_FullMethodName  = "/go_load.v1.GoLoadService/GetWebhookDeliveryList"
+	GoLoadService_CreateApiKey_FullMethodName            = "/go_load.v1.GoLoadService/CreateApiKey"
+	GoLoadService_ListApiKeys_FullMethodName             = "/go_load.v1.GoLoadService/ListApiKeys"
+	GoLoadService_RevokeApiKey_FullMethodName            = "/go_load.v1.GoLoadService/RevokeApiKey"
+	GoLoadService_CreateShareLink_FullMethodName         = "/go_load.v1.GoLoadService/CreateShareLink"
+	GoLoadService_ListShareLinks_FullMethodName          = "/go_load.v1.GoLoadService/ListShareLinks"
+	GoLoadService_RevokeShareLink_FullMethodName         = "/go_load.v1.GoLoadService/RevokeShareLink"
+	GoLoadService_CreateWorkspace_FullMethodName         = "/go_load.v1.GoLoadService/CreateWorkspace"
+	GoLoadService_GetWorkspaceList_FullMethodName        = "/go_load.v1.GoLoadService/GetWorkspaceList"
+	GoLoadService_DeleteWorkspace_FullMethodName         = "/go_load.v1.GoLoadService/DeleteWorkspace"
+	GoLoadService_GetWorkspaceMemberList_FullMethodName  = "/go_load.v1.GoLoadService/GetWorkspaceMemberList"
+	GoLoadService_AddWorkspaceMember_FullMethodName      = "/go_load.v1.GoLoadService/AddWorkspaceMember"
+	GoLoadService_UpdateWorkspaceMember_FullMethodName   = "/go_load.v1.GoLoadService/UpdateWorkspaceMember"
+	GoLoadService_RemoveWorkspaceMember_FullMethodName   = "/go_load.v1.GoLoadService/RemoveWorkspaceMember"
 )
 
 // GoLoadServiceClient is the client API for GoLoadService service.
@@ -34,11 +68,45 @@ const (
 type GoLoadServiceClient interface {
 	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
 	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error)
+	RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error)
+	DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionResponse, error)
+	DeleteAllSessions(ctx context.Context, in *DeleteAllSessionsRequest, opts ...grpc.CallOption) (*DeleteAllSessionsResponse, error)
+	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	EnrollTwoFactor(ctx context.Context, in *EnrollTwoFactorRequest, opts ...grpc.CallOption) (*EnrollTwoFactorResponse, error)
+	ConfirmTwoFactor(ctx context.Context, in *ConfirmTwoFactorRequest, opts ...grpc.CallOption) (*ConfirmTwoFactorResponse, error)
+	DisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResponse, error)
 	CreateDownloadTask(ctx context.Context, in *CreateDownloadTaskRequest, opts ...grpc.CallOption) (*CreateDownloadTaskResponse, error)
 	GetDownloadTaskList(ctx context.Context, in *GetDownloadTaskListRequest, opts ...grpc.CallOption) (*GetDownloadTaskListResponse, error)
 	UpdateDownloadTask(ctx context.Context, in *UpdateDownloadTaskRequest, opts ...grpc.CallOption) (*UpdateDownloadTaskResponse, error)
 	DeleteDownloadTask(ctx context.Context, in *DeleteDownloadTaskRequest, opts ...grpc.CallOption) (*DeleteDownloadTaskResponse, error)
 	GetDownloadTaskFile(ctx context.Context, in *GetDownloadTaskFileRequest, opts ...grpc.CallOption) (GoLoadService_GetDownloadTaskFileClient, error)
+	GetDownloadTaskHistory(ctx context.Context, in *GetDownloadTaskHistoryRequest, opts ...grpc.CallOption) (*GetDownloadTaskHistoryResponse, error)
+	RetryDownloadTask(ctx context.Context, in *RetryDownloadTaskRequest, opts ...grpc.CallOption) (*RetryDownloadTaskResponse, error)
+	CloneDownloadTask(ctx context.Context, in *CloneDownloadTaskRequest, opts ...grpc.CallOption) (*CloneDownloadTaskResponse, error)
+	BulkDeleteDownloadTasks(ctx context.Context, in *BulkDeleteDownloadTasksRequest, opts ...grpc.CallOption) (*BulkDeleteDownloadTasksResponse, error)
+	BulkRetryDownloadTasks(ctx context.Context, in *BulkRetryDownloadTasksRequest, opts ...grpc.CallOption) (*BulkRetryDownloadTasksResponse, error)
+	BulkCancelDownloadTasks(ctx context.Context, in *BulkCancelDownloadTasksRequest, opts ...grpc.CallOption) (*BulkCancelDownloadTasksResponse, error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	GetWebhookList(ctx context.Context, in *GetWebhookListRequest, opts ...grpc.CallOption) (*GetWebhookListResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	TestWebhook(ctx context.Context, in *TestWebhookRequest, opts ...grpc.CallOption) (*TestWebhookResponse, error)
+	GetWebhookDeliveryList(ctx context.Context, in *GetWebhookDeliveryListRequest, opts ...grpc.CallOption) (*GetWebhookDeliveryListResponse, error)
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error)
+	CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkResponse, error)
+	ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksResponse, error)
+	RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error)
+	CreateWorkspace(ctx context.Context, in *CreateWorkspaceRequest, opts ...grpc.CallOption) (*CreateWorkspaceResponse, error)
+	GetWorkspaceList(ctx context.Context, in *GetWorkspaceListRequest, opts ...grpc.CallOption) (*GetWorkspaceListResponse, error)
+	DeleteWorkspace(ctx context.Context, in *DeleteWorkspaceRequest, opts ...grpc.CallOption) (*DeleteWorkspaceResponse, error)
+	GetWorkspaceMemberList(ctx context.Context, in *GetWorkspaceMemberListRequest, opts ...grpc.CallOption) (*GetWorkspaceMemberListResponse, error)
+	AddWorkspaceMember(ctx context.Context, in *AddWorkspaceMemberRequest, opts ...grpc.CallOption) (*AddWorkspaceMemberResponse, error)
+	UpdateWorkspaceMember(ctx context.Context, in *UpdateWorkspaceMemberRequest, opts ...grpc.CallOption) (*UpdateWorkspaceMemberResponse, error)
+	RemoveWorkspaceMember(ctx context.Context, in *RemoveWorkspaceMemberRequest, opts ...grpc.CallOption) (*RemoveWorkspaceMemberResponse, error)
 }
 
 type goLoadServiceClient struct {
@@ -69,6 +137,106 @@ func (c *goLoadServiceClient) CreateSession(ctx context.Context, in *CreateSessi
 	return out, nil
 }
 
+func (c *goLoadServiceClient) RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshSessionResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_RefreshSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSessionResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_DeleteSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) DeleteAllSessions(ctx context.Context, in *DeleteAllSessionsRequest, opts ...grpc.CallOption) (*DeleteAllSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAllSessionsResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_DeleteAllSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_GetAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_DeleteAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) EnrollTwoFactor(ctx context.Context, in *EnrollTwoFactorRequest, opts ...grpc.CallOption) (*EnrollTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollTwoFactorResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_EnrollTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) ConfirmTwoFactor(ctx context.Context, in *ConfirmTwoFactorRequest, opts ...grpc.CallOption) (*ConfirmTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTwoFactorResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_ConfirmTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) DisableTwoFactor(ctx context.Context, in *DisableTwoFactorRequest, opts ...grpc.CallOption) (*DisableTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTwoFactorResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_DisableTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenerateRecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_RegenerateRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goLoadServiceClient) CreateDownloadTask(ctx context.Context, in *CreateDownloadTaskRequest, opts ...grpc.CallOption) (*CreateDownloadTaskResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateDownloadTaskResponse)
@@ -89,238 +257,1226 @@ func (c *goLoadServiceClient) GetDownloadTaskList(ctx context.Context, in *GetDo
 	return out, nil
 }
 
-func (c *goLoadServiceClient) UpdateDownloadTask(ctx context.Context, in *UpdateDownloadTaskRequest, opts ...grpc.CallOption) (*UpdateDownloadTaskResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateDownloadTaskResponse)
-	err := c.cc.Invoke(ctx, GoLoadService_UpdateDownloadTask_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func (c *goLoadServiceClient) UpdateDownloadTask(ctx context.Context, in *UpdateDownloadTaskRequest, opts ...grpc.CallOption) (*UpdateDownloadTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_UpdateDownloadTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) DeleteDownloadTask(ctx context.Context, in *DeleteDownloadTaskRequest, opts ...grpc.CallOption) (*DeleteDownloadTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_DeleteDownloadTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) GetDownloadTaskFile(ctx context.Context, in *GetDownloadTaskFileRequest, opts ...grpc.CallOption) (GoLoadService_GetDownloadTaskFileClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoLoadService_ServiceDesc.Streams[0], GoLoadService_GetDownloadTaskFile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goLoadServiceGetDownloadTaskFileClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoLoadService_GetDownloadTaskFileClient interface {
+	Recv() (*GetDownloadTaskFileResponse, error)
+	grpc.ClientStream
+}
+
+type goLoadServiceGetDownloadTaskFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *goLoadServiceGetDownloadTaskFileClient) Recv() (*GetDownloadTaskFileResponse, error) {
+	m := new(GetDownloadTaskFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goLoadServiceClient) GetDownloadTaskHistory(ctx context.Context, in *GetDownloadTaskHistoryRequest, opts ...grpc.CallOption) (*GetDownloadTaskHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDownloadTaskHistoryResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_GetDownloadTaskHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) RetryDownloadTask(ctx context.Context, in *RetryDownloadTaskRequest, opts ...grpc.CallOption) (*RetryDownloadTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetryDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_RetryDownloadTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) CloneDownloadTask(ctx context.Context, in *CloneDownloadTaskRequest, opts ...grpc.CallOption) (*CloneDownloadTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_CloneDownloadTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) BulkDeleteDownloadTasks(ctx context.Context, in *BulkDeleteDownloadTasksRequest, opts ...grpc.CallOption) (*BulkDeleteDownloadTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkDeleteDownloadTasksResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_BulkDeleteDownloadTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) BulkRetryDownloadTasks(ctx context.Context, in *BulkRetryDownloadTasksRequest, opts ...grpc.CallOption) (*BulkRetryDownloadTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkRetryDownloadTasksResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_BulkRetryDownloadTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) BulkCancelDownloadTasks(ctx context.Context, in *BulkCancelDownloadTasksRequest, opts ...grpc.CallOption) (*BulkCancelDownloadTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkCancelDownloadTasksResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_BulkCancelDownloadTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) GetWebhookList(ctx context.Context, in *GetWebhookListRequest, opts ...grpc.CallOption) (*GetWebhookListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWebhookListResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_GetWebhookList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) TestWebhook(ctx context.Context, in *TestWebhookRequest, opts ...grpc.CallOption) (*TestWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TestWebhookResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_TestWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) GetWebhookDeliveryList(ctx context.Context, in *GetWebhookDeliveryListRequest, opts ...grpc.CallOption) (*GetWebhookDeliveryListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWebhookDeliveryListResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_GetWebhookDeliveryList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_CreateApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_ListApiKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeApiKeyResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_RevokeApiKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateShareLinkResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_CreateShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShareLinksResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_ListShareLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeShareLinkResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_RevokeShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) CreateWorkspace(ctx context.Context, in *CreateWorkspaceRequest, opts ...grpc.CallOption) (*CreateWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWorkspaceResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_CreateWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) GetWorkspaceList(ctx context.Context, in *GetWorkspaceListRequest, opts ...grpc.CallOption) (*GetWorkspaceListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkspaceListResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_GetWorkspaceList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) DeleteWorkspace(ctx context.Context, in *DeleteWorkspaceRequest, opts ...grpc.CallOption) (*DeleteWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWorkspaceResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_DeleteWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) GetWorkspaceMemberList(ctx context.Context, in *GetWorkspaceMemberListRequest, opts ...grpc.CallOption) (*GetWorkspaceMemberListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkspaceMemberListResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_GetWorkspaceMemberList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) AddWorkspaceMember(ctx context.Context, in *AddWorkspaceMemberRequest, opts ...grpc.CallOption) (*AddWorkspaceMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddWorkspaceMemberResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_AddWorkspaceMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) UpdateWorkspaceMember(ctx context.Context, in *UpdateWorkspaceMemberRequest, opts ...grpc.CallOption) (*UpdateWorkspaceMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateWorkspaceMemberResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_UpdateWorkspaceMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) RemoveWorkspaceMember(ctx context.Context, in *RemoveWorkspaceMemberRequest, opts ...grpc.CallOption) (*RemoveWorkspaceMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveWorkspaceMemberResponse)
+	err := c.cc.Invoke(ctx, GoLoadService_RemoveWorkspaceMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GoLoadServiceServer is the server API for GoLoadService service.
+// All implementations must embed UnimplementedGoLoadServiceServer
+// for forward compatibility
+type GoLoadServiceServer interface {
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
+	RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error)
+	DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error)
+	DeleteAllSessions(context.Context, *DeleteAllSessionsRequest) (*DeleteAllSessionsResponse, error)
+	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	EnrollTwoFactor(context.Context, *EnrollTwoFactorRequest) (*EnrollTwoFactorResponse, error)
+	ConfirmTwoFactor(context.Context, *ConfirmTwoFactorRequest) (*ConfirmTwoFactorResponse, error)
+	DisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorResponse, error)
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*RegenerateRecoveryCodesResponse, error)
+	CreateDownloadTask(context.Context, *CreateDownloadTaskRequest) (*CreateDownloadTaskResponse, error)
+	GetDownloadTaskList(context.Context, *GetDownloadTaskListRequest) (*GetDownloadTaskListResponse, error)
+	UpdateDownloadTask(context.Context, *UpdateDownloadTaskRequest) (*UpdateDownloadTaskResponse, error)
+	DeleteDownloadTask(context.Context, *DeleteDownloadTaskRequest) (*DeleteDownloadTaskResponse, error)
+	GetDownloadTaskFile(*GetDownloadTaskFileRequest, GoLoadService_GetDownloadTaskFileServer) error
+	GetDownloadTaskHistory(context.Context, *GetDownloadTaskHistoryRequest) (*GetDownloadTaskHistoryResponse, error)
+	RetryDownloadTask(context.Context, *RetryDownloadTaskRequest) (*RetryDownloadTaskResponse, error)
+	CloneDownloadTask(context.Context, *CloneDownloadTaskRequest) (*CloneDownloadTaskResponse, error)
+	BulkDeleteDownloadTasks(context.Context, *BulkDeleteDownloadTasksRequest) (*BulkDeleteDownloadTasksResponse, error)
+	BulkRetryDownloadTasks(context.Context, *BulkRetryDownloadTasksRequest) (*BulkRetryDownloadTasksResponse, error)
+	BulkCancelDownloadTasks(context.Context, *BulkCancelDownloadTasksRequest) (*BulkCancelDownloadTasksResponse, error)
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	GetWebhookList(context.Context, *GetWebhookListRequest) (*GetWebhookListResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	TestWebhook(context.Context, *TestWebhookRequest) (*TestWebhookResponse, error)
+	GetWebhookDeliveryList(context.Context, *GetWebhookDeliveryListRequest) (*GetWebhookDeliveryListResponse, error)
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error)
+	CreateShareLink(context.Context, *CreateShareLinkRequest) (*CreateShareLinkResponse, error)
+	ListShareLinks(context.Context, *ListShareLinksRequest) (*ListShareLinksResponse, error)
+	RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error)
+	CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*CreateWorkspaceResponse, error)
+	GetWorkspaceList(context.Context, *GetWorkspaceListRequest) (*GetWorkspaceListResponse, error)
+	DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*DeleteWorkspaceResponse, error)
+	GetWorkspaceMemberList(context.Context, *GetWorkspaceMemberListRequest) (*GetWorkspaceMemberListResponse, error)
+	AddWorkspaceMember(context.Context, *AddWorkspaceMemberRequest) (*AddWorkspaceMemberResponse, error)
+	UpdateWorkspaceMember(context.Context, *UpdateWorkspaceMemberRequest) (*UpdateWorkspaceMemberResponse, error)
+	RemoveWorkspaceMember(context.Context, *RemoveWorkspaceMemberRequest) (*RemoveWorkspaceMemberResponse, error)
+	mustEmbedUnimplementedGoLoadServiceServer()
+}
+
+// UnimplementedGoLoadServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGoLoadServiceServer struct {
+}
+
+func (UnimplementedGoLoadServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedGoLoadServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
+}
+func (UnimplementedGoLoadServiceServer) RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshSession not implemented")
+}
+func (UnimplementedGoLoadServiceServer) DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSession not implemented")
+}
+func (UnimplementedGoLoadServiceServer) DeleteAllSessions(context.Context, *DeleteAllSessionsRequest) (*DeleteAllSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAllSessions not implemented")
+}
+func (UnimplementedGoLoadServiceServer) GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedGoLoadServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedGoLoadServiceServer) EnrollTwoFactor(context.Context, *EnrollTwoFactorRequest) (*EnrollTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTwoFactor not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ConfirmTwoFactor(context.Context, *ConfirmTwoFactorRequest) (*ConfirmTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTwoFactor not implemented")
+}
+func (UnimplementedGoLoadServiceServer) DisableTwoFactor(context.Context, *DisableTwoFactorRequest) (*DisableTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTwoFactor not implemented")
+}
+func (UnimplementedGoLoadServiceServer) RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*RegenerateRecoveryCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedGoLoadServiceServer) CreateDownloadTask(context.Context, *CreateDownloadTaskRequest) (*CreateDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDownloadTask not implemented")
+}
+func (UnimplementedGoLoadServiceServer) GetDownloadTaskList(context.Context, *GetDownloadTaskListRequest) (*GetDownloadTaskListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDownloadTaskList not implemented")
+}
+func (UnimplementedGoLoadServiceServer) UpdateDownloadTask(context.Context, *UpdateDownloadTaskRequest) (*UpdateDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDownloadTask not implemented")
+}
+func (UnimplementedGoLoadServiceServer) DeleteDownloadTask(context.Context, *DeleteDownloadTaskRequest) (*DeleteDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDownloadTask not implemented")
+}
+func (UnimplementedGoLoadServiceServer) GetDownloadTaskFile(*GetDownloadTaskFileRequest, GoLoadService_GetDownloadTaskFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetDownloadTaskFile not implemented")
+}
+func (UnimplementedGoLoadServiceServer) GetDownloadTaskHistory(context.Context, *GetDownloadTaskHistoryRequest) (*GetDownloadTaskHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDownloadTaskHistory not implemented")
+}
+func (UnimplementedGoLoadServiceServer) RetryDownloadTask(context.Context, *RetryDownloadTaskRequest) (*RetryDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryDownloadTask not implemented")
+}
+func (UnimplementedGoLoadServiceServer) CloneDownloadTask(context.Context, *CloneDownloadTaskRequest) (*CloneDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneDownloadTask not implemented")
+}
+func (UnimplementedGoLoadServiceServer) BulkDeleteDownloadTasks(context.Context, *BulkDeleteDownloadTasksRequest) (*BulkDeleteDownloadTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkDeleteDownloadTasks not implemented")
+}
+func (UnimplementedGoLoadServiceServer) BulkRetryDownloadTasks(context.Context, *BulkRetryDownloadTasksRequest) (*BulkRetryDownloadTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkRetryDownloadTasks not implemented")
+}
+func (UnimplementedGoLoadServiceServer) BulkCancelDownloadTasks(context.Context, *BulkCancelDownloadTasksRequest) (*BulkCancelDownloadTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkCancelDownloadTasks not implemented")
+}
+func (UnimplementedGoLoadServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedGoLoadServiceServer) GetWebhookList(context.Context, *GetWebhookListRequest) (*GetWebhookListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookList not implemented")
+}
+func (UnimplementedGoLoadServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedGoLoadServiceServer) TestWebhook(context.Context, *TestWebhookRequest) (*TestWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestWebhook not implemented")
+}
+func (UnimplementedGoLoadServiceServer) GetWebhookDeliveryList(context.Context, *GetWebhookDeliveryListRequest) (*GetWebhookDeliveryListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveryList not implemented")
+}
+func (UnimplementedGoLoadServiceServer) CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedGoLoadServiceServer) RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (UnimplementedGoLoadServiceServer) CreateShareLink(context.Context, *CreateShareLinkRequest) (*CreateShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShareLink not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ListShareLinks(context.Context, *ListShareLinksRequest) (*ListShareLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShareLinks not implemented")
+}
+func (UnimplementedGoLoadServiceServer) RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShareLink not implemented")
+}
+func (UnimplementedGoLoadServiceServer) CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*CreateWorkspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkspace not implemented")
+}
+func (UnimplementedGoLoadServiceServer) GetWorkspaceList(context.Context, *GetWorkspaceListRequest) (*GetWorkspaceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceList not implemented")
+}
+func (UnimplementedGoLoadServiceServer) DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*DeleteWorkspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkspace not implemented")
+}
+func (UnimplementedGoLoadServiceServer) GetWorkspaceMemberList(context.Context, *GetWorkspaceMemberListRequest) (*GetWorkspaceMemberListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceMemberList not implemented")
+}
+func (UnimplementedGoLoadServiceServer) AddWorkspaceMember(context.Context, *AddWorkspaceMemberRequest) (*AddWorkspaceMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWorkspaceMember not implemented")
+}
+func (UnimplementedGoLoadServiceServer) UpdateWorkspaceMember(context.Context, *UpdateWorkspaceMemberRequest) (*UpdateWorkspaceMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkspaceMember not implemented")
+}
+func (UnimplementedGoLoadServiceServer) RemoveWorkspaceMember(context.Context, *RemoveWorkspaceMemberRequest) (*RemoveWorkspaceMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWorkspaceMember not implemented")
+}
+func (UnimplementedGoLoadServiceServer) mustEmbedUnimplementedGoLoadServiceServer() {}
+
+// UnsafeGoLoadServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GoLoadServiceServer will
+// result in compilation errors.
+type UnsafeGoLoadServiceServer interface {
+	mustEmbedUnimplementedGoLoadServiceServer()
+}
+
+func RegisterGoLoadServiceServer(s grpc.ServiceRegistrar, srv GoLoadServiceServer) {
+	s.RegisterService(&GoLoadService_ServiceDesc, srv)
+}
+
+func _GoLoadService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_CreateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).CreateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_CreateSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).CreateSession(ctx, req.(*CreateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_RefreshSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).RefreshSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_RefreshSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).RefreshSession(ctx, req.(*RefreshSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_DeleteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).DeleteSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_DeleteSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).DeleteSession(ctx, req.(*DeleteSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_DeleteAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAllSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).DeleteAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_DeleteAllSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).DeleteAllSessions(ctx, req.(*DeleteAllSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_DeleteAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_EnrollTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).EnrollTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_EnrollTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).EnrollTwoFactor(ctx, req.(*EnrollTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_ConfirmTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).ConfirmTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_ConfirmTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).ConfirmTwoFactor(ctx, req.(*ConfirmTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_DisableTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).DisableTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_DisableTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).DisableTwoFactor(ctx, req.(*DisableTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateRecoveryCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_CreateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).CreateDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_CreateDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).CreateDownloadTask(ctx, req.(*CreateDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_GetDownloadTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDownloadTaskListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).GetDownloadTaskList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_GetDownloadTaskList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).GetDownloadTaskList(ctx, req.(*GetDownloadTaskListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_UpdateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).UpdateDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_UpdateDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).UpdateDownloadTask(ctx, req.(*UpdateDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_DeleteDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).DeleteDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_DeleteDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).DeleteDownloadTask(ctx, req.(*DeleteDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_GetDownloadTaskFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetDownloadTaskFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoLoadServiceServer).GetDownloadTaskFile(m, &goLoadServiceGetDownloadTaskFileServer{ServerStream: stream})
+}
+
+type GoLoadService_GetDownloadTaskFileServer interface {
+	Send(*GetDownloadTaskFileResponse) error
+	grpc.ServerStream
+}
+
+type goLoadServiceGetDownloadTaskFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *goLoadServiceGetDownloadTaskFileServer) Send(m *GetDownloadTaskFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoLoadService_GetDownloadTaskHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDownloadTaskHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).GetDownloadTaskHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_GetDownloadTaskHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).GetDownloadTaskHistory(ctx, req.(*GetDownloadTaskHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_RetryDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).RetryDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_RetryDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).RetryDownloadTask(ctx, req.(*RetryDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_CloneDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).CloneDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_CloneDownloadTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).CloneDownloadTask(ctx, req.(*CloneDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_BulkDeleteDownloadTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkDeleteDownloadTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).BulkDeleteDownloadTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_BulkDeleteDownloadTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).BulkDeleteDownloadTasks(ctx, req.(*BulkDeleteDownloadTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_BulkRetryDownloadTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkRetryDownloadTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).BulkRetryDownloadTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_BulkRetryDownloadTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).BulkRetryDownloadTasks(ctx, req.(*BulkRetryDownloadTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_BulkCancelDownloadTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkCancelDownloadTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).BulkCancelDownloadTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_BulkCancelDownloadTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).BulkCancelDownloadTasks(ctx, req.(*BulkCancelDownloadTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_GetWebhookList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).GetWebhookList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_GetWebhookList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).GetWebhookList(ctx, req.(*GetWebhookListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_TestWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).TestWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_TestWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).TestWebhook(ctx, req.(*TestWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_GetWebhookDeliveryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookDeliveryListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).GetWebhookDeliveryList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_GetWebhookDeliveryList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).GetWebhookDeliveryList(ctx, req.(*GetWebhookDeliveryListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_CreateApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_ListApiKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *goLoadServiceClient) DeleteDownloadTask(ctx context.Context, in *DeleteDownloadTaskRequest, opts ...grpc.CallOption) (*DeleteDownloadTaskResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteDownloadTaskResponse)
-	err := c.cc.Invoke(ctx, GoLoadService_DeleteDownloadTask_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _GoLoadService_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_RevokeApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *goLoadServiceClient) GetDownloadTaskFile(ctx context.Context, in *GetDownloadTaskFileRequest, opts ...grpc.CallOption) (GoLoadService_GetDownloadTaskFileClient, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &GoLoadService_ServiceDesc.Streams[0], GoLoadService_GetDownloadTaskFile_FullMethodName, cOpts...)
-	if err != nil {
+func _GoLoadService_CreateShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShareLinkRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &goLoadServiceGetDownloadTaskFileClient{ClientStream: stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).CreateShareLink(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_CreateShareLink_FullMethodName,
 	}
-	return x, nil
-}
-
-type GoLoadService_GetDownloadTaskFileClient interface {
-	Recv() (*GetDownloadTaskFileResponse, error)
-	grpc.ClientStream
-}
-
-type goLoadServiceGetDownloadTaskFileClient struct {
-	grpc.ClientStream
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).CreateShareLink(ctx, req.(*CreateShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *goLoadServiceGetDownloadTaskFileClient) Recv() (*GetDownloadTaskFileResponse, error) {
-	m := new(GetDownloadTaskFileResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _GoLoadService_ListShareLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShareLinksRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
-}
-
-// GoLoadServiceServer is the server API for GoLoadService service.
-// All implementations must embed UnimplementedGoLoadServiceServer
-// for forward compatibility
-type GoLoadServiceServer interface {
-	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
-	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
-	CreateDownloadTask(context.Context, *CreateDownloadTaskRequest) (*CreateDownloadTaskResponse, error)
-	GetDownloadTaskList(context.Context, *GetDownloadTaskListRequest) (*GetDownloadTaskListResponse, error)
-	UpdateDownloadTask(context.Context, *UpdateDownloadTaskRequest) (*UpdateDownloadTaskResponse, error)
-	DeleteDownloadTask(context.Context, *DeleteDownloadTaskRequest) (*DeleteDownloadTaskResponse, error)
-	GetDownloadTaskFile(*GetDownloadTaskFileRequest, GoLoadService_GetDownloadTaskFileServer) error
-	mustEmbedUnimplementedGoLoadServiceServer()
-}
-
-// UnimplementedGoLoadServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedGoLoadServiceServer struct {
-}
-
-func (UnimplementedGoLoadServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
-}
-func (UnimplementedGoLoadServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
-}
-func (UnimplementedGoLoadServiceServer) CreateDownloadTask(context.Context, *CreateDownloadTaskRequest) (*CreateDownloadTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDownloadTask not implemented")
-}
-func (UnimplementedGoLoadServiceServer) GetDownloadTaskList(context.Context, *GetDownloadTaskListRequest) (*GetDownloadTaskListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDownloadTaskList not implemented")
-}
-func (UnimplementedGoLoadServiceServer) UpdateDownloadTask(context.Context, *UpdateDownloadTaskRequest) (*UpdateDownloadTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDownloadTask not implemented")
-}
-func (UnimplementedGoLoadServiceServer) DeleteDownloadTask(context.Context, *DeleteDownloadTaskRequest) (*DeleteDownloadTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteDownloadTask not implemented")
-}
-func (UnimplementedGoLoadServiceServer) GetDownloadTaskFile(*GetDownloadTaskFileRequest, GoLoadService_GetDownloadTaskFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetDownloadTaskFile not implemented")
-}
-func (UnimplementedGoLoadServiceServer) mustEmbedUnimplementedGoLoadServiceServer() {}
-
-// UnsafeGoLoadServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GoLoadServiceServer will
-// result in compilation errors.
-type UnsafeGoLoadServiceServer interface {
-	mustEmbedUnimplementedGoLoadServiceServer()
-}
-
-func RegisterGoLoadServiceServer(s grpc.ServiceRegistrar, srv GoLoadServiceServer) {
-	s.RegisterService(&GoLoadService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).ListShareLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_ListShareLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).ListShareLinks(ctx, req.(*ListShareLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccountRequest)
+func _GoLoadService_RevokeShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoLoadServiceServer).CreateAccount(ctx, in)
+		return srv.(GoLoadServiceServer).RevokeShareLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoLoadService_CreateAccount_FullMethodName,
+		FullMethod: GoLoadService_RevokeShareLink_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoLoadServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+		return srv.(GoLoadServiceServer).RevokeShareLink(ctx, req.(*RevokeShareLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSessionRequest)
+func _GoLoadService_CreateWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkspaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoLoadServiceServer).CreateSession(ctx, in)
+		return srv.(GoLoadServiceServer).CreateWorkspace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoLoadService_CreateSession_FullMethodName,
+		FullMethod: GoLoadService_CreateWorkspace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoLoadServiceServer).CreateSession(ctx, req.(*CreateSessionRequest))
+		return srv.(GoLoadServiceServer).CreateWorkspace(ctx, req.(*CreateWorkspaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_CreateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDownloadTaskRequest)
+func _GoLoadService_GetWorkspaceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoLoadServiceServer).CreateDownloadTask(ctx, in)
+		return srv.(GoLoadServiceServer).GetWorkspaceList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoLoadService_CreateDownloadTask_FullMethodName,
+		FullMethod: GoLoadService_GetWorkspaceList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoLoadServiceServer).CreateDownloadTask(ctx, req.(*CreateDownloadTaskRequest))
+		return srv.(GoLoadServiceServer).GetWorkspaceList(ctx, req.(*GetWorkspaceListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_GetDownloadTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDownloadTaskListRequest)
+func _GoLoadService_DeleteWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkspaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoLoadServiceServer).GetDownloadTaskList(ctx, in)
+		return srv.(GoLoadServiceServer).DeleteWorkspace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoLoadService_GetDownloadTaskList_FullMethodName,
+		FullMethod: GoLoadService_DeleteWorkspace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoLoadServiceServer).GetDownloadTaskList(ctx, req.(*GetDownloadTaskListRequest))
+		return srv.(GoLoadServiceServer).DeleteWorkspace(ctx, req.(*DeleteWorkspaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_UpdateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateDownloadTaskRequest)
+func _GoLoadService_GetWorkspaceMemberList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceMemberListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoLoadServiceServer).UpdateDownloadTask(ctx, in)
+		return srv.(GoLoadServiceServer).GetWorkspaceMemberList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoLoadService_UpdateDownloadTask_FullMethodName,
+		FullMethod: GoLoadService_GetWorkspaceMemberList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoLoadServiceServer).UpdateDownloadTask(ctx, req.(*UpdateDownloadTaskRequest))
+		return srv.(GoLoadServiceServer).GetWorkspaceMemberList(ctx, req.(*GetWorkspaceMemberListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_DeleteDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteDownloadTaskRequest)
+func _GoLoadService_AddWorkspaceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWorkspaceMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoLoadServiceServer).DeleteDownloadTask(ctx, in)
+		return srv.(GoLoadServiceServer).AddWorkspaceMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: GoLoadService_DeleteDownloadTask_FullMethodName,
+		FullMethod: GoLoadService_AddWorkspaceMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoLoadServiceServer).DeleteDownloadTask(ctx, req.(*DeleteDownloadTaskRequest))
+		return srv.(GoLoadServiceServer).AddWorkspaceMember(ctx, req.(*AddWorkspaceMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_GetDownloadTaskFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetDownloadTaskFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GoLoadService_UpdateWorkspaceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkspaceMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GoLoadServiceServer).GetDownloadTaskFile(m, &goLoadServiceGetDownloadTaskFileServer{ServerStream: stream})
-}
-
-type GoLoadService_GetDownloadTaskFileServer interface {
-	Send(*GetDownloadTaskFileResponse) error
-	grpc.ServerStream
-}
-
-type goLoadServiceGetDownloadTaskFileServer struct {
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).UpdateWorkspaceMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_UpdateWorkspaceMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).UpdateWorkspaceMember(ctx, req.(*UpdateWorkspaceMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *goLoadServiceGetDownloadTaskFileServer) Send(m *GetDownloadTaskFileResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _GoLoadService_RemoveWorkspaceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWorkspaceMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).RemoveWorkspaceMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoLoadService_RemoveWorkspaceMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).RemoveWorkspaceMember(ctx, req.(*RemoveWorkspaceMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
 // GoLoadService_ServiceDesc is the grpc.ServiceDesc for GoLoadService service.
@@ -338,6 +1494,46 @@ var GoLoadService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSession",
 			Handler:    _GoLoadService_CreateSession_Handler,
 		},
+		{
+			MethodName: "RefreshSession",
+			Handler:    _GoLoadService_RefreshSession_Handler,
+		},
+		{
+			MethodName: "DeleteSession",
+			Handler:    _GoLoadService_DeleteSession_Handler,
+		},
+		{
+			MethodName: "DeleteAllSessions",
+			Handler:    _GoLoadService_DeleteAllSessions_Handler,
+		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _GoLoadService_GetAccount_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _GoLoadService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _GoLoadService_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "EnrollTwoFactor",
+			Handler:    _GoLoadService_EnrollTwoFactor_Handler,
+		},
+		{
+			MethodName: "ConfirmTwoFactor",
+			Handler:    _GoLoadService_ConfirmTwoFactor_Handler,
+		},
+		{
+			MethodName: "DisableTwoFactor",
+			Handler:    _GoLoadService_DisableTwoFactor_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _GoLoadService_RegenerateRecoveryCodes_Handler,
+		},
 		{
 			MethodName: "CreateDownloadTask",
 			Handler:    _GoLoadService_CreateDownloadTask_Handler,
@@ -354,6 +1550,102 @@ var GoLoadService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDownloadTask",
 			Handler:    _GoLoadService_DeleteDownloadTask_Handler,
 		},
+		{
+			MethodName: "GetDownloadTaskHistory",
+			Handler:    _GoLoadService_GetDownloadTaskHistory_Handler,
+		},
+		{
+			MethodName: "RetryDownloadTask",
+			Handler:    _GoLoadService_RetryDownloadTask_Handler,
+		},
+		{
+			MethodName: "CloneDownloadTask",
+			Handler:    _GoLoadService_CloneDownloadTask_Handler,
+		},
+		{
+			MethodName: "BulkDeleteDownloadTasks",
+			Handler:    _GoLoadService_BulkDeleteDownloadTasks_Handler,
+		},
+		{
+			MethodName: "BulkRetryDownloadTasks",
+			Handler:    _GoLoadService_BulkRetryDownloadTasks_Handler,
+		},
+		{
+			MethodName: "BulkCancelDownloadTasks",
+			Handler:    _GoLoadService_BulkCancelDownloadTasks_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _GoLoadService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhookList",
+			Handler:    _GoLoadService_GetWebhookList_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _GoLoadService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "TestWebhook",
+			Handler:    _GoLoadService_TestWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeliveryList",
+			Handler:    _GoLoadService_GetWebhookDeliveryList_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _GoLoadService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _GoLoadService_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _GoLoadService_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "CreateShareLink",
+			Handler:    _GoLoadService_CreateShareLink_Handler,
+		},
+		{
+			MethodName: "ListShareLinks",
+			Handler:    _GoLoadService_ListShareLinks_Handler,
+		},
+		{
+			MethodName: "RevokeShareLink",
+			Handler:    _GoLoadService_RevokeShareLink_Handler,
+		},
+		{
+			MethodName: "CreateWorkspace",
+			Handler:    _GoLoadService_CreateWorkspace_Handler,
+		},
+		{
+			MethodName: "GetWorkspaceList",
+			Handler:    _GoLoadService_GetWorkspaceList_Handler,
+		},
+		{
+			MethodName: "DeleteWorkspace",
+			Handler:    _GoLoadService_DeleteWorkspace_Handler,
+		},
+		{
+			MethodName: "GetWorkspaceMemberList",
+			Handler:    _GoLoadService_GetWorkspaceMemberList_Handler,
+		},
+		{
+			MethodName: "AddWorkspaceMember",
+			Handler:    _GoLoadService_AddWorkspaceMember_Handler,
+		},
+		{
+			MethodName: "UpdateWorkspaceMember",
+			Handler:    _GoLoadService_UpdateWorkspaceMember_Handler,
+		},
+		{
+			MethodName: "RemoveWorkspaceMember",
+			Handler:    _GoLoadService_RemoveWorkspaceMember_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
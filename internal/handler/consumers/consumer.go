@@ -6,6 +6,9 @@ import (
 	"go.uber.org/zap"
 	"goload/internal/dataaccess/mq/consumer"
 	"goload/internal/dataaccess/mq/producer"
+	go_load "goload/internal/generated/downloadClient/v1"
+
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 type Root interface {
@@ -14,17 +17,23 @@ type Root interface {
 
 type root struct {
 	downloadTaskCreatedHandler DownloadTaskCreated
+	downloadTaskWebhookHandler DownloadTaskWebhook
+	deadLetterHandler          DeadLetter
 	mqConsumer                 consumer.Consumer
 	logger                     *zap.Logger
 }
 
 func NewRoot(
 	downloadTaskCreatedHandler DownloadTaskCreated,
+	downloadTaskWebhookHandler DownloadTaskWebhook,
+	deadLetterHandler DeadLetter,
 	mqConsumer consumer.Consumer,
 	logger *zap.Logger,
 ) Root {
 	return &root{
 		downloadTaskCreatedHandler: downloadTaskCreatedHandler,
+		downloadTaskWebhookHandler: downloadTaskWebhookHandler,
+		deadLetterHandler:          deadLetterHandler,
 		mqConsumer:                 mqConsumer,
 		logger:                     logger,
 	}
@@ -43,5 +52,39 @@ func (r root) Start(ctx context.Context) error {
 		},
 	)
 
+	// Webhooks are only called for terminal status changes.
+	webhookQueueNameList := []string{
+		producer.MessageQueueDownloadTaskSucceeded,
+		producer.MessageQueueDownloadTaskFailed,
+		producer.MessageQueueDownloadTaskCancelled,
+	}
+	for _, queueName := range webhookQueueNameList {
+		r.mqConsumer.RegisterHandler(
+			queueName,
+			func(ctx context.Context, queueName string, payload []byte) error {
+				event := &go_load.DownloadTaskLifecycleEvent{}
+				if err := protojson.Unmarshal(payload, event); err != nil {
+					return err
+				}
+
+				return r.downloadTaskWebhookHandler.Handle(ctx, queueName, event)
+			},
+		)
+	}
+
+	for _, queueName := range append([]string{producer.MessageQueueDownloadTaskCreated}, webhookQueueNameList...) {
+		r.mqConsumer.RegisterHandler(
+			producer.GetDeadLetterQueueName(queueName),
+			func(ctx context.Context, queueName string, payload []byte) error {
+				var deadLetter producer.DeadLetter
+				if err := json.Unmarshal(payload, &deadLetter); err != nil {
+					return err
+				}
+
+				return r.deadLetterHandler.Handle(ctx, deadLetter)
+			},
+		)
+	}
+
 	return r.mqConsumer.Start(ctx)
 }
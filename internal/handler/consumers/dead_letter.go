@@ -0,0 +1,36 @@
+package consumers
+
+import (
+	"context"
+	"goload/internal/dataaccess/mq/producer"
+	"goload/internal/logic"
+	"goload/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+type DeadLetter interface {
+	Handle(ctx context.Context, deadLetter producer.DeadLetter) error
+}
+
+type deadLetter struct {
+	deadLetterLogic logic.DeadLetter
+	logger          *zap.Logger
+}
+
+func NewDeadLetter(
+	deadLetterLogic logic.DeadLetter,
+	logger *zap.Logger,
+) DeadLetter {
+	return &deadLetter{
+		deadLetterLogic: deadLetterLogic,
+		logger:          logger,
+	}
+}
+
+func (d deadLetter) Handle(ctx context.Context, deadLetter producer.DeadLetter) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.String("queue_name", deadLetter.QueueName))
+	logger.Warn("dead letter received")
+
+	return d.deadLetterLogic.StoreDeadLetter(ctx, deadLetter)
+}
@@ -3,6 +3,7 @@ package consumers
 import (
 	"context"
 	"goload/internal/dataaccess/mq/producer"
+	"goload/internal/logic"
 	"goload/internal/utils"
 
 	"go.uber.org/zap"
@@ -13,20 +14,27 @@ type DownloadTaskCreated interface {
 }
 
 type downloadTaskCreated struct {
-	logger *zap.Logger
+	downloadTaskLogic logic.DownloadTask
+	logger            *zap.Logger
 }
 
 func NewDownloadTaskCreated(
+	downloadTaskLogic logic.DownloadTask,
 	logger *zap.Logger,
 ) DownloadTaskCreated {
 	return &downloadTaskCreated{
-		logger: logger,
+		downloadTaskLogic: downloadTaskLogic,
+		logger:            logger,
 	}
 }
 
 func (d downloadTaskCreated) Handle(ctx context.Context, event producer.DownloadTaskCreated) error {
+	if event.CorrelationID != "" {
+		ctx = utils.WithCorrelationID(ctx, event.CorrelationID)
+	}
+
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("event", event))
 	logger.Info("download task created event received")
 
-	return nil
+	return d.downloadTaskLogic.ExecuteDownloadTask(ctx, event.ID)
 }
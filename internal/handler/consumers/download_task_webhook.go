@@ -0,0 +1,46 @@
+package consumers
+
+import (
+	"context"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/logic"
+	"goload/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+type DownloadTaskWebhook interface {
+	Handle(ctx context.Context, queueName string, event *go_load.DownloadTaskLifecycleEvent) error
+}
+
+type downloadTaskWebhook struct {
+	webhookLogic logic.Webhook
+	logger       *zap.Logger
+}
+
+func NewDownloadTaskWebhook(
+	webhookLogic logic.Webhook,
+	logger *zap.Logger,
+) DownloadTaskWebhook {
+	return &downloadTaskWebhook{
+		webhookLogic: webhookLogic,
+		logger:       logger,
+	}
+}
+
+func (d downloadTaskWebhook) Handle(
+	ctx context.Context,
+	queueName string,
+	event *go_load.DownloadTaskLifecycleEvent,
+) error {
+	if event.GetCorrelationId() != "" {
+		ctx = utils.WithCorrelationID(ctx, event.GetCorrelationId())
+	}
+
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.String("queue_name", queueName)).
+		With(zap.String("event_id", event.GetEventId()))
+	logger.Info("download task lifecycle event received, scheduling webhook deliveries")
+
+	return d.webhookLogic.EnqueueDownloadTaskWebhookDeliveryList(ctx, queueName, event)
+}
@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"context"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/logic"
+)
+
+type AdminHandler struct {
+	go_load.UnimplementedGoLoadAdminServiceServer
+	adminLogic        logic.Admin
+	downloadTaskLogic logic.DownloadTask
+	workspaceLogic    logic.Workspace
+}
+
+func NewAdminHandler(
+	adminLogic logic.Admin,
+	downloadTaskLogic logic.DownloadTask,
+	workspaceLogic logic.Workspace,
+) go_load.GoLoadAdminServiceServer {
+	return &AdminHandler{
+		adminLogic:        adminLogic,
+		downloadTaskLogic: downloadTaskLogic,
+		workspaceLogic:    workspaceLogic,
+	}
+}
+
+func (a AdminHandler) GetAccountList(
+	ctx context.Context,
+	request *go_load.GetAccountListRequest,
+) (*go_load.GetAccountListResponse, error) {
+	output, err := a.adminLogic.GetAccountList(ctx, logic.GetAccountListParams{
+		Offset: request.GetOffset(),
+		Limit:  request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.GetAccountListResponse{
+		AccountList:       output.AccountList,
+		TotalAccountCount: output.TotalAccountCount,
+	}, nil
+}
+
+func (a AdminHandler) UpdateAccountRole(
+	ctx context.Context,
+	request *go_load.UpdateAccountRoleRequest,
+) (*go_load.UpdateAccountRoleResponse, error) {
+	output, err := a.adminLogic.UpdateAccountRole(ctx, logic.UpdateAccountRoleParams{
+		AccountID: request.GetAccountId(),
+		Role:      request.GetRole(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.UpdateAccountRoleResponse{
+		Account: output.Account,
+	}, nil
+}
+
+func (a AdminHandler) DisableAccount(
+	ctx context.Context,
+	request *go_load.DisableAccountRequest,
+) (*go_load.DisableAccountResponse, error) {
+	output, err := a.adminLogic.DisableAccount(ctx, logic.DisableAccountParams{
+		AccountID: request.GetAccountId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.DisableAccountResponse{
+		Account: output.Account,
+	}, nil
+}
+
+func (a AdminHandler) EnableAccount(
+	ctx context.Context,
+	request *go_load.EnableAccountRequest,
+) (*go_load.EnableAccountResponse, error) {
+	output, err := a.adminLogic.EnableAccount(ctx, logic.EnableAccountParams{
+		AccountID: request.GetAccountId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.EnableAccountResponse{
+		Account: output.Account,
+	}, nil
+}
+
+func (a AdminHandler) UpdateAccountQuota(
+	ctx context.Context,
+	request *go_load.UpdateAccountQuotaRequest,
+) (*go_load.UpdateAccountQuotaResponse, error) {
+	output, err := a.adminLogic.UpdateAccountQuota(ctx, logic.UpdateAccountQuotaParams{
+		AccountID: request.GetAccountId(),
+		Quota:     request.GetQuota(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.UpdateAccountQuotaResponse{
+		Account: output.Account,
+	}, nil
+}
+
+func (a AdminHandler) UpdateWorkspaceQuota(
+	ctx context.Context,
+	request *go_load.UpdateWorkspaceQuotaRequest,
+) (*go_load.UpdateWorkspaceQuotaResponse, error) {
+	output, err := a.workspaceLogic.UpdateWorkspaceQuota(ctx, logic.UpdateWorkspaceQuotaParams{
+		WorkspaceID: request.GetWorkspaceId(),
+		Quota:       request.GetQuota(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.UpdateWorkspaceQuotaResponse{
+		Workspace: output.Workspace,
+	}, nil
+}
+
+func (a AdminHandler) GetAccountDownloadTaskList(
+	ctx context.Context,
+	request *go_load.GetAccountDownloadTaskListRequest,
+) (*go_load.GetAccountDownloadTaskListResponse, error) {
+	output, err := a.downloadTaskLogic.GetAccountDownloadTaskList(ctx, logic.GetAccountDownloadTaskListParams{
+		AccountID: request.GetAccountId(),
+		GetDownloadTaskListParams: logic.GetDownloadTaskListParams{
+			Offset:        request.GetOffset(),
+			Limit:         request.GetLimit(),
+			Filter:        request.GetFilter(),
+			SortKey:       request.GetSortKey(),
+			SortDirection: request.GetSortDirection(),
+			Cursor:        request.GetCursor(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.GetAccountDownloadTaskListResponse{
+		DownloadTaskList:       output.DownloadTaskList,
+		TotalDownloadTaskCount: output.TotalDownloadTaskCount,
+		NextCursor:             output.NextCursor,
+	}, nil
+}
+
+func (a AdminHandler) CancelDownloadTask(
+	ctx context.Context,
+	request *go_load.CancelDownloadTaskRequest,
+) (*go_load.CancelDownloadTaskResponse, error) {
+	output, err := a.downloadTaskLogic.CancelDownloadTask(ctx, logic.CancelDownloadTaskParams{
+		DownloadTaskID: request.GetDownloadTaskId(),
+		Reason:         request.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.CancelDownloadTaskResponse{
+		DownloadTask: output.DownloadTask,
+	}, nil
+}
@@ -0,0 +1,228 @@
+package grpc
+
+import (
+	"context"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/logic"
+	"goload/internal/utils"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type authPolicy int
+
+const (
+	// authPolicyAuthenticated is the policy of every method not listed in methodAuthPolicyMap.
+	authPolicyAuthenticated authPolicy = iota
+	authPolicyPublic
+)
+
+const (
+	authorizationMetadataName = "authorization"
+	bearerAuthScheme          = "Bearer"
+)
+
+var (
+	methodAuthPolicyMap = map[string]authPolicy{
+		go_load.GoLoadService_CreateAccount_FullMethodName:  authPolicyPublic,
+		go_load.GoLoadService_CreateSession_FullMethodName:  authPolicyPublic,
+		go_load.GoLoadService_RefreshSession_FullMethodName: authPolicyPublic,
+	}
+
+	// methodRequiredRoleMap is checked after authentication. Methods of the admin service that are not listed
+	// require logic.RoleAdmin.
+	methodRequiredRoleMap = map[string]logic.Role{
+		go_load.GoLoadAdminService_GetAccountList_FullMethodName:             logic.RoleOperator,
+		go_load.GoLoadAdminService_GetAccountDownloadTaskList_FullMethodName: logic.RoleOperator,
+		go_load.GoLoadAdminService_CancelDownloadTask_FullMethodName:         logic.RoleOperator,
+	}
+	adminServiceMethodPrefix = "/" + go_load.GoLoadAdminService_ServiceDesc.ServiceName + "/"
+
+	// methodRequiredScopeMap lists the only methods an API key can call. Methods of the admin service that are not
+	// listed require logic.APIKeyScopeAdmin, session and API key management is never allowed.
+	methodRequiredScopeMap = map[string]logic.APIKeyScope{
+		go_load.GoLoadService_CreateDownloadTask_FullMethodName:      logic.APIKeyScopeDownloadTaskWrite,
+		go_load.GoLoadService_GetDownloadTaskList_FullMethodName:     logic.APIKeyScopeDownloadTaskRead,
+		go_load.GoLoadService_UpdateDownloadTask_FullMethodName:      logic.APIKeyScopeDownloadTaskWrite,
+		go_load.GoLoadService_DeleteDownloadTask_FullMethodName:      logic.APIKeyScopeDownloadTaskWrite,
+		go_load.GoLoadService_GetDownloadTaskFile_FullMethodName:     logic.APIKeyScopeDownloadTaskRead,
+		go_load.GoLoadService_GetDownloadTaskHistory_FullMethodName:  logic.APIKeyScopeDownloadTaskRead,
+		go_load.GoLoadService_RetryDownloadTask_FullMethodName:       logic.APIKeyScopeDownloadTaskWrite,
+		go_load.GoLoadService_CloneDownloadTask_FullMethodName:       logic.APIKeyScopeDownloadTaskWrite,
+		go_load.GoLoadService_BulkDeleteDownloadTasks_FullMethodName: logic.APIKeyScopeDownloadTaskWrite,
+		go_load.GoLoadService_BulkRetryDownloadTasks_FullMethodName:  logic.APIKeyScopeDownloadTaskWrite,
+		go_load.GoLoadService_BulkCancelDownloadTasks_FullMethodName: logic.APIKeyScopeDownloadTaskWrite,
+		go_load.GoLoadService_CreateWebhook_FullMethodName:           logic.APIKeyScopeWebhookWrite,
+		go_load.GoLoadService_GetWebhookList_FullMethodName:          logic.APIKeyScopeWebhookRead,
+		go_load.GoLoadService_DeleteWebhook_FullMethodName:           logic.APIKeyScopeWebhookWrite,
+		go_load.GoLoadService_TestWebhook_FullMethodName:             logic.APIKeyScopeWebhookWrite,
+		go_load.GoLoadService_GetWebhookDeliveryList_FullMethodName:  logic.APIKeyScopeWebhookRead,
+		go_load.GoLoadService_CreateShareLink_FullMethodName:         logic.APIKeyScopeDownloadTaskWrite,
+		go_load.GoLoadService_ListShareLinks_FullMethodName:          logic.APIKeyScopeDownloadTaskRead,
+		go_load.GoLoadService_RevokeShareLink_FullMethodName:         logic.APIKeyScopeDownloadTaskWrite,
+		go_load.GoLoadService_GetWorkspaceList_FullMethodName:        logic.APIKeyScopeDownloadTaskRead,
+		go_load.GoLoadService_GetWorkspaceMemberList_FullMethodName:  logic.APIKeyScopeDownloadTaskRead,
+	}
+
+	errMissingAuthToken = status.Error(codes.Unauthenticated, "missing auth token")
+	errPermissionDenied = status.Error(codes.PermissionDenied, "permission denied")
+)
+
+type authInterceptor struct {
+	tokenLogic  logic.Token
+	apiKeyLogic logic.APIKey
+	logger      *zap.Logger
+}
+
+// getAuthTokenMetadata prefers the standard "authorization: Bearer <token>" metadata, which is also where the gateway
+// puts the HTTP Authorization header, over the auth token metadata.
+func getAuthTokenMetadata(ctx context.Context) string {
+	metadata, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	for _, value := range metadata.Get(authorizationMetadataName) {
+		scheme, token, found := strings.Cut(value, " ")
+		if found && strings.EqualFold(scheme, bearerAuthScheme) && token != "" {
+			return strings.TrimSpace(token)
+		}
+	}
+
+	metadataValues := metadata.Get(AuthTokenMetadataName)
+	if len(metadataValues) == 0 {
+		return ""
+	}
+
+	return metadataValues[0]
+}
+
+func getMethodRequiredRole(fullMethod string) (logic.Role, bool) {
+	if role, ok := methodRequiredRoleMap[fullMethod]; ok {
+		return role, true
+	}
+
+	if strings.HasPrefix(fullMethod, adminServiceMethodPrefix) {
+		return logic.RoleAdmin, true
+	}
+
+	return "", false
+}
+
+func getMethodRequiredScope(fullMethod string) (logic.APIKeyScope, bool) {
+	if scope, ok := methodRequiredScopeMap[fullMethod]; ok {
+		return scope, true
+	}
+
+	if strings.HasPrefix(fullMethod, adminServiceMethodPrefix) {
+		return logic.APIKeyScopeAdmin, true
+	}
+
+	return "", false
+}
+
+func (a authInterceptor) getPrincipal(ctx context.Context, fullMethod string, token string) (logic.Principal, error) {
+	if !logic.IsAPIKey(token) {
+		return a.tokenLogic.Authenticate(ctx, token)
+	}
+
+	principal, err := a.apiKeyLogic.Authenticate(ctx, token)
+	if err != nil {
+		return logic.Principal{}, err
+	}
+
+	if scope, ok := getMethodRequiredScope(fullMethod); !ok || !principal.HasScope(scope) {
+		utils.LoggerWithContext(ctx, a.logger).
+			With(zap.String("method", fullMethod)).
+			With(zap.Uint64("api_key_id", principal.APIKeyID)).
+			Warn("api key is missing the required scope")
+		return logic.Principal{}, errPermissionDenied
+	}
+
+	return principal, nil
+}
+
+// authenticate validates the auth token or API key once per call, checks the role the method requires and puts the
+// principal into the context.
+func (a authInterceptor) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	if methodAuthPolicyMap[fullMethod] == authPolicyPublic {
+		return ctx, nil
+	}
+
+	token := getAuthTokenMetadata(ctx)
+	if token == "" {
+		utils.LoggerWithContext(ctx, a.logger).With(zap.String("method", fullMethod)).Info("missing auth token")
+		return nil, errMissingAuthToken
+	}
+
+	principal, err := a.getPrincipal(ctx, fullMethod, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if role, ok := getMethodRequiredRole(fullMethod); ok && !principal.HasRole(role) {
+		utils.LoggerWithContext(ctx, a.logger).
+			With(zap.String("method", fullMethod)).
+			With(zap.Uint64("account_id", principal.AccountID)).
+			Warn("permission denied")
+		return nil, errPermissionDenied
+	}
+
+	return logic.WithPrincipal(ctx, principal), nil
+}
+
+func AuthUnaryServerInterceptor(
+	tokenLogic logic.Token,
+	apiKeyLogic logic.APIKey,
+	logger *zap.Logger,
+) grpc.UnaryServerInterceptor {
+	interceptor := authInterceptor{tokenLogic: tokenLogic, apiKeyLogic: apiKeyLogic, logger: logger}
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		ctx, err := interceptor.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (a authServerStream) Context() context.Context {
+	return a.ctx
+}
+
+func AuthStreamServerInterceptor(
+	tokenLogic logic.Token,
+	apiKeyLogic logic.APIKey,
+	logger *zap.Logger,
+) grpc.StreamServerInterceptor {
+	interceptor := authInterceptor{tokenLogic: tokenLogic, apiKeyLogic: apiKeyLogic, logger: logger}
+	return func(
+		srv any,
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := interceptor.authenticate(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, authServerStream{ServerStream: stream, ctx: ctx})
+	}
+}
@@ -0,0 +1,272 @@
+package grpc
+
+import (
+	"context"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/logic"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// mockTokenLogic accepts the name of a role as the auth token of an account with that role.
+type mockTokenLogic struct {
+	logic.Token
+}
+
+func (mockTokenLogic) Authenticate(_ context.Context, token string) (logic.Principal, error) {
+	switch role := logic.Role(token); role {
+	case logic.RoleUser, logic.RoleOperator, logic.RoleAdmin:
+		return logic.Principal{AccountID: 1, SessionID: 1, RoleList: []logic.Role{role}}, nil
+	default:
+		return logic.Principal{}, status.Error(codes.Unauthenticated, "invalid token")
+	}
+}
+
+// mockAPIKeyLogic accepts "gl_<scope>" as an API key of an admin account limited to that scope.
+type mockAPIKeyLogic struct {
+	logic.APIKey
+}
+
+func (mockAPIKeyLogic) Authenticate(_ context.Context, key string) (logic.Principal, error) {
+	return logic.Principal{
+		AccountID: 1,
+		RoleList:  []logic.Role{logic.RoleAdmin},
+		APIKeyID:  1,
+		ScopeList: []logic.APIKeyScope{logic.APIKeyScope(key[len("gl_"):])},
+	}, nil
+}
+
+func newTestAuthInterceptor() authInterceptor {
+	return authInterceptor{tokenLogic: mockTokenLogic{}, apiKeyLogic: mockAPIKeyLogic{}, logger: zap.NewNop()}
+}
+
+func withTestAuthToken(token string) context.Context {
+	if token == "" {
+		return context.Background()
+	}
+
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		authorizationMetadataName, bearerAuthScheme+" "+token))
+}
+
+func TestAuthenticateRequiredRole(t *testing.T) {
+	testCaseList := []struct {
+		name            string
+		method          string
+		token           string
+		expectedErrCode codes.Code
+	}{
+		{name: "public method without token", method: go_load.GoLoadService_CreateSession_FullMethodName},
+		{
+			name: "method without token", method: go_load.GoLoadService_GetDownloadTaskList_FullMethodName,
+			expectedErrCode: codes.Unauthenticated,
+		},
+		{
+			name: "method with invalid token", method: go_load.GoLoadService_GetDownloadTaskList_FullMethodName,
+			token: "invalid", expectedErrCode: codes.Unauthenticated,
+		},
+		{
+			name: "user calls user method", method: go_load.GoLoadService_GetDownloadTaskList_FullMethodName,
+			token: string(logic.RoleUser),
+		},
+		{
+			name: "user calls operator method", method: go_load.GoLoadAdminService_GetAccountList_FullMethodName,
+			token: string(logic.RoleUser), expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name:   "operator calls operator method",
+			method: go_load.GoLoadAdminService_CancelDownloadTask_FullMethodName,
+			token:  string(logic.RoleOperator),
+		},
+		{
+			name: "operator calls admin method", method: go_load.GoLoadAdminService_UpdateAccountRole_FullMethodName,
+			token: string(logic.RoleOperator), expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name: "operator calls unlisted admin method", method: "/go_load.v1.GoLoadAdminService/NewMethod",
+			token: string(logic.RoleOperator), expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name: "admin calls admin method", method: go_load.GoLoadAdminService_DisableAccount_FullMethodName,
+			token: string(logic.RoleAdmin),
+		},
+		{
+			name: "admin calls operator method", method: go_load.GoLoadAdminService_GetAccountList_FullMethodName,
+			token: string(logic.RoleAdmin),
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, err := newTestAuthInterceptor().authenticate(withTestAuthToken(testCase.token), testCase.method)
+			if status.Code(err) != testCase.expectedErrCode {
+				t.Fatalf("expected error with code %s, got %v", testCase.expectedErrCode, err)
+			}
+
+			if err != nil || testCase.token == "" {
+				return
+			}
+
+			if _, ok := logic.GetPrincipal(ctx); !ok {
+				t.Fatal("expected the principal in the context")
+			}
+		})
+	}
+}
+
+func TestAuthenticateAPIKeyScope(t *testing.T) {
+	testCaseList := []struct {
+		name            string
+		method          string
+		scope           logic.APIKeyScope
+		expectedErrCode codes.Code
+	}{
+		{
+			name: "read scope reads", method: go_load.GoLoadService_GetDownloadTaskList_FullMethodName,
+			scope: logic.APIKeyScopeDownloadTaskRead,
+		},
+		{
+			name: "read scope cannot write", method: go_load.GoLoadService_CreateDownloadTask_FullMethodName,
+			scope: logic.APIKeyScopeDownloadTaskRead, expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name: "write scope writes", method: go_load.GoLoadService_CreateDownloadTask_FullMethodName,
+			scope: logic.APIKeyScopeDownloadTaskWrite,
+		},
+		{
+			name:   "download task scope cannot manage webhooks",
+			method: go_load.GoLoadService_CreateWebhook_FullMethodName,
+			scope:  logic.APIKeyScopeDownloadTaskWrite, expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name: "admin scope cannot create api keys", method: go_load.GoLoadService_CreateApiKey_FullMethodName,
+			scope: logic.APIKeyScopeAdmin, expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name: "admin scope cannot delete sessions", method: go_load.GoLoadService_DeleteAllSessions_FullMethodName,
+			scope: logic.APIKeyScopeAdmin, expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name: "admin scope calls admin method", method: go_load.GoLoadAdminService_DisableAccount_FullMethodName,
+			scope: logic.APIKeyScopeAdmin,
+		},
+		{
+			name:   "read scope cannot call admin method",
+			method: go_load.GoLoadAdminService_GetAccountList_FullMethodName,
+			scope:  logic.APIKeyScopeDownloadTaskRead, expectedErrCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := newTestAuthInterceptor().authenticate(withTestAuthToken("gl_"+string(testCase.scope)),
+				testCase.method)
+			if status.Code(err) != testCase.expectedErrCode {
+				t.Fatalf("expected error with code %s, got %v", testCase.expectedErrCode, err)
+			}
+		})
+	}
+}
+
+func TestGetAuthTokenMetadata(t *testing.T) {
+	testCaseList := []struct {
+		name          string
+		metadata      metadata.MD
+		expectedToken string
+	}{
+		{name: "no metadata"},
+		{name: "bearer token", metadata: metadata.Pairs(authorizationMetadataName, "Bearer token"), expectedToken: "token"},
+		{
+			name: "bearer scheme in lower case", metadata: metadata.Pairs(authorizationMetadataName, "bearer token"),
+			expectedToken: "token",
+		},
+		{name: "other scheme", metadata: metadata.Pairs(authorizationMetadataName, "Basic dXNlcjpwYXNz")},
+		{name: "bearer without token", metadata: metadata.Pairs(authorizationMetadataName, "Bearer ")},
+		{name: "auth token metadata", metadata: metadata.Pairs(AuthTokenMetadataName, "token"), expectedToken: "token"},
+		{
+			name: "bearer token over auth token metadata",
+			metadata: metadata.Pairs(
+				AuthTokenMetadataName, "other", authorizationMetadataName, "Bearer token"),
+			expectedToken: "token",
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			if testCase.metadata != nil {
+				ctx = metadata.NewIncomingContext(ctx, testCase.metadata)
+			}
+
+			if token := getAuthTokenMetadata(ctx); token != testCase.expectedToken {
+				t.Fatalf("expected token %q, got %q", testCase.expectedToken, token)
+			}
+		})
+	}
+}
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (m mockServerStream) Context() context.Context {
+	return m.ctx
+}
+
+func TestAuthServerInterceptorPassesPrincipal(t *testing.T) {
+	testCaseList := []struct {
+		name            string
+		token           string
+		expectedErrCode codes.Code
+	}{
+		{name: "authenticated", token: string(logic.RoleUser)},
+		{name: "unauthenticated", expectedErrCode: codes.Unauthenticated},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			var principalList []logic.Principal
+			getPrincipal := func(ctx context.Context) {
+				if principal, ok := logic.GetPrincipal(ctx); ok {
+					principalList = append(principalList, principal)
+				}
+			}
+
+			method := go_load.GoLoadService_GetDownloadTaskList_FullMethodName
+			_, unaryErr := AuthUnaryServerInterceptor(mockTokenLogic{}, mockAPIKeyLogic{}, zap.NewNop())(
+				withTestAuthToken(testCase.token), nil, &grpc.UnaryServerInfo{FullMethod: method},
+				func(ctx context.Context, req any) (any, error) {
+					getPrincipal(ctx)
+					return nil, nil
+				})
+			streamErr := AuthStreamServerInterceptor(mockTokenLogic{}, mockAPIKeyLogic{}, zap.NewNop())(
+				nil, mockServerStream{ctx: withTestAuthToken(testCase.token)}, &grpc.StreamServerInfo{FullMethod: method},
+				func(srv any, stream grpc.ServerStream) error {
+					getPrincipal(stream.Context())
+					return nil
+				})
+
+			for _, err := range []error{unaryErr, streamErr} {
+				if status.Code(err) != testCase.expectedErrCode {
+					t.Fatalf("expected error with code %s, got %v", testCase.expectedErrCode, err)
+				}
+			}
+
+			expectedPrincipalCount := 2
+			if testCase.expectedErrCode != codes.OK {
+				expectedPrincipalCount = 0
+			}
+
+			if len(principalList) != expectedPrincipalCount {
+				t.Fatalf("expected the handlers to get the principal %d times, got %+v", expectedPrincipalCount,
+					principalList)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	forwardedForMetadataName = "x-forwarded-for"
+)
+
+// getClientIP returns the IP of the peer. The HTTP gateway calls in over loopback and appends the IP of its own
+// peer to x-forwarded-for, so for a loopback peer the last forwarded IP is used instead. Earlier entries are set by
+// the client and are not trusted.
+func getClientIP(ctx context.Context) string {
+	peer, ok := peer.FromContext(ctx)
+	if !ok || peer.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(peer.Addr.String())
+	if err != nil {
+		return ""
+	}
+
+	peerIP, err := netip.ParseAddr(host)
+	if err != nil {
+		return ""
+	}
+
+	if !peerIP.IsLoopback() {
+		return peerIP.String()
+	}
+
+	metadata, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return peerIP.String()
+	}
+
+	forwardedForValues := metadata.Get(forwardedForMetadataName)
+	if len(forwardedForValues) == 0 {
+		return peerIP.String()
+	}
+
+	forwardedForList := strings.Split(forwardedForValues[len(forwardedForValues)-1], ",")
+	forwardedIP, err := netip.ParseAddr(strings.TrimSpace(forwardedForList[len(forwardedForList)-1]))
+	if err != nil {
+		return peerIP.String()
+	}
+
+	return forwardedIP.String()
+}
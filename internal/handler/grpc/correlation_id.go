@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+	"goload/internal/utils"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	maxCorrelationIDLength = 128
+)
+
+// getCorrelationID reuses the correlation ID sent by the client if there is a sane one, so that a request can be
+// traced across services, and generates a new one otherwise.
+func getCorrelationID(ctx context.Context) string {
+	metadata, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		correlationIDValues := metadata.Get(utils.CorrelationIDMetadataKey)
+		if len(correlationIDValues) > 0 &&
+			correlationIDValues[0] != "" &&
+			len(correlationIDValues[0]) <= maxCorrelationIDLength {
+			return correlationIDValues[0]
+		}
+	}
+
+	return utils.NewID()
+}
+
+func CorrelationIDUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		correlationID := getCorrelationID(ctx)
+		// the correlation ID is only informational for the client, failing to send it back is not an error
+		_ = grpc.SetHeader(ctx, metadata.Pairs(utils.CorrelationIDMetadataKey, correlationID))
+		return handler(utils.WithCorrelationID(ctx, correlationID), req)
+	}
+}
+
+type correlationIDServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (c correlationIDServerStream) Context() context.Context {
+	return c.ctx
+}
+
+func CorrelationIDStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		stream grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		correlationID := getCorrelationID(stream.Context())
+		return handler(srv, correlationIDServerStream{
+			ServerStream: stream,
+			ctx:          utils.WithCorrelationID(stream.Context(), correlationID),
+		})
+	}
+}
@@ -11,31 +11,45 @@ import (
 const (
 	//nolint:gosec // This is just to specify the metadata name
 	AuthTokenMetadataName = "GOLOAD_AUTH"
+	//nolint:gosec // This is just to specify the metadata name
+	RefreshTokenMetadataName = "GOLOAD_REFRESH"
 )
 
 type Handler struct {
 	go_load.UnimplementedGoLoadServiceServer
 	accountLogic      logic.Account
 	downloadTaskLogic logic.DownloadTask
+	webhookLogic      logic.Webhook
+	apiKeyLogic       logic.APIKey
+	shareLinkLogic    logic.ShareLink
+	workspaceLogic    logic.Workspace
 }
 
 func NewHandler(
 	accountLogic logic.Account,
 	downloadTaskLogic logic.DownloadTask,
+	webhookLogic logic.Webhook,
+	apiKeyLogic logic.APIKey,
+	shareLinkLogic logic.ShareLink,
+	workspaceLogic logic.Workspace,
 ) go_load.GoLoadServiceServer {
 	return &Handler{
 		accountLogic:      accountLogic,
 		downloadTaskLogic: downloadTaskLogic,
+		webhookLogic:      webhookLogic,
+		apiKeyLogic:       apiKeyLogic,
+		shareLinkLogic:    shareLinkLogic,
+		workspaceLogic:    workspaceLogic,
 	}
 }
 
-func (a Handler) getAuthTokenMetadata(ctx context.Context) string {
+func (a Handler) getMetadata(ctx context.Context, metadataName string) string {
 	metadata, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return ""
 	}
 
-	metadataValues := metadata.Get(AuthTokenMetadataName)
+	metadataValues := metadata.Get(metadataName)
 	if len(metadataValues) == 0 {
 		return ""
 	}
@@ -43,6 +57,15 @@ func (a Handler) getAuthTokenMetadata(ctx context.Context) string {
 	return metadataValues[0]
 }
 
+// setSessionTokenHeader sends both tokens back as header metadata, the gateway turns them into cookies. Empty
+// tokens make the gateway delete the cookies.
+func (a Handler) setSessionTokenHeader(ctx context.Context, token, refreshToken string) error {
+	return grpc.SetHeader(ctx, metadata.Pairs(
+		AuthTokenMetadataName, token,
+		RefreshTokenMetadataName, refreshToken,
+	))
+}
+
 func (a Handler) CreateAccount(
 	ctx context.Context,
 	request *go_load.CreateAccountRequest,
@@ -60,21 +83,157 @@ func (a Handler) CreateAccount(
 	}, nil
 }
 
+func (a Handler) GetAccount(
+	ctx context.Context,
+	_ *go_load.GetAccountRequest,
+) (*go_load.GetAccountResponse, error) {
+	output, err := a.accountLogic.GetAccount(ctx, logic.GetAccountParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.GetAccountResponse{
+		Account: output.Account,
+	}, nil
+}
+
+func (a Handler) ChangePassword(
+	ctx context.Context,
+	request *go_load.ChangePasswordRequest,
+) (*go_load.ChangePasswordResponse, error) {
+	output, err := a.accountLogic.ChangePassword(ctx, logic.ChangePasswordParams{
+		OldPassword: request.GetOldPassword(),
+		NewPassword: request.GetNewPassword(),
+		ClientIP:    getClientIP(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.setSessionTokenHeader(ctx, output.Token, output.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ChangePasswordResponse{
+		Account:             output.Account,
+		RevokedSessionCount: output.RevokedSessionCount,
+	}, nil
+}
+
+func (a Handler) DeleteAccount(
+	ctx context.Context,
+	request *go_load.DeleteAccountRequest,
+) (*go_load.DeleteAccountResponse, error) {
+	output, err := a.accountLogic.DeleteAccount(ctx, logic.DeleteAccountParams{
+		Password:      request.GetPassword(),
+		TwoFactorCode: request.GetTwoFactorCode(),
+		ClientIP:      getClientIP(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = a.setSessionTokenHeader(ctx, "", ""); err != nil {
+		return nil, err
+	}
+
+	return &go_load.DeleteAccountResponse{
+		DeletedDownloadTaskCount: output.DeletedDownloadTaskCount,
+	}, nil
+}
+
+func (a Handler) EnrollTwoFactor(
+	ctx context.Context,
+	request *go_load.EnrollTwoFactorRequest,
+) (*go_load.EnrollTwoFactorResponse, error) {
+	output, err := a.accountLogic.EnrollTwoFactor(ctx, logic.EnrollTwoFactorParams{
+		Password: request.GetPassword(),
+		ClientIP: getClientIP(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.EnrollTwoFactorResponse{
+		Secret:          output.Secret,
+		ProvisioningUri: output.ProvisioningURI,
+	}, nil
+}
+
+func (a Handler) ConfirmTwoFactor(
+	ctx context.Context,
+	request *go_load.ConfirmTwoFactorRequest,
+) (*go_load.ConfirmTwoFactorResponse, error) {
+	output, err := a.accountLogic.ConfirmTwoFactor(ctx, logic.ConfirmTwoFactorParams{
+		Code: request.GetCode(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ConfirmTwoFactorResponse{
+		RecoveryCodeList: output.RecoveryCodeList,
+	}, nil
+}
+
+func (a Handler) DisableTwoFactor(
+	ctx context.Context,
+	request *go_load.DisableTwoFactorRequest,
+) (*go_load.DisableTwoFactorResponse, error) {
+	err := a.accountLogic.DisableTwoFactor(ctx, logic.DisableTwoFactorParams{
+		Password: request.GetPassword(),
+		Code:     request.GetCode(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.DisableTwoFactorResponse{}, nil
+}
+
+func (a Handler) RegenerateRecoveryCodes(
+	ctx context.Context,
+	request *go_load.RegenerateRecoveryCodesRequest,
+) (*go_load.RegenerateRecoveryCodesResponse, error) {
+	output, err := a.accountLogic.RegenerateRecoveryCodes(ctx, logic.RegenerateRecoveryCodesParams{
+		Code: request.GetCode(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.RegenerateRecoveryCodesResponse{
+		RecoveryCodeList: output.RecoveryCodeList,
+	}, nil
+}
+
 func (a Handler) CreateDownloadTask(
 	ctx context.Context,
 	request *go_load.CreateDownloadTaskRequest,
 ) (*go_load.CreateDownloadTaskResponse, error) {
-	output, err := a.downloadTaskLogic.CreateDownloadTask(ctx, logic.CreateDownloadTaskParams{
-		Token:        a.getAuthTokenMetadata(ctx),
-		DownloadType: request.GetDownloadType(),
-		URL:          request.GetUrl(),
-	})
+	params := logic.CreateDownloadTaskParams{
+		DownloadType:       request.GetDownloadType(),
+		URL:                request.GetUrl(),
+		TagList:            request.GetTagList(),
+		LabelMap:           request.GetLabelMap(),
+		CallbackURL:        request.GetCallbackUrl(),
+		WorkspaceID:        request.GetWorkspaceId(),
+		HTTPRequestOptions: request.GetHttpRequestOptions(),
+	}
+	if request.GetExpiresAt() != nil {
+		expiresAt := request.GetExpiresAt().AsTime()
+		params.ExpiresAt = &expiresAt
+	}
+
+	output, err := a.downloadTaskLogic.CreateDownloadTask(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
 	return &go_load.CreateDownloadTaskResponse{
-		DownloadTask: output.DownloadTask,
+		DownloadTask:   output.DownloadTask,
+		CallbackSecret: output.CallbackSecret,
 	}, nil
 }
 
@@ -83,14 +242,16 @@ func (a Handler) CreateSession(
 	request *go_load.CreateSessionRequest,
 ) (*go_load.CreateSessionResponse, error) {
 	output, err := a.accountLogic.CreateSession(ctx, logic.CreateSessionParams{
-		AccountName: request.GetAccountName(),
-		Password:    request.GetPassword(),
+		AccountName:   request.GetAccountName(),
+		Password:      request.GetPassword(),
+		TwoFactorCode: request.GetTwoFactorCode(),
+		ClientIP:      getClientIP(ctx),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	err = grpc.SetHeader(ctx, metadata.Pairs(AuthTokenMetadataName, output.Token))
+	err = a.setSessionTokenHeader(ctx, output.Token, output.RefreshToken)
 	if err != nil {
 		return nil, err
 	}
@@ -100,12 +261,75 @@ func (a Handler) CreateSession(
 	}, nil
 }
 
+func (a Handler) RefreshSession(
+	ctx context.Context,
+	request *go_load.RefreshSessionRequest,
+) (*go_load.RefreshSessionResponse, error) {
+	refreshToken := request.GetRefreshToken()
+	if refreshToken == "" {
+		refreshToken = a.getMetadata(ctx, RefreshTokenMetadataName)
+	}
+
+	output, err := a.accountLogic.RefreshSession(ctx, logic.RefreshSessionParams{
+		RefreshToken: refreshToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if output.RefreshToken == "" {
+		err = grpc.SetHeader(ctx, metadata.Pairs(AuthTokenMetadataName, output.Token))
+	} else {
+		err = a.setSessionTokenHeader(ctx, output.Token, output.RefreshToken)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.RefreshSessionResponse{
+		Account: output.Account,
+	}, nil
+}
+
+func (a Handler) DeleteSession(
+	ctx context.Context,
+	_ *go_load.DeleteSessionRequest,
+) (*go_load.DeleteSessionResponse, error) {
+	err := a.accountLogic.DeleteSession(ctx, logic.DeleteSessionParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = a.setSessionTokenHeader(ctx, "", ""); err != nil {
+		return nil, err
+	}
+
+	return &go_load.DeleteSessionResponse{}, nil
+}
+
+func (a Handler) DeleteAllSessions(
+	ctx context.Context,
+	_ *go_load.DeleteAllSessionsRequest,
+) (*go_load.DeleteAllSessionsResponse, error) {
+	output, err := a.accountLogic.DeleteAllSessions(ctx, logic.DeleteAllSessionsParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = a.setSessionTokenHeader(ctx, "", ""); err != nil {
+		return nil, err
+	}
+
+	return &go_load.DeleteAllSessionsResponse{
+		RevokedSessionCount: output.RevokedSessionCount,
+	}, nil
+}
+
 func (a Handler) DeleteDownloadTask(
 	ctx context.Context,
 	request *go_load.DeleteDownloadTaskRequest,
 ) (*go_load.DeleteDownloadTaskResponse, error) {
 	if err := a.downloadTaskLogic.DeleteDownloadTask(ctx, logic.DeleteDownloadTaskParams{
-		Token:          a.getAuthTokenMetadata(ctx),
 		DownloadTaskID: request.GetDownloadTaskId(),
 	}); err != nil {
 		return nil, err
@@ -121,14 +345,33 @@ func (a Handler) GetDownloadTaskFile(
 	panic("unimplemented")
 }
 
+func (a Handler) GetDownloadTaskHistory(
+	ctx context.Context,
+	request *go_load.GetDownloadTaskHistoryRequest,
+) (*go_load.GetDownloadTaskHistoryResponse, error) {
+	output, err := a.downloadTaskLogic.GetDownloadTaskHistory(ctx, logic.GetDownloadTaskHistoryParams{
+		DownloadTaskID: request.GetDownloadTaskId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.GetDownloadTaskHistoryResponse{
+		DownloadTaskEventList: output.DownloadTaskEventList,
+	}, nil
+}
+
 func (a Handler) GetDownloadTaskList(
 	ctx context.Context,
 	request *go_load.GetDownloadTaskListRequest,
 ) (*go_load.GetDownloadTaskListResponse, error) {
 	output, err := a.downloadTaskLogic.GetDownloadTaskList(ctx, logic.GetDownloadTaskListParams{
-		Token:  a.getAuthTokenMetadata(ctx),
-		Offset: request.GetOffset(),
-		Limit:  request.GetLimit(),
+		Offset:        request.GetOffset(),
+		Limit:         request.GetLimit(),
+		Filter:        request.GetFilter(),
+		SortKey:       request.GetSortKey(),
+		SortDirection: request.GetSortDirection(),
+		Cursor:        request.GetCursor(),
 	})
 	if err != nil {
 		return nil, err
@@ -137,6 +380,7 @@ func (a Handler) GetDownloadTaskList(
 	return &go_load.GetDownloadTaskListResponse{
 		DownloadTaskList:       output.DownloadTaskList,
 		TotalDownloadTaskCount: output.TotalDownloadTaskCount,
+		NextCursor:             output.NextCursor,
 	}, nil
 }
 
@@ -145,9 +389,10 @@ func (a Handler) UpdateDownloadTask(
 	request *go_load.UpdateDownloadTaskRequest,
 ) (*go_load.UpdateDownloadTaskResponse, error) {
 	output, err := a.downloadTaskLogic.UpdateDownloadTask(ctx, logic.UpdateDownloadTaskParams{
-		Token:          a.getAuthTokenMetadata(ctx),
 		DownloadTaskID: request.GetDownloadTaskId(),
 		URL:            request.GetUrl(),
+		Tags:           request.GetTags(),
+		Labels:         request.GetLabels(),
 	})
 	if err != nil {
 		return nil, err
@@ -157,3 +402,381 @@ func (a Handler) UpdateDownloadTask(
 		DownloadTask: output.DownloadTask,
 	}, nil
 }
+
+func (a Handler) BulkDeleteDownloadTasks(
+	ctx context.Context,
+	request *go_load.BulkDeleteDownloadTasksRequest,
+) (*go_load.BulkDeleteDownloadTasksResponse, error) {
+	output, err := a.downloadTaskLogic.BulkDeleteDownloadTasks(ctx, logic.BulkDownloadTasksParams{
+		DownloadTaskIDList: request.GetDownloadTaskIdList(),
+		Filter:             request.GetFilter(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.BulkDeleteDownloadTasksResponse{
+		ResultList: output.ResultList,
+	}, nil
+}
+
+func (a Handler) BulkRetryDownloadTasks(
+	ctx context.Context,
+	request *go_load.BulkRetryDownloadTasksRequest,
+) (*go_load.BulkRetryDownloadTasksResponse, error) {
+	output, err := a.downloadTaskLogic.BulkRetryDownloadTasks(ctx, logic.BulkDownloadTasksParams{
+		DownloadTaskIDList: request.GetDownloadTaskIdList(),
+		Filter:             request.GetFilter(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.BulkRetryDownloadTasksResponse{
+		ResultList: output.ResultList,
+	}, nil
+}
+
+func (a Handler) BulkCancelDownloadTasks(
+	ctx context.Context,
+	request *go_load.BulkCancelDownloadTasksRequest,
+) (*go_load.BulkCancelDownloadTasksResponse, error) {
+	output, err := a.downloadTaskLogic.BulkCancelDownloadTasks(ctx, logic.BulkDownloadTasksParams{
+		DownloadTaskIDList: request.GetDownloadTaskIdList(),
+		Filter:             request.GetFilter(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.BulkCancelDownloadTasksResponse{
+		ResultList: output.ResultList,
+	}, nil
+}
+
+func (a Handler) RetryDownloadTask(
+	ctx context.Context,
+	request *go_load.RetryDownloadTaskRequest,
+) (*go_load.RetryDownloadTaskResponse, error) {
+	output, err := a.downloadTaskLogic.RetryDownloadTask(ctx, logic.RetryDownloadTaskParams{
+		DownloadTaskID: request.GetDownloadTaskId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.RetryDownloadTaskResponse{
+		DownloadTask: output.DownloadTask,
+	}, nil
+}
+
+func (a Handler) CloneDownloadTask(
+	ctx context.Context,
+	request *go_load.CloneDownloadTaskRequest,
+) (*go_load.CloneDownloadTaskResponse, error) {
+	output, err := a.downloadTaskLogic.CloneDownloadTask(ctx, logic.CloneDownloadTaskParams{
+		DownloadTaskID: request.GetDownloadTaskId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.CloneDownloadTaskResponse{
+		DownloadTask: output.DownloadTask,
+	}, nil
+}
+
+func (a Handler) CreateWebhook(
+	ctx context.Context,
+	request *go_load.CreateWebhookRequest,
+) (*go_load.CreateWebhookResponse, error) {
+	output, err := a.webhookLogic.CreateWebhook(ctx, logic.CreateWebhookParams{
+		URL: request.GetUrl(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.CreateWebhookResponse{
+		Webhook: output.Webhook,
+		Secret:  output.Secret,
+	}, nil
+}
+
+func (a Handler) GetWebhookList(
+	ctx context.Context,
+	_ *go_load.GetWebhookListRequest,
+) (*go_load.GetWebhookListResponse, error) {
+	output, err := a.webhookLogic.GetWebhookList(ctx, logic.GetWebhookListParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.GetWebhookListResponse{
+		WebhookList: output.WebhookList,
+	}, nil
+}
+
+func (a Handler) DeleteWebhook(
+	ctx context.Context,
+	request *go_load.DeleteWebhookRequest,
+) (*go_load.DeleteWebhookResponse, error) {
+	err := a.webhookLogic.DeleteWebhook(ctx, logic.DeleteWebhookParams{
+		WebhookID: request.GetWebhookId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.DeleteWebhookResponse{}, nil
+}
+
+func (a Handler) TestWebhook(
+	ctx context.Context,
+	request *go_load.TestWebhookRequest,
+) (*go_load.TestWebhookResponse, error) {
+	output, err := a.webhookLogic.TestWebhook(ctx, logic.TestWebhookParams{
+		WebhookID: request.GetWebhookId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.TestWebhookResponse{
+		WebhookDelivery: output.WebhookDelivery,
+	}, nil
+}
+
+func (a Handler) GetWebhookDeliveryList(
+	ctx context.Context,
+	request *go_load.GetWebhookDeliveryListRequest,
+) (*go_load.GetWebhookDeliveryListResponse, error) {
+	output, err := a.webhookLogic.GetWebhookDeliveryList(ctx, logic.GetWebhookDeliveryListParams{
+		WebhookID:      request.GetWebhookId(),
+		DownloadTaskID: request.GetDownloadTaskId(),
+		Offset:         request.GetOffset(),
+		Limit:          request.GetLimit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.GetWebhookDeliveryListResponse{
+		WebhookDeliveryList: output.WebhookDeliveryList,
+	}, nil
+}
+
+func (a Handler) CreateApiKey(
+	ctx context.Context,
+	request *go_load.CreateApiKeyRequest,
+) (*go_load.CreateApiKeyResponse, error) {
+	params := logic.CreateAPIKeyParams{
+		Name:      request.GetName(),
+		ScopeList: request.GetScopeList(),
+	}
+	if request.GetExpiresAt() != nil {
+		expiresAt := request.GetExpiresAt().AsTime()
+		params.ExpiresAt = &expiresAt
+	}
+
+	output, err := a.apiKeyLogic.CreateAPIKey(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.CreateApiKeyResponse{
+		ApiKey: output.APIKey,
+		Key:    output.Key,
+	}, nil
+}
+
+func (a Handler) ListApiKeys(
+	ctx context.Context,
+	_ *go_load.ListApiKeysRequest,
+) (*go_load.ListApiKeysResponse, error) {
+	output, err := a.apiKeyLogic.ListAPIKeys(ctx, logic.ListAPIKeysParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ListApiKeysResponse{
+		ApiKeyList: output.APIKeyList,
+	}, nil
+}
+
+func (a Handler) RevokeApiKey(
+	ctx context.Context,
+	request *go_load.RevokeApiKeyRequest,
+) (*go_load.RevokeApiKeyResponse, error) {
+	err := a.apiKeyLogic.RevokeAPIKey(ctx, logic.RevokeAPIKeyParams{
+		APIKeyID: request.GetApiKeyId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.RevokeApiKeyResponse{}, nil
+}
+
+func (a Handler) CreateShareLink(
+	ctx context.Context,
+	request *go_load.CreateShareLinkRequest,
+) (*go_load.CreateShareLinkResponse, error) {
+	params := logic.CreateShareLinkParams{
+		DownloadTaskID:   request.GetDownloadTaskId(),
+		Password:         request.GetPassword(),
+		MaxDownloadCount: request.GetMaxDownloadCount(),
+	}
+	if request.GetExpiresAt() != nil {
+		expiresAt := request.GetExpiresAt().AsTime()
+		params.ExpiresAt = &expiresAt
+	}
+
+	output, err := a.shareLinkLogic.CreateShareLink(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.CreateShareLinkResponse{
+		ShareLink: output.ShareLink,
+	}, nil
+}
+
+func (a Handler) ListShareLinks(
+	ctx context.Context,
+	_ *go_load.ListShareLinksRequest,
+) (*go_load.ListShareLinksResponse, error) {
+	output, err := a.shareLinkLogic.ListShareLinks(ctx, logic.ListShareLinksParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ListShareLinksResponse{
+		ShareLinkList: output.ShareLinkList,
+	}, nil
+}
+
+func (a Handler) RevokeShareLink(
+	ctx context.Context,
+	request *go_load.RevokeShareLinkRequest,
+) (*go_load.RevokeShareLinkResponse, error) {
+	err := a.shareLinkLogic.RevokeShareLink(ctx, logic.RevokeShareLinkParams{
+		ShareLinkID: request.GetShareLinkId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.RevokeShareLinkResponse{}, nil
+}
+
+func (a Handler) CreateWorkspace(
+	ctx context.Context,
+	request *go_load.CreateWorkspaceRequest,
+) (*go_load.CreateWorkspaceResponse, error) {
+	output, err := a.workspaceLogic.CreateWorkspace(ctx, logic.CreateWorkspaceParams{
+		DisplayName: request.GetDisplayName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.CreateWorkspaceResponse{
+		Workspace: output.Workspace,
+	}, nil
+}
+
+func (a Handler) GetWorkspaceList(
+	ctx context.Context,
+	_ *go_load.GetWorkspaceListRequest,
+) (*go_load.GetWorkspaceListResponse, error) {
+	output, err := a.workspaceLogic.GetWorkspaceList(ctx, logic.GetWorkspaceListParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.GetWorkspaceListResponse{
+		WorkspaceList: output.WorkspaceList,
+	}, nil
+}
+
+func (a Handler) DeleteWorkspace(
+	ctx context.Context,
+	request *go_load.DeleteWorkspaceRequest,
+) (*go_load.DeleteWorkspaceResponse, error) {
+	err := a.workspaceLogic.DeleteWorkspace(ctx, logic.DeleteWorkspaceParams{
+		WorkspaceID: request.GetWorkspaceId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.DeleteWorkspaceResponse{}, nil
+}
+
+func (a Handler) GetWorkspaceMemberList(
+	ctx context.Context,
+	request *go_load.GetWorkspaceMemberListRequest,
+) (*go_load.GetWorkspaceMemberListResponse, error) {
+	output, err := a.workspaceLogic.GetWorkspaceMemberList(ctx, logic.GetWorkspaceMemberListParams{
+		WorkspaceID: request.GetWorkspaceId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.GetWorkspaceMemberListResponse{
+		WorkspaceMemberList: output.WorkspaceMemberList,
+	}, nil
+}
+
+func (a Handler) AddWorkspaceMember(
+	ctx context.Context,
+	request *go_load.AddWorkspaceMemberRequest,
+) (*go_load.AddWorkspaceMemberResponse, error) {
+	output, err := a.workspaceLogic.AddWorkspaceMember(ctx, logic.AddWorkspaceMemberParams{
+		WorkspaceID: request.GetWorkspaceId(),
+		AccountName: request.GetAccountName(),
+		Role:        request.GetRole(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.AddWorkspaceMemberResponse{
+		WorkspaceMember: output.WorkspaceMember,
+	}, nil
+}
+
+func (a Handler) UpdateWorkspaceMember(
+	ctx context.Context,
+	request *go_load.UpdateWorkspaceMemberRequest,
+) (*go_load.UpdateWorkspaceMemberResponse, error) {
+	output, err := a.workspaceLogic.UpdateWorkspaceMember(ctx, logic.UpdateWorkspaceMemberParams{
+		WorkspaceID: request.GetWorkspaceId(),
+		AccountID:   request.GetAccountId(),
+		Role:        request.GetRole(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.UpdateWorkspaceMemberResponse{
+		WorkspaceMember: output.WorkspaceMember,
+	}, nil
+}
+
+func (a Handler) RemoveWorkspaceMember(
+	ctx context.Context,
+	request *go_load.RemoveWorkspaceMemberRequest,
+) (*go_load.RemoveWorkspaceMemberResponse, error) {
+	err := a.workspaceLogic.RemoveWorkspaceMember(ctx, logic.RemoveWorkspaceMemberParams{
+		WorkspaceID: request.GetWorkspaceId(),
+		AccountID:   request.GetAccountId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.RemoveWorkspaceMemberResponse{}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"go.uber.org/zap"
 	"goload/internal/configs"
 	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/logic"
 	"goload/internal/utils"
 	"google.golang.org/grpc"
 	"net"
@@ -16,20 +17,29 @@ type Server interface {
 }
 
 type server struct {
-	handler    go_load.GoLoadServiceServer
-	grpcConfig configs.GRPC
-	logger     *zap.Logger
+	handler      go_load.GoLoadServiceServer
+	adminHandler go_load.GoLoadAdminServiceServer
+	tokenLogic   logic.Token
+	apiKeyLogic  logic.APIKey
+	grpcConfig   configs.GRPC
+	logger       *zap.Logger
 }
 
 func NewServer(
 	handler go_load.GoLoadServiceServer,
+	adminHandler go_load.GoLoadAdminServiceServer,
+	tokenLogic logic.Token,
+	apiKeyLogic logic.APIKey,
 	grpcConfig configs.GRPC,
 	logger *zap.Logger,
 ) Server {
 	return &server{
-		handler:    handler,
-		grpcConfig: grpcConfig,
-		logger:     logger,
+		handler:      handler,
+		adminHandler: adminHandler,
+		tokenLogic:   tokenLogic,
+		apiKeyLogic:  apiKeyLogic,
+		grpcConfig:   grpcConfig,
+		logger:       logger,
 	}
 }
 
@@ -46,13 +56,18 @@ func (s server) Start(ctx context.Context) error {
 
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
+			CorrelationIDUnaryServerInterceptor(),
+			AuthUnaryServerInterceptor(s.tokenLogic, s.apiKeyLogic, s.logger),
 			validator.UnaryServerInterceptor(),
 		),
 		grpc.ChainStreamInterceptor(
+			CorrelationIDStreamServerInterceptor(),
+			AuthStreamServerInterceptor(s.tokenLogic, s.apiKeyLogic, s.logger),
 			validator.StreamServerInterceptor(),
 		),
 	)
 	go_load.RegisterGoLoadServiceServer(server, s.handler)
+	go_load.RegisterGoLoadAdminServiceServer(server, s.adminHandler)
 
 	logger.With(zap.String("address", s.grpcConfig.Address)).Info("starting grpc server")
 	return server.Serve(listener)
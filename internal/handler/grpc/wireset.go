@@ -2,4 +2,4 @@ package grpc
 
 import "github.com/google/wire"
 
-var WireSet = wire.NewSet(NewHandler, NewServer)
+var WireSet = wire.NewSet(NewHandler, NewAdminHandler, NewServer)
@@ -0,0 +1,36 @@
+package http
+
+import (
+	"encoding/json"
+	"goload/internal/logic"
+	"goload/internal/utils"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+type jwksHandler struct {
+	tokenLogic logic.Token
+	logger     *zap.Logger
+}
+
+func (h jwksHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := utils.LoggerWithContext(r.Context(), h.logger)
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	jsonWebKeySet, err := h.tokenLogic.GetJSONWebKeySet(r.Context())
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err = json.NewEncoder(w).Encode(jsonWebKeySet); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to write json web key set")
+	}
+}
@@ -0,0 +1,110 @@
+package http
+
+import (
+	"crypto/subtle"
+	"goload/internal/handler/http/servemuxoptions"
+	"goload/internal/logic"
+	"goload/internal/utils"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	OIDCLoginPath    = "/auth/oidc/login"
+	OIDCCallbackPath = "/auth/oidc/callback"
+	// OIDCStateCookieName binds a login to the browser that started it, so a callback URL cannot be used to log
+	// someone else in.
+	OIDCStateCookieName = "GOLOAD_OIDC_STATE"
+	oidcStateCookiePath = "/auth/oidc"
+)
+
+type oidcHandler struct {
+	oidcLogic             logic.OIDC
+	tokenExpiresIn        time.Duration
+	refreshTokenExpiresIn time.Duration
+	postLoginRedirectURL  string
+	logger                *zap.Logger
+}
+
+func (h oidcHandler) writeError(w http.ResponseWriter, err error) {
+	http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+}
+
+func (h oidcHandler) setStateCookie(w http.ResponseWriter, state string) {
+	cookie := &http.Cookie{
+		Name:     OIDCStateCookieName,
+		Value:    state,
+		Path:     oidcStateCookiePath,
+		HttpOnly: true,
+		// the callback is a cross-site redirect from the provider, which strict cookies are not sent with
+		SameSite: http.SameSiteLaxMode,
+	}
+	if state == "" {
+		cookie.MaxAge = -1
+	}
+
+	http.SetCookie(w, cookie)
+}
+
+func (h oidcHandler) Login(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	output, err := h.oidcLogic.GetOIDCLoginURL(r.Context())
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	h.setStateCookie(w, output.State)
+	http.Redirect(w, r, output.URL, http.StatusFound)
+}
+
+func (h oidcHandler) Callback(w http.ResponseWriter, r *http.Request) {
+	logger := utils.LoggerWithContext(r.Context(), h.logger)
+
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	if errorCode := query.Get("error"); errorCode != "" {
+		logger.
+			With(zap.String("error", errorCode)).
+			With(zap.String("error_description", query.Get("error_description"))).
+			Info("oidc provider returned an error")
+		h.setStateCookie(w, "")
+		http.Error(w, "login failed: "+errorCode, http.StatusUnauthorized)
+		return
+	}
+
+	state := query.Get("state")
+	stateCookie, err := r.Cookie(OIDCStateCookieName)
+	if err != nil || state == "" || subtle.ConstantTimeCompare([]byte(stateCookie.Value), []byte(state)) != 1 {
+		http.Error(w, "login state does not match", http.StatusBadRequest)
+		return
+	}
+
+	h.setStateCookie(w, "")
+	output, err := h.oidcLogic.CreateOIDCSession(r.Context(), logic.CreateOIDCSessionParams{
+		State: state,
+		Code:  query.Get("code"),
+	})
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	servemuxoptions.SetAuthCookie(w, AuthTokenCookieName, output.Token, h.tokenExpiresIn)
+	servemuxoptions.SetAuthCookie(w, RefreshTokenCookieName, output.RefreshToken, h.refreshTokenExpiresIn)
+	http.Redirect(w, r, h.postLoginRedirectURL, http.StatusFound)
+}
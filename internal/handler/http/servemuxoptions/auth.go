@@ -7,6 +7,7 @@ import (
 	_ "google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -39,24 +40,40 @@ func WithAuthMetadataToAuthCookie(
 			return nil
 		}
 
-		http.SetCookie(w, &http.Cookie{
-			Name:     authCookieName,
-			Value:    authMetadataValues[0],
-			HttpOnly: true,
-			SameSite: http.SameSiteStrictMode,
-			Expires:  time.Now().Add(expiresInDuration),
-		})
-
+		SetAuthCookie(w, authCookieName, authMetadataValues[0], expiresInDuration)
 		return nil
 	})
 }
 
-func WithRemoveGoAuthMetadata(authMetadataName string) runtime.ServeMuxOption {
+func WithRemoveGoAuthMetadata(authMetadataNameList ...string) runtime.ServeMuxOption {
 	return runtime.WithOutgoingHeaderMatcher(func(s string) (string, bool) {
-		if s == authMetadataName {
-			return "", false
+		for _, authMetadataName := range authMetadataNameList {
+			if strings.EqualFold(s, authMetadataName) {
+				return "", false
+			}
 		}
 
 		return runtime.DefaultHeaderMatcher(s)
 	})
 }
+
+// SetAuthCookie deletes the cookie when value is empty, which means the session ended.
+func SetAuthCookie(w http.ResponseWriter, authCookieName, value string, expiresInDuration time.Duration) {
+	if value == "" {
+		http.SetCookie(w, &http.Cookie{
+			Name:     authCookieName,
+			HttpOnly: true,
+			SameSite: http.SameSiteStrictMode,
+			MaxAge:   -1,
+		})
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     authCookieName,
+		Value:    value,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+		Expires:  time.Now().Add(expiresInDuration),
+	})
+}
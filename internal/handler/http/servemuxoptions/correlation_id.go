@@ -0,0 +1,24 @@
+package servemuxoptions
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+)
+
+func WithCorrelationIDHeaderToMetadata(
+	correlationIDHeaderName, correlationIDMetadataName string,
+) runtime.ServeMuxOption {
+	return runtime.WithMetadata(func(ctx context.Context, r *http.Request) metadata.MD {
+		correlationID := r.Header.Get(correlationIDHeaderName)
+		if correlationID == "" {
+			return make(metadata.MD)
+		}
+
+		return metadata.New(map[string]string{
+			correlationIDMetadataName: correlationID,
+		})
+	})
+}
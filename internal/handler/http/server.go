@@ -2,10 +2,12 @@ package http
 
 import (
 	"context"
+	"expvar"
 	"goload/internal/configs"
 	go_load "goload/internal/generated/downloadClient/v1"
 	handlerGRPC "goload/internal/handler/grpc"
 	"goload/internal/handler/http/servemuxoptions"
+	"goload/internal/logic"
 	"goload/internal/utils"
 	"net/http"
 	"time"
@@ -19,6 +21,9 @@ import (
 const (
 	//nolint:gosec // This is just to specify the cookie name
 	AuthTokenCookieName = "GOLOAD_AUTH"
+	//nolint:gosec // This is just to specify the cookie name
+	RefreshTokenCookieName  = "GOLOAD_REFRESH"
+	CorrelationIDHeaderName = "X-Correlation-ID"
 )
 
 type Server interface {
@@ -26,23 +31,32 @@ type Server interface {
 }
 
 type server struct {
-	grpcConfig configs.GRPC
-	httpConfig configs.HTTP
-	authConfig configs.Auth
-	logger     *zap.Logger
+	grpcConfig     configs.GRPC
+	httpConfig     configs.HTTP
+	authConfig     configs.Auth
+	tokenLogic     logic.Token
+	oidcLogic      logic.OIDC
+	shareLinkLogic logic.ShareLink
+	logger         *zap.Logger
 }
 
 func NewServer(
 	grpcConfig configs.GRPC,
 	httpConfig configs.HTTP,
 	authConfig configs.Auth,
+	tokenLogic logic.Token,
+	oidcLogic logic.OIDC,
+	shareLinkLogic logic.ShareLink,
 	logger *zap.Logger,
 ) Server {
 	return &server{
-		grpcConfig: grpcConfig,
-		httpConfig: httpConfig,
-		authConfig: authConfig,
-		logger:     logger,
+		grpcConfig:     grpcConfig,
+		httpConfig:     httpConfig,
+		authConfig:     authConfig,
+		tokenLogic:     tokenLogic,
+		oidcLogic:      oidcLogic,
+		shareLinkLogic: shareLinkLogic,
+		logger:         logger,
 	}
 }
 
@@ -52,24 +66,78 @@ func (s server) getGRPCGatewayHandler(ctx context.Context) (http.Handler, error)
 		return nil, err
 	}
 
+	regenerateTokenBeforeExpiryDuration, err := s.authConfig.Token.GetGenerateTokenBeforeExpiryDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	refreshTokenExpiresInDuration, err := s.authConfig.Token.GetRefreshTokenExpiresInDuration()
+	if err != nil {
+		return nil, err
+	}
+
 	grpcMux := runtime.NewServeMux(
 		servemuxoptions.WithAuthCookieToAuthMetadata(AuthTokenCookieName, handlerGRPC.AuthTokenMetadataName),
+		servemuxoptions.WithAuthCookieToAuthMetadata(RefreshTokenCookieName, handlerGRPC.RefreshTokenMetadataName),
 		servemuxoptions.WithAuthMetadataToAuthCookie(
 			handlerGRPC.AuthTokenMetadataName, AuthTokenCookieName, tokenExpiresInDuration),
-		servemuxoptions.WithRemoveGoAuthMetadata(handlerGRPC.AuthTokenMetadataName),
+		servemuxoptions.WithAuthMetadataToAuthCookie(
+			handlerGRPC.RefreshTokenMetadataName, RefreshTokenCookieName, refreshTokenExpiresInDuration),
+		servemuxoptions.WithRemoveGoAuthMetadata(
+			handlerGRPC.AuthTokenMetadataName, handlerGRPC.RefreshTokenMetadataName),
+		servemuxoptions.WithCorrelationIDHeaderToMetadata(CorrelationIDHeaderName, utils.CorrelationIDMetadataKey),
 	)
-	err = go_load.RegisterGoLoadServiceHandlerFromEndpoint(
-		ctx,
-		grpcMux,
-		s.grpcConfig.Address,
-		[]grpc.DialOption{
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-		})
+	dialOptionList := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+	err = go_load.RegisterGoLoadServiceHandlerFromEndpoint(ctx, grpcMux, s.grpcConfig.Address, dialOptionList)
 	if err != nil {
 		return nil, err
 	}
 
-	return grpcMux, nil
+	err = go_load.RegisterGoLoadAdminServiceHandlerFromEndpoint(ctx, grpcMux, s.grpcConfig.Address, dialOptionList)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.DialContext(ctx, s.grpcConfig.Address, dialOptionList...)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		// the server is shutting down, there is nothing left to do with the error
+		_ = conn.Close()
+	}()
+
+	return sessionRenewal{
+		client:                      go_load.NewGoLoadServiceClient(conn),
+		regenerateTokenBeforeExpiry: regenerateTokenBeforeExpiryDuration,
+		tokenExpiresIn:              tokenExpiresInDuration,
+		refreshTokenExpiresIn:       refreshTokenExpiresInDuration,
+		logger:                      s.logger,
+	}.Wrap(grpcMux), nil
+}
+
+func (s server) getOIDCHandler() (oidcHandler, error) {
+	tokenExpiresInDuration, err := s.authConfig.Token.GetExpiresInDuration()
+	if err != nil {
+		return oidcHandler{}, err
+	}
+
+	refreshTokenExpiresInDuration, err := s.authConfig.Token.GetRefreshTokenExpiresInDuration()
+	if err != nil {
+		return oidcHandler{}, err
+	}
+
+	return oidcHandler{
+		oidcLogic:             s.oidcLogic,
+		tokenExpiresIn:        tokenExpiresInDuration,
+		refreshTokenExpiresIn: refreshTokenExpiresInDuration,
+		postLoginRedirectURL:  s.authConfig.OIDC.PostLoginRedirectURL,
+		logger:                s.logger,
+	}, nil
 }
 
 func (s server) Start(ctx context.Context) error {
@@ -80,10 +148,29 @@ func (s server) Start(ctx context.Context) error {
 		return err
 	}
 
+	serveMux := http.NewServeMux()
+	serveMux.Handle("/debug/vars", expvar.Handler())
+	serveMux.Handle("/.well-known/jwks.json", jwksHandler{tokenLogic: s.tokenLogic, logger: s.logger})
+	if s.oidcLogic.IsEnabled() {
+		oidc, err := s.getOIDCHandler()
+		if err != nil {
+			return err
+		}
+
+		serveMux.HandleFunc(OIDCLoginPath, oidc.Login)
+		serveMux.HandleFunc(OIDCCallbackPath, oidc.Callback)
+	}
+
+	if s.shareLinkLogic.IsEnabled() {
+		serveMux.Handle(logic.ShareLinkPathPrefix, shareLinkHandler{shareLinkLogic: s.shareLinkLogic, logger: s.logger})
+	}
+
+	serveMux.Handle("/", grpcGatewayHandler)
+
 	httpServer := http.Server{
 		Addr:              s.httpConfig.Address,
 		ReadHeaderTimeout: time.Minute,
-		Handler:           grpcGatewayHandler,
+		Handler:           serveMux,
 	}
 
 	logger.With(zap.String("address", s.httpConfig.Address)).Info("starting http server")
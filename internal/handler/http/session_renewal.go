@@ -0,0 +1,103 @@
+package http
+
+import (
+	go_load "goload/internal/generated/downloadClient/v1"
+	handlerGRPC "goload/internal/handler/grpc"
+	"goload/internal/handler/http/servemuxoptions"
+	"goload/internal/utils"
+	"net/http"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// sessionRenewal refreshes the session of a browser before the request reaches the gateway when its access token
+// cookie is about to expire, or already expired, and it still has a refresh token cookie.
+type sessionRenewal struct {
+	client                      go_load.GoLoadServiceClient
+	regenerateTokenBeforeExpiry time.Duration
+	tokenExpiresIn              time.Duration
+	refreshTokenExpiresIn       time.Duration
+	logger                      *zap.Logger
+}
+
+// isTokenExpiring does not verify the token, the gRPC server does that, it only reads its expiry.
+func (s sessionRenewal) isTokenExpiring(token string) bool {
+	claims := jwt.MapClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(token, claims); err != nil {
+		return true
+	}
+
+	expireTimeUnix, ok := claims["exp"].(float64)
+	if !ok {
+		return true
+	}
+
+	return time.Until(time.Unix(int64(expireTimeUnix), 0)) < s.regenerateTokenBeforeExpiry
+}
+
+// replaceRequestCookie makes the rest of the chain see the renewed tokens instead of the ones the browser sent.
+func replaceRequestCookie(r *http.Request, cookieName, value string) {
+	cookieList := r.Cookies()
+	r.Header.Del("Cookie")
+
+	replaced := false
+	for _, cookie := range cookieList {
+		if cookie.Name == cookieName {
+			cookie.Value = value
+			replaced = true
+		}
+
+		r.AddCookie(cookie)
+	}
+
+	if !replaced {
+		r.AddCookie(&http.Cookie{Name: cookieName, Value: value})
+	}
+}
+
+func (s sessionRenewal) Wrap(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		refreshTokenCookie, err := r.Cookie(RefreshTokenCookieName)
+		if err != nil || refreshTokenCookie.Value == "" {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		authTokenCookie, err := r.Cookie(AuthTokenCookieName)
+		if err == nil && !s.isTokenExpiring(authTokenCookie.Value) {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		logger := utils.LoggerWithContext(r.Context(), s.logger)
+
+		var header metadata.MD
+		_, err = s.client.RefreshSession(
+			r.Context(),
+			&go_load.RefreshSessionRequest{RefreshToken: refreshTokenCookie.Value},
+			grpc.Header(&header),
+		)
+		if err != nil {
+			logger.With(zap.Error(err)).Info("failed to renew session, passing the request through")
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		r = r.Clone(r.Context())
+		if tokenValues := header.Get(handlerGRPC.AuthTokenMetadataName); len(tokenValues) > 0 {
+			servemuxoptions.SetAuthCookie(w, AuthTokenCookieName, tokenValues[0], s.tokenExpiresIn)
+			replaceRequestCookie(r, AuthTokenCookieName, tokenValues[0])
+		}
+
+		if refreshTokenValues := header.Get(handlerGRPC.RefreshTokenMetadataName); len(refreshTokenValues) > 0 {
+			servemuxoptions.SetAuthCookie(w, RefreshTokenCookieName, refreshTokenValues[0], s.refreshTokenExpiresIn)
+			replaceRequestCookie(r, RefreshTokenCookieName, refreshTokenValues[0])
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,102 @@
+package http
+
+import (
+	"goload/internal/logic"
+	"goload/internal/utils"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type shareLinkHandler struct {
+	shareLinkLogic logic.ShareLink
+	logger         *zap.Logger
+}
+
+func (h shareLinkHandler) writeError(w http.ResponseWriter, err error) {
+	if status.Code(err) == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", `Basic realm="goload share link"`)
+	}
+
+	http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+}
+
+// isPartialDownload reports whether the request asks for a range that does not start at the first byte of the file.
+// A Range header that cannot be parsed counts as one, ServeContent rejects it anyway.
+func isPartialDownload(r *http.Request) bool {
+	rangeHeader := strings.TrimSpace(r.Header.Get("Range"))
+	if rangeHeader == "" {
+		return false
+	}
+
+	rangeSpecList, ok := strings.CutPrefix(rangeHeader, "bytes=")
+	if !ok {
+		return true
+	}
+
+	firstRangeSpec, _, _ := strings.Cut(rangeSpecList, ",")
+	start, _, _ := strings.Cut(firstRangeSpec, "-")
+	startOffset, err := strconv.ParseUint(strings.TrimSpace(start), 10, 64)
+	return err != nil || startOffset != 0
+}
+
+// ServeHTTP streams the file of a share link to anyone with its URL. The password of a protected link is read
+// from basic auth, the user name is ignored.
+func (h shareLinkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := utils.LoggerWithContext(r.Context(), h.logger)
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	shareLinkID, err := strconv.ParseUint(strings.TrimPrefix(r.URL.Path, logic.ShareLinkPathPrefix), 10, 64)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	expiresAt, err := strconv.ParseInt(r.URL.Query().Get("expires"), 10, 64)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	// the client IP is left empty when RemoteAddr cannot be parsed
+	clientIP, _, _ := net.SplitHostPort(r.RemoteAddr)
+
+	_, password, _ := r.BasicAuth()
+	output, err := h.shareLinkLogic.OpenShareLink(r.Context(), logic.OpenShareLinkParams{
+		ShareLinkID:       shareLinkID,
+		ExpiresAt:         expiresAt,
+		Signature:         r.URL.Query().Get("signature"),
+		Password:          password,
+		ClientIP:          clientIP,
+		CountDownload:     r.Method == http.MethodGet,
+		IsPartialDownload: isPartialDownload(r),
+	})
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	defer func() {
+		if closeErr := output.File.Close(); closeErr != nil {
+			logger.With(zap.Error(closeErr)).Warn("failed to close shared file")
+		}
+	}()
+
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": output.FileName,
+	}))
+	w.Header().Set("Cache-Control", "private, no-store")
+	http.ServeContent(w, r, output.FileName, output.ModTime, output.File)
+}
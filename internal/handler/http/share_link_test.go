@@ -0,0 +1,36 @@
+package http
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIsPartialDownload(t *testing.T) {
+	testCaseList := []struct {
+		name            string
+		rangeHeader     string
+		expectedPartial bool
+	}{
+		{name: "no range"},
+		{name: "range from the first byte", rangeHeader: "bytes=0-"},
+		{name: "bounded range from the first byte", rangeHeader: "bytes=0-99"},
+		{name: "several ranges from the first byte", rangeHeader: "bytes=0-99, 200-299"},
+		{name: "range from a later byte", rangeHeader: "bytes=100-", expectedPartial: true},
+		{name: "suffix range", rangeHeader: "bytes=-100", expectedPartial: true},
+		{name: "other unit", rangeHeader: "items=0-", expectedPartial: true},
+		{name: "invalid range", rangeHeader: "bytes=abc", expectedPartial: true},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/share/1", nil)
+			if testCase.rangeHeader != "" {
+				r.Header.Set("Range", testCase.rangeHeader)
+			}
+
+			if partial := isPartialDownload(r); partial != testCase.expectedPartial {
+				t.Fatalf("expected partial %t, got %t", testCase.expectedPartial, partial)
+			}
+		})
+	}
+}
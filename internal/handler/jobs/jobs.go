@@ -0,0 +1,68 @@
+package jobs
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+type Root interface {
+	Start(ctx context.Context) error
+}
+
+type root struct {
+	retentionJob       Retention
+	outboxRelayJob     OutboxRelay
+	webhookDeliveryJob WebhookDelivery
+	tokenSigningKeyJob TokenSigningKeyRotation
+	staleTaskJob       StaleDownloadTask
+	logger             *zap.Logger
+}
+
+func NewRoot(
+	retentionJob Retention,
+	outboxRelayJob OutboxRelay,
+	webhookDeliveryJob WebhookDelivery,
+	tokenSigningKeyJob TokenSigningKeyRotation,
+	staleTaskJob StaleDownloadTask,
+	logger *zap.Logger,
+) Root {
+	return &root{
+		retentionJob:       retentionJob,
+		outboxRelayJob:     outboxRelayJob,
+		webhookDeliveryJob: webhookDeliveryJob,
+		tokenSigningKeyJob: tokenSigningKeyJob,
+		staleTaskJob:       staleTaskJob,
+		logger:             logger,
+	}
+}
+
+func (r root) Start(ctx context.Context) error {
+	go func() {
+		err := r.retentionJob.Run(ctx)
+		r.logger.With(zap.Error(err)).Info("retention job stopped")
+	}()
+
+	go func() {
+		err := r.outboxRelayJob.Run(ctx)
+		r.logger.With(zap.Error(err)).Info("outbox relay job stopped")
+	}()
+
+	go func() {
+		err := r.webhookDeliveryJob.Run(ctx)
+		r.logger.With(zap.Error(err)).Info("webhook delivery job stopped")
+	}()
+
+	go func() {
+		err := r.tokenSigningKeyJob.Run(ctx)
+		r.logger.With(zap.Error(err)).Info("token signing key rotation job stopped")
+	}()
+
+	go func() {
+		err := r.staleTaskJob.Run(ctx)
+		r.logger.With(zap.Error(err)).Info("stale download task job stopped")
+	}()
+
+	<-ctx.Done()
+	return nil
+}
@@ -0,0 +1,103 @@
+package jobs
+
+import (
+	"context"
+	"expvar"
+	"goload/internal/configs"
+	"goload/internal/logic"
+	"goload/internal/utils"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	outboxRelayLagSeconds            = expvar.NewFloat("outbox_relay_lag_seconds")
+	outboxRelayPublishedMessageCount = expvar.NewInt("outbox_relay_published_message_count")
+)
+
+type OutboxRelay interface {
+	Run(ctx context.Context) error
+}
+
+type outboxRelay struct {
+	outboxLogic   logic.Outbox
+	relayInterval time.Duration
+	pruneInterval time.Duration
+	logger        *zap.Logger
+}
+
+func NewOutboxRelay(
+	outboxLogic logic.Outbox,
+	outboxConfig configs.Outbox,
+	logger *zap.Logger,
+) (OutboxRelay, error) {
+	relayInterval, err := outboxConfig.GetRelayIntervalDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse outbox relay_interval: " + outboxConfig.RelayInterval)
+		return nil, err
+	}
+
+	pruneInterval, err := outboxConfig.GetPruneIntervalDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse outbox prune_interval: " + outboxConfig.PruneInterval)
+		return nil, err
+	}
+
+	return &outboxRelay{
+		outboxLogic:   outboxLogic,
+		relayInterval: relayInterval,
+		pruneInterval: pruneInterval,
+		logger:        logger,
+	}, nil
+}
+
+func (o outboxRelay) relay(ctx context.Context) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	publishedCount, err := o.outboxLogic.RelayOutboxMessageList(ctx)
+	outboxRelayPublishedMessageCount.Add(int64(publishedCount))
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to relay outbox messages")
+	}
+
+	lag, err := o.outboxLogic.GetOutboxRelayLag(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get outbox relay lag")
+		return
+	}
+
+	outboxRelayLagSeconds.Set(lag.Seconds())
+}
+
+func (o outboxRelay) prune(ctx context.Context) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	deletedCount, err := o.outboxLogic.PruneOutboxMessageList(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to prune outbox messages")
+		return
+	}
+
+	logger.With(zap.Uint64("deleted_count", deletedCount)).Info("pruned published outbox messages")
+}
+
+func (o outboxRelay) Run(ctx context.Context) error {
+	relayTicker := time.NewTicker(o.relayInterval)
+	defer relayTicker.Stop()
+
+	pruneTicker := time.NewTicker(o.pruneInterval)
+	defer pruneTicker.Stop()
+
+	o.relay(ctx)
+	for {
+		select {
+		case <-relayTicker.C:
+			o.relay(ctx)
+		case <-pruneTicker.C:
+			o.prune(ctx)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package jobs
+
+import (
+	"context"
+	"goload/internal/configs"
+	"goload/internal/logic"
+	"goload/internal/utils"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type Retention interface {
+	Run(ctx context.Context) error
+}
+
+type retention struct {
+	retentionLogic logic.Retention
+	interval       time.Duration
+	logger         *zap.Logger
+}
+
+func NewRetention(
+	retentionLogic logic.Retention,
+	retentionConfig configs.Retention,
+	logger *zap.Logger,
+) (Retention, error) {
+	interval, err := retentionConfig.GetIntervalDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse retention interval: " + retentionConfig.Interval)
+		return nil, err
+	}
+
+	return &retention{
+		retentionLogic: retentionLogic,
+		interval:       interval,
+		logger:         logger,
+	}, nil
+}
+
+func (r retention) Run(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, r.logger)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.retentionLogic.RunRetention(ctx); err != nil {
+			logger.With(zap.Error(err)).Error("failed to run retention")
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package jobs
+
+import (
+	"context"
+	"goload/internal/configs"
+	"goload/internal/logic"
+	"goload/internal/utils"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type StaleDownloadTask interface {
+	Run(ctx context.Context) error
+}
+
+type staleDownloadTask struct {
+	downloadTaskLogic logic.DownloadTask
+	checkInterval     time.Duration
+	logger            *zap.Logger
+}
+
+func NewStaleDownloadTask(
+	downloadTaskLogic logic.DownloadTask,
+	downloadConfig configs.DownloadConfig,
+	logger *zap.Logger,
+) (StaleDownloadTask, error) {
+	checkInterval, err := downloadConfig.GetStaleTaskCheckIntervalDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).
+			Error("failed to parse stale_task_check_interval: " + downloadConfig.StaleTaskCheckInterval)
+		return nil, err
+	}
+
+	return &staleDownloadTask{
+		downloadTaskLogic: downloadTaskLogic,
+		checkInterval:     checkInterval,
+		logger:            logger,
+	}, nil
+}
+
+func (s staleDownloadTask) Run(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, s.logger)
+
+	ticker := time.NewTicker(s.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.downloadTaskLogic.RequeueStaleDownloadTasks(ctx); err != nil {
+			logger.With(zap.Error(err)).Error("failed to requeue stale download tasks")
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package jobs
+
+import (
+	"context"
+	"goload/internal/configs"
+	"goload/internal/logic"
+	"goload/internal/utils"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type TokenSigningKeyRotation interface {
+	Run(ctx context.Context) error
+}
+
+type tokenSigningKeyRotation struct {
+	tokenLogic    logic.Token
+	checkInterval time.Duration
+	logger        *zap.Logger
+}
+
+func NewTokenSigningKeyRotation(
+	tokenLogic logic.Token,
+	authConfig configs.Auth,
+	logger *zap.Logger,
+) (TokenSigningKeyRotation, error) {
+	signingKeyConfig := authConfig.Token.SigningKey
+	checkInterval, err := signingKeyConfig.GetRotationCheckIntervalDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).
+			Error("failed to parse rotation_check_interval: " + signingKeyConfig.RotationCheckInterval)
+		return nil, err
+	}
+
+	return &tokenSigningKeyRotation{
+		tokenLogic:    tokenLogic,
+		checkInterval: checkInterval,
+		logger:        logger,
+	}, nil
+}
+
+func (t tokenSigningKeyRotation) rotate(ctx context.Context) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	if err := t.tokenLogic.RotateSigningKey(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to rotate token signing key")
+	}
+}
+
+func (t tokenSigningKeyRotation) Run(ctx context.Context) error {
+	ticker := time.NewTicker(t.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			t.rotate(ctx)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
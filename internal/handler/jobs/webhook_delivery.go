@@ -0,0 +1,68 @@
+package jobs
+
+import (
+	"context"
+	"expvar"
+	"goload/internal/configs"
+	"goload/internal/logic"
+	"goload/internal/utils"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	webhookDeliveredCount = expvar.NewInt("webhook_delivered_count")
+)
+
+type WebhookDelivery interface {
+	Run(ctx context.Context) error
+}
+
+type webhookDelivery struct {
+	webhookLogic     logic.Webhook
+	deliveryInterval time.Duration
+	logger           *zap.Logger
+}
+
+func NewWebhookDelivery(
+	webhookLogic logic.Webhook,
+	webhookConfig configs.Webhook,
+	logger *zap.Logger,
+) (WebhookDelivery, error) {
+	deliveryInterval, err := webhookConfig.GetDeliveryIntervalDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse webhook delivery_interval: " + webhookConfig.DeliveryInterval)
+		return nil, err
+	}
+
+	return &webhookDelivery{
+		webhookLogic:     webhookLogic,
+		deliveryInterval: deliveryInterval,
+		logger:           logger,
+	}, nil
+}
+
+func (w webhookDelivery) deliver(ctx context.Context) {
+	logger := utils.LoggerWithContext(ctx, w.logger)
+
+	deliveredCount, err := w.webhookLogic.DeliverDueWebhookDeliveryList(ctx)
+	webhookDeliveredCount.Add(int64(deliveredCount))
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to deliver webhooks")
+	}
+}
+
+func (w webhookDelivery) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.deliveryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.deliver(ctx)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
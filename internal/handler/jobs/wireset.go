@@ -0,0 +1,12 @@
+package jobs
+
+import "github.com/google/wire"
+
+var WireSet = wire.NewSet(
+	NewRetention,
+	NewOutboxRelay,
+	NewWebhookDelivery,
+	NewTokenSigningKeyRotation,
+	NewStaleDownloadTask,
+	NewRoot,
+)
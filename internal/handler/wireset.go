@@ -5,10 +5,12 @@ import (
 	"goload/internal/handler/consumers"
 	"goload/internal/handler/grpc"
 	"goload/internal/handler/http"
+	"goload/internal/handler/jobs"
 )
 
 var WireSet = wire.NewSet(
 	grpc.WireSet,
 	http.WireSet,
 	consumers.WireSet,
+	jobs.WireSet,
 )
@@ -2,15 +2,21 @@ package logic
 
 import (
 	"context"
+	"crypto/cipher"
+	"database/sql"
 	"errors"
 	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
+	"goload/internal/configs"
 	"goload/internal/dataaccess/cache"
 	"goload/internal/dataaccess/database"
+	"goload/internal/dataaccess/file"
 	go_load "goload/internal/generated/downloadClient/v1"
 	"goload/internal/utils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"time"
 )
 
 type CreateAccountParams struct {
@@ -26,26 +32,71 @@ type CreateAccountOutput struct {
 type CreateSessionParams struct {
 	AccountName string
 	Password    string
+	// TwoFactorCode is a TOTP or recovery code, required once the account has enabled two-factor authentication.
+	TwoFactorCode string
+	// ClientIP is empty when it is not known, failed logins are then only counted per account name.
+	ClientIP string
 }
 
 type CreateSessionOutput struct {
-	Account *go_load.Account
-	Token   string
+	Account      *go_load.Account
+	Token        string
+	RefreshToken string
 }
 
 type Account interface {
 	CreateAccount(ctx context.Context, params CreateAccountParams) (CreateAccountOutput, error)
 	CreateSession(ctx context.Context, params CreateSessionParams) (CreateSessionOutput, error)
+	RefreshSession(ctx context.Context, params RefreshSessionParams) (RefreshSessionOutput, error)
+	DeleteSession(ctx context.Context, params DeleteSessionParams) error
+	DeleteAllSessions(ctx context.Context, params DeleteAllSessionsParams) (DeleteAllSessionsOutput, error)
+	GetAccount(ctx context.Context, params GetAccountParams) (GetAccountOutput, error)
+	ChangePassword(ctx context.Context, params ChangePasswordParams) (ChangePasswordOutput, error)
+	DeleteAccount(ctx context.Context, params DeleteAccountParams) (DeleteAccountOutput, error)
+	EnrollTwoFactor(ctx context.Context, params EnrollTwoFactorParams) (EnrollTwoFactorOutput, error)
+	ConfirmTwoFactor(ctx context.Context, params ConfirmTwoFactorParams) (ConfirmTwoFactorOutput, error)
+	DisableTwoFactor(ctx context.Context, params DisableTwoFactorParams) error
+	RegenerateRecoveryCodes(
+		ctx context.Context,
+		params RegenerateRecoveryCodesParams,
+	) (RegenerateRecoveryCodesOutput, error)
 }
 
+var (
+	errAccountDisabled = status.Error(codes.PermissionDenied, "account is disabled")
+)
+
 type account struct {
-	goquDatabase                *goqu.Database
-	takenAccountNameCache       cache.TakenAccountName
-	accountDataAccessor         database.AccountDataAccessor
-	accountPasswordDataAccessor database.AccountPasswordDataAccessor
-	hashLogic                   Hash
-	tokenLogic                  Token
-	logger                      *zap.Logger
+	goquDatabase                    *goqu.Database
+	takenAccountNameCache           cache.TakenAccountName
+	accountDataAccessor             database.AccountDataAccessor
+	accountPasswordDataAccessor     database.AccountPasswordDataAccessor
+	loginThrottle                   loginThrottle
+	sessionDataAccessor             database.SessionDataAccessor
+	downloadTaskDataAccessor        database.DownloadTaskDataAccessor
+	webhookDataAccessor             database.WebhookDataAccessor
+	webhookDeliveryDataAccessor     database.WebhookDeliveryDataAccessor
+	apiKeyDataAccessor              database.APIKeyDataAccessor
+	accountOIDCIdentityDataAccessor database.AccountOIDCIdentityDataAccessor
+	accountTOTPDataAccessor         database.AccountTOTPDataAccessor
+	accountRecoveryCodeDataAccessor database.AccountRecoveryCodeDataAccessor
+	workspaceMemberDataAccessor     database.WorkspaceMemberDataAccessor
+	shareLinkDataAccessor           database.ShareLinkDataAccessor
+	lifecycleEventProducer          DownloadTaskLifecycleEventProducer
+	fileClient                      file.Client
+	hashLogic                       Hash
+	tokenLogic                      Token
+	refreshTokenExpiresIn           time.Duration
+	passwordPolicy                  configs.PasswordPolicy
+	twoFactorConfig                 configs.TwoFactor
+	twoFactorRequirement            twoFactorRequirement
+	// totpSecretAEAD is nil when two-factor authentication is not configured.
+	totpSecretAEAD cipher.AEAD
+	// dummyPasswordHash is compared against when there is no password hash to compare against, so that logging in
+	// takes as long for an unknown account name as for a known one.
+	dummyPasswordHash   string
+	adminAccountNameSet map[string]struct{}
+	logger              *zap.Logger
 }
 
 func NewAccount(
@@ -53,28 +104,108 @@ func NewAccount(
 	takenAccountNameCache cache.TakenAccountName,
 	accountDataAccessor database.AccountDataAccessor,
 	accountPasswordDataAccessor database.AccountPasswordDataAccessor,
+	failedLoginAttemptCache cache.FailedLoginAttempt,
+	sessionDataAccessor database.SessionDataAccessor,
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
+	webhookDataAccessor database.WebhookDataAccessor,
+	webhookDeliveryDataAccessor database.WebhookDeliveryDataAccessor,
+	apiKeyDataAccessor database.APIKeyDataAccessor,
+	accountOIDCIdentityDataAccessor database.AccountOIDCIdentityDataAccessor,
+	accountTOTPDataAccessor database.AccountTOTPDataAccessor,
+	accountRecoveryCodeDataAccessor database.AccountRecoveryCodeDataAccessor,
+	workspaceMemberDataAccessor database.WorkspaceMemberDataAccessor,
+	shareLinkDataAccessor database.ShareLinkDataAccessor,
+	lifecycleEventProducer DownloadTaskLifecycleEventProducer,
+	fileClient file.Client,
 	hashLogic Hash,
 	tokenLogic Token,
+	authConfig configs.Auth,
 	logger *zap.Logger,
-) Account {
+) (Account, error) {
+	refreshTokenExpiresIn, err := authConfig.Token.GetRefreshTokenExpiresInDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).
+			Error("failed to parse refresh_token_expires_in: " + authConfig.Token.RefreshTokenExpiresIn)
+		return nil, err
+	}
+
+	throttle, err := newLoginThrottle(failedLoginAttemptCache, authConfig.LoginThrottle, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	dummyPasswordHash, err := hashLogic.Hash(context.Background(), "dummy password")
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to hash dummy password")
+		return nil, err
+	}
+
+	var totpSecretAEAD cipher.AEAD
+	if authConfig.TwoFactor.EncryptionKey != "" {
+		totpSecretAEAD, err = parseEncryptionKey(authConfig.TwoFactor.EncryptionKey)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to parse two-factor encryption key")
+			return nil, err
+		}
+	}
+
+	adminAccountNameSet := lo.SliceToMap(authConfig.AdminAccountNameList, func(accountName string) (string, struct{}) {
+		return accountName, struct{}{}
+	})
+
 	return &account{
-		goquDatabase:                goquDatabase,
-		takenAccountNameCache:       takenAccountNameCache,
-		accountDataAccessor:         accountDataAccessor,
-		accountPasswordDataAccessor: accountPasswordDataAccessor,
-		hashLogic:                   hashLogic,
-		tokenLogic:                  tokenLogic,
-		logger:                      logger,
+		goquDatabase:                    goquDatabase,
+		takenAccountNameCache:           takenAccountNameCache,
+		accountDataAccessor:             accountDataAccessor,
+		accountPasswordDataAccessor:     accountPasswordDataAccessor,
+		loginThrottle:                   throttle,
+		sessionDataAccessor:             sessionDataAccessor,
+		downloadTaskDataAccessor:        downloadTaskDataAccessor,
+		webhookDataAccessor:             webhookDataAccessor,
+		webhookDeliveryDataAccessor:     webhookDeliveryDataAccessor,
+		apiKeyDataAccessor:              apiKeyDataAccessor,
+		accountOIDCIdentityDataAccessor: accountOIDCIdentityDataAccessor,
+		accountTOTPDataAccessor:         accountTOTPDataAccessor,
+		accountRecoveryCodeDataAccessor: accountRecoveryCodeDataAccessor,
+		workspaceMemberDataAccessor:     workspaceMemberDataAccessor,
+		shareLinkDataAccessor:           shareLinkDataAccessor,
+		lifecycleEventProducer:          lifecycleEventProducer,
+		fileClient:                      fileClient,
+		hashLogic:                       hashLogic,
+		tokenLogic:                      tokenLogic,
+		refreshTokenExpiresIn:           refreshTokenExpiresIn,
+		passwordPolicy:                  authConfig.PasswordPolicy,
+		twoFactorConfig:                 authConfig.TwoFactor,
+		twoFactorRequirement:            newTwoFactorRequirement(authConfig.TwoFactor),
+		totpSecretAEAD:                  totpSecretAEAD,
+		dummyPasswordHash:               dummyPasswordHash,
+		adminAccountNameSet:             adminAccountNameSet,
+		logger:                          logger,
+	}, nil
+}
+
+func getAccountRoleList(account database.Account) []Role {
+	if account.Role == "" {
+		return []Role{RoleUser}
 	}
+
+	return []Role{Role(account.Role)}
 }
 
-func (a account) databaseAccountToProtoAccount(account database.Account) *go_load.Account {
+func databaseAccountToProtoAccount(account database.Account) *go_load.Account {
 	return &go_load.Account{
-		Id:          account.ID,
-		AccountName: account.AccountName,
+		Id:               account.ID,
+		AccountName:      account.AccountName,
+		Role:             roleToProtoRole(getAccountRoleList(account)[0]),
+		TwoFactorEnabled: account.TwoFactorEnabledAt.Valid,
 	}
 }
 
+func (a account) isAdminAccountName(accountName string) bool {
+	_, ok := a.adminAccountNameSet[accountName]
+	return ok
+}
+
 func (a account) isAccountAccountNameTaken(ctx context.Context, accountName string) (bool, error) {
 	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("account_name", accountName))
 
@@ -103,6 +234,10 @@ func (a account) isAccountAccountNameTaken(ctx context.Context, accountName stri
 }
 
 func (a account) CreateAccount(ctx context.Context, params CreateAccountParams) (CreateAccountOutput, error) {
+	if err := validatePassword(a.passwordPolicy, params.Password); err != nil {
+		return CreateAccountOutput{}, err
+	}
+
 	accountNameTaken, err := a.isAccountAccountNameTaken(ctx, params.AccountName)
 	if err != nil {
 		return CreateAccountOutput{}, status.Error(codes.Internal, "failed to check if account name is taken")
@@ -114,8 +249,14 @@ func (a account) CreateAccount(ctx context.Context, params CreateAccountParams)
 
 	var accountID uint64
 	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		role := RoleUser
+		if a.isAdminAccountName(params.AccountName) {
+			role = RoleAdmin
+		}
+
 		accountID, err = a.accountDataAccessor.WithDatabase(td).CreateAccount(ctx, database.Account{
 			AccountName: params.AccountName,
+			Role:        string(role),
 		})
 		if err != nil {
 			return err
@@ -146,36 +287,133 @@ func (a account) CreateAccount(ctx context.Context, params CreateAccountParams)
 	}, nil
 }
 
-func (a account) CreateSession(ctx context.Context, params CreateSessionParams) (CreateSessionOutput, error) {
-	existingAccount, err := a.accountDataAccessor.GetAccountByAccountName(ctx, params.AccountName)
+// authenticateAccountPassword returns errInvalidCredentials alike for an unknown account name, an account without a
+// password and an incorrect password, and hashes the password in all three cases. It also returns the password hash
+// of the account.
+func (a account) authenticateAccountPassword(
+	ctx context.Context,
+	accountName string,
+	password string,
+) (database.Account, string, error) {
+	passwordHash := a.dummyPasswordHash
+	existingAccount, err := a.accountDataAccessor.GetAccountByAccountName(ctx, accountName)
+	if err != nil && !errors.Is(err, database.ErrAccountNotFound) {
+		return database.Account{}, "", err
+	}
+
+	accountFound := err == nil
+	if accountFound {
+		existingAccountPassword, getErr := a.accountPasswordDataAccessor.GetAccountPassword(ctx, existingAccount.ID)
+		if getErr != nil && !errors.Is(getErr, sql.ErrNoRows) {
+			return database.Account{}, "", getErr
+		}
+
+		if getErr == nil {
+			passwordHash = existingAccountPassword.Hash
+		} else {
+			accountFound = false
+		}
+	}
+
+	isHashEqual, err := a.hashLogic.IsHashEqual(ctx, password, passwordHash)
 	if err != nil {
-		return CreateSessionOutput{}, err
+		return database.Account{}, "", err
+	}
+
+	if !accountFound || !isHashEqual {
+		return database.Account{}, "", errInvalidCredentials
+	}
+
+	return existingAccount, passwordHash, nil
+}
+
+// rehashAccountPasswordIfNeeded upgrades a password hash made with an older algorithm or weaker parameters, while
+// the password is at hand. A failure only postpones the upgrade to the next login.
+func (a account) rehashAccountPasswordIfNeeded(ctx context.Context, accountID uint64, password, passwordHash string) {
+	if !a.hashLogic.NeedsRehash(ctx, passwordHash) {
+		return
+	}
+
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	hashedPassword, err := a.hashLogic.Hash(ctx, password)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to rehash account password")
+		return
 	}
 
-	existingAccountPassword, err := a.accountPasswordDataAccessor.GetAccountPassword(ctx, existingAccount.ID)
+	err = a.accountPasswordDataAccessor.UpdateAccountPassword(ctx, database.AccountPassword{
+		OfAccountID: accountID,
+		Hash:        hashedPassword,
+	})
 	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to update rehashed account password")
+		return
+	}
+
+	logger.Info("rehashed account password")
+}
+
+func (a account) CreateSession(ctx context.Context, params CreateSessionParams) (CreateSessionOutput, error) {
+	if err := a.loginThrottle.check(ctx, params.AccountName, params.ClientIP); err != nil {
 		return CreateSessionOutput{}, err
 	}
 
-	isHashEqual, err := a.hashLogic.IsHashEqual(ctx, params.Password, existingAccountPassword.Hash)
+	existingAccount, passwordHash, err := a.authenticateAccountPassword(ctx, params.AccountName, params.Password)
 	if err != nil {
+		if errors.Is(err, errInvalidCredentials) {
+			a.loginThrottle.recordFailure(ctx, params.AccountName, params.ClientIP)
+		}
+
 		return CreateSessionOutput{}, err
 	}
 
-	if !isHashEqual {
-		return CreateSessionOutput{}, status.Error(codes.Unauthenticated, "incorrect password")
+	if existingAccount.DisabledAt.Valid {
+		return CreateSessionOutput{}, errAccountDisabled
 	}
 
-	token, _, err := a.tokenLogic.GetToken(ctx, existingAccount.ID)
+	if existingAccount.TwoFactorEnabledAt.Valid {
+		if params.TwoFactorCode == "" {
+			return CreateSessionOutput{}, errTwoFactorCodeRequired
+		}
+
+		err = a.verifyTwoFactorCode(ctx, existingAccount.ID, params.TwoFactorCode, true)
+		if err != nil {
+			if errors.Is(err, errInvalidTwoFactorCode) {
+				a.loginThrottle.recordFailure(ctx, params.AccountName, params.ClientIP)
+			}
+
+			return CreateSessionOutput{}, err
+		}
+	}
+
+	a.loginThrottle.recordSuccess(ctx, params.AccountName)
+
+	a.rehashAccountPasswordIfNeeded(ctx, existingAccount.ID, params.Password, passwordHash)
+
+	if a.isAdminAccountName(existingAccount.AccountName) && existingAccount.Role != string(RoleAdmin) {
+		if err = a.accountDataAccessor.UpdateAccountRole(ctx, existingAccount.ID, string(RoleAdmin)); err != nil {
+			return CreateSessionOutput{}, err
+		}
+
+		existingAccount.Role = string(RoleAdmin)
+	}
+
+	sessionID, refreshToken, err := createSession(
+		ctx, a.goquDatabase, a.sessionDataAccessor, existingAccount.ID, a.refreshTokenExpiresIn)
 	if err != nil {
 		return CreateSessionOutput{}, err
 	}
 
-	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("token", token))
-	logger.Info("Token data")
+	token, _, err := a.tokenLogic.GetToken(
+		ctx, existingAccount.ID, sessionID, a.twoFactorRequirement.getAccountRoleList(existingAccount))
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
 
 	return CreateSessionOutput{
-		Account: a.databaseAccountToProtoAccount(existingAccount),
-		Token:   token,
+		Account:      databaseAccountToProtoAccount(existingAccount),
+		Token:        token,
+		RefreshToken: refreshToken,
 	}, nil
 }
@@ -0,0 +1,467 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"goload/internal/dataaccess/database"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/utils"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	accountDeletionBatchSize = 100
+	// maxReauthenticationLoginAge is how recently an account without a password must have logged in with single
+	// sign-on to confirm a sensitive change without a two-factor code.
+	maxReauthenticationLoginAge = 5 * time.Minute
+)
+
+var (
+	errIncorrectPassword    = status.Error(codes.Unauthenticated, "incorrect password")
+	errAccountHasNoPassword = status.Error(
+		codes.FailedPrecondition, "account has no password, it logs in with single sign-on")
+	errReauthenticationRequired = status.Error(
+		codes.FailedPrecondition, "log in again with single sign-on to confirm, the last login is too old")
+)
+
+type GetAccountParams struct{}
+
+type GetAccountOutput struct {
+	Account *go_load.Account
+}
+
+type ChangePasswordParams struct {
+	OldPassword string
+	NewPassword string
+	// ClientIP is empty when it is not known, failed attempts are then only counted per account name.
+	ClientIP string
+}
+
+type ChangePasswordOutput struct {
+	Account             *go_load.Account
+	Token               string
+	RefreshToken        string
+	RevokedSessionCount uint64
+}
+
+type DeleteAccountParams struct {
+	Password      string
+	TwoFactorCode string
+	// ClientIP is empty when it is not known, failed attempts are then only counted per account name.
+	ClientIP string
+}
+
+type DeleteAccountOutput struct {
+	DeletedDownloadTaskCount uint64
+}
+
+// verifyAccountPassword returns false without an error when the account has no password.
+func (a account) verifyAccountPassword(ctx context.Context, accountID uint64, password string) (bool, error) {
+	accountPassword, err := a.accountPasswordDataAccessor.GetAccountPassword(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	isHashEqual, err := a.hashLogic.IsHashEqual(ctx, password, accountPassword.Hash)
+	if err != nil {
+		return false, err
+	}
+
+	if !isHashEqual {
+		return false, errIncorrectPassword
+	}
+
+	return true, nil
+}
+
+// checkAccountPassword is verifyAccountPassword behind the login throttle, so that a stolen session cannot be used
+// to guess the password of its account.
+func (a account) checkAccountPassword(
+	ctx context.Context,
+	existingAccount database.Account,
+	password string,
+	clientIP string,
+) (bool, error) {
+	if err := a.loginThrottle.check(ctx, existingAccount.AccountName, clientIP); err != nil {
+		return false, err
+	}
+
+	hasPassword, err := a.verifyAccountPassword(ctx, existingAccount.ID, password)
+	if err != nil {
+		if errors.Is(err, errIncorrectPassword) {
+			a.loginThrottle.recordFailure(ctx, existingAccount.AccountName, clientIP)
+		}
+
+		return false, err
+	}
+
+	if hasPassword {
+		a.loginThrottle.recordSuccess(ctx, existingAccount.AccountName)
+	}
+
+	return hasPassword, nil
+}
+
+// reauthenticateAccount confirms that the caller knows the password of the account, instead of only holding one of
+// its tokens. An account without a password gives a TOTP code once it has enabled two-factor authentication, and
+// otherwise must have logged in with single sign-on within maxReauthenticationLoginAge.
+func (a account) reauthenticateAccount(
+	ctx context.Context,
+	existingAccount database.Account,
+	password string,
+	twoFactorCode string,
+	clientIP string,
+) error {
+	hasPassword, err := a.checkAccountPassword(ctx, existingAccount, password, clientIP)
+	if err != nil || hasPassword {
+		return err
+	}
+
+	if existingAccount.TwoFactorEnabledAt.Valid {
+		if twoFactorCode == "" {
+			return errTwoFactorCodeRequired
+		}
+
+		if err = a.loginThrottle.check(ctx, existingAccount.AccountName, clientIP); err != nil {
+			return err
+		}
+
+		if err = a.verifyTwoFactorCode(ctx, existingAccount.ID, twoFactorCode, false); err != nil {
+			if errors.Is(err, errInvalidTwoFactorCode) {
+				a.loginThrottle.recordFailure(ctx, existingAccount.AccountName, clientIP)
+			}
+
+			return err
+		}
+
+		a.loginThrottle.recordSuccess(ctx, existingAccount.AccountName)
+		return nil
+	}
+
+	principal, err := getPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+
+	// an API key is not a login
+	if principal.SessionID == 0 {
+		return errReauthenticationRequired
+	}
+
+	session, err := a.sessionDataAccessor.GetSession(ctx, principal.SessionID)
+	if err != nil {
+		return err
+	}
+
+	if time.Since(session.CreatedAt) > maxReauthenticationLoginAge {
+		return errReauthenticationRequired
+	}
+
+	return nil
+}
+
+func (a account) GetAccount(ctx context.Context, _ GetAccountParams) (GetAccountOutput, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return GetAccountOutput{}, err
+	}
+
+	existingAccount, err := a.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return GetAccountOutput{}, err
+	}
+
+	return GetAccountOutput{
+		Account: databaseAccountToProtoAccount(existingAccount),
+	}, nil
+}
+
+func (a account) ChangePassword(ctx context.Context, params ChangePasswordParams) (ChangePasswordOutput, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return ChangePasswordOutput{}, err
+	}
+
+	existingAccount, err := a.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return ChangePasswordOutput{}, err
+	}
+
+	hasPassword, err := a.checkAccountPassword(ctx, existingAccount, params.OldPassword, params.ClientIP)
+	if err != nil {
+		return ChangePasswordOutput{}, err
+	}
+
+	if !hasPassword {
+		return ChangePasswordOutput{}, errAccountHasNoPassword
+	}
+
+	if err = validatePassword(a.passwordPolicy, params.NewPassword); err != nil {
+		return ChangePasswordOutput{}, err
+	}
+
+	hashedPassword, err := a.hashLogic.Hash(ctx, params.NewPassword)
+	if err != nil {
+		return ChangePasswordOutput{}, err
+	}
+
+	var sessionIDList []uint64
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		updateErr := a.accountPasswordDataAccessor.WithDatabase(td).UpdateAccountPassword(ctx, database.AccountPassword{
+			OfAccountID: accountID,
+			Hash:        hashedPassword,
+		})
+		if updateErr != nil {
+			return updateErr
+		}
+
+		// whoever knew the old password may still hold a session
+		sessionIDList, updateErr = revokeActiveSessionListOfAccount(
+			ctx, a.sessionDataAccessor.WithDatabase(td), accountID, time.Now())
+		return updateErr
+	})
+	if txErr != nil {
+		return ChangePasswordOutput{}, txErr
+	}
+
+	if err = a.tokenLogic.RevokeSessionList(ctx, sessionIDList); err != nil {
+		return ChangePasswordOutput{}, err
+	}
+
+	sessionID, refreshToken, err := createSession(
+		ctx, a.goquDatabase, a.sessionDataAccessor, accountID, a.refreshTokenExpiresIn)
+	if err != nil {
+		return ChangePasswordOutput{}, err
+	}
+
+	token, _, err := a.tokenLogic.GetToken(
+		ctx, accountID, sessionID, a.twoFactorRequirement.getAccountRoleList(existingAccount))
+	if err != nil {
+		return ChangePasswordOutput{}, err
+	}
+
+	return ChangePasswordOutput{
+		Account:             databaseAccountToProtoAccount(existingAccount),
+		Token:               token,
+		RefreshToken:        refreshToken,
+		RevokedSessionCount: uint64(len(sessionIDList)),
+	}, nil
+}
+
+// disableAccountForDeletion disables the account and revokes its sessions, so nothing new is created for it while
+// its data is deleted.
+func (a account) disableAccountForDeletion(ctx context.Context, accountID uint64) error {
+	var sessionIDList []uint64
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		now := time.Now()
+		existingAccount, err := a.accountDataAccessor.WithDatabase(td).GetAccountByIDWithXLock(ctx, accountID)
+		if err != nil {
+			return err
+		}
+
+		if !existingAccount.DisabledAt.Valid {
+			err = a.accountDataAccessor.WithDatabase(td).
+				UpdateAccountDisabledAt(ctx, accountID, sql.NullTime{Time: now, Valid: true})
+			if err != nil {
+				return err
+			}
+		}
+
+		sessionIDList, err = revokeActiveSessionListOfAccount(ctx, a.sessionDataAccessor.WithDatabase(td), accountID, now)
+		return err
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	return a.tokenLogic.RevokeSessionList(ctx, sessionIDList)
+}
+
+// getWorkspaceSuccessorMap maps each workspace the account is a member of to another owner of it, who takes over
+// the download tasks the account created there. It fails when the account is the last owner of a workspace.
+func (a account) getWorkspaceSuccessorMap(
+	ctx context.Context,
+	db database.Database,
+	accountID uint64,
+) (map[uint64]uint64, error) {
+	memberList, err := a.workspaceMemberDataAccessor.WithDatabase(db).GetWorkspaceMemberListOfAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	successorMap := make(map[uint64]uint64, len(memberList))
+	for _, member := range memberList {
+		workspaceMemberList, getErr := a.workspaceMemberDataAccessor.WithDatabase(db).
+			GetWorkspaceMemberListOfWorkspace(ctx, member.OfWorkspaceID)
+		if getErr != nil {
+			return nil, getErr
+		}
+
+		successor, ok := lo.Find(workspaceMemberList, func(otherMember database.WorkspaceMember) bool {
+			return otherMember.OfAccountID != accountID && WorkspaceRole(otherMember.Role) == WorkspaceRoleOwner
+		})
+		if !ok {
+			if len(workspaceMemberList) == 1 {
+				return nil, status.Errorf(
+					codes.FailedPrecondition, "delete workspace %d first", member.OfWorkspaceID)
+			}
+
+			return nil, status.Errorf(
+				codes.FailedPrecondition, "make another member an owner of workspace %d first", member.OfWorkspaceID)
+		}
+
+		successorMap[member.OfWorkspaceID] = successor.OfAccountID
+	}
+
+	return successorMap, nil
+}
+
+// deleteDownloadTaskListOfAccount deletes the tasks of the account and their files in batches, so a large account
+// does not hold locks for long. It returns how many tasks were deleted.
+func (a account) deleteDownloadTaskListOfAccount(ctx context.Context, accountID uint64) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	deletedCount := uint64(0)
+	for {
+		taskList, err := a.downloadTaskDataAccessor.GetDownloadTaskList(
+			ctx,
+			database.DownloadTaskListFilter{OfAccountID: accountID},
+			database.DownloadTaskListSort{},
+			nil,
+			0,
+			accountDeletionBatchSize,
+		)
+		if err != nil {
+			return deletedCount, err
+		}
+
+		if len(taskList) == 0 {
+			return deletedCount, nil
+		}
+
+		err = a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+			for _, task := range taskList {
+				produceErr := a.lifecycleEventProducer.ProduceDownloadTaskLifecycleEvent(
+					ctx, td, task, &go_load.DownloadTaskLifecycleEvent{
+						Event: &go_load.DownloadTaskLifecycleEvent_Deleted{
+							Deleted: &go_load.DownloadTaskDeleted{Actor: getAccountActor(accountID)},
+						},
+					})
+				if produceErr != nil {
+					return produceErr
+				}
+			}
+
+			return a.downloadTaskDataAccessor.WithDatabase(td).DeleteDownloadTaskList(
+				ctx,
+				lo.Map(taskList, func(task database.DownloadTask, _ int) uint64 { return task.ID }),
+			)
+		})
+		if err != nil {
+			return deletedCount, err
+		}
+
+		// deleted once the rows are, so that a failed transaction leaves no task without its file
+		for _, task := range taskList {
+			if err = a.fileClient.Delete(ctx, getDownloadTaskFileName(task.ID)); err != nil {
+				// the retention job removes files without a matching task later on
+				logger.With(zap.Error(err)).With(zap.Uint64("id", task.ID)).
+					Warn("failed to delete file of download task of deleted account")
+			}
+		}
+
+		deletedCount += uint64(len(taskList))
+	}
+}
+
+func (a account) DeleteAccount(ctx context.Context, params DeleteAccountParams) (DeleteAccountOutput, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return DeleteAccountOutput{}, err
+	}
+
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	existingAccount, err := a.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return DeleteAccountOutput{}, err
+	}
+
+	err = a.reauthenticateAccount(ctx, existingAccount, params.Password, params.TwoFactorCode, params.ClientIP)
+	if err != nil {
+		return DeleteAccountOutput{}, err
+	}
+
+	// checked up front so the account is not left disabled, it is checked again when the memberships are deleted
+	if _, err = a.getWorkspaceSuccessorMap(ctx, a.goquDatabase, accountID); err != nil {
+		return DeleteAccountOutput{}, err
+	}
+
+	if err = a.disableAccountForDeletion(ctx, accountID); err != nil {
+		return DeleteAccountOutput{}, err
+	}
+
+	deletedDownloadTaskCount, err := a.deleteDownloadTaskListOfAccount(ctx, accountID)
+	if err != nil {
+		return DeleteAccountOutput{}, err
+	}
+
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		successorMap, successorErr := a.getWorkspaceSuccessorMap(ctx, td, accountID)
+		if successorErr != nil {
+			return successorErr
+		}
+
+		for workspaceID, successorAccountID := range successorMap {
+			updateErr := a.downloadTaskDataAccessor.WithDatabase(td).
+				UpdateDownloadTaskListOfAccountIDInWorkspace(ctx, workspaceID, accountID, successorAccountID)
+			if updateErr != nil {
+				return updateErr
+			}
+		}
+
+		for _, deleteFunc := range []func(context.Context, uint64) error{
+			a.shareLinkDataAccessor.WithDatabase(td).DeleteShareLinkListOfAccount,
+			a.workspaceMemberDataAccessor.WithDatabase(td).DeleteWorkspaceMemberListOfAccount,
+			a.webhookDeliveryDataAccessor.WithDatabase(td).DeleteWebhookDeliveryListOfAccount,
+			a.webhookDataAccessor.WithDatabase(td).DeleteWebhookListOfAccount,
+			a.apiKeyDataAccessor.WithDatabase(td).DeleteAPIKeyListOfAccount,
+			a.accountOIDCIdentityDataAccessor.WithDatabase(td).DeleteAccountOIDCIdentityListOfAccount,
+			a.accountRecoveryCodeDataAccessor.WithDatabase(td).DeleteAccountRecoveryCodeListOfAccount,
+			a.accountTOTPDataAccessor.WithDatabase(td).DeleteAccountTOTP,
+			a.sessionDataAccessor.WithDatabase(td).DeleteSessionListOfAccount,
+			a.accountPasswordDataAccessor.WithDatabase(td).DeleteAccountPassword,
+			a.accountDataAccessor.WithDatabase(td).DeleteAccount,
+		} {
+			if deleteErr := deleteFunc(ctx, accountID); deleteErr != nil {
+				return deleteErr
+			}
+		}
+
+		return nil
+	})
+	if txErr != nil {
+		return DeleteAccountOutput{}, txErr
+	}
+
+	// the account is already gone, a failure here only keeps CreateAccount rejecting the name
+	if err = a.takenAccountNameCache.Remove(ctx, existingAccount.AccountName); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to remove account name of deleted account from taken set in cache")
+	}
+
+	logger.With(zap.Uint64("deleted_download_task_count", deletedDownloadTaskCount)).Info("deleted account")
+	return DeleteAccountOutput{
+		DeletedDownloadTaskCount: deletedDownloadTaskCount,
+	}, nil
+}
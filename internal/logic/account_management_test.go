@@ -0,0 +1,242 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/cache"
+	"goload/internal/dataaccess/database"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const testAccountPassword = "correct horse battery staple"
+
+func newTestLoginThrottle(t *testing.T, maxFailedAttemptCountPerAccount uint64) loginThrottle {
+	t.Helper()
+
+	throttle, err := newLoginThrottle(
+		cache.NewFailedLoginAttempt(cache.NewInMemoryClient(zap.NewNop()), zap.NewNop()),
+		configs.LoginThrottle{
+			MaxFailedAttemptCountPerAccount:  maxFailedAttemptCountPerAccount,
+			MaxFailedAttemptCountPerClientIP: 100,
+			LockoutDuration:                  "1m",
+			InitialDelay:                     "1ms",
+			MaxDelay:                         "1ms",
+		},
+		zap.NewNop(),
+	)
+	if err != nil {
+		t.Fatalf("failed to create login throttle: %v", err)
+	}
+
+	return throttle
+}
+
+// newTestAccount returns account logic over a store holding one account, with a password when hasPassword is set.
+func newTestAccount(t *testing.T, hasPassword bool) (account, *mockStore) {
+	t.Helper()
+
+	encryptionKey := make([]byte, 32)
+	if _, err := rand.Read(encryptionKey); err != nil {
+		t.Fatalf("failed to generate encryption key: %v", err)
+	}
+
+	totpSecretAEAD, err := parseEncryptionKey(base64.StdEncoding.EncodeToString(encryptionKey))
+	if err != nil {
+		t.Fatalf("failed to parse encryption key: %v", err)
+	}
+
+	store := newMockStore()
+	store.accountList = []database.Account{{ID: 1, AccountName: "alice123", Role: string(RoleUser)}}
+	if hasPassword {
+		store.passwordHashMap[1] = "hashed:" + testAccountPassword
+	}
+
+	return account{
+		goquDatabase:                newMockGoquDatabase(),
+		accountDataAccessor:         mockAccountDataAccessor{store: store},
+		accountPasswordDataAccessor: mockAccountPasswordDataAccessor{store: store},
+		accountTOTPDataAccessor:     mockAccountTOTPDataAccessor{store: store},
+		sessionDataAccessor:         mockSessionDataAccessor{store: store},
+		loginThrottle:               newTestLoginThrottle(t, 3),
+		hashLogic:                   mockHash{},
+		totpSecretAEAD:              totpSecretAEAD,
+		logger:                      zap.NewNop(),
+	}, store
+}
+
+// enableTestTwoFactor enables two-factor authentication of the account and returns a valid TOTP code.
+func enableTestTwoFactor(t *testing.T, a account, store *mockStore) string {
+	t.Helper()
+
+	secret := make([]byte, totpSecretByteCount)
+	if _, err := rand.Read(secret); err != nil {
+		t.Fatalf("failed to generate totp secret: %v", err)
+	}
+
+	encryptedSecret, err := encryptTOTPSecret(a.totpSecretAEAD, 1, secret)
+	if err != nil {
+		t.Fatalf("failed to encrypt totp secret: %v", err)
+	}
+
+	store.totpMap[1] = database.AccountTOTP{OfAccountID: 1, EncryptedSecret: encryptedSecret}
+	store.accountList[0].TwoFactorEnabledAt = sql.NullTime{Time: time.Now(), Valid: true}
+	return generateTOTPCode(secret, uint64(time.Now().Unix())/uint64(totpPeriod/time.Second))
+}
+
+// withTestSession adds a session created loginAge ago, and returns a context with a principal of it.
+func withTestSession(store *mockStore, loginAge time.Duration) context.Context {
+	createdAt := time.Now().Add(-loginAge)
+	store.sessionList = append(store.sessionList, database.Session{
+		ID:          uint64(len(store.sessionList) + 1),
+		OfAccountID: 1,
+		CreatedAt:   createdAt,
+		RefreshedAt: createdAt,
+		ExpiresAt:   createdAt.Add(24 * time.Hour),
+	})
+
+	return WithPrincipal(context.Background(), Principal{
+		AccountID: 1,
+		SessionID: uint64(len(store.sessionList)),
+		RoleList:  []Role{RoleUser},
+	})
+}
+
+func TestReauthenticateAccount(t *testing.T) {
+	testCaseList := []struct {
+		name             string
+		hasPassword      bool
+		hasTwoFactor     bool
+		password         string
+		useTwoFactorCode bool
+		twoFactorCode    string
+		loginAge         time.Duration
+		isAPIKey         bool
+		expectedErr      error
+	}{
+		{name: "correct password", hasPassword: true, password: testAccountPassword, loginAge: time.Hour},
+		{
+			name: "incorrect password", hasPassword: true, password: "wrong", loginAge: time.Minute,
+			expectedErr: errIncorrectPassword,
+		},
+		{name: "sso account with recent login", loginAge: time.Minute},
+		{name: "sso account with old login", loginAge: time.Hour, expectedErr: errReauthenticationRequired},
+		{
+			name: "sso account with api key", loginAge: time.Minute, isAPIKey: true,
+			expectedErr: errReauthenticationRequired,
+		},
+		{
+			name: "sso account with two-factor and no code", hasTwoFactor: true, loginAge: time.Minute,
+			expectedErr: errTwoFactorCodeRequired,
+		},
+		{
+			name: "sso account with two-factor and wrong code", hasTwoFactor: true, twoFactorCode: "000000",
+			loginAge: time.Minute, expectedErr: errInvalidTwoFactorCode,
+		},
+		{
+			name: "sso account with two-factor and valid code", hasTwoFactor: true, useTwoFactorCode: true,
+			loginAge: time.Hour,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			a, store := newTestAccount(t, testCase.hasPassword)
+			twoFactorCode := testCase.twoFactorCode
+			if testCase.hasTwoFactor {
+				validTwoFactorCode := enableTestTwoFactor(t, a, store)
+				if testCase.useTwoFactorCode {
+					twoFactorCode = validTwoFactorCode
+				} else if twoFactorCode == validTwoFactorCode {
+					t.Skip("the wrong code happens to be valid")
+				}
+			}
+
+			ctx := withTestSession(store, testCase.loginAge)
+			if testCase.isAPIKey {
+				ctx = WithPrincipal(context.Background(), Principal{AccountID: 1, APIKeyID: 1})
+			}
+
+			err := a.reauthenticateAccount(ctx, store.accountList[0], testCase.password, twoFactorCode, "192.0.2.1")
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("expected error %v, got %v", testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestCheckAccountPasswordIsThrottled(t *testing.T) {
+	a, store := newTestAccount(t, true)
+	ctx := withTestSession(store, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		if _, err := a.checkAccountPassword(ctx, store.accountList[0], "wrong", "192.0.2.1"); !errors.Is(
+			err, errIncorrectPassword) {
+			t.Fatalf("attempt %d: expected incorrect password, got %v", i, err)
+		}
+	}
+
+	// the correct password is refused too once the account is locked out
+	if _, err := a.checkAccountPassword(ctx, store.accountList[0], testAccountPassword, "192.0.2.1"); !errors.Is(
+		err, errTooManyFailedLoginAttempts) {
+		t.Fatalf("expected the account to be locked out, got %v", err)
+	}
+}
+
+func TestChangePasswordIsThrottled(t *testing.T) {
+	a, store := newTestAccount(t, true)
+	ctx := withTestSession(store, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		_, err := a.ChangePassword(ctx, ChangePasswordParams{
+			OldPassword: "wrong",
+			NewPassword: "a new password",
+			ClientIP:    "192.0.2.1",
+		})
+		if !errors.Is(err, errIncorrectPassword) {
+			t.Fatalf("attempt %d: expected incorrect password, got %v", i, err)
+		}
+	}
+
+	_, err := a.ChangePassword(ctx, ChangePasswordParams{
+		OldPassword: testAccountPassword,
+		NewPassword: "a new password",
+		ClientIP:    "192.0.2.1",
+	})
+	if !errors.Is(err, errTooManyFailedLoginAttempts) {
+		t.Fatalf("expected the account to be locked out, got %v", err)
+	}
+
+	if store.passwordHashMap[1] != "hashed:"+testAccountPassword {
+		t.Fatal("expected the password to be left unchanged")
+	}
+}
+
+func TestEnrollTwoFactorRequiresRecentLoginWithoutPassword(t *testing.T) {
+	testCaseList := []struct {
+		name        string
+		loginAge    time.Duration
+		expectedErr error
+	}{
+		{name: "recent login", loginAge: time.Minute},
+		{name: "old login", loginAge: time.Hour, expectedErr: errReauthenticationRequired},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			a, store := newTestAccount(t, false)
+			ctx := withTestSession(store, testCase.loginAge)
+
+			_, err := a.EnrollTwoFactor(ctx, EnrollTwoFactorParams{ClientIP: "192.0.2.1"})
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("expected error %v, got %v", testCase.expectedErr, err)
+			}
+		})
+	}
+}
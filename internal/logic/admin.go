@@ -0,0 +1,319 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/database"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/utils"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultAccountListLimit = 100
+)
+
+var (
+	errCannotUpdateOwnAccount = status.Error(codes.FailedPrecondition, "cannot change the role of or disable own account")
+)
+
+type GetAccountListParams struct {
+	Offset uint64
+	Limit  uint64
+}
+
+type GetAccountListOutput struct {
+	AccountList       []*go_load.AdminAccount
+	TotalAccountCount uint64
+}
+
+type UpdateAccountRoleParams struct {
+	AccountID uint64
+	Role      go_load.Role
+}
+
+type UpdateAccountRoleOutput struct {
+	Account *go_load.AdminAccount
+}
+
+type DisableAccountParams struct {
+	AccountID uint64
+}
+
+type DisableAccountOutput struct {
+	Account *go_load.AdminAccount
+}
+
+type EnableAccountParams struct {
+	AccountID uint64
+}
+
+type EnableAccountOutput struct {
+	Account *go_load.AdminAccount
+}
+
+type UpdateAccountQuotaParams struct {
+	AccountID uint64
+	Quota     *go_load.AccountQuota
+}
+
+type UpdateAccountQuotaOutput struct {
+	Account *go_load.AdminAccount
+}
+
+// Admin manages accounts. Viewing accounts requires RoleOperator, changing them requires RoleAdmin.
+type Admin interface {
+	GetAccountList(ctx context.Context, params GetAccountListParams) (GetAccountListOutput, error)
+	// UpdateAccountRole and DisableAccount revoke the sessions of the account, so that its tokens stop carrying
+	// the old role right away.
+	UpdateAccountRole(ctx context.Context, params UpdateAccountRoleParams) (UpdateAccountRoleOutput, error)
+	DisableAccount(ctx context.Context, params DisableAccountParams) (DisableAccountOutput, error)
+	EnableAccount(ctx context.Context, params EnableAccountParams) (EnableAccountOutput, error)
+	UpdateAccountQuota(ctx context.Context, params UpdateAccountQuotaParams) (UpdateAccountQuotaOutput, error)
+}
+
+type admin struct {
+	goquDatabase        *goqu.Database
+	accountDataAccessor database.AccountDataAccessor
+	sessionDataAccessor database.SessionDataAccessor
+	tokenLogic          Token
+	quotaConfig         configs.Quota
+	logger              *zap.Logger
+}
+
+func NewAdmin(
+	goquDatabase *goqu.Database,
+	accountDataAccessor database.AccountDataAccessor,
+	sessionDataAccessor database.SessionDataAccessor,
+	tokenLogic Token,
+	quotaConfig configs.Quota,
+	logger *zap.Logger,
+) Admin {
+	return &admin{
+		goquDatabase:        goquDatabase,
+		accountDataAccessor: accountDataAccessor,
+		sessionDataAccessor: sessionDataAccessor,
+		tokenLogic:          tokenLogic,
+		quotaConfig:         quotaConfig,
+		logger:              logger,
+	}
+}
+
+func nullInt64ToProtoUint64(nullInt64 sql.NullInt64) *uint64 {
+	if !nullInt64.Valid {
+		return nil
+	}
+
+	return proto.Uint64(uint64(nullInt64.Int64))
+}
+
+func protoUint64ToNullInt64(value *uint64) sql.NullInt64 {
+	if value == nil {
+		return sql.NullInt64{}
+	}
+
+	return sql.NullInt64{Int64: int64(*value), Valid: true}
+}
+
+func (a admin) databaseAccountToProtoAdminAccount(account database.Account) *go_load.AdminAccount {
+	effectiveQuota := getAccountQuota(account, a.quotaConfig)
+	return &go_load.AdminAccount{
+		Account:    databaseAccountToProtoAccount(account),
+		DisabledAt: nullTimeToProtoTimestamp(account.DisabledAt),
+		Quota: &go_load.AccountQuota{
+			MaxDownloadTaskCount:       nullInt64ToProtoUint64(account.QuotaMaxDownloadTaskCount),
+			MaxActiveDownloadTaskCount: nullInt64ToProtoUint64(account.QuotaMaxActiveDownloadTaskCount),
+		},
+		EffectiveQuota: &go_load.AccountQuota{
+			MaxDownloadTaskCount:       proto.Uint64(effectiveQuota.MaxDownloadTaskCount),
+			MaxActiveDownloadTaskCount: proto.Uint64(effectiveQuota.MaxActiveDownloadTaskCount),
+		},
+	}
+}
+
+func (a admin) GetAccountList(ctx context.Context, params GetAccountListParams) (GetAccountListOutput, error) {
+	if _, err := getPrincipalWithRole(ctx, RoleOperator); err != nil {
+		return GetAccountListOutput{}, err
+	}
+
+	limit := params.Limit
+	if limit == 0 || limit > defaultAccountListLimit {
+		limit = defaultAccountListLimit
+	}
+
+	totalAccountCount, err := a.accountDataAccessor.GetAccountCount(ctx)
+	if err != nil {
+		return GetAccountListOutput{}, err
+	}
+
+	accountList, err := a.accountDataAccessor.GetAccountList(ctx, params.Offset, limit)
+	if err != nil {
+		return GetAccountListOutput{}, err
+	}
+
+	return GetAccountListOutput{
+		AccountList: lo.Map(accountList, func(account database.Account, _ int) *go_load.AdminAccount {
+			return a.databaseAccountToProtoAdminAccount(account)
+		}),
+		TotalAccountCount: totalAccountCount,
+	}, nil
+}
+
+// updateAccount locks the account, applies the update and revokes the sessions of the account if requested.
+func (a admin) updateAccount(
+	ctx context.Context,
+	accountID uint64,
+	revokeSessionList bool,
+	update func(db database.Database, account *database.Account) error,
+) (*go_load.AdminAccount, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	var (
+		account       database.Account
+		sessionIDList []uint64
+	)
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		var err error
+		account, err = a.accountDataAccessor.WithDatabase(td).GetAccountByIDWithXLock(ctx, accountID)
+		if err != nil {
+			return err
+		}
+
+		if err = update(td, &account); err != nil {
+			return err
+		}
+
+		if !revokeSessionList {
+			return nil
+		}
+
+		sessionIDList, err = revokeActiveSessionListOfAccount(
+			ctx, a.sessionDataAccessor.WithDatabase(td), accountID, time.Now())
+		return err
+	})
+	if txErr != nil {
+		return nil, txErr
+	}
+
+	if err := a.tokenLogic.RevokeSessionList(ctx, sessionIDList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to add sessions of updated account to revocation list")
+		return nil, err
+	}
+
+	return a.databaseAccountToProtoAdminAccount(account), nil
+}
+
+func (a admin) UpdateAccountRole(
+	ctx context.Context,
+	params UpdateAccountRoleParams,
+) (UpdateAccountRoleOutput, error) {
+	principal, err := getPrincipalWithRole(ctx, RoleAdmin)
+	if err != nil {
+		return UpdateAccountRoleOutput{}, err
+	}
+
+	if principal.AccountID == params.AccountID {
+		return UpdateAccountRoleOutput{}, errCannotUpdateOwnAccount
+	}
+
+	role, ok := protoRoleToRole(params.Role)
+	if !ok {
+		return UpdateAccountRoleOutput{}, status.Error(codes.InvalidArgument, "invalid role")
+	}
+
+	account, err := a.updateAccount(ctx, params.AccountID, true, func(
+		db database.Database,
+		account *database.Account,
+	) error {
+		account.Role = string(role)
+		return a.accountDataAccessor.WithDatabase(db).UpdateAccountRole(ctx, account.ID, account.Role)
+	})
+	if err != nil {
+		return UpdateAccountRoleOutput{}, err
+	}
+
+	return UpdateAccountRoleOutput{Account: account}, nil
+}
+
+func (a admin) DisableAccount(ctx context.Context, params DisableAccountParams) (DisableAccountOutput, error) {
+	principal, err := getPrincipalWithRole(ctx, RoleAdmin)
+	if err != nil {
+		return DisableAccountOutput{}, err
+	}
+
+	if principal.AccountID == params.AccountID {
+		return DisableAccountOutput{}, errCannotUpdateOwnAccount
+	}
+
+	account, err := a.updateAccount(ctx, params.AccountID, true, func(
+		db database.Database,
+		account *database.Account,
+	) error {
+		if account.DisabledAt.Valid {
+			return nil
+		}
+
+		account.DisabledAt = sql.NullTime{Time: time.Now(), Valid: true}
+		return a.accountDataAccessor.WithDatabase(db).UpdateAccountDisabledAt(ctx, account.ID, account.DisabledAt)
+	})
+	if err != nil {
+		return DisableAccountOutput{}, err
+	}
+
+	return DisableAccountOutput{Account: account}, nil
+}
+
+func (a admin) EnableAccount(ctx context.Context, params EnableAccountParams) (EnableAccountOutput, error) {
+	if _, err := getPrincipalWithRole(ctx, RoleAdmin); err != nil {
+		return EnableAccountOutput{}, err
+	}
+
+	account, err := a.updateAccount(ctx, params.AccountID, false, func(
+		db database.Database,
+		account *database.Account,
+	) error {
+		account.DisabledAt = sql.NullTime{}
+		return a.accountDataAccessor.WithDatabase(db).UpdateAccountDisabledAt(ctx, account.ID, account.DisabledAt)
+	})
+	if err != nil {
+		return EnableAccountOutput{}, err
+	}
+
+	return EnableAccountOutput{Account: account}, nil
+}
+
+func (a admin) UpdateAccountQuota(
+	ctx context.Context,
+	params UpdateAccountQuotaParams,
+) (UpdateAccountQuotaOutput, error) {
+	if _, err := getPrincipalWithRole(ctx, RoleAdmin); err != nil {
+		return UpdateAccountQuotaOutput{}, err
+	}
+
+	quota := params.Quota
+	if quota == nil {
+		quota = &go_load.AccountQuota{}
+	}
+
+	account, err := a.updateAccount(ctx, params.AccountID, false, func(
+		db database.Database,
+		account *database.Account,
+	) error {
+		account.QuotaMaxDownloadTaskCount = protoUint64ToNullInt64(quota.MaxDownloadTaskCount)
+		account.QuotaMaxActiveDownloadTaskCount = protoUint64ToNullInt64(quota.MaxActiveDownloadTaskCount)
+		return a.accountDataAccessor.WithDatabase(db).UpdateAccountQuota(ctx, *account)
+	})
+	if err != nil {
+		return UpdateAccountQuotaOutput{}, err
+	}
+
+	return UpdateAccountQuotaOutput{Account: account}, nil
+}
@@ -0,0 +1,90 @@
+package logic
+
+import (
+	"context"
+	"goload/internal/dataaccess/database"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func TestUpdateAccountAsAdmin(t *testing.T) {
+	updateRole := func(a admin, ctx context.Context, accountID uint64) error {
+		_, err := a.UpdateAccountRole(ctx, UpdateAccountRoleParams{AccountID: accountID, Role: go_load.Role_ROLE_ADMIN})
+		return err
+	}
+	disable := func(a admin, ctx context.Context, accountID uint64) error {
+		_, err := a.DisableAccount(ctx, DisableAccountParams{AccountID: accountID})
+		return err
+	}
+
+	testCaseList := []struct {
+		name            string
+		update          func(a admin, ctx context.Context, accountID uint64) error
+		callerRole      Role
+		accountID       uint64
+		expectedErrCode codes.Code
+	}{
+		{name: "admin updates role", update: updateRole, callerRole: RoleAdmin, accountID: 2},
+		{
+			name: "operator cannot update role", update: updateRole, callerRole: RoleOperator, accountID: 2,
+			expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name: "user cannot update role", update: updateRole, callerRole: RoleUser, accountID: 2,
+			expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name: "admin cannot update own role", update: updateRole, callerRole: RoleAdmin, accountID: 1,
+			expectedErrCode: codes.FailedPrecondition,
+		},
+		{name: "admin disables account", update: disable, callerRole: RoleAdmin, accountID: 2},
+		{
+			name: "operator cannot disable account", update: disable, callerRole: RoleOperator, accountID: 2,
+			expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name: "admin cannot disable own account", update: disable, callerRole: RoleAdmin, accountID: 1,
+			expectedErrCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			store := newMockStore()
+			store.accountList = []database.Account{
+				{ID: 1, AccountName: "caller", Role: string(testCase.callerRole)},
+				{ID: 2, AccountName: "target", Role: string(RoleUser)},
+			}
+			store.sessionList = []database.Session{{ID: 1, OfAccountID: 2, ExpiresAt: time.Now().Add(time.Hour)}}
+			a := admin{
+				goquDatabase:        newMockGoquDatabase(),
+				accountDataAccessor: mockAccountDataAccessor{store: store},
+				sessionDataAccessor: mockSessionDataAccessor{store: store},
+				tokenLogic:          mockTokenLogic{store: store},
+				logger:              zap.NewNop(),
+			}
+			ctx := WithPrincipal(context.Background(), Principal{
+				AccountID: 1,
+				SessionID: 1,
+				RoleList:  []Role{testCase.callerRole},
+			})
+
+			err := testCase.update(a, ctx, testCase.accountID)
+			requireStatusCode(t, err, testCase.expectedErrCode)
+
+			// the target is logged out only by a successful update, so its new role or state applies right away
+			updated := testCase.expectedErrCode == codes.OK
+			if store.sessionList[0].RevokedAt.Valid != updated || (len(store.revokedSessionList) == 1) != updated {
+				t.Fatalf("expected sessions of the account revoked %t", updated)
+			}
+
+			if !updated && (store.accountList[1].Role != string(RoleUser) || store.accountList[1].DisabledAt.Valid) {
+				t.Fatal("expected the account to be left unchanged")
+			}
+		})
+	}
+}
@@ -0,0 +1,361 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/database"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/utils"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type APIKeyScope string
+
+const (
+	APIKeyScopeDownloadTaskRead  APIKeyScope = "download_task:read"
+	APIKeyScopeDownloadTaskWrite APIKeyScope = "download_task:write"
+	APIKeyScopeWebhookRead       APIKeyScope = "webhook:read"
+	APIKeyScopeWebhookWrite      APIKeyScope = "webhook:write"
+	APIKeyScopeAdmin             APIKeyScope = "admin"
+)
+
+const (
+	apiKeyPrefix                   = "gl_"
+	apiKeySecretByteCount          = 32
+	apiKeySecretDigestPrefix       = "sha256:"
+	apiKeyScopeSeparator           = ","
+	maxAPIKeyCountPerAccount       = 32
+	apiKeyLastUsedAtUpdateInterval = time.Minute
+)
+
+var (
+	protoAPIKeyScopeMap = map[go_load.ApiKeyScope]APIKeyScope{
+		go_load.ApiKeyScope_API_KEY_SCOPE_DOWNLOAD_TASK_READ:  APIKeyScopeDownloadTaskRead,
+		go_load.ApiKeyScope_API_KEY_SCOPE_DOWNLOAD_TASK_WRITE: APIKeyScopeDownloadTaskWrite,
+		go_load.ApiKeyScope_API_KEY_SCOPE_WEBHOOK_READ:        APIKeyScopeWebhookRead,
+		go_load.ApiKeyScope_API_KEY_SCOPE_WEBHOOK_WRITE:       APIKeyScopeWebhookWrite,
+		go_load.ApiKeyScope_API_KEY_SCOPE_ADMIN:               APIKeyScopeAdmin,
+	}
+
+	errInvalidAPIKey = status.Error(codes.Unauthenticated, "invalid api key")
+)
+
+type CreateAPIKeyParams struct {
+	Name      string
+	ScopeList []go_load.ApiKeyScope
+	ExpiresAt *time.Time
+}
+
+type CreateAPIKeyOutput struct {
+	APIKey *go_load.ApiKey
+	Key    string
+}
+
+type ListAPIKeysParams struct{}
+
+type ListAPIKeysOutput struct {
+	APIKeyList []*go_load.ApiKey
+}
+
+type RevokeAPIKeyParams struct {
+	APIKeyID uint64
+}
+
+type APIKey interface {
+	CreateAPIKey(ctx context.Context, params CreateAPIKeyParams) (CreateAPIKeyOutput, error)
+	ListAPIKeys(ctx context.Context, params ListAPIKeysParams) (ListAPIKeysOutput, error)
+	RevokeAPIKey(ctx context.Context, params RevokeAPIKeyParams) error
+	// Authenticate validates the key and returns the principal it was issued to.
+	Authenticate(ctx context.Context, key string) (Principal, error)
+}
+
+type apiKey struct {
+	apiKeyDataAccessor  database.APIKeyDataAccessor
+	accountDataAccessor database.AccountDataAccessor
+	// hashLogic only verifies legacy secrets, new ones are stored as a SHA-256 digest.
+	hashLogic            Hash
+	twoFactorRequirement twoFactorRequirement
+	logger               *zap.Logger
+}
+
+func NewAPIKey(
+	apiKeyDataAccessor database.APIKeyDataAccessor,
+	accountDataAccessor database.AccountDataAccessor,
+	hashLogic Hash,
+	authConfig configs.Auth,
+	logger *zap.Logger,
+) APIKey {
+	return &apiKey{
+		apiKeyDataAccessor:   apiKeyDataAccessor,
+		accountDataAccessor:  accountDataAccessor,
+		hashLogic:            hashLogic,
+		twoFactorRequirement: newTwoFactorRequirement(authConfig.TwoFactor),
+		logger:               logger,
+	}
+}
+
+// IsAPIKey tells API keys apart from access tokens, both can be sent the same way.
+func IsAPIKey(credential string) bool {
+	return strings.HasPrefix(credential, apiKeyPrefix)
+}
+
+func generateAPIKeySecret() (string, error) {
+	secretBytes := make([]byte, apiKeySecretByteCount)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secretBytes), nil
+}
+
+// getAPIKeySecretDigest is what is stored for the secret of a key. Unlike passwords, secrets are not hashed with
+// Hash on purpose: they are 256 random bits, so a slow hash adds nothing against guessing them, while argon2id or
+// bcrypt would cost every request made with an API key tens of milliseconds and megabytes of memory. Hash is only
+// kept to verify the keys created before, see isAPIKeySecretEqual.
+func getAPIKeySecretDigest(secret string) string {
+	digest := sha256.Sum256([]byte(secret))
+	return apiKeySecretDigestPrefix + hex.EncodeToString(digest[:])
+}
+
+// isAPIKeySecretEqual also accepts keys created while secrets were stored as password hashes, it reports whether
+// the stored hash should be replaced by the digest.
+func (a apiKey) isAPIKeySecretEqual(ctx context.Context, secret, keyHash string) (bool, bool, error) {
+	if strings.HasPrefix(keyHash, apiKeySecretDigestPrefix) {
+		return subtle.ConstantTimeCompare([]byte(getAPIKeySecretDigest(secret)), []byte(keyHash)) == 1, false, nil
+	}
+
+	isHashEqual, err := a.hashLogic.IsHashEqual(ctx, secret, keyHash)
+	if err != nil {
+		return false, false, err
+	}
+
+	return isHashEqual, isHashEqual, nil
+}
+
+// An API key is "gl_<id>_<secret>", only the digest of the secret is stored.
+func parseAPIKey(key string) (uint64, string, bool) {
+	idString, secret, found := strings.Cut(strings.TrimPrefix(key, apiKeyPrefix), "_")
+	if !found || secret == "" {
+		return 0, "", false
+	}
+
+	id, err := strconv.ParseUint(idString, 10, 64)
+	if err != nil {
+		return 0, "", false
+	}
+
+	return id, secret, true
+}
+
+func parseAPIKeyScopeList(scopes string) []APIKeyScope {
+	return lo.Map(strings.Split(scopes, apiKeyScopeSeparator), func(scope string, _ int) APIKeyScope {
+		return APIKeyScope(scope)
+	})
+}
+
+func databaseAPIKeyToProtoAPIKey(apiKey database.APIKey) *go_load.ApiKey {
+	scopeList := parseAPIKeyScopeList(apiKey.Scopes)
+	protoScopeList := make([]go_load.ApiKeyScope, 0, len(scopeList))
+	for protoScope, scope := range protoAPIKeyScopeMap {
+		if lo.Contains(scopeList, scope) {
+			protoScopeList = append(protoScopeList, protoScope)
+		}
+	}
+
+	sort.Slice(protoScopeList, func(i, j int) bool { return protoScopeList[i] < protoScopeList[j] })
+	return &go_load.ApiKey{
+		Id:         apiKey.ID,
+		Name:       apiKey.Name,
+		ScopeList:  protoScopeList,
+		CreatedAt:  timestamppb.New(apiKey.CreatedAt),
+		ExpiresAt:  nullTimeToProtoTimestamp(apiKey.ExpiresAt),
+		LastUsedAt: nullTimeToProtoTimestamp(apiKey.LastUsedAt),
+	}
+}
+
+func (a apiKey) CreateAPIKey(ctx context.Context, params CreateAPIKeyParams) (CreateAPIKeyOutput, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return CreateAPIKeyOutput{}, err
+	}
+
+	if params.Name == "" || len(params.Name) > 128 {
+		return CreateAPIKeyOutput{}, status.Error(codes.InvalidArgument, "name must be 1 to 128 characters long")
+	}
+
+	if len(params.ScopeList) == 0 {
+		return CreateAPIKeyOutput{}, status.Error(codes.InvalidArgument, "at least one scope is required")
+	}
+
+	scopeList := make([]string, 0, len(params.ScopeList))
+	for _, protoScope := range lo.Uniq(params.ScopeList) {
+		scope, ok := protoAPIKeyScopeMap[protoScope]
+		if !ok {
+			return CreateAPIKeyOutput{}, status.Errorf(codes.InvalidArgument, "invalid scope %s", protoScope.String())
+		}
+
+		scopeList = append(scopeList, string(scope))
+	}
+
+	now := time.Now()
+	newAPIKey := database.APIKey{
+		OfAccountID: accountID,
+		Name:        params.Name,
+		Scopes:      strings.Join(scopeList, apiKeyScopeSeparator),
+		CreatedAt:   now,
+	}
+	if params.ExpiresAt != nil {
+		if !params.ExpiresAt.After(now) {
+			return CreateAPIKeyOutput{}, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+		}
+
+		newAPIKey.ExpiresAt = sql.NullTime{Time: *params.ExpiresAt, Valid: true}
+	}
+
+	apiKeyList, err := a.apiKeyDataAccessor.GetAPIKeyListOfAccount(ctx, accountID)
+	if err != nil {
+		return CreateAPIKeyOutput{}, err
+	}
+
+	if len(apiKeyList) >= maxAPIKeyCountPerAccount {
+		return CreateAPIKeyOutput{}, status.Errorf(
+			codes.ResourceExhausted, "an account can have at most %d api keys", maxAPIKeyCountPerAccount)
+	}
+
+	secret, err := generateAPIKeySecret()
+	if err != nil {
+		utils.LoggerWithContext(ctx, a.logger).With(zap.Error(err)).Error("failed to generate api key secret")
+		return CreateAPIKeyOutput{}, status.Error(codes.Internal, "failed to generate api key")
+	}
+
+	newAPIKey.KeyHash = getAPIKeySecretDigest(secret)
+
+	newAPIKey.ID, err = a.apiKeyDataAccessor.CreateAPIKey(ctx, newAPIKey)
+	if err != nil {
+		return CreateAPIKeyOutput{}, err
+	}
+
+	return CreateAPIKeyOutput{
+		APIKey: databaseAPIKeyToProtoAPIKey(newAPIKey),
+		Key:    fmt.Sprintf("%s%d_%s", apiKeyPrefix, newAPIKey.ID, secret),
+	}, nil
+}
+
+func (a apiKey) ListAPIKeys(ctx context.Context, _ ListAPIKeysParams) (ListAPIKeysOutput, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return ListAPIKeysOutput{}, err
+	}
+
+	apiKeyList, err := a.apiKeyDataAccessor.GetAPIKeyListOfAccount(ctx, accountID)
+	if err != nil {
+		return ListAPIKeysOutput{}, err
+	}
+
+	return ListAPIKeysOutput{
+		APIKeyList: lo.Map(apiKeyList, func(apiKey database.APIKey, _ int) *go_load.ApiKey {
+			return databaseAPIKeyToProtoAPIKey(apiKey)
+		}),
+	}, nil
+}
+
+func (a apiKey) RevokeAPIKey(ctx context.Context, params RevokeAPIKeyParams) error {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return err
+	}
+
+	existingAPIKey, err := a.apiKeyDataAccessor.GetAPIKey(ctx, params.APIKeyID)
+	if err != nil {
+		return err
+	}
+
+	if existingAPIKey.OfAccountID != accountID {
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	if existingAPIKey.RevokedAt.Valid {
+		return nil
+	}
+
+	return a.apiKeyDataAccessor.UpdateAPIKeyRevokedAt(ctx, existingAPIKey.ID, time.Now())
+}
+
+func (a apiKey) Authenticate(ctx context.Context, key string) (Principal, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	apiKeyID, secret, ok := parseAPIKey(key)
+	if !ok {
+		return Principal{}, errInvalidAPIKey
+	}
+
+	existingAPIKey, err := a.apiKeyDataAccessor.GetAPIKey(ctx, apiKeyID)
+	if err != nil {
+		if errors.Is(err, database.ErrAPIKeyNotFound) {
+			return Principal{}, errInvalidAPIKey
+		}
+
+		return Principal{}, err
+	}
+
+	now := time.Now()
+	if existingAPIKey.RevokedAt.Valid || (existingAPIKey.ExpiresAt.Valid && !now.Before(existingAPIKey.ExpiresAt.Time)) {
+		return Principal{}, errInvalidAPIKey
+	}
+
+	isSecretEqual, shouldUpdateKeyHash, err := a.isAPIKeySecretEqual(ctx, secret, existingAPIKey.KeyHash)
+	if err != nil {
+		return Principal{}, err
+	}
+
+	if !isSecretEqual {
+		logger.With(zap.Uint64("api_key_id", apiKeyID)).Warn("api key with wrong secret used")
+		return Principal{}, errInvalidAPIKey
+	}
+
+	if shouldUpdateKeyHash {
+		// the password hash is checked again on the next request if this fails
+		if err = a.apiKeyDataAccessor.UpdateAPIKeyKeyHash(ctx, apiKeyID, getAPIKeySecretDigest(secret)); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to replace password hash of api key with digest")
+		}
+	}
+
+	account, err := a.accountDataAccessor.GetAccountByID(ctx, existingAPIKey.OfAccountID)
+	if err != nil {
+		return Principal{}, err
+	}
+
+	if account.DisabledAt.Valid {
+		return Principal{}, errAccountDisabled
+	}
+
+	// last_used_at is only informational, so it is updated at most once per interval to spare the database
+	if !existingAPIKey.LastUsedAt.Valid || now.Sub(existingAPIKey.LastUsedAt.Time) >= apiKeyLastUsedAtUpdateInterval {
+		if err = a.apiKeyDataAccessor.UpdateAPIKeyLastUsedAt(ctx, apiKeyID, now); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to update last used time of api key")
+		}
+	}
+
+	return Principal{
+		AccountID: account.ID,
+		RoleList:  a.twoFactorRequirement.getAccountRoleList(account),
+		APIKeyID:  apiKeyID,
+		ScopeList: parseAPIKeyScopeList(existingAPIKey.Scopes),
+	}, nil
+}
@@ -0,0 +1,195 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"goload/internal/dataaccess/database"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func newTestAPIKey(store *mockStore) apiKey {
+	store.accountList = []database.Account{{ID: 1, AccountName: "alice123", Role: string(RoleUser)}}
+	return apiKey{
+		apiKeyDataAccessor:  mockAPIKeyDataAccessor{store: store},
+		accountDataAccessor: mockAccountDataAccessor{store: store},
+		hashLogic:           mockHash{},
+		logger:              zap.NewNop(),
+	}
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	testCaseList := []struct {
+		name              string
+		scopeList         []go_load.ApiKeyScope
+		expiresIn         time.Duration
+		expectedErrCode   codes.Code
+		expectedScopeList []APIKeyScope
+	}{
+		{
+			name: "scopes are stored without duplicates",
+			scopeList: []go_load.ApiKeyScope{
+				go_load.ApiKeyScope_API_KEY_SCOPE_DOWNLOAD_TASK_READ,
+				go_load.ApiKeyScope_API_KEY_SCOPE_WEBHOOK_WRITE,
+				go_load.ApiKeyScope_API_KEY_SCOPE_DOWNLOAD_TASK_READ,
+			},
+			expectedScopeList: []APIKeyScope{APIKeyScopeDownloadTaskRead, APIKeyScopeWebhookWrite},
+		},
+		{name: "no scope", expectedErrCode: codes.InvalidArgument},
+		{
+			name:            "unspecified scope",
+			scopeList:       []go_load.ApiKeyScope{go_load.ApiKeyScope_API_KEY_SCOPE_UNSPECIFIED},
+			expectedErrCode: codes.InvalidArgument,
+		},
+		{
+			name:            "expiry in the past",
+			scopeList:       []go_load.ApiKeyScope{go_load.ApiKeyScope_API_KEY_SCOPE_DOWNLOAD_TASK_READ},
+			expiresIn:       -time.Minute,
+			expectedErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			store := newMockStore()
+			a := newTestAPIKey(store)
+			ctx := WithPrincipal(context.Background(), Principal{AccountID: 1, SessionID: 1})
+
+			params := CreateAPIKeyParams{Name: "ci", ScopeList: testCase.scopeList}
+			if testCase.expiresIn != 0 {
+				expiresAt := time.Now().Add(testCase.expiresIn)
+				params.ExpiresAt = &expiresAt
+			}
+
+			output, err := a.CreateAPIKey(ctx, params)
+			requireStatusCode(t, err, testCase.expectedErrCode)
+			if err != nil {
+				return
+			}
+
+			// only a digest of the secret is stored
+			if _, secret, _ := parseAPIKey(output.Key); store.apiKeyList[0].KeyHash != getAPIKeySecretDigest(secret) {
+				t.Fatal("expected the digest of the secret to be stored")
+			}
+
+			principal, err := a.Authenticate(context.Background(), output.Key)
+			if err != nil {
+				t.Fatalf("failed to authenticate with the new key: %v", err)
+			}
+
+			if fmt.Sprint(principal.ScopeList) != fmt.Sprint(testCase.expectedScopeList) {
+				t.Fatalf("expected scopes %v, got %v", testCase.expectedScopeList, principal.ScopeList)
+			}
+		})
+	}
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	testCaseList := []struct {
+		name string
+		// update changes the stored key or account before the key is presented, and returns the presented key
+		update        func(store *mockStore, key string) string
+		expectedErr   error
+		expectLastUse bool
+	}{
+		{name: "valid key", update: func(_ *mockStore, key string) string { return key }, expectLastUse: true},
+		{
+			name:        "wrong secret",
+			update:      func(_ *mockStore, key string) string { return key[:len(key)-1] + "x" },
+			expectedErr: errInvalidAPIKey,
+		},
+		{
+			name:        "unknown key",
+			update:      func(_ *mockStore, key string) string { return strings.Replace(key, "gl_1_", "gl_2_", 1) },
+			expectedErr: errInvalidAPIKey,
+		},
+		{
+			name:        "malformed key",
+			update:      func(_ *mockStore, _ string) string { return "gl_notakey" },
+			expectedErr: errInvalidAPIKey,
+		},
+		{
+			name: "revoked key",
+			update: func(store *mockStore, key string) string {
+				store.apiKeyList[0].RevokedAt = sql.NullTime{Time: time.Now(), Valid: true}
+				return key
+			},
+			expectedErr: errInvalidAPIKey,
+		},
+		{
+			name: "expired key",
+			update: func(store *mockStore, key string) string {
+				store.apiKeyList[0].ExpiresAt = sql.NullTime{Time: time.Now(), Valid: true}
+				return key
+			},
+			expectedErr: errInvalidAPIKey,
+		},
+		{
+			name: "disabled account",
+			update: func(store *mockStore, key string) string {
+				store.accountList[0].DisabledAt = sql.NullTime{Time: time.Now(), Valid: true}
+				return key
+			},
+			expectedErr: errAccountDisabled,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			store := newMockStore()
+			a := newTestAPIKey(store)
+			ctx := WithPrincipal(context.Background(), Principal{AccountID: 1})
+			output, err := a.CreateAPIKey(ctx, CreateAPIKeyParams{
+				Name:      "ci",
+				ScopeList: []go_load.ApiKeyScope{go_load.ApiKeyScope_API_KEY_SCOPE_DOWNLOAD_TASK_READ},
+			})
+			if err != nil {
+				t.Fatalf("failed to create api key: %v", err)
+			}
+
+			principal, err := a.Authenticate(context.Background(), testCase.update(store, output.Key))
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("expected error %v, got %v", testCase.expectedErr, err)
+			}
+
+			if store.apiKeyList[0].LastUsedAt.Valid != testCase.expectLastUse {
+				t.Fatalf("expected last used time set %t", testCase.expectLastUse)
+			}
+
+			if err == nil && (principal.AccountID != 1 || principal.APIKeyID != 1 || principal.SessionID != 0) {
+				t.Fatalf("unexpected principal %+v", principal)
+			}
+		})
+	}
+}
+
+func TestAuthenticateAPIKeyWithPasswordHash(t *testing.T) {
+	store := newMockStore()
+	a := newTestAPIKey(store)
+	store.apiKeyList = []database.APIKey{{
+		ID:          1,
+		OfAccountID: 1,
+		Name:        "legacy",
+		KeyHash:     "hashed:secret",
+		Scopes:      string(APIKeyScopeDownloadTaskRead),
+	}}
+
+	if _, err := a.Authenticate(context.Background(), "gl_1_secret"); err != nil {
+		t.Fatalf("failed to authenticate with a key stored as a password hash: %v", err)
+	}
+
+	if store.apiKeyList[0].KeyHash != getAPIKeySecretDigest("secret") {
+		t.Fatalf("expected the password hash to be replaced by the digest, got %s", store.apiKeyList[0].KeyHash)
+	}
+
+	if _, err := a.Authenticate(context.Background(), "gl_1_secret"); err != nil {
+		t.Fatalf("failed to authenticate with the digest: %v", err)
+	}
+}
@@ -0,0 +1,125 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"goload/internal/dataaccess/database"
+	"goload/internal/dataaccess/mq/producer"
+	"goload/internal/utils"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultDeadLetterMessageListLimit = 100
+)
+
+type GetDeadLetterMessageListParams struct {
+	QueueName string
+	Offset    uint64
+	Limit     uint64
+}
+
+type DeadLetter interface {
+	StoreDeadLetter(ctx context.Context, deadLetter producer.DeadLetter) error
+	GetDeadLetterMessageList(
+		ctx context.Context,
+		params GetDeadLetterMessageListParams,
+	) ([]database.DeadLetterMessage, error)
+	GetDeadLetterMessage(ctx context.Context, id uint64) (database.DeadLetterMessage, error)
+	ReplayDeadLetterMessage(ctx context.Context, id uint64) (database.DeadLetterMessage, error)
+}
+
+type deadLetter struct {
+	deadLetterMessageDataAccessor database.DeadLetterMessageDataAccessor
+	outboxMessageDataAccessor     database.OutboxMessageDataAccessor
+	goquDatabase                  *goqu.Database
+	logger                        *zap.Logger
+}
+
+func NewDeadLetter(
+	deadLetterMessageDataAccessor database.DeadLetterMessageDataAccessor,
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	goquDatabase *goqu.Database,
+	logger *zap.Logger,
+) DeadLetter {
+	return &deadLetter{
+		deadLetterMessageDataAccessor: deadLetterMessageDataAccessor,
+		outboxMessageDataAccessor:     outboxMessageDataAccessor,
+		goquDatabase:                  goquDatabase,
+		logger:                        logger,
+	}
+}
+
+func (d deadLetter) StoreDeadLetter(ctx context.Context, deadLetter producer.DeadLetter) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.String("queue_name", deadLetter.QueueName))
+
+	lastError := ""
+	if lastAttempt, err := lo.Last(deadLetter.AttemptList); err == nil {
+		lastError = lastAttempt.Error
+	}
+
+	id, err := d.deadLetterMessageDataAccessor.CreateDeadLetterMessage(ctx, database.DeadLetterMessage{
+		QueueName:      deadLetter.QueueName,
+		Payload:        deadLetter.Payload,
+		LastError:      lastError,
+		AttemptList:    database.JSON{Data: deadLetter.AttemptList},
+		DeadLetteredAt: deadLetter.DeadLetteredAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.With(zap.Uint64("id", id)).Info("stored dead letter message")
+	return nil
+}
+
+func (d deadLetter) GetDeadLetterMessageList(
+	ctx context.Context,
+	params GetDeadLetterMessageListParams,
+) ([]database.DeadLetterMessage, error) {
+	limit := params.Limit
+	if limit == 0 {
+		limit = defaultDeadLetterMessageListLimit
+	}
+
+	return d.deadLetterMessageDataAccessor.GetDeadLetterMessageList(ctx, params.QueueName, params.Offset, limit)
+}
+
+func (d deadLetter) GetDeadLetterMessage(ctx context.Context, id uint64) (database.DeadLetterMessage, error) {
+	return d.deadLetterMessageDataAccessor.GetDeadLetterMessage(ctx, id)
+}
+
+// ReplayDeadLetterMessage publishes the original payload to its original queue again through the outbox. The
+// dead letter message is kept, so it can be replayed again if it fails again.
+func (d deadLetter) ReplayDeadLetterMessage(ctx context.Context, id uint64) (database.DeadLetterMessage, error) {
+	var message database.DeadLetterMessage
+	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		var err error
+		message, err = d.deadLetterMessageDataAccessor.WithDatabase(td).GetDeadLetterMessageWithXLock(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if _, err = d.outboxMessageDataAccessor.WithDatabase(td).CreateOutboxMessage(ctx, database.OutboxMessage{
+			QueueName: message.QueueName,
+			Payload:   message.Payload,
+			CreatedAt: now,
+		}); err != nil {
+			return err
+		}
+
+		message.ReplayCount++
+		message.ReplayedAt = sql.NullTime{Time: now, Valid: true}
+		return d.deadLetterMessageDataAccessor.WithDatabase(td).UpdateDeadLetterMessage(ctx, message)
+	})
+	if txErr != nil {
+		return database.DeadLetterMessage{}, txErr
+	}
+
+	return message, nil
+}
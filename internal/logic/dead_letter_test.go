@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"context"
+	"goload/internal/dataaccess/database"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func TestReplayDeadLetterMessage(t *testing.T) {
+	testCaseList := []struct {
+		name                string
+		id                  uint64
+		replayCount         int
+		expectedErrCode     codes.Code
+		expectedReplayCount uint64
+	}{
+		{name: "replayed once", id: 1, replayCount: 1, expectedReplayCount: 1},
+		{name: "replayed again after failing again", id: 1, replayCount: 2, expectedReplayCount: 2},
+		{name: "unknown message", id: 2, replayCount: 1, expectedErrCode: codes.NotFound},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			store := newMockStore()
+			store.deadLetterList = []database.DeadLetterMessage{{
+				ID:             1,
+				QueueName:      "download_task_created",
+				Payload:        []byte(`{"download_task":{"id":"1"}}`),
+				LastError:      "poison message",
+				DeadLetteredAt: time.Now(),
+			}}
+
+			d := deadLetter{
+				deadLetterMessageDataAccessor: mockDeadLetterMessageDataAccessor{store: store},
+				outboxMessageDataAccessor:     mockOutboxMessageDataAccessor{store: store},
+				goquDatabase:                  newMockGoquDatabase(),
+				logger:                        zap.NewNop(),
+			}
+
+			var err error
+			for i := 0; i < testCase.replayCount; i++ {
+				_, err = d.ReplayDeadLetterMessage(context.Background(), testCase.id)
+			}
+
+			requireStatusCode(t, err, testCase.expectedErrCode)
+			if testCase.expectedErrCode != codes.OK {
+				if len(store.outboxMessageList) != 0 {
+					t.Fatal("expected nothing to be published")
+				}
+
+				return
+			}
+
+			// the original payload goes back to its original queue, through the outbox
+			if len(store.outboxMessageList) != testCase.replayCount {
+				t.Fatalf("expected %d outbox messages, got %d", testCase.replayCount, len(store.outboxMessageList))
+			}
+
+			for _, message := range store.outboxMessageList {
+				if message.QueueName != "download_task_created" ||
+					string(message.Payload) != string(store.deadLetterList[0].Payload) {
+					t.Fatalf("unexpected outbox message %+v", message)
+				}
+			}
+
+			// the dead letter message is kept so it can be replayed again
+			message := store.deadLetterList[0]
+			if message.ReplayCount != testCase.expectedReplayCount || !message.ReplayedAt.Valid {
+				t.Fatalf("expected replay count %d, got %d", testCase.expectedReplayCount, message.ReplayCount)
+			}
+		})
+	}
+}
@@ -2,9 +2,11 @@ package logic
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"github.com/samber/lo"
+	"goload/internal/configs"
 	"goload/internal/dataaccess/database"
 	"goload/internal/dataaccess/file"
 	"goload/internal/dataaccess/mq/producer"
@@ -12,36 +14,63 @@ import (
 	"goload/internal/utils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sync"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"go.uber.org/zap"
 )
 
+const (
+	downloadTaskFileNamePrefix   = "download_file_"
+	defaultDownloadTaskListLimit = 100
+	downloadTaskActorSystem      = "system"
+	staleDownloadTaskBatchSize   = 100
+)
+
+var (
+	downloadTaskProgressMilestonePercentList = []uint32{25, 50, 75}
+)
+
 type CreateDownloadTaskParams struct {
-	Token        string
 	DownloadType go_load.DownloadType
 	URL          string
+	ExpiresAt    *time.Time
+	TagList      []string
+	LabelMap     map[string]string
+	CallbackURL  string
+	// WorkspaceID is 0 for a personal download task.
+	WorkspaceID        uint64
+	HTTPRequestOptions *go_load.HttpRequestOptions
 }
 
 type CreateDownloadTaskOutput struct {
-	DownloadTask *go_load.DownloadTask
+	DownloadTask   *go_load.DownloadTask
+	CallbackSecret string
 }
 
 type GetDownloadTaskListParams struct {
-	Token  string
-	Offset uint64
-	Limit  uint64
+	Offset        uint64
+	Limit         uint64
+	Filter        *go_load.DownloadTaskFilter
+	SortKey       go_load.DownloadTaskSortKey
+	SortDirection go_load.SortDirection
+	Cursor        string
 }
 
 type GetDownloadTaskListOutput struct {
 	DownloadTaskList       []*go_load.DownloadTask
 	TotalDownloadTaskCount uint64
+	NextCursor             string
 }
 
 type UpdateDownloadTaskParams struct {
-	Token          string
 	DownloadTaskID uint64
 	URL            string
+	// Tags and Labels replace the existing ones when set, and are left untouched when nil.
+	Tags   *go_load.DownloadTaskTags
+	Labels *go_load.DownloadTaskLabels
 }
 
 type UpdateDownloadTaskOutput struct {
@@ -49,65 +78,444 @@ type UpdateDownloadTaskOutput struct {
 }
 
 type DeleteDownloadTaskParams struct {
-	Token          string
 	DownloadTaskID uint64
 }
 
+type GetDownloadTaskHistoryParams struct {
+	DownloadTaskID uint64
+}
+
+type GetDownloadTaskHistoryOutput struct {
+	DownloadTaskEventList []*go_load.DownloadTaskEvent
+}
+
+type RetryDownloadTaskParams struct {
+	DownloadTaskID uint64
+}
+
+type RetryDownloadTaskOutput struct {
+	DownloadTask *go_load.DownloadTask
+}
+
+type CloneDownloadTaskParams struct {
+	DownloadTaskID uint64
+}
+
+type CloneDownloadTaskOutput struct {
+	DownloadTask *go_load.DownloadTask
+}
+
+type GetAccountDownloadTaskListParams struct {
+	AccountID uint64
+	GetDownloadTaskListParams
+}
+
+type CancelDownloadTaskParams struct {
+	DownloadTaskID uint64
+	Reason         string
+}
+
+type CancelDownloadTaskOutput struct {
+	DownloadTask *go_load.DownloadTask
+}
+
 type DownloadTask interface {
 	CreateDownloadTask(context.Context, CreateDownloadTaskParams) (CreateDownloadTaskOutput, error)
 	GetDownloadTaskList(context.Context, GetDownloadTaskListParams) (GetDownloadTaskListOutput, error)
 	UpdateDownloadTask(context.Context, UpdateDownloadTaskParams) (UpdateDownloadTaskOutput, error)
 	DeleteDownloadTask(context.Context, DeleteDownloadTaskParams) error
+	GetDownloadTaskHistory(context.Context, GetDownloadTaskHistoryParams) (GetDownloadTaskHistoryOutput, error)
+	RetryDownloadTask(context.Context, RetryDownloadTaskParams) (RetryDownloadTaskOutput, error)
+	CloneDownloadTask(context.Context, CloneDownloadTaskParams) (CloneDownloadTaskOutput, error)
+	BulkDeleteDownloadTasks(context.Context, BulkDownloadTasksParams) (BulkDownloadTasksOutput, error)
+	BulkRetryDownloadTasks(context.Context, BulkDownloadTasksParams) (BulkDownloadTasksOutput, error)
+	BulkCancelDownloadTasks(context.Context, BulkDownloadTasksParams) (BulkDownloadTasksOutput, error)
+	// GetAccountDownloadTaskList and CancelDownloadTask work on the download tasks of any account, they require
+	// RoleOperator.
+	GetAccountDownloadTaskList(context.Context, GetAccountDownloadTaskListParams) (GetDownloadTaskListOutput, error)
+	CancelDownloadTask(context.Context, CancelDownloadTaskParams) (CancelDownloadTaskOutput, error)
 	ExecuteDownloadTask(context.Context, uint64) error
+	// RequeueStaleDownloadTasks puts back to pending the downloading tasks whose worker stopped sending heartbeats,
+	// and returns how many were requeued.
+	RequeueStaleDownloadTasks(context.Context) (int, error)
+}
+
+type runningDownloadTask struct {
+	attempt        uint32
+	cancelDownload context.CancelFunc
+}
+
+// runningDownloadTaskMap holds the downloads running in this process by task ID, so cancelling a task stops its
+// download right away when it runs here. Downloads running elsewhere stop at their next heartbeat.
+type runningDownloadTaskMap struct {
+	mutex                      sync.Mutex
+	runningDownloadTaskByIDMap map[uint64]runningDownloadTask
+}
+
+func (r *runningDownloadTaskMap) add(id uint64, attempt uint32, cancelDownload context.CancelFunc) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.runningDownloadTaskByIDMap[id] = runningDownloadTask{attempt: attempt, cancelDownload: cancelDownload}
+}
+
+func (r *runningDownloadTaskMap) remove(id uint64, attempt uint32) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.runningDownloadTaskByIDMap[id].attempt == attempt {
+		delete(r.runningDownloadTaskByIDMap, id)
+	}
+}
+
+func (r *runningDownloadTaskMap) cancel(id uint64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if running, ok := r.runningDownloadTaskByIDMap[id]; ok {
+		running.cancelDownload()
+	}
 }
 
 type downloadTask struct {
-	tokenLogic                  Token
-	accountDataAccessor         database.AccountDataAccessor
-	downloadTaskDataAccessor    database.DownloadTaskDataAccessor
-	downloadTaskCreatedProducer producer.DownloadTaskCreatedProducer
-	goquDatabase                *goqu.Database
-	logger                      *zap.Logger
-	client                      file.Client
+	accountDataAccessor           database.AccountDataAccessor
+	downloadTaskDataAccessor      database.DownloadTaskDataAccessor
+	downloadTaskEventDataAccessor database.DownloadTaskEventDataAccessor
+	downloadTaskTagDataAccessor   database.DownloadTaskTagDataAccessor
+	downloadTaskLabelDataAccessor database.DownloadTaskLabelDataAccessor
+	workspaceDataAccessor         database.WorkspaceDataAccessor
+	workspaceMemberDataAccessor   database.WorkspaceMemberDataAccessor
+	outboxMessageDataAccessor     database.OutboxMessageDataAccessor
+	lifecycleEventProducer        DownloadTaskLifecycleEventProducer
+	goquDatabase                  *goqu.Database
+	quotaConfig                   configs.Quota
+	heartbeatInterval             time.Duration
+	staleTaskTimeout              time.Duration
+	runningDownloadTaskMap        *runningDownloadTaskMap
+	logger                        *zap.Logger
+	client                        file.Client
 }
 
 func NewDownloadTask(
-	tokenLogic Token,
 	accountDataAccessor database.AccountDataAccessor,
 	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
-	downloadTaskCreatedProducer producer.DownloadTaskCreatedProducer,
+	downloadTaskEventDataAccessor database.DownloadTaskEventDataAccessor,
+	downloadTaskTagDataAccessor database.DownloadTaskTagDataAccessor,
+	downloadTaskLabelDataAccessor database.DownloadTaskLabelDataAccessor,
+	workspaceDataAccessor database.WorkspaceDataAccessor,
+	workspaceMemberDataAccessor database.WorkspaceMemberDataAccessor,
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	lifecycleEventProducer DownloadTaskLifecycleEventProducer,
 	goquDatabase *goqu.Database,
 	client file.Client,
+	quotaConfig configs.Quota,
+	downloadConfig configs.DownloadConfig,
 	logger *zap.Logger,
-) DownloadTask {
+) (DownloadTask, error) {
+	heartbeatInterval, err := downloadConfig.GetHeartbeatIntervalDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse heartbeat_interval: " + downloadConfig.HeartbeatInterval)
+		return nil, err
+	}
+
+	// updated_at is stored to the second, a shorter interval could leave it unchanged and look like a lost task
+	if heartbeatInterval < time.Second {
+		logger.Error("heartbeat_interval must be at least one second: " + downloadConfig.HeartbeatInterval)
+		return nil, errors.New("heartbeat_interval must be at least one second")
+	}
+
+	staleTaskTimeout, err := downloadConfig.GetStaleTaskTimeoutDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse stale_task_timeout: " + downloadConfig.StaleTaskTimeout)
+		return nil, err
+	}
+
+	if staleTaskTimeout <= heartbeatInterval {
+		logger.Error("stale_task_timeout must be longer than heartbeat_interval: " + downloadConfig.StaleTaskTimeout)
+		return nil, errors.New("stale_task_timeout must be longer than heartbeat_interval")
+	}
+
 	return &downloadTask{
-		tokenLogic:                  tokenLogic,
-		accountDataAccessor:         accountDataAccessor,
-		downloadTaskDataAccessor:    downloadTaskDataAccessor,
-		downloadTaskCreatedProducer: downloadTaskCreatedProducer,
-		goquDatabase:                goquDatabase,
-		logger:                      logger,
+		accountDataAccessor:           accountDataAccessor,
+		downloadTaskDataAccessor:      downloadTaskDataAccessor,
+		downloadTaskEventDataAccessor: downloadTaskEventDataAccessor,
+		downloadTaskTagDataAccessor:   downloadTaskTagDataAccessor,
+		downloadTaskLabelDataAccessor: downloadTaskLabelDataAccessor,
+		workspaceDataAccessor:         workspaceDataAccessor,
+		workspaceMemberDataAccessor:   workspaceMemberDataAccessor,
+		outboxMessageDataAccessor:     outboxMessageDataAccessor,
+		lifecycleEventProducer:        lifecycleEventProducer,
+		goquDatabase:                  goquDatabase,
+		client:                        client,
+		quotaConfig:                   quotaConfig,
+		heartbeatInterval:             heartbeatInterval,
+		staleTaskTimeout:              staleTaskTimeout,
+		runningDownloadTaskMap: &runningDownloadTaskMap{
+			runningDownloadTaskByIDMap: make(map[uint64]runningDownloadTask),
+		},
+		logger: logger,
+	}, nil
+}
+
+func getDownloadTaskFileName(id uint64) string {
+	return fmt.Sprintf("%s%d", downloadTaskFileNamePrefix, id)
+}
+
+func getAccountActor(accountID uint64) string {
+	return fmt.Sprintf("account:%d", accountID)
+}
+
+func nullTimeToProtoTimestamp(nullTime sql.NullTime) *timestamppb.Timestamp {
+	if !nullTime.Valid {
+		return nil
 	}
+
+	return timestamppb.New(nullTime.Time)
 }
 
-func (d downloadTask) databaseDownloadTaskToProtoDownloadTask(task database.DownloadTask, account database.Account) *go_load.DownloadTask {
+func databaseDownloadTaskToProtoDownloadTask(
+	task database.DownloadTask,
+	account database.Account,
+) *go_load.DownloadTask {
 	return &go_load.DownloadTask{
 		Id: task.ID,
 		OfAccount: &go_load.Account{
 			Id:          account.ID,
 			AccountName: account.AccountName,
 		},
-		DownloadType:   task.DownloadType,
-		Url:            task.URL,
-		DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING,
+		DownloadType:       task.DownloadType,
+		Url:                task.URL,
+		DownloadStatus:     task.DownloadStatus,
+		ExpiresAt:          nullTimeToProtoTimestamp(task.ExpiresAt),
+		CreatedAt:          timestamppb.New(task.CreatedAt),
+		UpdatedAt:          timestamppb.New(task.UpdatedAt),
+		StartedAt:          nullTimeToProtoTimestamp(task.StartedAt),
+		FinishedAt:         nullTimeToProtoTimestamp(task.FinishedAt),
+		Attempt:            task.Attempt,
+		CallbackUrl:        task.CallbackURL,
+		WorkspaceId:        uint64(task.OfWorkspaceID.Int64),
+		HttpRequestOptions: getDownloadTaskRedactedProtoHTTPRequestOptions(task),
+	}
+}
+
+func (d downloadTask) databaseDownloadTaskEventToProtoDownloadTaskEvent(
+	event database.DownloadTaskEvent,
+) *go_load.DownloadTaskEvent {
+	return &go_load.DownloadTaskEvent{
+		Id:                 event.ID,
+		DownloadTaskId:     event.OfDownloadTaskID,
+		FromDownloadStatus: event.FromDownloadStatus,
+		ToDownloadStatus:   event.ToDownloadStatus,
+		Actor:              event.Actor,
+		Reason:             event.Reason,
+		CreatedAt:          timestamppb.New(event.CreatedAt),
+	}
+}
+
+// updateDownloadTaskStatus moves the task to toStatus, maintains its timestamps, records the transition in the
+// task's history and produces the matching lifecycle event. It must be called with the same database the task was
+// locked with.
+func (d downloadTask) updateDownloadTaskStatus(
+	ctx context.Context,
+	db database.Database,
+	task *database.DownloadTask,
+	toStatus go_load.DownloadStatus,
+	actor string,
+	reason string,
+) error {
+	now := time.Now()
+	fromStatus := task.DownloadStatus
+
+	task.DownloadStatus = toStatus
+	task.UpdatedAt = now
+	switch toStatus {
+	case go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING:
+		task.StartedAt = sql.NullTime{}
+		task.FinishedAt = sql.NullTime{}
+	case go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING:
+		task.StartedAt = sql.NullTime{Time: now, Valid: true}
+		task.FinishedAt = sql.NullTime{}
+	case go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS,
+		go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED,
+		go_load.DownloadStatus_DOWNLOAD_STATUS_CANCELLED:
+		task.FinishedAt = sql.NullTime{Time: now, Valid: true}
+	default:
+	}
+
+	if err := d.downloadTaskDataAccessor.WithDatabase(db).UpdateDownloadTask(ctx, *task); err != nil {
+		return err
+	}
+
+	_, err := d.downloadTaskEventDataAccessor.WithDatabase(db).CreateDownloadTaskEvent(ctx, database.DownloadTaskEvent{
+		OfDownloadTaskID:   task.ID,
+		FromDownloadStatus: fromStatus,
+		ToDownloadStatus:   toStatus,
+		Actor:              actor,
+		Reason:             reason,
+		CreatedAt:          now,
+	})
+	if err != nil {
+		return err
+	}
+
+	lifecycleEvent := getDownloadTaskStatusChangedLifecycleEvent(*task, toStatus, actor, reason)
+	if lifecycleEvent == nil {
+		return nil
+	}
+
+	return d.lifecycleEventProducer.ProduceDownloadTaskLifecycleEvent(ctx, db, *task, lifecycleEvent)
+}
+
+// deleteDownloadTask produces a DownloadTaskDeleted event with the last snapshot of the task before deleting it.
+// It must be called with the same database the task was locked with.
+func (d downloadTask) deleteDownloadTask(
+	ctx context.Context,
+	db database.Database,
+	task database.DownloadTask,
+	actor string,
+) error {
+	err := d.lifecycleEventProducer.ProduceDownloadTaskLifecycleEvent(
+		ctx, db, task, &go_load.DownloadTaskLifecycleEvent{Event: &go_load.DownloadTaskLifecycleEvent_Deleted{
+			Deleted: &go_load.DownloadTaskDeleted{Actor: actor},
+		}})
+	if err != nil {
+		return err
+	}
+
+	return d.downloadTaskDataAccessor.WithDatabase(db).DeleteDownloadTask(ctx, task.ID)
+}
+
+// produceDownloadTaskCreated goes through the outbox, so it must be called in the transaction that creates or
+// resets the task.
+func (d downloadTask) produceDownloadTaskCreated(ctx context.Context, db database.Database, id uint64) error {
+	return enqueueOutboxMessage(
+		ctx,
+		d.outboxMessageDataAccessor,
+		db,
+		producer.MessageQueueDownloadTaskCreated,
+		producer.DownloadTaskCreated{ID: id, CorrelationID: utils.GetCorrelationID(ctx)},
+	)
+}
+
+// retryDownloadTask puts a finished task back to pending, counts a new attempt and produces a new
+// DownloadTaskCreated message so a consumer picks it up again. It must be called with the same database the task
+// was locked with.
+func (d downloadTask) retryDownloadTask(
+	ctx context.Context,
+	db database.Database,
+	task *database.DownloadTask,
+	actor string,
+) error {
+	if err := d.checkDownloadTaskQuota(ctx, db, *task, false); err != nil {
+		return err
+	}
+
+	task.Attempt++
+	err := d.updateDownloadTaskStatus(
+		ctx, db, task, go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING, actor, "download task retried")
+	if err != nil {
+		return err
+	}
+
+	return d.produceDownloadTaskCreated(ctx, db, task.ID)
+}
+
+func (d downloadTask) protoDownloadTaskFilterToDatabaseDownloadTaskListFilter(
+	accountID uint64,
+	filter *go_load.DownloadTaskFilter,
+) database.DownloadTaskListFilter {
+	databaseFilter := database.DownloadTaskListFilter{
+		OfAccountID:        accountID,
+		OfWorkspaceID:      filter.GetWorkspaceId(),
+		DownloadStatusList: filter.GetDownloadStatusList(),
+		DownloadType:       filter.GetDownloadType(),
+		URLContains:        filter.GetUrlContains(),
+		TagList:            filter.GetTagList(),
+		LabelSelector:      filter.GetLabelSelector(),
 	}
+
+	if filter.GetCreatedAfter() != nil {
+		createdAfter := filter.GetCreatedAfter().AsTime()
+		databaseFilter.CreatedAfter = &createdAfter
+	}
+
+	if filter.GetCreatedBefore() != nil {
+		createdBefore := filter.GetCreatedBefore().AsTime()
+		databaseFilter.CreatedBefore = &createdBefore
+	}
+
+	return databaseFilter
+}
+
+// createDownloadTask inserts the task together with its tags, labels and first history event, then produces a
+// DownloadTaskCreated message for it.
+func (d downloadTask) createDownloadTask(
+	ctx context.Context,
+	downloadTask database.DownloadTask,
+	tagList []string,
+	labelMap map[string]string,
+	actor string,
+	reason string,
+) (database.DownloadTask, []string, error) {
+	now := time.Now()
+	downloadTask.DownloadStatus = go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING
+	downloadTask.CreatedAt = now
+	downloadTask.UpdatedAt = now
+	downloadTask.Attempt = 1
+
+	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		if checkQuotaErr := d.checkDownloadTaskQuota(ctx, td, downloadTask, true); checkQuotaErr != nil {
+			return checkQuotaErr
+		}
+
+		downloadTaskID, createDownloadTaskErr := d.downloadTaskDataAccessor.
+			WithDatabase(td).
+			CreateDownloadTask(ctx, downloadTask)
+		if createDownloadTaskErr != nil {
+			return createDownloadTaskErr
+		}
+
+		downloadTask.ID = downloadTaskID
+		_, createDownloadTaskEventErr := d.downloadTaskEventDataAccessor.
+			WithDatabase(td).
+			CreateDownloadTaskEvent(ctx, database.DownloadTaskEvent{
+				OfDownloadTaskID:   downloadTaskID,
+				FromDownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_UNSPECIFIED,
+				ToDownloadStatus:   go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING,
+				Actor:              actor,
+				Reason:             reason,
+				CreatedAt:          now,
+			})
+		if createDownloadTaskEventErr != nil {
+			return createDownloadTaskEventErr
+		}
+
+		var setDownloadTaskTagListErr error
+		tagList, setDownloadTaskTagListErr = d.setDownloadTaskTagList(ctx, td, downloadTaskID, tagList)
+		if setDownloadTaskTagListErr != nil {
+			return setDownloadTaskTagListErr
+		}
+
+		if setDownloadTaskLabelMapErr := d.setDownloadTaskLabelMap(
+			ctx, td, downloadTaskID, labelMap,
+		); setDownloadTaskLabelMapErr != nil {
+			return setDownloadTaskLabelMapErr
+		}
+
+		return d.produceDownloadTaskCreated(ctx, td, downloadTaskID)
+	})
+	if txErr != nil {
+		return database.DownloadTask{}, nil, txErr
+	}
+
+	return downloadTask, tagList, nil
 }
 
 func (d downloadTask) CreateDownloadTask(
 	ctx context.Context,
 	params CreateDownloadTaskParams,
 ) (CreateDownloadTaskOutput, error) {
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	accountID, err := getPrincipalAccountID(ctx)
 	if err != nil {
 		return CreateDownloadTaskOutput{}, err
 	}
@@ -118,39 +526,62 @@ func (d downloadTask) CreateDownloadTask(
 	}
 
 	downloadTask := database.DownloadTask{
-		OfAccountID:    accountID,
-		DownloadType:   params.DownloadType,
-		URL:            params.URL,
-		DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING,
+		OfAccountID:  accountID,
+		DownloadType: params.DownloadType,
+		URL:          params.URL,
 		Metadata: database.JSON{
 			Data: make(map[string]any),
 		},
 	}
 
-	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
-		downloadTaskID, createDownloadTaskErr := d.downloadTaskDataAccessor.
-			WithDatabase(td).
-			CreateDownloadTask(ctx, downloadTask)
-		if createDownloadTaskErr != nil {
-			return createDownloadTaskErr
+	if params.WorkspaceID != 0 {
+		_, err = checkWorkspaceRole(ctx, d.workspaceMemberDataAccessor, params.WorkspaceID, accountID, WorkspaceRoleEditor)
+		if err != nil {
+			return CreateDownloadTaskOutput{}, err
 		}
 
-		downloadTask.ID = downloadTaskID
-		produceErr := d.downloadTaskCreatedProducer.Produce(ctx, producer.DownloadTaskCreated{
-			ID: downloadTaskID,
-		})
-		if produceErr != nil {
-			return produceErr
+		downloadTask.OfWorkspaceID = sql.NullInt64{Int64: int64(params.WorkspaceID), Valid: true}
+	}
+
+	if params.ExpiresAt != nil {
+		downloadTask.ExpiresAt = sql.NullTime{Time: *params.ExpiresAt, Valid: true}
+	}
+
+	if params.HTTPRequestOptions != nil {
+		if params.DownloadType != go_load.DownloadType_DOWNLOAD_TYPE_HTTP {
+			return CreateDownloadTaskOutput{}, status.Error(
+				codes.InvalidArgument, "http_request_options is only allowed for DOWNLOAD_TYPE_HTTP")
 		}
 
-		return nil
-	})
-	if txErr != nil {
-		return CreateDownloadTaskOutput{}, txErr
+		httpRequestOptions, convertErr := protoHTTPRequestOptionsToHTTPRequestOptions(params.HTTPRequestOptions)
+		if convertErr != nil {
+			return CreateDownloadTaskOutput{}, convertErr
+		}
+
+		downloadTask.Metadata.Data.(map[string]any)[downloadTaskMetadataKeyHTTPRequestOptions] = httpRequestOptions
 	}
 
+	if params.CallbackURL != "" {
+		if err = validateWebhookURL(params.CallbackURL); err != nil {
+			return CreateDownloadTaskOutput{}, err
+		}
+
+		downloadTask.CallbackURL = params.CallbackURL
+		downloadTask.CallbackSecret = generateWebhookSecret()
+	}
+
+	downloadTask, tagList, err := d.createDownloadTask(
+		ctx, downloadTask, params.TagList, params.LabelMap, getAccountActor(accountID), "download task created")
+	if err != nil {
+		return CreateDownloadTaskOutput{}, err
+	}
+
+	protoDownloadTask := databaseDownloadTaskToProtoDownloadTask(downloadTask, account)
+	protoDownloadTask.TagList = tagList
+	protoDownloadTask.LabelMap = params.LabelMap
 	return CreateDownloadTaskOutput{
-		DownloadTask: d.databaseDownloadTaskToProtoDownloadTask(downloadTask, account),
+		DownloadTask:   protoDownloadTask,
+		CallbackSecret: downloadTask.CallbackSecret,
 	}, nil
 }
 
@@ -158,41 +589,104 @@ func (d downloadTask) GetDownloadTaskList(
 	ctx context.Context,
 	params GetDownloadTaskListParams,
 ) (GetDownloadTaskListOutput, error) {
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	accountID, err := getPrincipalAccountID(ctx)
 	if err != nil {
 		return GetDownloadTaskListOutput{}, err
 	}
 
-	account, err := d.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if workspaceID := params.Filter.GetWorkspaceId(); workspaceID != 0 {
+		_, err = checkWorkspaceRole(ctx, d.workspaceMemberDataAccessor, workspaceID, accountID, WorkspaceRoleViewer)
+		if err != nil {
+			return GetDownloadTaskListOutput{}, err
+		}
+	}
+
+	return d.getDownloadTaskList(ctx, accountID, params)
+}
+
+func (d downloadTask) GetAccountDownloadTaskList(
+	ctx context.Context,
+	params GetAccountDownloadTaskListParams,
+) (GetDownloadTaskListOutput, error) {
+	if _, err := getPrincipalWithRole(ctx, RoleOperator); err != nil {
+		return GetDownloadTaskListOutput{}, err
+	}
+
+	if _, err := d.accountDataAccessor.GetAccountByID(ctx, params.AccountID); err != nil {
+		return GetDownloadTaskListOutput{}, err
+	}
+
+	return d.getDownloadTaskList(ctx, params.AccountID, params.GetDownloadTaskListParams)
+}
+
+func (d downloadTask) getDownloadTaskList(
+	ctx context.Context,
+	accountID uint64,
+	params GetDownloadTaskListParams,
+) (GetDownloadTaskListOutput, error) {
+	cursor, err := decodeDownloadTaskListCursor(params.Cursor, params.SortKey, params.SortDirection)
+	if err != nil {
+		return GetDownloadTaskListOutput{}, err
+	}
+
+	limit := params.Limit
+	if limit == 0 {
+		limit = defaultDownloadTaskListLimit
+	}
+
+	filter := d.protoDownloadTaskFilterToDatabaseDownloadTaskListFilter(accountID, params.Filter)
+	totalDownloadTaskCount, err := d.downloadTaskDataAccessor.GetDownloadTaskCount(ctx, filter)
 	if err != nil {
 		return GetDownloadTaskListOutput{}, err
 	}
 
-	totalDownloadTaskCount, err := d.downloadTaskDataAccessor.GetDownloadTaskCountOfAccount(ctx, accountID)
+	// One extra row is requested to find out whether there is a next page without a second query.
+	downloadTaskList, err := d.downloadTaskDataAccessor.GetDownloadTaskList(
+		ctx,
+		filter,
+		getDownloadTaskListSort(params.SortKey, params.SortDirection),
+		cursor,
+		params.Offset,
+		limit+1,
+	)
 	if err != nil {
 		return GetDownloadTaskListOutput{}, err
 	}
 
-	downloadTaskList, err := d.downloadTaskDataAccessor.GetDownloadTaskListOfAccount(ctx, accountID, params.Offset, params.Limit)
+	nextCursor := ""
+	if uint64(len(downloadTaskList)) > limit {
+		downloadTaskList = downloadTaskList[:limit]
+		nextCursor, err = encodeDownloadTaskListCursor(
+			params.SortKey, params.SortDirection, downloadTaskList[len(downloadTaskList)-1])
+		if err != nil {
+			return GetDownloadTaskListOutput{}, err
+		}
+	}
+
+	// the download tasks of a workspace can be created by different accounts
+	accountList, err := d.accountDataAccessor.GetAccountListByIDList(
+		ctx, lo.Uniq(lo.Map(downloadTaskList, func(task database.DownloadTask, _ int) uint64 { return task.OfAccountID })))
 	if err != nil {
 		return GetDownloadTaskListOutput{}, err
 	}
 
+	accountMap := lo.KeyBy(accountList, func(account database.Account) uint64 { return account.ID })
+	protoDownloadTaskList := lo.Map(downloadTaskList, func(task database.DownloadTask, index int) *go_load.DownloadTask {
+		return databaseDownloadTaskToProtoDownloadTask(task, accountMap[task.OfAccountID])
+	})
+	if err = d.fillDownloadTaskTagsAndLabels(ctx, d.goquDatabase, protoDownloadTaskList); err != nil {
+		return GetDownloadTaskListOutput{}, err
+	}
+
 	return GetDownloadTaskListOutput{
-		DownloadTaskList: lo.Map(downloadTaskList, func(task database.DownloadTask, index int) *go_load.DownloadTask {
-			return d.databaseDownloadTaskToProtoDownloadTask(task, account)
-		}),
+		DownloadTaskList:       protoDownloadTaskList,
 		TotalDownloadTaskCount: totalDownloadTaskCount,
+		NextCursor:             nextCursor,
 	}, nil
 }
 
 func (d downloadTask) UpdateDownloadTask(ctx context.Context, params UpdateDownloadTaskParams) (UpdateDownloadTaskOutput, error) {
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
-	if err != nil {
-		return UpdateDownloadTaskOutput{}, err
-	}
-
-	account, err := d.accountDataAccessor.GetAccountByID(ctx, accountID)
+	accountID, err := getPrincipalAccountID(ctx)
 	if err != nil {
 		return UpdateDownloadTaskOutput{}, err
 	}
@@ -207,13 +701,50 @@ func (d downloadTask) UpdateDownloadTask(ctx context.Context, params UpdateDownl
 			return getDownloadTaskWithXLockErr
 		}
 
-		if downloadTask.OfAccountID != accountID {
-			return status.Error(codes.PermissionDenied, "permission denied")
+		if checkRoleErr := checkDownloadTaskRole(
+			ctx, d.workspaceMemberDataAccessor.WithDatabase(td), downloadTask, accountID, WorkspaceRoleEditor,
+		); checkRoleErr != nil {
+			return checkRoleErr
 		}
 
-		downloadTask.URL = params.URL
-		output.DownloadTask = d.databaseDownloadTaskToProtoDownloadTask(downloadTask, account)
-		return d.downloadTaskDataAccessor.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask)
+		if params.URL != "" {
+			if !isSameHTTPOrigin(downloadTask.URL, params.URL) {
+				downloadTask.Metadata = getDownloadTaskMetadataWithoutHTTPRequestSecrets(downloadTask)
+			}
+
+			downloadTask.URL = params.URL
+		}
+
+		downloadTask.UpdatedAt = time.Now()
+		if updateDownloadTaskErr := d.downloadTaskDataAccessor.
+			WithDatabase(td).
+			UpdateDownloadTask(ctx, downloadTask); updateDownloadTaskErr != nil {
+			return updateDownloadTaskErr
+		}
+
+		if params.Tags != nil {
+			if _, setDownloadTaskTagListErr := d.setDownloadTaskTagList(
+				ctx, td, downloadTask.ID, params.Tags.GetTagList(),
+			); setDownloadTaskTagListErr != nil {
+				return setDownloadTaskTagListErr
+			}
+		}
+
+		if params.Labels != nil {
+			if setDownloadTaskLabelMapErr := d.setDownloadTaskLabelMap(
+				ctx, td, downloadTask.ID, params.Labels.GetLabelMap(),
+			); setDownloadTaskLabelMapErr != nil {
+				return setDownloadTaskLabelMapErr
+			}
+		}
+
+		account, getAccountErr := d.accountDataAccessor.WithDatabase(td).GetAccountByID(ctx, downloadTask.OfAccountID)
+		if getAccountErr != nil {
+			return getAccountErr
+		}
+
+		output.DownloadTask = databaseDownloadTaskToProtoDownloadTask(downloadTask, account)
+		return d.fillDownloadTaskTagsAndLabels(ctx, td, []*go_load.DownloadTask{output.DownloadTask})
 	})
 
 	if txErr != nil {
@@ -224,7 +755,7 @@ func (d downloadTask) UpdateDownloadTask(ctx context.Context, params UpdateDownl
 }
 
 func (d downloadTask) DeleteDownloadTask(ctx context.Context, params DeleteDownloadTaskParams) error {
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	accountID, err := getPrincipalAccountID(ctx)
 	if err != nil {
 		return err
 	}
@@ -238,11 +769,13 @@ func (d downloadTask) DeleteDownloadTask(ctx context.Context, params DeleteDownl
 			return getDownloadTaskWithXLockErr
 		}
 
-		if downloadTask.OfAccountID != accountID {
-			return status.Error(codes.PermissionDenied, "permission denied")
+		if checkRoleErr := checkDownloadTaskRole(
+			ctx, d.workspaceMemberDataAccessor.WithDatabase(td), downloadTask, accountID, WorkspaceRoleEditor,
+		); checkRoleErr != nil {
+			return checkRoleErr
 		}
 
-		return d.downloadTaskDataAccessor.WithDatabase(td).DeleteDownloadTask(ctx, params.DownloadTaskID)
+		return d.deleteDownloadTask(ctx, td, downloadTask, getAccountActor(accountID))
 	})
 
 	if txErr != nil {
@@ -252,14 +785,222 @@ func (d downloadTask) DeleteDownloadTask(ctx context.Context, params DeleteDownl
 	return nil
 }
 
+func (d downloadTask) GetDownloadTaskHistory(
+	ctx context.Context,
+	params GetDownloadTaskHistoryParams,
+) (GetDownloadTaskHistoryOutput, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return GetDownloadTaskHistoryOutput{}, err
+	}
+
+	downloadTask, err := d.downloadTaskDataAccessor.GetDownloadTask(ctx, params.DownloadTaskID)
+	if err != nil {
+		return GetDownloadTaskHistoryOutput{}, err
+	}
+
+	err = checkDownloadTaskRole(ctx, d.workspaceMemberDataAccessor, downloadTask, accountID, WorkspaceRoleViewer)
+	if err != nil {
+		return GetDownloadTaskHistoryOutput{}, err
+	}
+
+	eventList, err := d.downloadTaskEventDataAccessor.GetDownloadTaskEventListOfDownloadTask(ctx, params.DownloadTaskID)
+	if err != nil {
+		return GetDownloadTaskHistoryOutput{}, err
+	}
+
+	return GetDownloadTaskHistoryOutput{
+		DownloadTaskEventList: lo.Map(eventList, func(event database.DownloadTaskEvent, _ int) *go_load.DownloadTaskEvent {
+			return d.databaseDownloadTaskEventToProtoDownloadTaskEvent(event)
+		}),
+	}, nil
+}
+
+func (d downloadTask) RetryDownloadTask(
+	ctx context.Context,
+	params RetryDownloadTaskParams,
+) (RetryDownloadTaskOutput, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return RetryDownloadTaskOutput{}, err
+	}
+
+	output := RetryDownloadTaskOutput{}
+	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		downloadTask, getDownloadTaskWithXLockErr := d.downloadTaskDataAccessor.
+			WithDatabase(td).
+			GetDownloadTaskWithXLock(ctx, params.DownloadTaskID)
+		if getDownloadTaskWithXLockErr != nil {
+			return getDownloadTaskWithXLockErr
+		}
+
+		if checkRoleErr := checkDownloadTaskRole(
+			ctx, d.workspaceMemberDataAccessor.WithDatabase(td), downloadTask, accountID, WorkspaceRoleEditor,
+		); checkRoleErr != nil {
+			return checkRoleErr
+		}
+
+		if downloadTask.DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED &&
+			downloadTask.DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_CANCELLED {
+			return status.Errorf(
+				codes.FailedPrecondition,
+				"only failed or cancelled download tasks can be retried, download task is %s",
+				downloadTask.DownloadStatus.String())
+		}
+
+		if retryDownloadTaskErr := d.retryDownloadTask(
+			ctx, td, &downloadTask, getAccountActor(accountID),
+		); retryDownloadTaskErr != nil {
+			return retryDownloadTaskErr
+		}
+
+		account, getAccountErr := d.accountDataAccessor.WithDatabase(td).GetAccountByID(ctx, downloadTask.OfAccountID)
+		if getAccountErr != nil {
+			return getAccountErr
+		}
+
+		output.DownloadTask = databaseDownloadTaskToProtoDownloadTask(downloadTask, account)
+		return d.fillDownloadTaskTagsAndLabels(ctx, td, []*go_load.DownloadTask{output.DownloadTask})
+	})
+	if txErr != nil {
+		return RetryDownloadTaskOutput{}, txErr
+	}
+
+	return output, nil
+}
+
+func (d downloadTask) CloneDownloadTask(
+	ctx context.Context,
+	params CloneDownloadTaskParams,
+) (CloneDownloadTaskOutput, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return CloneDownloadTaskOutput{}, err
+	}
+
+	account, err := d.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return CloneDownloadTaskOutput{}, err
+	}
+
+	sourceDownloadTask, err := d.downloadTaskDataAccessor.GetDownloadTask(ctx, params.DownloadTaskID)
+	if err != nil {
+		return CloneDownloadTaskOutput{}, err
+	}
+
+	// the clone is created next to the source, in the same workspace
+	err = checkDownloadTaskRole(ctx, d.workspaceMemberDataAccessor, sourceDownloadTask, accountID, WorkspaceRoleEditor)
+	if err != nil {
+		return CloneDownloadTaskOutput{}, err
+	}
+
+	protoSourceDownloadTask := databaseDownloadTaskToProtoDownloadTask(sourceDownloadTask, account)
+	if err = d.fillDownloadTaskTagsAndLabels(
+		ctx, d.goquDatabase, []*go_load.DownloadTask{protoSourceDownloadTask},
+	); err != nil {
+		return CloneDownloadTaskOutput{}, err
+	}
+
+	downloadTask := database.DownloadTask{
+		OfAccountID:   accountID,
+		OfWorkspaceID: sourceDownloadTask.OfWorkspaceID,
+		DownloadType:  sourceDownloadTask.DownloadType,
+		URL:           sourceDownloadTask.URL,
+		Metadata:      sourceDownloadTask.Metadata,
+	}
+
+	// a workspace member cloning the task of another one must not get to use its credentials
+	if sourceDownloadTask.OfAccountID != accountID {
+		downloadTask.Metadata = getDownloadTaskMetadataWithoutHTTPRequestSecrets(sourceDownloadTask)
+	}
+
+	// An expiry that has already passed would get the cloned file deleted right after it is downloaded.
+	if sourceDownloadTask.ExpiresAt.Valid && sourceDownloadTask.ExpiresAt.Time.After(time.Now()) {
+		downloadTask.ExpiresAt = sourceDownloadTask.ExpiresAt
+	}
+
+	downloadTask, tagList, err := d.createDownloadTask(
+		ctx,
+		downloadTask,
+		protoSourceDownloadTask.GetTagList(),
+		protoSourceDownloadTask.GetLabelMap(),
+		getAccountActor(accountID),
+		fmt.Sprintf("download task cloned from %d", sourceDownloadTask.ID),
+	)
+	if err != nil {
+		return CloneDownloadTaskOutput{}, err
+	}
+
+	protoDownloadTask := databaseDownloadTaskToProtoDownloadTask(downloadTask, account)
+	protoDownloadTask.TagList = tagList
+	protoDownloadTask.LabelMap = protoSourceDownloadTask.GetLabelMap()
+	return CloneDownloadTaskOutput{
+		DownloadTask: protoDownloadTask,
+	}, nil
+}
+
+func (d downloadTask) CancelDownloadTask(
+	ctx context.Context,
+	params CancelDownloadTaskParams,
+) (CancelDownloadTaskOutput, error) {
+	principal, err := getPrincipalWithRole(ctx, RoleOperator)
+	if err != nil {
+		return CancelDownloadTaskOutput{}, err
+	}
+
+	reason := params.Reason
+	if reason == "" {
+		reason = "download task cancelled by operator"
+	}
+
+	output := CancelDownloadTaskOutput{}
+	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		downloadTask, getDownloadTaskWithXLockErr := d.downloadTaskDataAccessor.
+			WithDatabase(td).
+			GetDownloadTaskWithXLock(ctx, params.DownloadTaskID)
+		if getDownloadTaskWithXLockErr != nil {
+			return getDownloadTaskWithXLockErr
+		}
+
+		if downloadTask.DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING &&
+			downloadTask.DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING {
+			return status.Errorf(
+				codes.FailedPrecondition,
+				"only pending or downloading download tasks can be cancelled, download task is %s",
+				downloadTask.DownloadStatus.String())
+		}
+
+		if updateDownloadTaskStatusErr := d.updateDownloadTaskStatus(
+			ctx, td, &downloadTask, go_load.DownloadStatus_DOWNLOAD_STATUS_CANCELLED,
+			getAccountActor(principal.AccountID), reason,
+		); updateDownloadTaskStatusErr != nil {
+			return updateDownloadTaskStatusErr
+		}
+
+		account, getAccountErr := d.accountDataAccessor.WithDatabase(td).GetAccountByID(ctx, downloadTask.OfAccountID)
+		if getAccountErr != nil {
+			return getAccountErr
+		}
+
+		output.DownloadTask = databaseDownloadTaskToProtoDownloadTask(downloadTask, account)
+		return d.fillDownloadTaskTagsAndLabels(ctx, td, []*go_load.DownloadTask{output.DownloadTask})
+	})
+	if txErr != nil {
+		return CancelDownloadTaskOutput{}, txErr
+	}
+
+	d.runningDownloadTaskMap.cancel(params.DownloadTaskID)
+	return output, nil
+}
+
 func (d downloadTask) updateDownloadTaskStatusFromPendingToDownloading(
 	ctx context.Context,
-	id uint64) (bool, database.DownloadTask, error) {
+	id uint64,
+) (bool, database.DownloadTask, error) {
 	var (
 		logger  = utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
 		updated = false
 		task    database.DownloadTask
-		err     error
 	)
 
 	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
@@ -274,63 +1015,264 @@ func (d downloadTask) updateDownloadTaskStatusFromPendingToDownloading(
 			return err
 		}
 
+		// a redelivered message of a task whose worker is gone is dropped here, RequeueStaleDownloadTasks produces a
+		// new one once the heartbeats of that worker stop
 		if downloadTask.DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING {
 			logger.Warn("download task is not pending, will not execute")
 			return nil
 		}
-		downloadTask.DownloadStatus = go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING
 
-		err = d.downloadTaskDataAccessor.UpdateDownloadTask(ctx, downloadTask)
+		err = d.updateDownloadTaskStatus(
+			ctx, td, &downloadTask,
+			go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING, downloadTaskActorSystem, "download started")
 		if err != nil {
 			logger.With(zap.Error(err)).Error("failed to update download task")
 			return err
 		}
+
 		updated = true
+		task = downloadTask
 		return nil
 	})
-
 	if txErr != nil {
-
 		return false, database.DownloadTask{}, txErr
 	}
-	return updated, task, err
+
+	return updated, task, nil
 }
 
-func (d downloadTask) ExecuteDownloadTask(ctx context.Context, id uint64) error {
+// updateDownloadTaskStatusFromDownloading finishes the given attempt of the task, and leaves the task alone when it
+// has been cancelled or requeued since.
+func (d downloadTask) updateDownloadTaskStatusFromDownloading(
+	ctx context.Context,
+	id uint64,
+	attempt uint32,
+	toStatus go_load.DownloadStatus,
+	reason string,
+) error {
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
 
-	updated, task, err := d.updateDownloadTaskStatusFromPendingToDownloading(ctx, id)
-	if err != nil {
-		return err
-	}
+	return d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		downloadTask, err := d.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskWithXLock(ctx, id)
+		if err != nil {
+			if errors.Is(err, database.ErrDownloadTaskNotFound) {
+				logger.Warn("download task was deleted while downloading")
+				return nil
+			}
 
-	if !updated {
-		return nil
-	}
+			return err
+		}
+
+		if downloadTask.DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING ||
+			downloadTask.Attempt != attempt {
+			logger.With(zap.String("download_status", downloadTask.DownloadStatus.String())).
+				Warn("download task is no longer downloading this attempt, will not update status")
+			return nil
+		}
+
+		return d.updateDownloadTaskStatus(ctx, td, &downloadTask, toStatus, downloadTaskActorSystem, reason)
+	})
+}
 
+func (d downloadTask) downloadFile(ctx context.Context, task database.DownloadTask) error {
 	var downloader Downloader
 	switch task.DownloadType {
 	case go_load.DownloadType_DOWNLOAD_TYPE_HTTP:
-		downloader = NewHTTPDownloader(task.URL, d.logger)
+		httpRequestOptions, err := getDownloadTaskHTTPRequestOptions(task)
+		if err != nil {
+			return err
+		}
+
+		downloader = NewHTTPDownloader(task.URL, lo.FromPtr(httpRequestOptions), d.logger)
 	default:
-		logger.With(zap.String("download_type", task.DownloadType.String())).Error("unsupported download type")
-		return nil
+		return status.Errorf(codes.InvalidArgument, "unsupported download type %s", task.DownloadType.String())
 	}
 
-	fileName := fmt.Sprintf("download_file_%d", task.ID)
-	fileWriterClose, err := d.client.Write(ctx, fileName)
+	fileWriteCloser, err := d.client.Write(ctx, getDownloadTaskFileName(task.ID))
 	if err != nil {
 		return err
 	}
 
-	defer fileWriterClose.Close()
+	defer fileWriteCloser.Close()
+
+	return downloader.Download(ctx, fileWriteCloser, d.getDownloadTaskProgressFunc(ctx, task))
+}
+
+// getDownloadTaskProgressFunc produces a DownloadTaskProgress event each time the download crosses one of the
+// milestones. Progress events are best effort, failing to produce one does not fail the download.
+func (d downloadTask) getDownloadTaskProgressFunc(
+	ctx context.Context,
+	task database.DownloadTask,
+) DownloadProgressFunc {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", task.ID))
+	nextMilestoneIndex := 0
+
+	return func(downloadedBytes, totalBytes uint64) {
+		if totalBytes == 0 || nextMilestoneIndex >= len(downloadTaskProgressMilestonePercentList) {
+			return
+		}
+
+		percent := uint32(min(downloadedBytes*100/totalBytes, 100))
+		if percent < downloadTaskProgressMilestonePercentList[nextMilestoneIndex] {
+			return
+		}
+
+		for nextMilestoneIndex < len(downloadTaskProgressMilestonePercentList) &&
+			downloadTaskProgressMilestonePercentList[nextMilestoneIndex] <= percent {
+			nextMilestoneIndex++
+		}
+
+		err := d.lifecycleEventProducer.ProduceDownloadTaskLifecycleEvent(
+			ctx, d.goquDatabase, task, &go_load.DownloadTaskLifecycleEvent{
+				Event: &go_load.DownloadTaskLifecycleEvent_Progress{Progress: &go_load.DownloadTaskProgress{
+					Percent:         downloadTaskProgressMilestonePercentList[nextMilestoneIndex-1],
+					DownloadedBytes: downloadedBytes,
+					TotalBytes:      totalBytes,
+				}},
+			})
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to produce download task progress event")
+		}
+	}
+}
+
+func (d downloadTask) ExecuteDownloadTask(ctx context.Context, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
 
-	err = downloader.Download(ctx, fileWriterClose)
+	updated, task, err := d.updateDownloadTaskStatusFromPendingToDownloading(ctx, id)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to download")
 		return err
 	}
 
+	if !updated {
+		return nil
+	}
+
+	downloadCtx, cancelDownload := context.WithCancel(ctx)
+	defer cancelDownload()
+
+	d.runningDownloadTaskMap.add(id, task.Attempt, cancelDownload)
+	defer d.runningDownloadTaskMap.remove(id, task.Attempt)
+
+	go d.keepDownloadTaskAlive(downloadCtx, cancelDownload, task)
+
+	if err = d.downloadFile(downloadCtx, task); err != nil {
+		if downloadCtx.Err() != nil && ctx.Err() == nil {
+			logger.Info("download stopped, download task was cancelled or requeued")
+			return nil
+		}
+
+		logger.With(zap.Error(err)).Error("failed to download")
+		return d.updateDownloadTaskStatusFromDownloading(
+			ctx, id, task.Attempt, go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED, err.Error())
+	}
+
 	logger.With(zap.String("url", task.URL)).Info("downloaded")
-	return nil
+	return d.updateDownloadTaskStatusFromDownloading(
+		ctx, id, task.Attempt, go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS, "download finished")
+}
+
+// keepDownloadTaskAlive sends heartbeats for the attempt being downloaded until ctx is done, so that
+// RequeueStaleDownloadTasks can tell it from an attempt whose worker is gone. The download is stopped once the
+// attempt is no longer the task's current one.
+func (d downloadTask) keepDownloadTaskAlive(
+	ctx context.Context,
+	cancelDownload context.CancelFunc,
+	task database.DownloadTask,
+) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", task.ID)).
+		With(zap.Uint32("attempt", task.Attempt))
+
+	ticker := time.NewTicker(d.heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+
+		alive, err := d.downloadTaskDataAccessor.UpdateDownloadTaskHeartbeat(ctx, task.ID, task.Attempt, time.Now())
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to send download task heartbeat")
+			continue
+		}
+
+		if !alive {
+			logger.Info("download task is no longer downloading this attempt, stopping download")
+			cancelDownload()
+			return
+		}
+	}
+}
+
+func (d downloadTask) RequeueStaleDownloadTasks(ctx context.Context) (int, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger)
+
+	staleTaskList, err := d.downloadTaskDataAccessor.GetDownloadTaskListWithStatusUpdatedBefore(
+		ctx,
+		[]go_load.DownloadStatus{go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING},
+		time.Now().Add(-d.staleTaskTimeout),
+		staleDownloadTaskBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	requeuedCount := 0
+	for _, staleTask := range staleTaskList {
+		requeued, requeueErr := d.requeueStaleDownloadTask(ctx, staleTask.ID)
+		if requeueErr != nil {
+			logger.With(zap.Uint64("id", staleTask.ID)).With(zap.Error(requeueErr)).
+				Error("failed to requeue stale download task")
+			continue
+		}
+
+		if requeued {
+			requeuedCount++
+		}
+	}
+
+	return requeuedCount, nil
+}
+
+func (d downloadTask) requeueStaleDownloadTask(ctx context.Context, id uint64) (bool, error) {
+	requeued := false
+	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		downloadTask, err := d.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskWithXLock(ctx, id)
+		if err != nil {
+			if errors.Is(err, database.ErrDownloadTaskNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		// the worker may have sent a heartbeat or finished since the list was read
+		if downloadTask.DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING ||
+			time.Since(downloadTask.UpdatedAt) < d.staleTaskTimeout {
+			return nil
+		}
+
+		// a new attempt makes the heartbeats of the lost worker fail, should it come back
+		downloadTask.Attempt++
+		err = d.updateDownloadTaskStatus(
+			ctx, td, &downloadTask,
+			go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING, downloadTaskActorSystem, "download task stalled")
+		if err != nil {
+			return err
+		}
+
+		requeued = true
+		return d.produceDownloadTaskCreated(ctx, td, downloadTask.ID)
+	})
+	if txErr != nil {
+		return false, txErr
+	}
+
+	if requeued {
+		utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id)).Warn("requeued stale download task")
+	}
+
+	return requeued, nil
 }
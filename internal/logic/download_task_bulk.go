@@ -0,0 +1,289 @@
+package logic
+
+import (
+	"context"
+	"goload/internal/dataaccess/database"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/utils"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxBulkDownloadTaskCount  = 1000
+	bulkDownloadTaskBatchSize = 100
+)
+
+type BulkDownloadTasksParams struct {
+	// Exactly one of DownloadTaskIDList and Filter must be set.
+	DownloadTaskIDList []uint64
+	Filter             *go_load.DownloadTaskFilter
+}
+
+type BulkDownloadTasksOutput struct {
+	ResultList []*go_load.BulkDownloadTaskResult
+}
+
+// bulkDownloadTaskOperation is applied to a task that is locked and that the caller can edit. It reports false when
+// the task is in a status the operation does not apply to, an error rolls back the whole batch.
+type bulkDownloadTaskOperation func(
+	ctx context.Context,
+	db database.Database,
+	task *database.DownloadTask,
+	actor string,
+) (bool, error)
+
+func (d downloadTask) getBulkDownloadTaskIDList(
+	ctx context.Context,
+	accountID uint64,
+	params BulkDownloadTasksParams,
+) ([]uint64, error) {
+	if (len(params.DownloadTaskIDList) == 0) == (params.Filter == nil) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of download_task_id_list and filter must be set")
+	}
+
+	if params.Filter == nil {
+		idList := lo.Uniq(params.DownloadTaskIDList)
+		if len(idList) > maxBulkDownloadTaskCount {
+			return nil, status.Errorf(
+				codes.InvalidArgument, "at most %d download tasks can be processed at once", maxBulkDownloadTaskCount)
+		}
+
+		return idList, nil
+	}
+
+	if workspaceID := params.Filter.GetWorkspaceId(); workspaceID != 0 {
+		_, err := checkWorkspaceRole(ctx, d.workspaceMemberDataAccessor, workspaceID, accountID, WorkspaceRoleViewer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	taskList, err := d.downloadTaskDataAccessor.GetDownloadTaskList(
+		ctx,
+		d.protoDownloadTaskFilterToDatabaseDownloadTaskListFilter(accountID, params.Filter),
+		database.DownloadTaskListSort{Key: database.DownloadTaskListSortKeyID},
+		nil,
+		0,
+		maxBulkDownloadTaskCount+1,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(taskList) > maxBulkDownloadTaskCount {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "filter matches more than %d download tasks", maxBulkDownloadTaskCount)
+	}
+
+	return lo.Map(taskList, func(task database.DownloadTask, _ int) uint64 { return task.ID }), nil
+}
+
+// isDownloadTaskEditable is checkDownloadTaskRole for WorkspaceRoleEditor, with the workspace roles of the account
+// looked up once for a whole batch.
+func isDownloadTaskEditable(
+	task database.DownloadTask,
+	accountID uint64,
+	workspaceRoleMap map[uint64]WorkspaceRole,
+) bool {
+	if !task.OfWorkspaceID.Valid {
+		return task.OfAccountID == accountID
+	}
+
+	workspaceRole, ok := workspaceRoleMap[uint64(task.OfWorkspaceID.Int64)]
+	return ok && isWorkspaceRoleGranted(workspaceRole, WorkspaceRoleEditor)
+}
+
+func (d downloadTask) runBulkDownloadTaskBatch(
+	ctx context.Context,
+	accountID uint64,
+	idList []uint64,
+	operation bulkDownloadTaskOperation,
+) ([]*go_load.BulkDownloadTaskResult, error) {
+	resultList := make([]*go_load.BulkDownloadTaskResult, 0, len(idList))
+	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		taskList, err := d.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskListByIDListWithXLock(ctx, idList)
+		if err != nil {
+			return err
+		}
+
+		memberList, err := d.workspaceMemberDataAccessor.WithDatabase(td).GetWorkspaceMemberListOfAccount(ctx, accountID)
+		if err != nil {
+			return err
+		}
+
+		taskMap := lo.KeyBy(taskList, func(task database.DownloadTask) uint64 { return task.ID })
+		workspaceRoleMap := lo.SliceToMap(memberList, func(member database.WorkspaceMember) (uint64, WorkspaceRole) {
+			return member.OfWorkspaceID, WorkspaceRole(member.Role)
+		})
+		for _, id := range idList {
+			result := &go_load.BulkDownloadTaskResult{DownloadTaskId: id}
+			resultList = append(resultList, result)
+
+			task, ok := taskMap[id]
+			if !ok {
+				result.Outcome = go_load.BulkDownloadTaskOutcome_BULK_DOWNLOAD_TASK_OUTCOME_NOT_FOUND
+				result.Message = "download task not found"
+				continue
+			}
+
+			if !isDownloadTaskEditable(task, accountID, workspaceRoleMap) {
+				result.Outcome = go_load.BulkDownloadTaskOutcome_BULK_DOWNLOAD_TASK_OUTCOME_PERMISSION_DENIED
+				result.Message = "permission denied"
+				continue
+			}
+
+			downloadStatus := task.DownloadStatus
+			applied, err := operation(ctx, td, &task, getAccountActor(accountID))
+			if err != nil {
+				// the quota is checked before anything is written, so the rest of the batch can go on
+				if isDownloadTaskQuotaExceededError(err) {
+					result.Outcome = go_load.BulkDownloadTaskOutcome_BULK_DOWNLOAD_TASK_OUTCOME_QUOTA_EXCEEDED
+					result.Message = status.Convert(err).Message()
+					continue
+				}
+
+				return err
+			}
+
+			if !applied {
+				result.Outcome = go_load.BulkDownloadTaskOutcome_BULK_DOWNLOAD_TASK_OUTCOME_INVALID_STATUS
+				result.Message = "download task is " + downloadStatus.String()
+				continue
+			}
+
+			result.Outcome = go_load.BulkDownloadTaskOutcome_BULK_DOWNLOAD_TASK_OUTCOME_SUCCEEDED
+		}
+
+		return nil
+	})
+	if txErr != nil {
+		return nil, txErr
+	}
+
+	return resultList, nil
+}
+
+// runBulkDownloadTaskOperation processes the selected tasks in batches, each batch in its own transaction. A
+// failed batch is rolled back and reported per task without stopping the remaining batches.
+func (d downloadTask) runBulkDownloadTaskOperation(
+	ctx context.Context,
+	params BulkDownloadTasksParams,
+	operation bulkDownloadTaskOperation,
+) (BulkDownloadTasksOutput, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger)
+
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return BulkDownloadTasksOutput{}, err
+	}
+
+	idList, err := d.getBulkDownloadTaskIDList(ctx, accountID, params)
+	if err != nil {
+		return BulkDownloadTasksOutput{}, err
+	}
+
+	output := BulkDownloadTasksOutput{
+		ResultList: make([]*go_load.BulkDownloadTaskResult, 0, len(idList)),
+	}
+	for _, idBatch := range lo.Chunk(idList, bulkDownloadTaskBatchSize) {
+		resultList, batchErr := d.runBulkDownloadTaskBatch(ctx, accountID, idBatch, operation)
+		if batchErr != nil {
+			logger.With(zap.Error(batchErr)).With(zap.Uint64s("id_list", idBatch)).
+				Error("failed to process bulk download task batch")
+			resultList = lo.Map(idBatch, func(id uint64, _ int) *go_load.BulkDownloadTaskResult {
+				return &go_load.BulkDownloadTaskResult{
+					DownloadTaskId: id,
+					Outcome:        go_load.BulkDownloadTaskOutcome_BULK_DOWNLOAD_TASK_OUTCOME_INTERNAL_ERROR,
+					Message:        status.Convert(batchErr).Message(),
+				}
+			})
+		}
+
+		output.ResultList = append(output.ResultList, resultList...)
+	}
+
+	return output, nil
+}
+
+func (d downloadTask) BulkDeleteDownloadTasks(
+	ctx context.Context,
+	params BulkDownloadTasksParams,
+) (BulkDownloadTasksOutput, error) {
+	return d.runBulkDownloadTaskOperation(ctx, params, func(
+		ctx context.Context,
+		db database.Database,
+		task *database.DownloadTask,
+		actor string,
+	) (bool, error) {
+		if err := d.deleteDownloadTask(ctx, db, *task, actor); err != nil {
+			return false, err
+		}
+
+		return true, nil
+	})
+}
+
+func (d downloadTask) BulkRetryDownloadTasks(
+	ctx context.Context,
+	params BulkDownloadTasksParams,
+) (BulkDownloadTasksOutput, error) {
+	return d.runBulkDownloadTaskOperation(ctx, params, func(
+		ctx context.Context,
+		db database.Database,
+		task *database.DownloadTask,
+		actor string,
+	) (bool, error) {
+		if task.DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED &&
+			task.DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_CANCELLED {
+			return false, nil
+		}
+
+		if err := d.retryDownloadTask(ctx, db, task, actor); err != nil {
+			return false, err
+		}
+
+		return true, nil
+	})
+}
+
+func (d downloadTask) BulkCancelDownloadTasks(
+	ctx context.Context,
+	params BulkDownloadTasksParams,
+) (BulkDownloadTasksOutput, error) {
+	output, err := d.runBulkDownloadTaskOperation(ctx, params, func(
+		ctx context.Context,
+		db database.Database,
+		task *database.DownloadTask,
+		actor string,
+	) (bool, error) {
+		if task.DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING &&
+			task.DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING {
+			return false, nil
+		}
+
+		err := d.updateDownloadTaskStatus(
+			ctx, db, task, go_load.DownloadStatus_DOWNLOAD_STATUS_CANCELLED, actor, "download task cancelled")
+		if err != nil {
+			return false, err
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return BulkDownloadTasksOutput{}, err
+	}
+
+	// the downloads are stopped once their batch is committed, a rolled back batch leaves them running
+	for _, result := range output.ResultList {
+		if result.Outcome == go_load.BulkDownloadTaskOutcome_BULK_DOWNLOAD_TASK_OUTCOME_SUCCEEDED {
+			d.runningDownloadTaskMap.cancel(result.DownloadTaskId)
+		}
+	}
+
+	return output, nil
+}
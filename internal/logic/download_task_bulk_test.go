@@ -0,0 +1,109 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"goload/internal/dataaccess/database"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestBulkCancelDownloadTasks(t *testing.T) {
+	workspaceID := func(id int64) sql.NullInt64 { return sql.NullInt64{Int64: id, Valid: true} }
+	store := newMockStore()
+	store.downloadTaskList = []database.DownloadTask{
+		{ID: 1, OfAccountID: 1, DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING},
+		{ID: 2, OfAccountID: 1, DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS},
+		{ID: 3, OfAccountID: 2, DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING},
+		{
+			ID: 4, OfAccountID: 2, OfWorkspaceID: workspaceID(1),
+			DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING,
+		},
+		{
+			ID: 5, OfAccountID: 2, OfWorkspaceID: workspaceID(2),
+			DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING,
+		},
+	}
+	store.memberList = []database.WorkspaceMember{
+		{OfWorkspaceID: 1, OfAccountID: 1, Role: string(WorkspaceRoleEditor)},
+		{OfWorkspaceID: 2, OfAccountID: 1, Role: string(WorkspaceRoleViewer)},
+	}
+
+	ctx := WithPrincipal(context.Background(), Principal{AccountID: 1, SessionID: 1})
+	output, err := newTestDownloadTask(store).BulkCancelDownloadTasks(ctx, BulkDownloadTasksParams{
+		DownloadTaskIDList: []uint64{1, 2, 3, 4, 5, 6, 1},
+	})
+	if err != nil {
+		t.Fatalf("failed to cancel download tasks: %v", err)
+	}
+
+	expectedOutcomeList := []go_load.BulkDownloadTaskOutcome{
+		go_load.BulkDownloadTaskOutcome_BULK_DOWNLOAD_TASK_OUTCOME_SUCCEEDED,
+		go_load.BulkDownloadTaskOutcome_BULK_DOWNLOAD_TASK_OUTCOME_INVALID_STATUS,
+		go_load.BulkDownloadTaskOutcome_BULK_DOWNLOAD_TASK_OUTCOME_PERMISSION_DENIED,
+		go_load.BulkDownloadTaskOutcome_BULK_DOWNLOAD_TASK_OUTCOME_SUCCEEDED,
+		go_load.BulkDownloadTaskOutcome_BULK_DOWNLOAD_TASK_OUTCOME_PERMISSION_DENIED,
+		go_load.BulkDownloadTaskOutcome_BULK_DOWNLOAD_TASK_OUTCOME_NOT_FOUND,
+	}
+	if len(output.ResultList) != len(expectedOutcomeList) {
+		t.Fatalf("expected one result per distinct id, got %+v", output.ResultList)
+	}
+
+	for i, expectedOutcome := range expectedOutcomeList {
+		result := output.ResultList[i]
+		if result.DownloadTaskId != uint64(i+1) || result.Outcome != expectedOutcome {
+			t.Errorf("expected %s for task %d, got %s for task %d", expectedOutcome, i+1, result.Outcome,
+				result.DownloadTaskId)
+		}
+	}
+
+	for _, task := range store.downloadTaskList {
+		cancelled := task.DownloadStatus == go_load.DownloadStatus_DOWNLOAD_STATUS_CANCELLED
+		if expectedCancelled := task.ID == 1 || task.ID == 4; cancelled != expectedCancelled {
+			t.Errorf("expected task %d cancelled %t, got status %s", task.ID, expectedCancelled, task.DownloadStatus)
+		}
+	}
+}
+
+func TestGetBulkDownloadTaskIDList(t *testing.T) {
+	tooManyIDList := make([]uint64, 0, maxBulkDownloadTaskCount+1)
+	for i := 0; i <= maxBulkDownloadTaskCount; i++ {
+		tooManyIDList = append(tooManyIDList, uint64(i+1))
+	}
+
+	testCaseList := []struct {
+		name            string
+		params          BulkDownloadTasksParams
+		expectedErrCode codes.Code
+	}{
+		{name: "id list", params: BulkDownloadTasksParams{DownloadTaskIDList: []uint64{1, 2}}},
+		{name: "neither id list nor filter", expectedErrCode: codes.InvalidArgument},
+		{
+			name: "both id list and filter",
+			params: BulkDownloadTasksParams{
+				DownloadTaskIDList: []uint64{1}, Filter: &go_load.DownloadTaskFilter{},
+			},
+			expectedErrCode: codes.InvalidArgument,
+		},
+		{
+			name:            "too many ids",
+			params:          BulkDownloadTasksParams{DownloadTaskIDList: tooManyIDList},
+			expectedErrCode: codes.InvalidArgument,
+		},
+		{
+			name:            "filter of a workspace the account is not a member of",
+			params:          BulkDownloadTasksParams{Filter: &go_load.DownloadTaskFilter{WorkspaceId: 1}},
+			expectedErrCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := newTestDownloadTask(newMockStore()).getBulkDownloadTaskIDList(
+				context.Background(), 1, testCase.params)
+			requireStatusCode(t, err, testCase.expectedErrCode)
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package logic
+
+import (
+	"encoding/json"
+	"fmt"
+	"goload/internal/dataaccess/database"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	downloadTaskMetadataKeyHTTPRequestOptions = "http_request_options"
+
+	maxHTTPRequestHeaderCount       = 32
+	maxHTTPRequestCookieCount       = 32
+	maxHTTPRequestHeaderValueLength = 4096
+	maxHTTPRequestBodyLength        = 65536
+	maxHTTPRequestUserAgentLength   = 512
+	maxHTTPBasicAuthFieldLength     = 256
+	maxHTTPBearerTokenLength        = 4096
+
+	redactedHTTPRequestOptionValue = "REDACTED"
+)
+
+var (
+	httpRequestMethodSet = map[string]struct{}{
+		http.MethodGet:   {},
+		http.MethodPost:  {},
+		http.MethodPut:   {},
+		http.MethodPatch: {},
+	}
+	httpTokenRegexp       = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]{1,256}$")
+	httpCookieValueRegexp = regexp.MustCompile(`^[\x21\x23-\x2B\x2D-\x3A\x3C-\x5B\x5D-\x7E]*$`)
+	httpBearerTokenRegexp = regexp.MustCompile(`^[A-Za-z0-9._~+/-]+=*$`)
+
+	// reservedHTTPRequestHeaderNameSet lists the headers that have their own option or are managed by the downloader.
+	reservedHTTPRequestHeaderNameSet = map[string]struct{}{
+		"Authorization":     {},
+		"Connection":        {},
+		"Content-Length":    {},
+		"Cookie":            {},
+		"Host":              {},
+		"Keep-Alive":        {},
+		"Proxy-Connection":  {},
+		"Range":             {},
+		"Te":                {},
+		"Trailer":           {},
+		"Transfer-Encoding": {},
+		"Upgrade":           {},
+		"User-Agent":        {},
+	}
+	secretHTTPHeaderNamePartList = []string{
+		"auth", "token", "key", "secret", "password", "session", "cookie", "signature", "credential",
+	}
+)
+
+func isSecretHTTPHeaderName(name string) bool {
+	name = strings.ToLower(name)
+	return lo.SomeBy(secretHTTPHeaderNamePartList, func(part string) bool { return strings.Contains(name, part) })
+}
+
+func isValidHTTPHeaderValue(value string) bool {
+	return !strings.ContainsAny(value, "\r\n\x00")
+}
+
+func protoHTTPRequestOptionsToHTTPRequestOptions(
+	protoOptions *go_load.HttpRequestOptions,
+) (HTTPRequestOptions, error) {
+	options := HTTPRequestOptions{
+		Method:      protoOptions.GetMethod(),
+		BearerToken: protoOptions.GetBearerToken(),
+		Body:        protoOptions.GetBody(),
+		UserAgent:   protoOptions.GetUserAgent(),
+	}
+
+	if _, ok := httpRequestMethodSet[options.Method]; options.Method != "" && !ok {
+		return HTTPRequestOptions{}, status.Errorf(codes.InvalidArgument, "unsupported http method %q", options.Method)
+	}
+
+	if len(options.Body) > 0 && (options.Method == "" || options.Method == http.MethodGet) {
+		return HTTPRequestOptions{}, status.Error(codes.InvalidArgument, "body cannot be sent with GET")
+	}
+
+	if len(options.Body) > maxHTTPRequestBodyLength {
+		return HTTPRequestOptions{}, status.Errorf(
+			codes.InvalidArgument, "body can be at most %d bytes", maxHTTPRequestBodyLength)
+	}
+
+	if len(protoOptions.GetHeaderMap()) > maxHTTPRequestHeaderCount {
+		return HTTPRequestOptions{}, status.Errorf(
+			codes.InvalidArgument, "at most %d headers can be set", maxHTTPRequestHeaderCount)
+	}
+
+	if len(protoOptions.GetHeaderMap()) > 0 {
+		options.HeaderMap = make(map[string]string, len(protoOptions.GetHeaderMap()))
+	}
+
+	for name, value := range protoOptions.GetHeaderMap() {
+		if !httpTokenRegexp.MatchString(name) {
+			return HTTPRequestOptions{}, status.Errorf(codes.InvalidArgument, "invalid header name %q", name)
+		}
+
+		name = http.CanonicalHeaderKey(name)
+		if _, ok := reservedHTTPRequestHeaderNameSet[name]; ok {
+			return HTTPRequestOptions{}, status.Errorf(codes.InvalidArgument, "header %q cannot be set", name)
+		}
+
+		if _, ok := options.HeaderMap[name]; ok {
+			return HTTPRequestOptions{}, status.Errorf(codes.InvalidArgument, "header %q is set more than once", name)
+		}
+
+		if !isValidHTTPHeaderValue(value) || len(value) > maxHTTPRequestHeaderValueLength {
+			return HTTPRequestOptions{}, status.Errorf(codes.InvalidArgument, "invalid value of header %q", name)
+		}
+
+		options.HeaderMap[name] = value
+	}
+
+	if len(protoOptions.GetCookieMap()) > maxHTTPRequestCookieCount {
+		return HTTPRequestOptions{}, status.Errorf(
+			codes.InvalidArgument, "at most %d cookies can be set", maxHTTPRequestCookieCount)
+	}
+
+	for name, value := range protoOptions.GetCookieMap() {
+		if !httpTokenRegexp.MatchString(name) {
+			return HTTPRequestOptions{}, status.Errorf(codes.InvalidArgument, "invalid cookie name %q", name)
+		}
+
+		if !httpCookieValueRegexp.MatchString(value) || len(value) > maxHTTPRequestHeaderValueLength {
+			return HTTPRequestOptions{}, status.Errorf(codes.InvalidArgument, "invalid value of cookie %q", name)
+		}
+	}
+
+	if len(protoOptions.GetCookieMap()) > 0 {
+		options.CookieMap = protoOptions.GetCookieMap()
+	}
+
+	if basicAuth := protoOptions.GetBasicAuth(); basicAuth != nil {
+		if strings.Contains(basicAuth.GetUsername(), ":") ||
+			len(basicAuth.GetUsername()) > maxHTTPBasicAuthFieldLength ||
+			len(basicAuth.GetPassword()) > maxHTTPBasicAuthFieldLength {
+			return HTTPRequestOptions{}, status.Error(codes.InvalidArgument, "invalid basic auth")
+		}
+
+		options.BasicAuth = &HTTPBasicAuth{
+			Username: basicAuth.GetUsername(),
+			Password: basicAuth.GetPassword(),
+		}
+	}
+
+	if options.BearerToken != "" &&
+		(!httpBearerTokenRegexp.MatchString(options.BearerToken) || len(options.BearerToken) > maxHTTPBearerTokenLength) {
+		return HTTPRequestOptions{}, status.Error(codes.InvalidArgument, "invalid bearer token")
+	}
+
+	if !isValidHTTPHeaderValue(options.UserAgent) || len(options.UserAgent) > maxHTTPRequestUserAgentLength {
+		return HTTPRequestOptions{}, status.Error(codes.InvalidArgument, "invalid user agent")
+	}
+
+	return options, nil
+}
+
+// getDownloadTaskHTTPRequestOptions returns nil when the download task has no request options.
+func getDownloadTaskHTTPRequestOptions(task database.DownloadTask) (*HTTPRequestOptions, error) {
+	metadataMap, ok := task.Metadata.Data.(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+
+	value, ok := metadataMap[downloadTaskMetadataKeyHTTPRequestOptions]
+	if !ok {
+		return nil, nil
+	}
+
+	// the metadata holds HTTPRequestOptions until it is written, and what json made of it after it is read
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read http request options: %w", err)
+	}
+
+	options := &HTTPRequestOptions{}
+	if err = json.Unmarshal(data, options); err != nil {
+		return nil, fmt.Errorf("failed to read http request options: %w", err)
+	}
+
+	return options, nil
+}
+
+// isSameHTTPOrigin reports whether both URLs have the same scheme and host, so that credentials meant for one can be
+// sent to the other.
+func isSameHTTPOrigin(firstURL, secondURL string) bool {
+	firstParsedURL, err := url.Parse(firstURL)
+	if err != nil {
+		return false
+	}
+
+	secondParsedURL, err := url.Parse(secondURL)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(firstParsedURL.Scheme, secondParsedURL.Scheme) &&
+		strings.EqualFold(firstParsedURL.Host, secondParsedURL.Host)
+}
+
+// getDownloadTaskMetadataWithoutHTTPRequestSecrets returns a copy of the metadata of the download task, with the
+// basic auth, bearer token, cookies, secret headers and body left out of its request options. They have to be
+// supplied again when a task is pointed at another host or cloned by an account that did not supply them.
+// Options that cannot be read are left out whole.
+func getDownloadTaskMetadataWithoutHTTPRequestSecrets(task database.DownloadTask) database.JSON {
+	metadataMap, ok := task.Metadata.Data.(map[string]any)
+	if !ok {
+		return task.Metadata
+	}
+
+	metadataMap = lo.Assign(metadataMap)
+	options, err := getDownloadTaskHTTPRequestOptions(task)
+	switch {
+	case err != nil:
+		delete(metadataMap, downloadTaskMetadataKeyHTTPRequestOptions)
+	case options != nil:
+		options.HeaderMap = lo.OmitBy(options.HeaderMap, func(name string, _ string) bool {
+			return isSecretHTTPHeaderName(name)
+		})
+		options.CookieMap = nil
+		options.BasicAuth = nil
+		options.BearerToken = ""
+		options.Body = nil
+		metadataMap[downloadTaskMetadataKeyHTTPRequestOptions] = *options
+	}
+
+	return database.JSON{Data: metadataMap}
+}
+
+// getDownloadTaskRedactedProtoHTTPRequestOptions leaves out the body and replaces the secrets, so the options can be
+// returned to anyone who can see the download task. Options that cannot be read are left out, the download task
+// fails when it is downloaded.
+func getDownloadTaskRedactedProtoHTTPRequestOptions(task database.DownloadTask) *go_load.HttpRequestOptions {
+	options, err := getDownloadTaskHTTPRequestOptions(task)
+	if err != nil || options == nil {
+		return nil
+	}
+
+	protoOptions := &go_load.HttpRequestOptions{
+		Method:    options.Method,
+		UserAgent: options.UserAgent,
+		HeaderMap: lo.MapValues(options.HeaderMap, func(value string, name string) string {
+			if isSecretHTTPHeaderName(name) {
+				return redactedHTTPRequestOptionValue
+			}
+
+			return value
+		}),
+		CookieMap: lo.MapValues(options.CookieMap, func(_ string, _ string) string {
+			return redactedHTTPRequestOptionValue
+		}),
+	}
+
+	if options.BasicAuth != nil {
+		protoOptions.Auth = &go_load.HttpRequestOptions_BasicAuth{BasicAuth: &go_load.HttpBasicAuth{
+			Username: options.BasicAuth.Username,
+			Password: redactedHTTPRequestOptionValue,
+		}}
+	} else if options.BearerToken != "" {
+		protoOptions.Auth = &go_load.HttpRequestOptions_BearerToken{BearerToken: redactedHTTPRequestOptionValue}
+	}
+
+	return protoOptions
+}
@@ -0,0 +1,80 @@
+package logic
+
+import (
+	"encoding/json"
+	"goload/internal/dataaccess/database"
+	"reflect"
+	"testing"
+)
+
+func TestIsSameHTTPOrigin(t *testing.T) {
+	testCaseList := []struct {
+		firstURL  string
+		secondURL string
+		expected  bool
+	}{
+		{firstURL: "https://example.com/a", secondURL: "https://EXAMPLE.com/b?c=d", expected: true},
+		{firstURL: "https://example.com/a", secondURL: "http://example.com/a", expected: false},
+		{firstURL: "https://example.com/a", secondURL: "https://example.com:8443/a", expected: false},
+		{firstURL: "https://example.com/a", secondURL: "https://attacker.example/a", expected: false},
+		{firstURL: "https://example.com/a", secondURL: "https://example.com.attacker.example/a", expected: false},
+		{firstURL: "https://example.com/a", secondURL: "://invalid", expected: false},
+	}
+
+	for _, testCase := range testCaseList {
+		if actual := isSameHTTPOrigin(testCase.firstURL, testCase.secondURL); actual != testCase.expected {
+			t.Errorf("isSameHTTPOrigin(%q, %q) = %v, expected %v",
+				testCase.firstURL, testCase.secondURL, actual, testCase.expected)
+		}
+	}
+}
+
+func TestGetDownloadTaskMetadataWithoutHTTPRequestSecrets(t *testing.T) {
+	options := HTTPRequestOptions{
+		Method:      "POST",
+		HeaderMap:   map[string]string{"X-Api-Key": "secret", "Accept": "application/json"},
+		CookieMap:   map[string]string{"session": "secret"},
+		BasicAuth:   &HTTPBasicAuth{Username: "user", Password: "secret"},
+		BearerToken: "secret",
+		Body:        []byte("password=secret"),
+		UserAgent:   "goload",
+	}
+
+	// the metadata holds the options as json made of them once it has been read from the database
+	data, err := json.Marshal(map[string]any{downloadTaskMetadataKeyHTTPRequestOptions: options, "other": "kept"})
+	if err != nil {
+		t.Fatalf("failed to marshal metadata: %v", err)
+	}
+
+	metadataMap := make(map[string]any)
+	if err = json.Unmarshal(data, &metadataMap); err != nil {
+		t.Fatalf("failed to unmarshal metadata: %v", err)
+	}
+
+	task := database.DownloadTask{Metadata: database.JSON{Data: metadataMap}}
+	strippedTask := database.DownloadTask{Metadata: getDownloadTaskMetadataWithoutHTTPRequestSecrets(task)}
+
+	strippedOptions, err := getDownloadTaskHTTPRequestOptions(strippedTask)
+	if err != nil {
+		t.Fatalf("failed to read stripped options: %v", err)
+	}
+
+	expectedOptions := &HTTPRequestOptions{
+		Method:    "POST",
+		HeaderMap: map[string]string{"Accept": "application/json"},
+		UserAgent: "goload",
+	}
+	if !reflect.DeepEqual(strippedOptions, expectedOptions) {
+		t.Fatalf("unexpected stripped options %+v", strippedOptions)
+	}
+
+	if strippedTask.Metadata.Data.(map[string]any)["other"] != "kept" {
+		t.Fatal("expected the rest of the metadata to be kept")
+	}
+
+	// the source task keeps its secrets
+	sourceOptions, err := getDownloadTaskHTTPRequestOptions(task)
+	if err != nil || sourceOptions.BearerToken != "secret" {
+		t.Fatalf("expected the source task to keep its options, got %+v, %v", sourceOptions, err)
+	}
+}
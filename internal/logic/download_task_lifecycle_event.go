@@ -0,0 +1,161 @@
+package logic
+
+import (
+	"context"
+	"goload/internal/dataaccess/database"
+	"goload/internal/dataaccess/mq/producer"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/utils"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DownloadTaskLifecycleEventProducer publishes go_load.v1.DownloadTaskLifecycleEvent messages through the
+// outbox, so it must be called with the transaction that makes the change the event describes.
+type DownloadTaskLifecycleEventProducer interface {
+	// ProduceDownloadTaskLifecycleEvent fills the envelope of event, which only needs its event set, with a
+	// snapshot of task and publishes it to the queue of its event type.
+	ProduceDownloadTaskLifecycleEvent(
+		ctx context.Context,
+		db database.Database,
+		task database.DownloadTask,
+		event *go_load.DownloadTaskLifecycleEvent,
+	) error
+}
+
+type downloadTaskLifecycleEventProducer struct {
+	accountDataAccessor           database.AccountDataAccessor
+	downloadTaskTagDataAccessor   database.DownloadTaskTagDataAccessor
+	downloadTaskLabelDataAccessor database.DownloadTaskLabelDataAccessor
+	outboxMessageDataAccessor     database.OutboxMessageDataAccessor
+	logger                        *zap.Logger
+}
+
+func NewDownloadTaskLifecycleEventProducer(
+	accountDataAccessor database.AccountDataAccessor,
+	downloadTaskTagDataAccessor database.DownloadTaskTagDataAccessor,
+	downloadTaskLabelDataAccessor database.DownloadTaskLabelDataAccessor,
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	logger *zap.Logger,
+) DownloadTaskLifecycleEventProducer {
+	return &downloadTaskLifecycleEventProducer{
+		accountDataAccessor:           accountDataAccessor,
+		downloadTaskTagDataAccessor:   downloadTaskTagDataAccessor,
+		downloadTaskLabelDataAccessor: downloadTaskLabelDataAccessor,
+		outboxMessageDataAccessor:     outboxMessageDataAccessor,
+		logger:                        logger,
+	}
+}
+
+func getDownloadTaskLifecycleEventQueueName(event *go_load.DownloadTaskLifecycleEvent) (string, bool) {
+	switch event.GetEvent().(type) {
+	case *go_load.DownloadTaskLifecycleEvent_Started:
+		return producer.MessageQueueDownloadTaskStarted, true
+	case *go_load.DownloadTaskLifecycleEvent_Progress:
+		return producer.MessageQueueDownloadTaskProgress, true
+	case *go_load.DownloadTaskLifecycleEvent_Succeeded:
+		return producer.MessageQueueDownloadTaskSucceeded, true
+	case *go_load.DownloadTaskLifecycleEvent_Failed:
+		return producer.MessageQueueDownloadTaskFailed, true
+	case *go_load.DownloadTaskLifecycleEvent_Cancelled:
+		return producer.MessageQueueDownloadTaskCancelled, true
+	case *go_load.DownloadTaskLifecycleEvent_Deleted:
+		return producer.MessageQueueDownloadTaskDeleted, true
+	default:
+		return "", false
+	}
+}
+
+// getDownloadTaskStatusChangedLifecycleEvent returns the event to publish when a task moves to toStatus, or nil
+// if the transition has no event of its own.
+func getDownloadTaskStatusChangedLifecycleEvent(
+	task database.DownloadTask,
+	toStatus go_load.DownloadStatus,
+	actor string,
+	reason string,
+) *go_load.DownloadTaskLifecycleEvent {
+	switch toStatus {
+	case go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING:
+		return &go_load.DownloadTaskLifecycleEvent{Event: &go_load.DownloadTaskLifecycleEvent_Started{
+			Started: &go_load.DownloadTaskStarted{Attempt: task.Attempt},
+		}}
+	case go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS:
+		return &go_load.DownloadTaskLifecycleEvent{Event: &go_load.DownloadTaskLifecycleEvent_Succeeded{
+			Succeeded: &go_load.DownloadTaskSucceeded{},
+		}}
+	case go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED:
+		return &go_load.DownloadTaskLifecycleEvent{Event: &go_load.DownloadTaskLifecycleEvent_Failed{
+			Failed: &go_load.DownloadTaskFailed{Reason: reason},
+		}}
+	case go_load.DownloadStatus_DOWNLOAD_STATUS_CANCELLED:
+		return &go_load.DownloadTaskLifecycleEvent{Event: &go_load.DownloadTaskLifecycleEvent_Cancelled{
+			Cancelled: &go_load.DownloadTaskCancelled{Actor: actor, Reason: reason},
+		}}
+	default:
+		return nil
+	}
+}
+
+func (d downloadTaskLifecycleEventProducer) getDownloadTaskSnapshot(
+	ctx context.Context,
+	db database.Database,
+	task database.DownloadTask,
+) (*go_load.DownloadTask, error) {
+	account, err := d.accountDataAccessor.WithDatabase(db).GetAccountByID(ctx, task.OfAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	downloadTaskIDToTagList, err := d.downloadTaskTagDataAccessor.
+		WithDatabase(db).
+		GetDownloadTaskTagListOfDownloadTaskList(ctx, []uint64{task.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	downloadTaskIDToLabelMap, err := d.downloadTaskLabelDataAccessor.
+		WithDatabase(db).
+		GetDownloadTaskLabelMapOfDownloadTaskList(ctx, []uint64{task.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	protoDownloadTask := databaseDownloadTaskToProtoDownloadTask(task, account)
+	protoDownloadTask.TagList = downloadTaskIDToTagList[task.ID]
+	protoDownloadTask.LabelMap = downloadTaskIDToLabelMap[task.ID]
+	return protoDownloadTask, nil
+}
+
+func (d downloadTaskLifecycleEventProducer) ProduceDownloadTaskLifecycleEvent(
+	ctx context.Context,
+	db database.Database,
+	task database.DownloadTask,
+	event *go_load.DownloadTaskLifecycleEvent,
+) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", task.ID))
+
+	queueName, ok := getDownloadTaskLifecycleEventQueueName(event)
+	if !ok {
+		logger.Error("download task lifecycle event has no event set")
+		return status.Error(codes.Internal, "invalid download task lifecycle event")
+	}
+
+	snapshot, err := d.getDownloadTaskSnapshot(ctx, db, task)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task snapshot")
+		return err
+	}
+
+	event.SchemaVersion = producer.DownloadTaskLifecycleEventSchemaVersion
+	event.EventId = utils.NewID()
+	event.CorrelationId = utils.GetCorrelationID(ctx)
+	event.AccountId = task.OfAccountID
+	event.DownloadTask = snapshot
+	event.OccurredAt = timestamppb.New(time.Now())
+
+	return enqueueOutboxMessage(ctx, d.outboxMessageDataAccessor, db, queueName, event)
+}
@@ -0,0 +1,89 @@
+package logic
+
+import (
+	"context"
+	"goload/internal/dataaccess/database"
+	"goload/internal/dataaccess/mq/producer"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/utils"
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestProduceDownloadTaskStatusChangedLifecycleEvent(t *testing.T) {
+	testCaseList := []struct {
+		toStatus          go_load.DownloadStatus
+		expectedQueueName string
+	}{
+		{toStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING},
+		{
+			toStatus:          go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING,
+			expectedQueueName: producer.MessageQueueDownloadTaskStarted,
+		},
+		{
+			toStatus:          go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS,
+			expectedQueueName: producer.MessageQueueDownloadTaskSucceeded,
+		},
+		{
+			toStatus:          go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED,
+			expectedQueueName: producer.MessageQueueDownloadTaskFailed,
+		},
+		{
+			toStatus:          go_load.DownloadStatus_DOWNLOAD_STATUS_CANCELLED,
+			expectedQueueName: producer.MessageQueueDownloadTaskCancelled,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.toStatus.String(), func(t *testing.T) {
+			store := newMockStore()
+			store.accountList = []database.Account{{ID: 1, AccountName: "alice"}}
+			store.tagListMap[1] = []string{"video"}
+			task := database.DownloadTask{ID: 1, OfAccountID: 1, DownloadStatus: testCase.toStatus, Attempt: 2}
+
+			lifecycleEvent := getDownloadTaskStatusChangedLifecycleEvent(task, testCase.toStatus, "system", "reason")
+			if lifecycleEvent == nil {
+				if testCase.expectedQueueName != "" {
+					t.Fatal("expected a lifecycle event")
+				}
+
+				return
+			}
+
+			lifecycleEventProducer := NewDownloadTaskLifecycleEventProducer(
+				mockAccountDataAccessor{store: store},
+				mockDownloadTaskTagDataAccessor{store: store},
+				mockDownloadTaskLabelDataAccessor{store: store},
+				mockOutboxMessageDataAccessor{store: store},
+				zap.NewNop(),
+			)
+			ctx := utils.WithCorrelationID(context.Background(), "correlation-1")
+			if err := lifecycleEventProducer.ProduceDownloadTaskLifecycleEvent(ctx, nil, task, lifecycleEvent); err != nil {
+				t.Fatalf("failed to produce lifecycle event: %v", err)
+			}
+
+			if len(store.outboxMessageList) != 1 || store.outboxMessageList[0].QueueName != testCase.expectedQueueName {
+				t.Fatalf("expected one message to %s, got %+v", testCase.expectedQueueName, store.outboxMessageList)
+			}
+
+			publishedEvent := &go_load.DownloadTaskLifecycleEvent{}
+			if err := protojson.Unmarshal(store.outboxMessageList[0].Payload, publishedEvent); err != nil {
+				t.Fatalf("failed to unmarshal lifecycle event: %v", err)
+			}
+
+			if publishedEvent.GetSchemaVersion() != producer.DownloadTaskLifecycleEventSchemaVersion ||
+				publishedEvent.GetEventId() == "" || publishedEvent.GetCorrelationId() != "correlation-1" ||
+				publishedEvent.GetAccountId() != 1 || publishedEvent.GetOccurredAt() == nil {
+				t.Fatalf("unexpected envelope %+v", publishedEvent)
+			}
+
+			snapshot := publishedEvent.GetDownloadTask()
+			if snapshot.GetId() != 1 || snapshot.GetDownloadStatus() != testCase.toStatus ||
+				len(snapshot.GetTagList()) != 1 {
+				t.Fatalf("unexpected snapshot %+v", snapshot)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package logic
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"goload/internal/dataaccess/database"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errInvalidDownloadTaskListCursor = status.Error(codes.InvalidArgument, "invalid download task list cursor")
+)
+
+// downloadTaskListCursor is serialized into the opaque cursor string returned to clients. The sort key and
+// direction are embedded so a cursor cannot be replayed against a different ordering.
+type downloadTaskListCursor struct {
+	SortKey       go_load.DownloadTaskSortKey `json:"k"`
+	SortDirection go_load.SortDirection       `json:"d"`
+	CreatedAt     int64                       `json:"c,omitempty"`
+	ID            uint64                      `json:"i"`
+}
+
+func getDownloadTaskListSort(
+	sortKey go_load.DownloadTaskSortKey,
+	sortDirection go_load.SortDirection,
+) database.DownloadTaskListSort {
+	sort := database.DownloadTaskListSort{
+		Key:        database.DownloadTaskListSortKeyID,
+		Descending: sortDirection == go_load.SortDirection_SORT_DIRECTION_DESC,
+	}
+
+	if sortKey == go_load.DownloadTaskSortKey_DOWNLOAD_TASK_SORT_KEY_CREATED_AT {
+		sort.Key = database.DownloadTaskListSortKeyCreatedAt
+	}
+
+	return sort
+}
+
+func encodeDownloadTaskListCursor(
+	sortKey go_load.DownloadTaskSortKey,
+	sortDirection go_load.SortDirection,
+	task database.DownloadTask,
+) (string, error) {
+	cursorBytes, err := json.Marshal(downloadTaskListCursor{
+		SortKey:       sortKey,
+		SortDirection: sortDirection,
+		CreatedAt:     task.CreatedAt.UnixNano(),
+		ID:            task.ID,
+	})
+	if err != nil {
+		return "", status.Error(codes.Internal, "failed to encode download task list cursor")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(cursorBytes), nil
+}
+
+func decodeDownloadTaskListCursor(
+	cursor string,
+	sortKey go_load.DownloadTaskSortKey,
+	sortDirection go_load.SortDirection,
+) (*database.DownloadTaskListCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	cursorBytes, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errInvalidDownloadTaskListCursor
+	}
+
+	decodedCursor := downloadTaskListCursor{}
+	if err = json.Unmarshal(cursorBytes, &decodedCursor); err != nil {
+		return nil, errInvalidDownloadTaskListCursor
+	}
+
+	if decodedCursor.SortKey != sortKey || decodedCursor.SortDirection != sortDirection {
+		return nil, status.Error(codes.InvalidArgument, "download task list cursor does not match requested sort order")
+	}
+
+	return &database.DownloadTaskListCursor{
+		CreatedAt: time.Unix(0, decodedCursor.CreatedAt),
+		ID:        decodedCursor.ID,
+	}, nil
+}
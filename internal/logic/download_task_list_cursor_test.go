@@ -0,0 +1,71 @@
+package logic
+
+import (
+	"goload/internal/dataaccess/database"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestDecodeDownloadTaskListCursor(t *testing.T) {
+	task := database.DownloadTask{ID: 42, CreatedAt: time.Unix(1700000000, 123456789)}
+	cursor, err := encodeDownloadTaskListCursor(go_load.DownloadTaskSortKey_DOWNLOAD_TASK_SORT_KEY_CREATED_AT,
+		go_load.SortDirection_SORT_DIRECTION_DESC, task)
+	if err != nil {
+		t.Fatalf("failed to encode cursor: %v", err)
+	}
+
+	testCaseList := []struct {
+		name            string
+		cursor          string
+		sortKey         go_load.DownloadTaskSortKey
+		sortDirection   go_load.SortDirection
+		expectedCursor  *database.DownloadTaskListCursor
+		expectedErrCode codes.Code
+	}{
+		{name: "first page"},
+		{
+			name: "same sort order", cursor: cursor,
+			sortKey:        go_load.DownloadTaskSortKey_DOWNLOAD_TASK_SORT_KEY_CREATED_AT,
+			sortDirection:  go_load.SortDirection_SORT_DIRECTION_DESC,
+			expectedCursor: &database.DownloadTaskListCursor{CreatedAt: task.CreatedAt, ID: task.ID},
+		},
+		{
+			name: "other sort key", cursor: cursor,
+			sortKey:         go_load.DownloadTaskSortKey_DOWNLOAD_TASK_SORT_KEY_ID,
+			sortDirection:   go_load.SortDirection_SORT_DIRECTION_DESC,
+			expectedErrCode: codes.InvalidArgument,
+		},
+		{
+			name: "other sort direction", cursor: cursor,
+			sortKey:         go_load.DownloadTaskSortKey_DOWNLOAD_TASK_SORT_KEY_CREATED_AT,
+			sortDirection:   go_load.SortDirection_SORT_DIRECTION_ASC,
+			expectedErrCode: codes.InvalidArgument,
+		},
+		{name: "not base64", cursor: "not a cursor!", expectedErrCode: codes.InvalidArgument},
+		{name: "not json", cursor: "bm90IGpzb24", expectedErrCode: codes.InvalidArgument},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			decodedCursor, err := decodeDownloadTaskListCursor(testCase.cursor, testCase.sortKey,
+				testCase.sortDirection)
+			requireStatusCode(t, err, testCase.expectedErrCode)
+
+			if testCase.expectedCursor == nil {
+				if decodedCursor != nil {
+					t.Fatalf("expected no cursor, got %+v", decodedCursor)
+				}
+
+				return
+			}
+
+			if decodedCursor == nil || decodedCursor.ID != testCase.expectedCursor.ID ||
+				!decodedCursor.CreatedAt.Equal(testCase.expectedCursor.CreatedAt) {
+				t.Fatalf("expected cursor %+v, got %+v", testCase.expectedCursor, decodedCursor)
+			}
+		})
+	}
+}
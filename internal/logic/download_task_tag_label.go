@@ -0,0 +1,123 @@
+package logic
+
+import (
+	"context"
+	"goload/internal/dataaccess/database"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"regexp"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxDownloadTaskTagCount        = 32
+	maxDownloadTaskLabelCount      = 32
+	maxDownloadTaskLabelValueRunes = 256
+)
+
+var (
+	downloadTaskTagAndLabelKeyRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.:/-]{0,63}$`)
+)
+
+func normalizeDownloadTaskTagList(tagList []string) ([]string, error) {
+	tagList = lo.Uniq(tagList)
+	if len(tagList) > maxDownloadTaskTagCount {
+		return nil, status.Errorf(codes.InvalidArgument, "a download task can have at most %d tags", maxDownloadTaskTagCount)
+	}
+
+	for _, tag := range tagList {
+		if !downloadTaskTagAndLabelKeyRegexp.MatchString(tag) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tag %q", tag)
+		}
+	}
+
+	sort.Strings(tagList)
+	return tagList, nil
+}
+
+func validateDownloadTaskLabelMap(labelMap map[string]string) error {
+	if len(labelMap) > maxDownloadTaskLabelCount {
+		return status.Errorf(codes.InvalidArgument, "a download task can have at most %d labels", maxDownloadTaskLabelCount)
+	}
+
+	for key, value := range labelMap {
+		if !downloadTaskTagAndLabelKeyRegexp.MatchString(key) {
+			return status.Errorf(codes.InvalidArgument, "invalid label key %q", key)
+		}
+
+		if utf8.RuneCountInString(value) > maxDownloadTaskLabelValueRunes {
+			return status.Errorf(codes.InvalidArgument, "value of label %q is too long", key)
+		}
+	}
+
+	return nil
+}
+
+func (d downloadTask) setDownloadTaskTagList(
+	ctx context.Context,
+	db database.Database,
+	downloadTaskID uint64,
+	tagList []string,
+) ([]string, error) {
+	tagList, err := normalizeDownloadTaskTagList(tagList)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.downloadTaskTagDataAccessor.WithDatabase(db).SetDownloadTaskTagList(ctx, downloadTaskID, tagList)
+	if err != nil {
+		return nil, err
+	}
+
+	return tagList, nil
+}
+
+func (d downloadTask) setDownloadTaskLabelMap(
+	ctx context.Context,
+	db database.Database,
+	downloadTaskID uint64,
+	labelMap map[string]string,
+) error {
+	if err := validateDownloadTaskLabelMap(labelMap); err != nil {
+		return err
+	}
+
+	return d.downloadTaskLabelDataAccessor.WithDatabase(db).SetDownloadTaskLabelMap(ctx, downloadTaskID, labelMap)
+}
+
+// fillDownloadTaskTagsAndLabels loads tags and labels of every task in the list with one query each, instead of
+// one query per task.
+func (d downloadTask) fillDownloadTaskTagsAndLabels(
+	ctx context.Context,
+	db database.Database,
+	protoDownloadTaskList []*go_load.DownloadTask,
+) error {
+	downloadTaskIDList := lo.Map(protoDownloadTaskList, func(task *go_load.DownloadTask, _ int) uint64 {
+		return task.GetId()
+	})
+
+	downloadTaskIDToTagList, err := d.downloadTaskTagDataAccessor.
+		WithDatabase(db).
+		GetDownloadTaskTagListOfDownloadTaskList(ctx, downloadTaskIDList)
+	if err != nil {
+		return err
+	}
+
+	downloadTaskIDToLabelMap, err := d.downloadTaskLabelDataAccessor.
+		WithDatabase(db).
+		GetDownloadTaskLabelMapOfDownloadTaskList(ctx, downloadTaskIDList)
+	if err != nil {
+		return err
+	}
+
+	for _, protoDownloadTask := range protoDownloadTaskList {
+		protoDownloadTask.TagList = downloadTaskIDToTagList[protoDownloadTask.GetId()]
+		protoDownloadTask.LabelMap = downloadTaskIDToLabelMap[protoDownloadTask.GetId()]
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package logic
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestNormalizeDownloadTaskTagList(t *testing.T) {
+	tooManyTagList := make([]string, 0, maxDownloadTaskTagCount+1)
+	for i := 0; i <= maxDownloadTaskTagCount; i++ {
+		tooManyTagList = append(tooManyTagList, fmt.Sprintf("tag-%d", i))
+	}
+
+	testCaseList := []struct {
+		name            string
+		tagList         []string
+		expectedTagList []string
+		expectedErrCode codes.Code
+	}{
+		{name: "no tags", tagList: []string{}, expectedTagList: []string{}},
+		{
+			name:            "sorted without duplicates",
+			tagList:         []string{"video", "archive/2024", "video", "a.b:c_d-e"},
+			expectedTagList: []string{"a.b:c_d-e", "archive/2024", "video"},
+		},
+		{name: "too many tags", tagList: tooManyTagList, expectedErrCode: codes.InvalidArgument},
+		{name: "too many tags before removing duplicates", tagList: append(tooManyTagList[1:], "tag-1")},
+		{name: "empty tag", tagList: []string{""}, expectedErrCode: codes.InvalidArgument},
+		{name: "leading symbol", tagList: []string{"-video"}, expectedErrCode: codes.InvalidArgument},
+		{name: "space", tagList: []string{"my video"}, expectedErrCode: codes.InvalidArgument},
+		{name: "too long", tagList: []string{strings.Repeat("a", 65)}, expectedErrCode: codes.InvalidArgument},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			tagList, err := normalizeDownloadTaskTagList(testCase.tagList)
+			requireStatusCode(t, err, testCase.expectedErrCode)
+
+			if testCase.expectedTagList != nil && !slices.Equal(tagList, testCase.expectedTagList) {
+				t.Fatalf("expected tags %v, got %v", testCase.expectedTagList, tagList)
+			}
+		})
+	}
+}
+
+func TestValidateDownloadTaskLabelMap(t *testing.T) {
+	tooManyLabelMap := make(map[string]string)
+	for i := 0; i <= maxDownloadTaskLabelCount; i++ {
+		tooManyLabelMap[fmt.Sprintf("key-%d", i)] = "value"
+	}
+
+	testCaseList := []struct {
+		name            string
+		labelMap        map[string]string
+		expectedErrCode codes.Code
+	}{
+		{name: "valid labels", labelMap: map[string]string{"team": "media", "source/host": "", "note": "ünïcödé"}},
+		{name: "too many labels", labelMap: tooManyLabelMap, expectedErrCode: codes.InvalidArgument},
+		{name: "invalid key", labelMap: map[string]string{"my key": "value"}, expectedErrCode: codes.InvalidArgument},
+		{
+			name:     "longest value",
+			labelMap: map[string]string{"note": strings.Repeat("é", maxDownloadTaskLabelValueRunes)},
+		},
+		{
+			name:            "too long value",
+			labelMap:        map[string]string{"note": strings.Repeat("a", maxDownloadTaskLabelValueRunes+1)},
+			expectedErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			requireStatusCode(t, validateDownloadTaskLabelMap(testCase.labelMap), testCase.expectedErrCode)
+		})
+	}
+}
@@ -0,0 +1,469 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/database"
+	"goload/internal/dataaccess/file"
+	"goload/internal/dataaccess/mq/producer"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func newTestDownloadTask(store *mockStore) downloadTask {
+	return downloadTask{
+		accountDataAccessor:           mockAccountDataAccessor{store: store},
+		downloadTaskDataAccessor:      mockDownloadTaskDataAccessor{store: store},
+		downloadTaskTagDataAccessor:   mockDownloadTaskTagDataAccessor{store: store},
+		downloadTaskLabelDataAccessor: mockDownloadTaskLabelDataAccessor{store: store},
+		downloadTaskEventDataAccessor: mockDownloadTaskEventDataAccessor{store: store},
+		outboxMessageDataAccessor:     mockOutboxMessageDataAccessor{store: store},
+		lifecycleEventProducer:        mockDownloadTaskLifecycleEventProducer{store: store},
+		workspaceMemberDataAccessor:   mockWorkspaceMemberDataAccessor{store: store},
+		goquDatabase:                  newMockGoquDatabase(),
+		heartbeatInterval:             10 * time.Millisecond,
+		staleTaskTimeout:              time.Minute,
+		runningDownloadTaskMap: &runningDownloadTaskMap{
+			runningDownloadTaskByIDMap: make(map[uint64]runningDownloadTask),
+		},
+		logger: zap.NewNop(),
+	}
+}
+
+func TestRequeueStaleDownloadTasks(t *testing.T) {
+	now := time.Now()
+	store := newMockStore()
+	store.downloadTaskList = []database.DownloadTask{
+		{ID: 1, DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING, Attempt: 1,
+			UpdatedAt: now.Add(-time.Hour)},
+		{ID: 2, DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING, Attempt: 1,
+			UpdatedAt: now.Add(-time.Second)},
+		{ID: 3, DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING, Attempt: 1,
+			UpdatedAt: now.Add(-time.Hour)},
+		{ID: 4, DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS, Attempt: 1,
+			UpdatedAt: now.Add(-time.Hour)},
+	}
+
+	requeuedCount, err := newTestDownloadTask(store).RequeueStaleDownloadTasks(context.Background())
+	if err != nil {
+		t.Fatalf("failed to requeue stale download tasks: %v", err)
+	}
+
+	if requeuedCount != 1 {
+		t.Fatalf("expected 1 requeued task, got %d", requeuedCount)
+	}
+
+	expectedList := []struct {
+		status  go_load.DownloadStatus
+		attempt uint32
+	}{
+		{status: go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING, attempt: 2},
+		{status: go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING, attempt: 1},
+		{status: go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING, attempt: 1},
+		{status: go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS, attempt: 1},
+	}
+	for i, expected := range expectedList {
+		task := store.downloadTaskList[i]
+		if task.DownloadStatus != expected.status || task.Attempt != expected.attempt {
+			t.Errorf("task %d is %s at attempt %d, expected %s at attempt %d",
+				task.ID, task.DownloadStatus, task.Attempt, expected.status, expected.attempt)
+		}
+	}
+
+	if len(store.outboxMessageList) != 1 ||
+		store.outboxMessageList[0].QueueName != producer.MessageQueueDownloadTaskCreated {
+		t.Fatalf("expected one download task created message, got %+v", store.outboxMessageList)
+	}
+
+	if len(store.eventList) != 1 || store.eventList[0].OfDownloadTaskID != 1 ||
+		store.eventList[0].FromDownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING {
+		t.Fatalf("expected the requeue to be recorded in the task history, got %+v", store.eventList)
+	}
+}
+
+func TestKeepDownloadTaskAliveStopsRequeuedDownload(t *testing.T) {
+	startedAt := time.Now().Add(-time.Hour)
+	store := newMockStore()
+	store.downloadTaskList = []database.DownloadTask{
+		{ID: 1, DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING, Attempt: 1, UpdatedAt: startedAt},
+	}
+
+	d := newTestDownloadTask(store)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	downloadCtx, cancelDownload := context.WithCancel(ctx)
+	defer cancelDownload()
+
+	go d.keepDownloadTaskAlive(downloadCtx, cancelDownload, store.downloadTaskList[0])
+
+	for {
+		task, _ := d.downloadTaskDataAccessor.GetDownloadTask(ctx, 1)
+		if task.UpdatedAt.After(startedAt) {
+			break
+		}
+
+		if ctx.Err() != nil {
+			t.Fatal("expected the worker to send heartbeats")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	if downloadCtx.Err() != nil {
+		t.Fatal("expected the download to go on while its attempt is current")
+	}
+
+	// requeuing the task starts a new attempt
+	store.mutex.Lock()
+	store.downloadTaskList[0].Attempt = 2
+	store.mutex.Unlock()
+
+	<-downloadCtx.Done()
+	if ctx.Err() != nil {
+		t.Fatal("expected the download of the stale attempt to be stopped")
+	}
+}
+
+func TestUpdateDownloadTaskStatusFromDownloadingIgnoresStaleAttempt(t *testing.T) {
+	store := newMockStore()
+	store.downloadTaskList = []database.DownloadTask{
+		{ID: 1, DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING, Attempt: 2},
+	}
+
+	d := newTestDownloadTask(store)
+	err := d.updateDownloadTaskStatusFromDownloading(
+		context.Background(), 1, 1, go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED, "stale attempt failed")
+	if err != nil {
+		t.Fatalf("failed to update download task status: %v", err)
+	}
+
+	if store.downloadTaskList[0].DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING {
+		t.Fatal("expected the stale attempt to leave the task alone")
+	}
+
+	err = d.updateDownloadTaskStatusFromDownloading(
+		context.Background(), 1, 2, go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS, "download finished")
+	if err != nil {
+		t.Fatalf("failed to update download task status: %v", err)
+	}
+
+	if store.downloadTaskList[0].DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS {
+		t.Fatal("expected the current attempt to finish the task")
+	}
+}
+
+func TestExecuteDownloadTaskStopsCancelledDownload(t *testing.T) {
+	testCaseList := []struct {
+		name              string
+		heartbeatInterval time.Duration
+		cancelInProcess   bool
+	}{
+		// a heartbeat that never comes makes sure the download is stopped by the cancellation itself
+		{name: "cancelled in this process", heartbeatInterval: time.Hour, cancelInProcess: true},
+		{name: "cancelled in another process", heartbeatInterval: 10 * time.Millisecond},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			// the server sends the start of the file and then hangs until the request is given up
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Length", "1000000")
+				_, _ = w.Write([]byte("partial content"))
+				w.(http.Flusher).Flush()
+				<-r.Context().Done()
+			}))
+			defer server.Close()
+
+			store := newMockStore()
+			store.downloadTaskList = []database.DownloadTask{{
+				ID:             1,
+				DownloadType:   go_load.DownloadType_DOWNLOAD_TYPE_HTTP,
+				URL:            server.URL,
+				DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING,
+				Attempt:        1,
+			}}
+
+			d := newTestDownloadTask(store)
+			d.heartbeatInterval = testCase.heartbeatInterval
+			d.client = file.NewLocalFileClient(zap.NewNop(), configs.DownloadConfig{DownloadDir: t.TempDir()})
+
+			errChan := make(chan error, 1)
+			go func() {
+				errChan <- d.ExecuteDownloadTask(context.Background(), 1)
+			}()
+
+			// wait for the download to be running before cancelling it
+			for {
+				d.runningDownloadTaskMap.mutex.Lock()
+				_, running := d.runningDownloadTaskMap.runningDownloadTaskByIDMap[1]
+				d.runningDownloadTaskMap.mutex.Unlock()
+				if running {
+					break
+				}
+
+				time.Sleep(time.Millisecond)
+			}
+
+			store.mutex.Lock()
+			store.downloadTaskList[0].DownloadStatus = go_load.DownloadStatus_DOWNLOAD_STATUS_CANCELLED
+			store.mutex.Unlock()
+			if testCase.cancelInProcess {
+				d.runningDownloadTaskMap.cancel(1)
+			}
+
+			select {
+			case err := <-errChan:
+				if err != nil {
+					t.Fatalf("expected the cancelled download to stop quietly, got %v", err)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("expected the cancelled download to stop")
+			}
+
+			if store.downloadTaskList[0].DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_CANCELLED {
+				t.Fatalf("expected the task to stay cancelled, got %s", store.downloadTaskList[0].DownloadStatus)
+			}
+
+			d.runningDownloadTaskMap.mutex.Lock()
+			defer d.runningDownloadTaskMap.mutex.Unlock()
+			if len(d.runningDownloadTaskMap.runningDownloadTaskByIDMap) != 0 {
+				t.Fatal("expected the stopped download to be forgotten")
+			}
+		})
+	}
+}
+
+func TestUpdateDownloadTaskStatus(t *testing.T) {
+	startedAt := sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true}
+	testCaseList := []struct {
+		name               string
+		fromStatus         go_load.DownloadStatus
+		toStatus           go_load.DownloadStatus
+		expectedStarted    bool
+		expectedStartedNow bool
+		expectedFinished   bool
+	}{
+		{
+			name:               "download started",
+			fromStatus:         go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING,
+			toStatus:           go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING,
+			expectedStarted:    true,
+			expectedStartedNow: true,
+		},
+		{
+			name:             "download finished",
+			fromStatus:       go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING,
+			toStatus:         go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS,
+			expectedStarted:  true,
+			expectedFinished: true,
+		},
+		{
+			name:             "download cancelled",
+			fromStatus:       go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING,
+			toStatus:         go_load.DownloadStatus_DOWNLOAD_STATUS_CANCELLED,
+			expectedStarted:  true,
+			expectedFinished: true,
+		},
+		{
+			name:       "failed download retried",
+			fromStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED,
+			toStatus:   go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			store := newMockStore()
+			store.downloadTaskList = []database.DownloadTask{{ID: 1, DownloadStatus: testCase.fromStatus}}
+			task := store.downloadTaskList[0]
+			task.StartedAt = startedAt
+			if testCase.fromStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING {
+				task.FinishedAt = sql.NullTime{Time: time.Now(), Valid: true}
+			}
+
+			err := newTestDownloadTask(store).updateDownloadTaskStatus(
+				context.Background(), nil, &task, testCase.toStatus, getAccountActor(1), "test")
+			if err != nil {
+				t.Fatalf("failed to update download task status: %v", err)
+			}
+
+			if store.downloadTaskList[0].DownloadStatus != testCase.toStatus {
+				t.Fatalf("expected status %s, got %s", testCase.toStatus, store.downloadTaskList[0].DownloadStatus)
+			}
+
+			if task.StartedAt.Valid != testCase.expectedStarted ||
+				(testCase.expectedStarted && task.StartedAt.Time.After(startedAt.Time) != testCase.expectedStartedNow) {
+				t.Fatalf("unexpected started_at %+v", task.StartedAt)
+			}
+
+			if task.FinishedAt.Valid != testCase.expectedFinished {
+				t.Fatalf("unexpected finished_at %+v", task.FinishedAt)
+			}
+
+			if len(store.eventList) != 1 || store.eventList[0].FromDownloadStatus != testCase.fromStatus ||
+				store.eventList[0].ToDownloadStatus != testCase.toStatus ||
+				store.eventList[0].Actor != getAccountActor(1) || store.eventList[0].Reason != "test" {
+				t.Fatalf("expected the status change to be recorded in the task history, got %+v", store.eventList)
+			}
+		})
+	}
+}
+
+func TestRetryDownloadTask(t *testing.T) {
+	testCaseList := []struct {
+		name                       string
+		task                       database.DownloadTask
+		maxActiveDownloadTaskCount uint64
+		expectedErrCode            codes.Code
+	}{
+		{
+			name: "failed task",
+			task: database.DownloadTask{
+				OfAccountID: 1, DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED,
+			},
+		},
+		{
+			name: "cancelled task",
+			task: database.DownloadTask{
+				OfAccountID: 1, DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_CANCELLED,
+			},
+		},
+		{
+			name: "successful task",
+			task: database.DownloadTask{
+				OfAccountID: 1, DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS,
+			},
+			expectedErrCode: codes.FailedPrecondition,
+		},
+		{
+			name: "task of another account",
+			task: database.DownloadTask{
+				OfAccountID: 2, DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED,
+			},
+			expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name: "active download task quota reached",
+			task: database.DownloadTask{
+				OfAccountID: 1, DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED,
+			},
+			maxActiveDownloadTaskCount: 1,
+			expectedErrCode:            codes.ResourceExhausted,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			store := newMockStore()
+			store.accountList = []database.Account{{ID: 1}, {ID: 2}}
+			testCase.task.ID = 1
+			testCase.task.Attempt = 1
+			store.downloadTaskList = []database.DownloadTask{
+				testCase.task,
+				{ID: 2, OfAccountID: 1, DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING},
+			}
+			store.tagListMap[1] = []string{"video"}
+
+			d := newTestDownloadTask(store)
+			d.quotaConfig.MaxActiveDownloadTaskCount = testCase.maxActiveDownloadTaskCount
+			ctx := WithPrincipal(context.Background(), Principal{AccountID: 1, SessionID: 1})
+			output, err := d.RetryDownloadTask(ctx, RetryDownloadTaskParams{DownloadTaskID: 1})
+			requireStatusCode(t, err, testCase.expectedErrCode)
+
+			if err != nil {
+				if store.downloadTaskList[0].DownloadStatus != testCase.task.DownloadStatus ||
+					len(store.outboxMessageList) != 0 {
+					t.Fatal("expected the task to be left alone")
+				}
+
+				return
+			}
+
+			task := store.downloadTaskList[0]
+			if task.DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING || task.Attempt != 2 {
+				t.Fatalf("expected a pending second attempt, got %s at attempt %d", task.DownloadStatus, task.Attempt)
+			}
+
+			if len(store.outboxMessageList) != 1 ||
+				store.outboxMessageList[0].QueueName != producer.MessageQueueDownloadTaskCreated {
+				t.Fatalf("expected one download task created message, got %+v", store.outboxMessageList)
+			}
+
+			if output.DownloadTask.GetDownloadStatus() != go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING ||
+				len(output.DownloadTask.GetTagList()) != 1 {
+				t.Fatalf("unexpected output %+v", output.DownloadTask)
+			}
+		})
+	}
+}
+
+func TestCloneDownloadTask(t *testing.T) {
+	testCaseList := []struct {
+		name              string
+		expiresAt         sql.NullTime
+		expectedExpiresAt bool
+	}{
+		{name: "without expiry"},
+		{
+			name:              "expiry in the future",
+			expiresAt:         sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true},
+			expectedExpiresAt: true,
+		},
+		{name: "expiry in the past", expiresAt: sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true}},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			store := newMockStore()
+			store.accountList = []database.Account{{ID: 1}}
+			store.downloadTaskList = []database.DownloadTask{{
+				ID:             1,
+				OfAccountID:    1,
+				DownloadType:   go_load.DownloadType_DOWNLOAD_TYPE_HTTP,
+				URL:            "https://example.com/file",
+				DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS,
+				Attempt:        3,
+				ExpiresAt:      testCase.expiresAt,
+			}}
+			store.tagListMap[1] = []string{"video"}
+			store.labelMapMap[1] = map[string]string{"team": "media"}
+
+			ctx := WithPrincipal(context.Background(), Principal{AccountID: 1, SessionID: 1})
+			output, err := newTestDownloadTask(store).CloneDownloadTask(ctx, CloneDownloadTaskParams{DownloadTaskID: 1})
+			if err != nil {
+				t.Fatalf("failed to clone download task: %v", err)
+			}
+
+			if len(store.downloadTaskList) != 2 || output.DownloadTask.GetId() != 2 {
+				t.Fatalf("expected a new download task, got %+v", output.DownloadTask)
+			}
+
+			clone := store.downloadTaskList[1]
+			if clone.URL != "https://example.com/file" || clone.Attempt != 1 ||
+				clone.DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING {
+				t.Fatalf("unexpected clone %+v", clone)
+			}
+
+			if clone.ExpiresAt.Valid != testCase.expectedExpiresAt {
+				t.Fatalf("expected expiry %t, got %+v", testCase.expectedExpiresAt, clone.ExpiresAt)
+			}
+
+			if len(store.tagListMap[2]) != 1 || store.labelMapMap[2]["team"] != "media" {
+				t.Fatalf("expected the tags and labels to be copied, got %v and %v", store.tagListMap[2],
+					store.labelMapMap[2])
+			}
+
+			if len(store.outboxMessageList) != 1 {
+				t.Fatalf("expected one download task created message, got %+v", store.outboxMessageList)
+			}
+		})
+	}
+}
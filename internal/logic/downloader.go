@@ -1,50 +1,250 @@
 package logic
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"go.uber.org/zap"
 	"goload/internal/utils"
 	"io"
 	"net/http"
+	"strings"
+	"time"
 )
 
+const (
+	maxHTTPRedirectCount = 10
+	// maxHTTPResumeAttemptCount limits how many times a download interrupted by the network is resumed.
+	maxHTTPResumeAttemptCount = 3
+	httpResumeInitialDelay    = time.Second
+)
+
+// DownloadProgressFunc is called after every write with the number of bytes downloaded so far, and the total
+// number of bytes, which is 0 if unknown.
+type DownloadProgressFunc func(downloadedBytes, totalBytes uint64)
+
 type Downloader interface {
-	Download(ctx context.Context, writer io.Writer) error
+	Download(ctx context.Context, writer io.Writer, progressFunc DownloadProgressFunc) error
+}
+
+// progressWriter keeps the error of the writer apart, so that a download is not resumed after it failed to store
+// what it had downloaded.
+type progressWriter struct {
+	writer          io.Writer
+	downloadedBytes uint64
+	totalBytes      uint64
+	progressFunc    DownloadProgressFunc
+	writeErr        error
+}
+
+func (p *progressWriter) Write(data []byte) (int, error) {
+	n, err := p.writer.Write(data)
+	p.downloadedBytes += uint64(n)
+	p.writeErr = err
+	if p.progressFunc != nil {
+		p.progressFunc(p.downloadedBytes, p.totalBytes)
+	}
+
+	return n, err
+}
+
+type HTTPBasicAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// HTTPRequestOptions customizes the requests of an HTTPDownloader. It is stored in the metadata of the download
+// task, so a retried download makes the same requests.
+type HTTPRequestOptions struct {
+	// Method is GET when empty.
+	Method      string            `json:"method,omitempty"`
+	HeaderMap   map[string]string `json:"header_map,omitempty"`
+	CookieMap   map[string]string `json:"cookie_map,omitempty"`
+	BasicAuth   *HTTPBasicAuth    `json:"basic_auth,omitempty"`
+	BearerToken string            `json:"bearer_token,omitempty"`
+	Body        []byte            `json:"body,omitempty"`
+	UserAgent   string            `json:"user_agent,omitempty"`
 }
 
 type HTTPDownloader struct {
-	URL    string
-	logger *zap.Logger
+	URL            string
+	RequestOptions HTTPRequestOptions
+	client         *http.Client
+	logger         *zap.Logger
+}
+
+func NewHTTPDownloader(URL string, requestOptions HTTPRequestOptions, logger *zap.Logger) Downloader {
+	downloader := &HTTPDownloader{
+		URL:            URL,
+		RequestOptions: requestOptions,
+		logger:         logger,
+	}
+	downloader.client = &http.Client{CheckRedirect: downloader.checkRedirect}
+	return downloader
 }
 
-func NewHTTPDownloader(URL string, logger *zap.Logger) Downloader {
-	return &HTTPDownloader{
-		URL:    URL,
-		logger: logger,
+// checkRedirect keeps the headers that look like secrets from reaching another host, the same way the client
+// already drops Authorization and Cookie.
+func (h *HTTPDownloader) checkRedirect(request *http.Request, via []*http.Request) error {
+	if len(via) >= maxHTTPRedirectCount {
+		return fmt.Errorf("stopped after %d redirects", maxHTTPRedirectCount)
+	}
+
+	if request.URL.Host == via[0].URL.Host {
+		return nil
+	}
+
+	for name := range h.RequestOptions.HeaderMap {
+		if isSecretHTTPHeaderName(name) {
+			request.Header.Del(name)
+		}
+	}
+
+	return nil
+}
+
+// newRequest applies the request options, every request of a download must be made with it.
+func (h *HTTPDownloader) newRequest(ctx context.Context) (*http.Request, error) {
+	method := h.RequestOptions.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	var body io.Reader = http.NoBody
+	if len(h.RequestOptions.Body) > 0 {
+		body = bytes.NewReader(h.RequestOptions.Body)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, h.URL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, value := range h.RequestOptions.HeaderMap {
+		request.Header.Set(name, value)
+	}
+
+	for name, value := range h.RequestOptions.CookieMap {
+		request.AddCookie(&http.Cookie{Name: name, Value: value})
+	}
+
+	if h.RequestOptions.BasicAuth != nil {
+		request.SetBasicAuth(h.RequestOptions.BasicAuth.Username, h.RequestOptions.BasicAuth.Password)
+	}
+
+	if h.RequestOptions.BearerToken != "" {
+		request.Header.Set("Authorization", "Bearer "+h.RequestOptions.BearerToken)
 	}
+
+	if h.RequestOptions.UserAgent != "" {
+		request.Header.Set("User-Agent", h.RequestOptions.UserAgent)
+	}
+
+	return request, nil
 }
 
-func (h *HTTPDownloader) Download(ctx context.Context, writer io.Writer) error {
+// isResumable reports whether the rest of a response can be requested with a Range header. Only GET requests are
+// resumed, the other methods may not be safe to send again.
+func (h *HTTPDownloader) isResumable(response *http.Response) bool {
+	return (h.RequestOptions.Method == "" || h.RequestOptions.Method == http.MethodGet) &&
+		(response.StatusCode == http.StatusPartialContent || response.Header.Get("Accept-Ranges") == "bytes")
+}
+
+// downloadFrom makes one request for what the writer has not received yet, and reports whether the download can
+// be resumed when it fails. ifRange is the validator of the first response, so that a resumed request does not
+// append the bytes of a file that has changed since.
+func (h *HTTPDownloader) downloadFrom(
+	ctx context.Context,
+	writer *progressWriter,
+	ifRange *string,
+) (bool, error) {
 	logger := utils.LoggerWithContext(ctx, h.logger)
 
-	request, err := http.NewRequestWithContext(ctx, http.MethodGet, h.URL, http.NoBody)
+	request, err := h.newRequest(ctx)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to create http get request")
-		return err
+		logger.With(zap.Error(err)).Error("failed to create http request")
+		return false, err
+	}
+
+	offset := writer.downloadedBytes
+	if offset > 0 {
+		request.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		if *ifRange != "" {
+			request.Header.Set("If-Range", *ifRange)
+		}
 	}
 
-	response, err := http.DefaultClient.Do(request)
+	response, err := h.client.Do(request)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to make http get request")
+		logger.With(zap.Error(err)).Error("failed to make http request")
+		// nothing has been written by this request, so it can be made again as long as a resumed one could
+		return offset > 0, err
 	}
 
 	defer response.Body.Close()
 
-	_, err = io.Copy(writer, response.Body)
-	if err != nil {
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		logger.With(zap.Int("status_code", response.StatusCode)).Error("unexpected http response status code")
+		return false, fmt.Errorf("unexpected http response status code: %d", response.StatusCode)
+	}
+
+	if offset == 0 {
+		writer.totalBytes = uint64(max(response.ContentLength, 0))
+		// a weak ETag cannot be used with If-Range
+		if etag := response.Header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+			*ifRange = etag
+		} else {
+			*ifRange = response.Header.Get("Last-Modified")
+		}
+	} else if response.StatusCode != http.StatusPartialContent ||
+		!strings.HasPrefix(response.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", offset)) {
+		// the server sent the file from the start, or the file has changed
+		logger.With(zap.Int("status_code", response.StatusCode)).Error("http server did not resume the download")
+		return false, errors.New("http server did not resume the download")
+	}
+
+	if _, err = io.Copy(writer, response.Body); err != nil {
 		logger.With(zap.Error(err)).Error("failed to copy response body")
-		return err
+		return writer.writeErr == nil && h.isResumable(response), err
 	}
 
-	return nil
+	return false, nil
+}
+
+// Download resumes a GET interrupted by the network from where it stopped, with a Range request made by newRequest
+// like the first one. The parts of a download are written in order, since the writer is not seekable.
+func (h *HTTPDownloader) Download(ctx context.Context, writer io.Writer, progressFunc DownloadProgressFunc) error {
+	logger := utils.LoggerWithContext(ctx, h.logger)
+
+	var (
+		ifRange        string
+		progressWriter = &progressWriter{writer: writer, progressFunc: progressFunc}
+		delay          = httpResumeInitialDelay
+	)
+	for attemptCount := 1; ; attemptCount++ {
+		resumable, err := h.downloadFrom(ctx, progressWriter, &ifRange)
+		if err == nil {
+			return nil
+		}
+
+		if !resumable || attemptCount > maxHTTPResumeAttemptCount || ctx.Err() != nil {
+			return err
+		}
+
+		logger.
+			With(zap.Uint64("downloaded_bytes", progressWriter.downloadedBytes)).
+			With(zap.Int("attempt_count", attemptCount)).
+			Warn("resuming interrupted download")
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+
+		delay *= 2
+	}
 }
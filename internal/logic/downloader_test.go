@@ -0,0 +1,161 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// mockFileServer serves a file that supports ranges, and cuts the connection of the first request for the whole
+// file halfway through it.
+type mockFileServer struct {
+	server        *httptest.Server
+	content       []byte
+	modTime       time.Time
+	acceptRanges  bool
+	mutex         sync.Mutex
+	requestList   []*http.Request
+	interruptNext bool
+}
+
+func newMockFileServer(t *testing.T, acceptRanges bool) *mockFileServer {
+	t.Helper()
+
+	m := &mockFileServer{
+		content:       bytes.Repeat([]byte("0123456789"), 1000),
+		modTime:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		acceptRanges:  acceptRanges,
+		interruptNext: true,
+	}
+	m.server = httptest.NewServer(http.HandlerFunc(m.handle))
+	t.Cleanup(m.server.Close)
+	return m
+}
+
+func (m *mockFileServer) handle(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	m.requestList = append(m.requestList, r.Clone(context.Background()))
+	interrupt := m.interruptNext && r.Header.Get("Range") == ""
+	m.interruptNext = false
+	modTime := m.modTime
+	m.mutex.Unlock()
+
+	if interrupt {
+		if m.acceptRanges {
+			w.Header().Set("Accept-Ranges", "bytes")
+		}
+
+		w.Header().Set("Last-Modified", modTime.Format(http.TimeFormat))
+		w.Header().Set("Content-Length", strconv.Itoa(len(m.content)))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(m.content[:len(m.content)/2])
+		w.(http.Flusher).Flush()
+		panic(http.ErrAbortHandler)
+	}
+
+	if !m.acceptRanges {
+		r.Header.Del("Range")
+	}
+
+	http.ServeContent(w, r, "file", modTime, bytes.NewReader(m.content))
+}
+
+func TestHTTPDownloaderResumesInterruptedDownload(t *testing.T) {
+	fileServer := newMockFileServer(t, true)
+	requestOptions := HTTPRequestOptions{
+		HeaderMap:   map[string]string{"X-Api-Token": "header-secret"},
+		CookieMap:   map[string]string{"session": "cookie-secret"},
+		BearerToken: "bearer-secret",
+		UserAgent:   "goload-test",
+	}
+
+	var (
+		buffer          bytes.Buffer
+		downloadedBytes uint64
+		totalBytes      uint64
+	)
+	err := NewHTTPDownloader(fileServer.server.URL, requestOptions, zap.NewNop()).Download(
+		context.Background(), &buffer, func(progressDownloadedBytes, progressTotalBytes uint64) {
+			downloadedBytes, totalBytes = progressDownloadedBytes, progressTotalBytes
+		})
+	if err != nil {
+		t.Fatalf("failed to download: %v", err)
+	}
+
+	if !bytes.Equal(buffer.Bytes(), fileServer.content) {
+		t.Fatalf("downloaded %d bytes that differ from the file", buffer.Len())
+	}
+
+	if downloadedBytes != uint64(len(fileServer.content)) || totalBytes != uint64(len(fileServer.content)) {
+		t.Fatalf("unexpected progress %d of %d", downloadedBytes, totalBytes)
+	}
+
+	if len(fileServer.requestList) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(fileServer.requestList))
+	}
+
+	if rangeHeader := fileServer.requestList[1].Header.Get("Range"); rangeHeader !=
+		"bytes="+strconv.Itoa(len(fileServer.content)/2)+"-" {
+		t.Fatalf("unexpected range of the resumed request %q", rangeHeader)
+	}
+
+	// the request options apply to the resumed request as well
+	for i, request := range fileServer.requestList {
+		cookie, cookieErr := request.Cookie("session")
+		switch {
+		case request.Header.Get("Authorization") != "Bearer bearer-secret":
+			t.Fatalf("request %d has authorization %q", i, request.Header.Get("Authorization"))
+		case request.Header.Get("X-Api-Token") != "header-secret":
+			t.Fatalf("request %d has no custom header", i)
+		case cookieErr != nil || cookie.Value != "cookie-secret":
+			t.Fatalf("request %d has no cookie", i)
+		case request.UserAgent() != "goload-test":
+			t.Fatalf("request %d has user agent %q", i, request.UserAgent())
+		}
+	}
+}
+
+func TestHTTPDownloaderDoesNotResume(t *testing.T) {
+	testCaseList := []struct {
+		name                 string
+		acceptRanges         bool
+		method               string
+		changeFile           bool
+		expectedRequestCount int
+	}{
+		{name: "server without ranges", acceptRanges: false, expectedRequestCount: 1},
+		{name: "post request", acceptRanges: true, method: http.MethodPost, expectedRequestCount: 1},
+		{name: "file changed", acceptRanges: true, changeFile: true, expectedRequestCount: 2},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			fileServer := newMockFileServer(t, testCase.acceptRanges)
+			requestOptions := HTTPRequestOptions{Method: testCase.method}
+
+			var buffer bytes.Buffer
+			err := NewHTTPDownloader(fileServer.server.URL, requestOptions, zap.NewNop()).Download(
+				context.Background(), &buffer, func(uint64, uint64) {
+					if testCase.changeFile {
+						fileServer.mutex.Lock()
+						fileServer.modTime = fileServer.modTime.Add(time.Hour)
+						fileServer.mutex.Unlock()
+					}
+				})
+			if err == nil {
+				t.Fatal("expected the interrupted download to fail")
+			}
+
+			if len(fileServer.requestList) != testCase.expectedRequestCount {
+				t.Fatalf("expected %d requests, got %d", testCase.expectedRequestCount, len(fileServer.requestList))
+			}
+		})
+	}
+}
@@ -2,16 +2,30 @@ package logic
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
 	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
 	"goload/internal/configs"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	argon2IDHashPrefix = "$argon2id$"
+)
+
 type Hash interface {
 	Hash(ctx context.Context, data string) (string, error)
 	IsHashEqual(ctx context.Context, data string, hashed string) (bool, error)
+	// NeedsRehash returns true when hashed was not made with the configured algorithm, or with weaker parameters
+	// than the configured ones.
+	NeedsRehash(ctx context.Context, hashed string) bool
 }
 
 type hash struct {
@@ -22,9 +36,108 @@ func NewHash(config configs.Auth) Hash {
 	return &hash{accountConfig: config}
 }
 
+func (h hash) getAlgorithm() configs.HashAlgorithm {
+	if h.accountConfig.Hash.Algorithm == "" {
+		return configs.HashAlgorithmBcrypt
+	}
+
+	return h.accountConfig.Hash.Algorithm
+}
+
+type argon2IDHash struct {
+	version     int
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	salt        []byte
+	key         []byte
+}
+
+// encode returns the hash in the PHC string format, the same one the reference implementation uses.
+func (a argon2IDHash) encode() string {
+	return fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2IDHashPrefix,
+		a.version,
+		a.memory,
+		a.iterations,
+		a.parallelism,
+		base64.RawStdEncoding.EncodeToString(a.salt),
+		base64.RawStdEncoding.EncodeToString(a.key),
+	)
+}
+
+func decodeArgon2IDHash(hashed string) (argon2IDHash, error) {
+	partList := strings.Split(strings.TrimPrefix(hashed, argon2IDHashPrefix), "$")
+	if !strings.HasPrefix(hashed, argon2IDHashPrefix) || len(partList) != 4 {
+		return argon2IDHash{}, errors.New("invalid argon2id hash format")
+	}
+
+	decodedHash := argon2IDHash{}
+	if _, err := fmt.Sscanf(partList[0], "v=%d", &decodedHash.version); err != nil {
+		return argon2IDHash{}, err
+	}
+
+	if decodedHash.version != argon2.Version {
+		return argon2IDHash{}, fmt.Errorf("unsupported argon2id version %d", decodedHash.version)
+	}
+
+	_, err := fmt.Sscanf(
+		partList[1], "m=%d,t=%d,p=%d", &decodedHash.memory, &decodedHash.iterations, &decodedHash.parallelism)
+	if err != nil {
+		return argon2IDHash{}, err
+	}
+
+	if decodedHash.salt, err = base64.RawStdEncoding.DecodeString(partList[2]); err != nil {
+		return argon2IDHash{}, err
+	}
+
+	if decodedHash.key, err = base64.RawStdEncoding.DecodeString(partList[3]); err != nil {
+		return argon2IDHash{}, err
+	}
+
+	if decodedHash.iterations == 0 || decodedHash.parallelism == 0 || len(decodedHash.key) == 0 {
+		return argon2IDHash{}, errors.New("invalid argon2id hash parameters")
+	}
+
+	return decodedHash, nil
+}
+
+func (h hash) hashArgon2ID(data string) (string, error) {
+	argon2IDConfig := h.accountConfig.Hash.Argon2ID
+	salt := make([]byte, argon2IDConfig.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", status.Error(codes.Internal, "failed to hash data")
+	}
+
+	return argon2IDHash{
+		version:     argon2.Version,
+		memory:      argon2IDConfig.Memory,
+		iterations:  argon2IDConfig.Iterations,
+		parallelism: argon2IDConfig.Parallelism,
+		salt:        salt,
+		key: argon2.IDKey(
+			[]byte(data),
+			salt,
+			argon2IDConfig.Iterations,
+			argon2IDConfig.Memory,
+			argon2IDConfig.Parallelism,
+			argon2IDConfig.KeyLength,
+		),
+	}.encode(), nil
+}
+
 func (h hash) Hash(ctx context.Context, data string) (string, error) {
+	if h.getAlgorithm() == configs.HashAlgorithmArgon2ID {
+		return h.hashArgon2ID(data)
+	}
+
 	hashed, err := bcrypt.GenerateFromPassword([]byte(data), h.accountConfig.Hash.HashCost)
 	if err != nil {
+		if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+			return "", status.Error(codes.InvalidArgument, "data is too long to hash")
+		}
+
 		return "", status.Error(codes.Internal, "failed to hash data")
 	}
 
@@ -32,6 +145,23 @@ func (h hash) Hash(ctx context.Context, data string) (string, error) {
 }
 
 func (h hash) IsHashEqual(ctx context.Context, data string, hashed string) (bool, error) {
+	if strings.HasPrefix(hashed, argon2IDHashPrefix) {
+		decodedHash, err := decodeArgon2IDHash(hashed)
+		if err != nil {
+			return false, status.Error(codes.Internal, "failed to if data equal hash")
+		}
+
+		key := argon2.IDKey(
+			[]byte(data),
+			decodedHash.salt,
+			decodedHash.iterations,
+			decodedHash.memory,
+			decodedHash.parallelism,
+			uint32(len(decodedHash.key)),
+		)
+		return subtle.ConstantTimeCompare(key, decodedHash.key) == 1, nil
+	}
+
 	if err := bcrypt.CompareHashAndPassword([]byte(hashed), []byte(data)); err != nil {
 		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
 			return false, nil
@@ -42,3 +172,25 @@ func (h hash) IsHashEqual(ctx context.Context, data string, hashed string) (bool
 
 	return true, nil
 }
+
+func (h hash) NeedsRehash(ctx context.Context, hashed string) bool {
+	if h.getAlgorithm() == configs.HashAlgorithmArgon2ID {
+		decodedHash, err := decodeArgon2IDHash(hashed)
+		if err != nil {
+			return true
+		}
+
+		argon2IDConfig := h.accountConfig.Hash.Argon2ID
+		return decodedHash.memory < argon2IDConfig.Memory ||
+			decodedHash.iterations < argon2IDConfig.Iterations ||
+			uint32(len(decodedHash.salt)) < argon2IDConfig.SaltLength ||
+			uint32(len(decodedHash.key)) < argon2IDConfig.KeyLength
+	}
+
+	cost, err := bcrypt.Cost([]byte(hashed))
+	if err != nil {
+		return true
+	}
+
+	return cost < h.accountConfig.Hash.HashCost
+}
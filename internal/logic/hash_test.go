@@ -0,0 +1,180 @@
+package logic
+
+import (
+	"context"
+	"goload/internal/configs"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newTestHashConfig(algorithm configs.HashAlgorithm, cost int, memory uint32) configs.Auth {
+	return configs.Auth{Hash: configs.Hash{
+		Algorithm: algorithm,
+		HashCost:  cost,
+		Argon2ID: configs.Argon2ID{
+			Memory:      memory,
+			Iterations:  1,
+			Parallelism: 1,
+			SaltLength:  16,
+			KeyLength:   32,
+		},
+	}}
+}
+
+func TestHashIsHashEqual(t *testing.T) {
+	testCaseList := []struct {
+		name           string
+		hashConfig     configs.Auth
+		verifyConfig   configs.Auth
+		expectedPrefix string
+	}{
+		{
+			name:           "bcrypt",
+			hashConfig:     newTestHashConfig(configs.HashAlgorithmBcrypt, bcrypt.MinCost, 64),
+			verifyConfig:   newTestHashConfig(configs.HashAlgorithmBcrypt, bcrypt.MinCost, 64),
+			expectedPrefix: "$2a$",
+		},
+		{
+			name:           "bcrypt by default",
+			hashConfig:     newTestHashConfig("", bcrypt.MinCost, 64),
+			verifyConfig:   newTestHashConfig("", bcrypt.MinCost, 64),
+			expectedPrefix: "$2a$",
+		},
+		{
+			name:           "argon2id",
+			hashConfig:     newTestHashConfig(configs.HashAlgorithmArgon2ID, bcrypt.MinCost, 64),
+			verifyConfig:   newTestHashConfig(configs.HashAlgorithmArgon2ID, bcrypt.MinCost, 64),
+			expectedPrefix: argon2IDHashPrefix,
+		},
+		{
+			name:           "bcrypt hash verified after switching to argon2id",
+			hashConfig:     newTestHashConfig(configs.HashAlgorithmBcrypt, bcrypt.MinCost, 64),
+			verifyConfig:   newTestHashConfig(configs.HashAlgorithmArgon2ID, bcrypt.MinCost, 64),
+			expectedPrefix: "$2a$",
+		},
+		{
+			name:           "argon2id hash verified after switching to bcrypt",
+			hashConfig:     newTestHashConfig(configs.HashAlgorithmArgon2ID, bcrypt.MinCost, 64),
+			verifyConfig:   newTestHashConfig(configs.HashAlgorithmBcrypt, bcrypt.MinCost, 64),
+			expectedPrefix: argon2IDHashPrefix,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			hashed, err := NewHash(testCase.hashConfig).Hash(ctx, "password")
+			if err != nil {
+				t.Fatalf("failed to hash: %v", err)
+			}
+
+			if !strings.HasPrefix(hashed, testCase.expectedPrefix) {
+				t.Fatalf("expected hash with prefix %s, got %s", testCase.expectedPrefix, hashed)
+			}
+
+			verifyHash := NewHash(testCase.verifyConfig)
+			if isEqual, err := verifyHash.IsHashEqual(ctx, "password", hashed); err != nil || !isEqual {
+				t.Fatalf("expected the hash to match, got %t, %v", isEqual, err)
+			}
+
+			if isEqual, err := verifyHash.IsHashEqual(ctx, "other password", hashed); err != nil || isEqual {
+				t.Fatalf("expected the hash not to match, got %t, %v", isEqual, err)
+			}
+		})
+	}
+}
+
+func TestDecodeArgon2IDHash(t *testing.T) {
+	testCaseList := []struct {
+		name        string
+		hashed      string
+		expectedErr bool
+	}{
+		{name: "valid", hashed: "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHQ$a2V5a2V5"},
+		{name: "not argon2id", hashed: "$argon2i$v=19$m=64,t=1,p=1$c2FsdHNhbHQ$a2V5a2V5", expectedErr: true},
+		{name: "missing key", hashed: "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHQ", expectedErr: true},
+		{name: "unsupported version", hashed: "$argon2id$v=16$m=64,t=1,p=1$c2FsdHNhbHQ$a2V5a2V5", expectedErr: true},
+		{name: "invalid parameters", hashed: "$argon2id$v=19$m=64,p=1$c2FsdHNhbHQ$a2V5a2V5", expectedErr: true},
+		{name: "zero iterations", hashed: "$argon2id$v=19$m=64,t=0,p=1$c2FsdHNhbHQ$a2V5a2V5", expectedErr: true},
+		{name: "invalid salt", hashed: "$argon2id$v=19$m=64,t=1,p=1$!$a2V5a2V5", expectedErr: true},
+		{name: "empty key", hashed: "$argon2id$v=19$m=64,t=1,p=1$c2FsdHNhbHQ$", expectedErr: true},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			decodedHash, err := decodeArgon2IDHash(testCase.hashed)
+			if (err != nil) != testCase.expectedErr {
+				t.Fatalf("expected error %t, got %v", testCase.expectedErr, err)
+			}
+
+			if err == nil && decodedHash.encode() != testCase.hashed {
+				t.Fatalf("expected %s to encode back, got %s", testCase.hashed, decodedHash.encode())
+			}
+		})
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	testCaseList := []struct {
+		name          string
+		hashConfig    configs.Auth
+		currentConfig configs.Auth
+		expected      bool
+	}{
+		{
+			name:          "same bcrypt cost",
+			hashConfig:    newTestHashConfig(configs.HashAlgorithmBcrypt, bcrypt.MinCost, 64),
+			currentConfig: newTestHashConfig(configs.HashAlgorithmBcrypt, bcrypt.MinCost, 64),
+		},
+		{
+			name:          "lower bcrypt cost",
+			hashConfig:    newTestHashConfig(configs.HashAlgorithmBcrypt, bcrypt.MinCost, 64),
+			currentConfig: newTestHashConfig(configs.HashAlgorithmBcrypt, bcrypt.MinCost+1, 64),
+			expected:      true,
+		},
+		{
+			name:          "higher bcrypt cost",
+			hashConfig:    newTestHashConfig(configs.HashAlgorithmBcrypt, bcrypt.MinCost+1, 64),
+			currentConfig: newTestHashConfig(configs.HashAlgorithmBcrypt, bcrypt.MinCost, 64),
+		},
+		{
+			name:          "same argon2id parameters",
+			hashConfig:    newTestHashConfig(configs.HashAlgorithmArgon2ID, bcrypt.MinCost, 64),
+			currentConfig: newTestHashConfig(configs.HashAlgorithmArgon2ID, bcrypt.MinCost, 64),
+		},
+		{
+			name:          "lower argon2id memory",
+			hashConfig:    newTestHashConfig(configs.HashAlgorithmArgon2ID, bcrypt.MinCost, 64),
+			currentConfig: newTestHashConfig(configs.HashAlgorithmArgon2ID, bcrypt.MinCost, 128),
+			expected:      true,
+		},
+		{
+			name:          "bcrypt after switching to argon2id",
+			hashConfig:    newTestHashConfig(configs.HashAlgorithmBcrypt, bcrypt.MinCost, 64),
+			currentConfig: newTestHashConfig(configs.HashAlgorithmArgon2ID, bcrypt.MinCost, 64),
+			expected:      true,
+		},
+		{
+			name:          "argon2id after switching to bcrypt",
+			hashConfig:    newTestHashConfig(configs.HashAlgorithmArgon2ID, bcrypt.MinCost, 64),
+			currentConfig: newTestHashConfig(configs.HashAlgorithmBcrypt, bcrypt.MinCost, 64),
+			expected:      true,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			hashed, err := NewHash(testCase.hashConfig).Hash(ctx, "password")
+			if err != nil {
+				t.Fatalf("failed to hash: %v", err)
+			}
+
+			if needsRehash := NewHash(testCase.currentConfig).NeedsRehash(ctx, hashed); needsRehash != testCase.expected {
+				t.Fatalf("expected needs rehash %t, got %t", testCase.expected, needsRehash)
+			}
+		})
+	}
+}
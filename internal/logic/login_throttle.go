@@ -0,0 +1,133 @@
+package logic
+
+import (
+	"context"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/cache"
+	"goload/internal/utils"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errInvalidCredentials         = status.Error(codes.Unauthenticated, "incorrect account name or password")
+	errTooManyFailedLoginAttempts = status.Error(
+		codes.ResourceExhausted, "too many failed login attempts, try again later")
+)
+
+// loginThrottle counts failed logins per account name, whether the account exists or not, and per client IP. The
+// cache failing never blocks a login, it only turns the throttling off.
+type loginThrottle struct {
+	failedLoginAttemptCache cache.FailedLoginAttempt
+	loginThrottleConfig     configs.LoginThrottle
+	lockoutDuration         time.Duration
+	initialDelay            time.Duration
+	maxDelay                time.Duration
+	logger                  *zap.Logger
+}
+
+func newLoginThrottle(
+	failedLoginAttemptCache cache.FailedLoginAttempt,
+	loginThrottleConfig configs.LoginThrottle,
+	logger *zap.Logger,
+) (loginThrottle, error) {
+	lockoutDuration, err := loginThrottleConfig.GetLockoutDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse lockout_duration: " + loginThrottleConfig.LockoutDuration)
+		return loginThrottle{}, err
+	}
+
+	initialDelay, err := loginThrottleConfig.GetInitialDelayDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse initial_delay: " + loginThrottleConfig.InitialDelay)
+		return loginThrottle{}, err
+	}
+
+	maxDelay, err := loginThrottleConfig.GetMaxDelayDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse max_delay: " + loginThrottleConfig.MaxDelay)
+		return loginThrottle{}, err
+	}
+
+	return loginThrottle{
+		failedLoginAttemptCache: failedLoginAttemptCache,
+		loginThrottleConfig:     loginThrottleConfig,
+		lockoutDuration:         lockoutDuration,
+		initialDelay:            initialDelay,
+		maxDelay:                maxDelay,
+		logger:                  logger,
+	}, nil
+}
+
+func (l loginThrottle) getDelay(failedAttemptCount uint64) time.Duration {
+	delay := l.initialDelay
+	for i := uint64(1); i < failedAttemptCount && delay < l.maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, l.maxDelay)
+}
+
+// check returns errTooManyFailedLoginAttempts while the account name or the client IP is locked out.
+func (l loginThrottle) check(ctx context.Context, accountName, clientIP string) error {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	if maxCount := l.loginThrottleConfig.MaxFailedAttemptCountPerAccount; maxCount > 0 {
+		count, err := l.failedLoginAttemptCache.GetAccountCount(ctx, accountName)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to get failed login attempt count of account name")
+		} else if count >= maxCount {
+			logger.With(zap.String("account_name", accountName)).Info("login of locked out account name rejected")
+			return errTooManyFailedLoginAttempts
+		}
+	}
+
+	if maxCount := l.loginThrottleConfig.MaxFailedAttemptCountPerClientIP; maxCount > 0 && clientIP != "" {
+		count, err := l.failedLoginAttemptCache.GetClientIPCount(ctx, clientIP)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to get failed login attempt count of client ip")
+		} else if count >= maxCount {
+			logger.With(zap.String("client_ip", clientIP)).Info("login from locked out client ip rejected")
+			return errTooManyFailedLoginAttempts
+		}
+	}
+
+	return nil
+}
+
+// recordFailure counts the failed login, then waits longer the more failed logins the account name had, before
+// the caller answers.
+func (l loginThrottle) recordFailure(ctx context.Context, accountName, clientIP string) {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	count, err := l.failedLoginAttemptCache.IncrementAccountCount(ctx, accountName, l.lockoutDuration)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to increment failed login attempt count of account name")
+		count = 1
+	}
+
+	if clientIP != "" {
+		_, err = l.failedLoginAttemptCache.IncrementClientIPCount(ctx, clientIP, l.lockoutDuration)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to increment failed login attempt count of client ip")
+		}
+	}
+
+	timer := time.NewTimer(l.getDelay(count))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
+
+func (l loginThrottle) recordSuccess(ctx context.Context, accountName string) {
+	if err := l.failedLoginAttemptCache.ResetAccountCount(ctx, accountName); err != nil {
+		utils.LoggerWithContext(ctx, l.logger).With(zap.Error(err)).
+			Warn("failed to reset failed login attempt count of account name")
+	}
+}
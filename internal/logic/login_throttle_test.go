@@ -0,0 +1,162 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/cache"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestLoginThrottleGetDelay(t *testing.T) {
+	throttle, err := newLoginThrottle(nil, configs.LoginThrottle{
+		LockoutDuration: "1m",
+		InitialDelay:    "100ms",
+		MaxDelay:        "1s",
+	}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to create login throttle: %v", err)
+	}
+
+	testCaseList := []struct {
+		failedAttemptCount uint64
+		expectedDelay      time.Duration
+	}{
+		{failedAttemptCount: 0, expectedDelay: 100 * time.Millisecond},
+		{failedAttemptCount: 1, expectedDelay: 100 * time.Millisecond},
+		{failedAttemptCount: 2, expectedDelay: 200 * time.Millisecond},
+		{failedAttemptCount: 4, expectedDelay: 800 * time.Millisecond},
+		{failedAttemptCount: 5, expectedDelay: time.Second},
+		{failedAttemptCount: 1000, expectedDelay: time.Second},
+	}
+
+	for _, testCase := range testCaseList {
+		if delay := throttle.getDelay(testCase.failedAttemptCount); delay != testCase.expectedDelay {
+			t.Errorf("expected delay %s after %d failed attempts, got %s", testCase.expectedDelay,
+				testCase.failedAttemptCount, delay)
+		}
+	}
+}
+
+func TestNewLoginThrottleInvalidConfig(t *testing.T) {
+	testCaseList := []struct {
+		name   string
+		config configs.LoginThrottle
+	}{
+		{
+			name:   "invalid lockout duration",
+			config: configs.LoginThrottle{LockoutDuration: "x", InitialDelay: "1s", MaxDelay: "1s"},
+		},
+		{
+			name:   "invalid initial delay",
+			config: configs.LoginThrottle{LockoutDuration: "1m", InitialDelay: "x", MaxDelay: "1s"},
+		},
+		{
+			name:   "invalid max delay",
+			config: configs.LoginThrottle{LockoutDuration: "1m", InitialDelay: "1s", MaxDelay: "x"},
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			if _, err := newLoginThrottle(nil, testCase.config, zap.NewNop()); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestLoginThrottleCheck(t *testing.T) {
+	type failure struct {
+		accountName string
+		clientIP    string
+	}
+
+	testCaseList := []struct {
+		name        string
+		failureList []failure
+		succeeded   bool
+		accountName string
+		clientIP    string
+		expectedErr error
+	}{
+		{name: "no failed attempts", accountName: "alice", clientIP: "10.0.0.1"},
+		{
+			name:        "below the account limit",
+			failureList: []failure{{"alice", "10.0.0.1"}, {"alice", "10.0.0.1"}},
+			accountName: "alice",
+			clientIP:    "10.0.0.1",
+		},
+		{
+			name:        "account locked out",
+			failureList: []failure{{"alice", "10.0.0.1"}, {"alice", "10.0.0.2"}, {"alice", "10.0.0.3"}},
+			accountName: "alice",
+			clientIP:    "10.0.0.4",
+			expectedErr: errTooManyFailedLoginAttempts,
+		},
+		{
+			name:        "account locked out whether it exists or not",
+			failureList: []failure{{"nobody", ""}, {"nobody", ""}, {"nobody", ""}},
+			accountName: "nobody",
+			expectedErr: errTooManyFailedLoginAttempts,
+		},
+		{
+			name:        "other account not locked out",
+			failureList: []failure{{"alice", "10.0.0.1"}, {"alice", "10.0.0.2"}, {"alice", "10.0.0.3"}},
+			accountName: "bob",
+			clientIP:    "10.0.0.4",
+		},
+		{
+			name: "client ip locked out",
+			failureList: []failure{
+				{"alice", "10.0.0.1"}, {"bob", "10.0.0.1"}, {"carol", "10.0.0.1"}, {"dave", "10.0.0.1"},
+				{"erin", "10.0.0.1"},
+			},
+			accountName: "frank",
+			clientIP:    "10.0.0.1",
+			expectedErr: errTooManyFailedLoginAttempts,
+		},
+		{
+			name:        "success resets the account count",
+			failureList: []failure{{"alice", "10.0.0.1"}, {"alice", "10.0.0.2"}, {"alice", "10.0.0.3"}},
+			succeeded:   true,
+			accountName: "alice",
+			clientIP:    "10.0.0.4",
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			throttle, err := newLoginThrottle(
+				cache.NewFailedLoginAttempt(cache.NewInMemoryClient(zap.NewNop()), zap.NewNop()),
+				configs.LoginThrottle{
+					MaxFailedAttemptCountPerAccount:  3,
+					MaxFailedAttemptCountPerClientIP: 5,
+					LockoutDuration:                  "1m",
+					InitialDelay:                     "1ms",
+					MaxDelay:                         "1ms",
+				},
+				zap.NewNop(),
+			)
+			if err != nil {
+				t.Fatalf("failed to create login throttle: %v", err)
+			}
+
+			ctx := context.Background()
+			for _, failure := range testCase.failureList {
+				throttle.recordFailure(ctx, failure.accountName, failure.clientIP)
+			}
+
+			if testCase.succeeded {
+				throttle.recordSuccess(ctx, testCase.accountName)
+			}
+
+			if err = throttle.check(ctx, testCase.accountName, testCase.clientIP); !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("expected error %v, got %v", testCase.expectedErr, err)
+			}
+		})
+	}
+}
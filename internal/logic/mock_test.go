@@ -0,0 +1,1104 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"goload/internal/dataaccess/cache"
+	"goload/internal/dataaccess/database"
+	"goload/internal/dataaccess/mq/producer"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockTxDriver only supports transactions, the data accessors of the tests keep their rows in memory.
+type mockTxDriver struct{}
+
+func (mockTxDriver) Open(string) (driver.Conn, error)             { return mockTxDriver{}, nil }
+func (mockTxDriver) Connect(context.Context) (driver.Conn, error) { return mockTxDriver{}, nil }
+func (mockTxDriver) Driver() driver.Driver                        { return mockTxDriver{} }
+func (mockTxDriver) Prepare(string) (driver.Stmt, error)          { return nil, errors.New("not supported") }
+func (mockTxDriver) Close() error                                 { return nil }
+func (mockTxDriver) Begin() (driver.Tx, error)                    { return mockTxDriver{}, nil }
+func (mockTxDriver) Commit() error                                { return nil }
+func (mockTxDriver) Rollback() error                              { return nil }
+
+func newMockGoquDatabase() *goqu.Database {
+	return goqu.New("mysql", sql.OpenDB(mockTxDriver{}))
+}
+
+// mockStore keeps the rows of the mock data accessors, the ID of a row is its index plus one.
+type mockStore struct {
+	mutex              sync.Mutex
+	accountList        []database.Account
+	passwordHashMap    map[uint64]string
+	totpMap            map[uint64]database.AccountTOTP
+	identityList       []database.AccountOIDCIdentity
+	sessionList        []database.Session
+	revokedSessionList []uint64
+	loginStateMap      map[string]cache.OIDCLoginStateData
+	downloadTaskList   []database.DownloadTask
+	deletedTaskIDList  []uint64
+	tagListMap         map[uint64][]string
+	labelMapMap        map[uint64]map[string]string
+	tokenPublicKeyList []database.TokenPublicKey
+	eventList          []database.DownloadTaskEvent
+	outboxMessageList  []database.OutboxMessage
+	lifecycleEventList []*go_load.DownloadTaskLifecycleEvent
+	deadLetterList     []database.DeadLetterMessage
+	apiKeyList         []database.APIKey
+	shareLinkList      []database.ShareLink
+	workspaceList      []database.Workspace
+	memberList         []database.WorkspaceMember
+}
+
+func newMockStore() *mockStore {
+	return &mockStore{
+		passwordHashMap: make(map[uint64]string),
+		totpMap:         make(map[uint64]database.AccountTOTP),
+		loginStateMap:   make(map[string]cache.OIDCLoginStateData),
+		tagListMap:      make(map[uint64][]string),
+		labelMapMap:     make(map[uint64]map[string]string),
+	}
+}
+
+type mockAccountDataAccessor struct {
+	database.AccountDataAccessor
+	store *mockStore
+}
+
+func (m mockAccountDataAccessor) CreateAccount(_ context.Context, account database.Account) (uint64, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	account.ID = uint64(len(m.store.accountList) + 1)
+	m.store.accountList = append(m.store.accountList, account)
+	return account.ID, nil
+}
+
+func (m mockAccountDataAccessor) GetAccountByAccountName(
+	_ context.Context,
+	accountName string,
+) (database.Account, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	for _, account := range m.store.accountList {
+		if account.AccountName == accountName {
+			return account, nil
+		}
+	}
+
+	return database.Account{}, database.ErrAccountNotFound
+}
+
+func (m mockAccountDataAccessor) GetAccountByID(ctx context.Context, id uint64) (database.Account, error) {
+	return m.GetAccountByIDWithXLock(ctx, id)
+}
+
+func (m mockAccountDataAccessor) GetAccountListByIDList(
+	_ context.Context,
+	idList []uint64,
+) ([]database.Account, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	return lo.Filter(m.store.accountList, func(account database.Account, _ int) bool {
+		return lo.Contains(idList, account.ID)
+	}), nil
+}
+
+func (m mockAccountDataAccessor) GetAccountByIDWithXLock(_ context.Context, id uint64) (database.Account, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	if id == 0 || id > uint64(len(m.store.accountList)) {
+		return database.Account{}, database.ErrAccountNotFound
+	}
+
+	return m.store.accountList[id-1], nil
+}
+
+func (m mockAccountDataAccessor) UpdateAccountRole(_ context.Context, id uint64, role string) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.accountList[id-1].Role = role
+	return nil
+}
+
+func (m mockAccountDataAccessor) UpdateAccountDisabledAt(_ context.Context, id uint64, disabledAt sql.NullTime) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.accountList[id-1].DisabledAt = disabledAt
+	return nil
+}
+
+func (m mockAccountDataAccessor) WithDatabase(database.Database) database.AccountDataAccessor {
+	return m
+}
+
+type mockAccountOIDCIdentityDataAccessor struct {
+	database.AccountOIDCIdentityDataAccessor
+	store *mockStore
+}
+
+func (m mockAccountOIDCIdentityDataAccessor) CreateAccountOIDCIdentity(
+	_ context.Context,
+	identity database.AccountOIDCIdentity,
+) (uint64, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	identity.ID = uint64(len(m.store.identityList) + 1)
+	m.store.identityList = append(m.store.identityList, identity)
+	return identity.ID, nil
+}
+
+func (m mockAccountOIDCIdentityDataAccessor) GetAccountOIDCIdentityByIssuerSubject(
+	_ context.Context,
+	issuer, subject string,
+) (database.AccountOIDCIdentity, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	for _, identity := range m.store.identityList {
+		if identity.Issuer == issuer && identity.Subject == subject {
+			return identity, nil
+		}
+	}
+
+	return database.AccountOIDCIdentity{}, database.ErrAccountOIDCIdentityNotFound
+}
+
+func (m mockAccountOIDCIdentityDataAccessor) UpdateAccountOIDCIdentityLastLogin(
+	_ context.Context,
+	id uint64,
+	email string,
+	lastLoginAt time.Time,
+) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.identityList[id-1].Email = email
+	m.store.identityList[id-1].LastLoginAt = lastLoginAt
+	return nil
+}
+
+func (m mockAccountOIDCIdentityDataAccessor) WithDatabase(
+	database.Database,
+) database.AccountOIDCIdentityDataAccessor {
+	return m
+}
+
+type mockSessionDataAccessor struct {
+	database.SessionDataAccessor
+	store *mockStore
+}
+
+func (m mockSessionDataAccessor) CreateSession(_ context.Context, session database.Session) (uint64, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	session.ID = uint64(len(m.store.sessionList) + 1)
+	m.store.sessionList = append(m.store.sessionList, session)
+	return session.ID, nil
+}
+
+func (m mockSessionDataAccessor) GetSession(_ context.Context, id uint64) (database.Session, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	if id == 0 || id > uint64(len(m.store.sessionList)) {
+		return database.Session{}, database.ErrSessionNotFound
+	}
+
+	return m.store.sessionList[id-1], nil
+}
+
+func (m mockSessionDataAccessor) GetSessionWithXLock(ctx context.Context, id uint64) (database.Session, error) {
+	return m.GetSession(ctx, id)
+}
+
+func (m mockSessionDataAccessor) UpdateSession(_ context.Context, session database.Session) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.sessionList[session.ID-1] = session
+	return nil
+}
+
+func (m mockSessionDataAccessor) GetActiveSessionListOfAccountWithXLock(
+	_ context.Context,
+	accountID uint64,
+	now time.Time,
+) ([]database.Session, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	sessionList := make([]database.Session, 0)
+	for _, session := range m.store.sessionList {
+		if session.OfAccountID == accountID && !session.RevokedAt.Valid && session.ExpiresAt.After(now) {
+			sessionList = append(sessionList, session)
+		}
+	}
+
+	return sessionList, nil
+}
+
+func (m mockSessionDataAccessor) UpdateSessionListRevoked(
+	_ context.Context,
+	idList []uint64,
+	revokedAt time.Time,
+) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	for _, id := range idList {
+		m.store.sessionList[id-1].RevokedAt = sql.NullTime{Time: revokedAt, Valid: true}
+	}
+
+	return nil
+}
+
+func (m mockSessionDataAccessor) WithDatabase(database.Database) database.SessionDataAccessor {
+	return m
+}
+
+type mockOIDCLoginStateCache struct {
+	store *mockStore
+}
+
+func (m mockOIDCLoginStateCache) Add(
+	_ context.Context,
+	state string,
+	data cache.OIDCLoginStateData,
+	_ time.Duration,
+) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.loginStateMap[state] = data
+	return nil
+}
+
+func (m mockOIDCLoginStateCache) Take(_ context.Context, state string) (cache.OIDCLoginStateData, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	data, ok := m.store.loginStateMap[state]
+	if !ok {
+		return cache.OIDCLoginStateData{}, cache.ErrCacheMiss
+	}
+
+	delete(m.store.loginStateMap, state)
+	return data, nil
+}
+
+type mockTokenLogic struct {
+	Token
+	store *mockStore
+}
+
+func (m mockTokenLogic) GetToken(
+	_ context.Context,
+	accountID uint64,
+	sessionID uint64,
+	roleList []Role,
+) (string, time.Time, error) {
+	return fmt.Sprintf("token-%d-%d-%v", accountID, sessionID, roleList), time.Now().Add(time.Hour), nil
+}
+
+func (m mockTokenLogic) RevokeSessionList(_ context.Context, sessionIDList []uint64) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.revokedSessionList = append(m.store.revokedSessionList, sessionIDList...)
+	return nil
+}
+
+func requireStatusCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+
+	if status.Code(err) != code {
+		t.Fatalf("expected error with code %s, got %v", code, err)
+	}
+}
+
+type mockAccountPasswordDataAccessor struct {
+	database.AccountPasswordDataAccessor
+	store *mockStore
+}
+
+func (m mockAccountPasswordDataAccessor) GetAccountPassword(
+	_ context.Context,
+	ofAccountID uint64,
+) (database.AccountPassword, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	hash, ok := m.store.passwordHashMap[ofAccountID]
+	if !ok {
+		return database.AccountPassword{}, sql.ErrNoRows
+	}
+
+	return database.AccountPassword{OfAccountID: ofAccountID, Hash: hash}, nil
+}
+
+func (m mockAccountPasswordDataAccessor) UpdateAccountPassword(
+	_ context.Context,
+	accountPassword database.AccountPassword,
+) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.passwordHashMap[accountPassword.OfAccountID] = accountPassword.Hash
+	return nil
+}
+
+func (m mockAccountPasswordDataAccessor) WithDatabase(database.Database) database.AccountPasswordDataAccessor {
+	return m
+}
+
+type mockAccountTOTPDataAccessor struct {
+	database.AccountTOTPDataAccessor
+	store *mockStore
+}
+
+func (m mockAccountTOTPDataAccessor) GetAccountTOTPWithXLock(
+	_ context.Context,
+	accountID uint64,
+) (database.AccountTOTP, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	accountTOTP, ok := m.store.totpMap[accountID]
+	if !ok {
+		return database.AccountTOTP{}, database.ErrAccountTOTPNotFound
+	}
+
+	return accountTOTP, nil
+}
+
+func (m mockAccountTOTPDataAccessor) UpdateAccountTOTPLastUsedTimeStep(
+	_ context.Context,
+	accountID uint64,
+	lastUsedTimeStep uint64,
+) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	accountTOTP := m.store.totpMap[accountID]
+	accountTOTP.LastUsedTimeStep = lastUsedTimeStep
+	m.store.totpMap[accountID] = accountTOTP
+	return nil
+}
+
+func (m mockAccountTOTPDataAccessor) CreateAccountTOTP(_ context.Context, accountTOTP database.AccountTOTP) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.totpMap[accountTOTP.OfAccountID] = accountTOTP
+	return nil
+}
+
+func (m mockAccountTOTPDataAccessor) DeleteAccountTOTP(_ context.Context, accountID uint64) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	delete(m.store.totpMap, accountID)
+	return nil
+}
+
+func (m mockAccountTOTPDataAccessor) WithDatabase(database.Database) database.AccountTOTPDataAccessor {
+	return m
+}
+
+// mockHash is a plain prefix, hashing for real would only slow the tests down.
+type mockHash struct{}
+
+func (mockHash) Hash(_ context.Context, data string) (string, error) {
+	return "hashed:" + data, nil
+}
+
+func (mockHash) IsHashEqual(_ context.Context, data string, hashed string) (bool, error) {
+	return "hashed:"+data == hashed, nil
+}
+
+func (mockHash) NeedsRehash(context.Context, string) bool {
+	return false
+}
+
+type mockDownloadTaskDataAccessor struct {
+	database.DownloadTaskDataAccessor
+	store *mockStore
+}
+
+func (m mockDownloadTaskDataAccessor) CreateDownloadTask(
+	_ context.Context,
+	task database.DownloadTask,
+) (uint64, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	task.ID = uint64(len(m.store.downloadTaskList) + 1)
+	m.store.downloadTaskList = append(m.store.downloadTaskList, task)
+	return task.ID, nil
+}
+
+func (m mockDownloadTaskDataAccessor) GetDownloadTaskCount(
+	_ context.Context,
+	filter database.DownloadTaskListFilter,
+) (uint64, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	return uint64(lo.CountBy(m.store.downloadTaskList, func(task database.DownloadTask) bool {
+		if filter.OfWorkspaceID != 0 {
+			if uint64(task.OfWorkspaceID.Int64) != filter.OfWorkspaceID {
+				return false
+			}
+		} else if task.OfAccountID != filter.OfAccountID || task.OfWorkspaceID.Valid {
+			return false
+		}
+
+		return len(filter.DownloadStatusList) == 0 || lo.Contains(filter.DownloadStatusList, task.DownloadStatus)
+	})), nil
+}
+
+func (m mockDownloadTaskDataAccessor) GetDownloadTask(ctx context.Context, id uint64) (database.DownloadTask, error) {
+	return m.GetDownloadTaskWithXLock(ctx, id)
+}
+
+func (m mockDownloadTaskDataAccessor) GetDownloadTaskWithXLock(
+	_ context.Context,
+	id uint64,
+) (database.DownloadTask, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	if id == 0 || id > uint64(len(m.store.downloadTaskList)) {
+		return database.DownloadTask{}, database.ErrDownloadTaskNotFound
+	}
+
+	return m.store.downloadTaskList[id-1], nil
+}
+
+func (m mockDownloadTaskDataAccessor) GetDownloadTaskListWithStatusUpdatedBefore(
+	_ context.Context,
+	statusList []go_load.DownloadStatus,
+	updatedBefore time.Time,
+	limit uint64,
+) ([]database.DownloadTask, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	taskList := make([]database.DownloadTask, 0)
+	for _, task := range m.store.downloadTaskList {
+		if uint64(len(taskList)) < limit && task.UpdatedAt.Before(updatedBefore) &&
+			lo.Contains(statusList, task.DownloadStatus) && !lo.Contains(m.store.deletedTaskIDList, task.ID) {
+			taskList = append(taskList, task)
+		}
+	}
+
+	return taskList, nil
+}
+
+func (m mockDownloadTaskDataAccessor) GetDownloadTaskListByIDList(
+	_ context.Context,
+	idList []uint64,
+) ([]database.DownloadTask, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	return lo.Filter(m.store.downloadTaskList, func(task database.DownloadTask, _ int) bool {
+		return lo.Contains(idList, task.ID) && !lo.Contains(m.store.deletedTaskIDList, task.ID)
+	}), nil
+}
+
+func (m mockDownloadTaskDataAccessor) GetDownloadTaskListByIDListWithXLock(
+	ctx context.Context,
+	idList []uint64,
+) ([]database.DownloadTask, error) {
+	return m.GetDownloadTaskListByIDList(ctx, idList)
+}
+
+func (m mockDownloadTaskDataAccessor) DeleteDownloadTaskList(_ context.Context, idList []uint64) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.deletedTaskIDList = append(m.store.deletedTaskIDList, idList...)
+	return nil
+}
+
+func (m mockDownloadTaskDataAccessor) UpdateDownloadTask(_ context.Context, task database.DownloadTask) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.downloadTaskList[task.ID-1] = task
+	return nil
+}
+
+func (m mockDownloadTaskDataAccessor) UpdateDownloadTaskHeartbeat(
+	_ context.Context,
+	id uint64,
+	attempt uint32,
+	updatedAt time.Time,
+) (bool, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	task := &m.store.downloadTaskList[id-1]
+	if task.DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING || task.Attempt != attempt {
+		return false, nil
+	}
+
+	task.UpdatedAt = updatedAt
+	return true, nil
+}
+
+func (m mockDownloadTaskDataAccessor) WithDatabase(database.Database) database.DownloadTaskDataAccessor {
+	return m
+}
+
+type mockDownloadTaskEventDataAccessor struct {
+	database.DownloadTaskEventDataAccessor
+	store *mockStore
+}
+
+func (m mockDownloadTaskEventDataAccessor) CreateDownloadTaskEvent(
+	_ context.Context,
+	event database.DownloadTaskEvent,
+) (uint64, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	event.ID = uint64(len(m.store.eventList) + 1)
+	m.store.eventList = append(m.store.eventList, event)
+	return event.ID, nil
+}
+
+func (m mockDownloadTaskEventDataAccessor) WithDatabase(database.Database) database.DownloadTaskEventDataAccessor {
+	return m
+}
+
+type mockDownloadTaskTagDataAccessor struct {
+	database.DownloadTaskTagDataAccessor
+	store *mockStore
+}
+
+func (m mockDownloadTaskTagDataAccessor) SetDownloadTaskTagList(
+	_ context.Context,
+	downloadTaskID uint64,
+	tagList []string,
+) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.tagListMap[downloadTaskID] = tagList
+	return nil
+}
+
+func (m mockDownloadTaskTagDataAccessor) GetDownloadTaskTagListOfDownloadTaskList(
+	_ context.Context,
+	downloadTaskIDList []uint64,
+) (map[uint64][]string, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	return lo.PickByKeys(m.store.tagListMap, downloadTaskIDList), nil
+}
+
+func (m mockDownloadTaskTagDataAccessor) WithDatabase(database.Database) database.DownloadTaskTagDataAccessor {
+	return m
+}
+
+type mockDownloadTaskLabelDataAccessor struct {
+	database.DownloadTaskLabelDataAccessor
+	store *mockStore
+}
+
+func (m mockDownloadTaskLabelDataAccessor) SetDownloadTaskLabelMap(
+	_ context.Context,
+	downloadTaskID uint64,
+	labelMap map[string]string,
+) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.labelMapMap[downloadTaskID] = labelMap
+	return nil
+}
+
+func (m mockDownloadTaskLabelDataAccessor) GetDownloadTaskLabelMapOfDownloadTaskList(
+	_ context.Context,
+	downloadTaskIDList []uint64,
+) (map[uint64]map[string]string, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	return lo.PickByKeys(m.store.labelMapMap, downloadTaskIDList), nil
+}
+
+func (m mockDownloadTaskLabelDataAccessor) WithDatabase(database.Database) database.DownloadTaskLabelDataAccessor {
+	return m
+}
+
+type mockOutboxMessageDataAccessor struct {
+	database.OutboxMessageDataAccessor
+	store *mockStore
+}
+
+func (m mockOutboxMessageDataAccessor) CreateOutboxMessage(
+	_ context.Context,
+	message database.OutboxMessage,
+) (uint64, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	message.ID = uint64(len(m.store.outboxMessageList) + 1)
+	m.store.outboxMessageList = append(m.store.outboxMessageList, message)
+	return message.ID, nil
+}
+
+func (m mockOutboxMessageDataAccessor) WithDatabase(database.Database) database.OutboxMessageDataAccessor {
+	return m
+}
+
+type mockDownloadTaskLifecycleEventProducer struct {
+	store *mockStore
+}
+
+func (m mockDownloadTaskLifecycleEventProducer) ProduceDownloadTaskLifecycleEvent(
+	_ context.Context,
+	_ database.Database,
+	_ database.DownloadTask,
+	event *go_load.DownloadTaskLifecycleEvent,
+) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.lifecycleEventList = append(m.store.lifecycleEventList, event)
+	return nil
+}
+
+func (m mockOutboxMessageDataAccessor) GetPendingOutboxMessageListWithXLock(
+	_ context.Context,
+	limit uint64,
+) ([]database.OutboxMessage, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	messageList := make([]database.OutboxMessage, 0)
+	for _, message := range m.store.outboxMessageList {
+		if uint64(len(messageList)) < limit && !message.PublishedAt.Valid {
+			messageList = append(messageList, message)
+		}
+	}
+
+	return messageList, nil
+}
+
+func (m mockOutboxMessageDataAccessor) UpdateOutboxMessageListPublished(
+	_ context.Context,
+	idList []uint64,
+	publishedAt time.Time,
+) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	for _, id := range idList {
+		m.store.outboxMessageList[id-1].PublishedAt = sql.NullTime{Time: publishedAt, Valid: true}
+	}
+
+	return nil
+}
+
+// mockProducerClient fails every message of failingQueueName, and passes the others on to Client.
+type mockProducerClient struct {
+	producer.Client
+	failingQueueName string
+}
+
+func (m mockProducerClient) Produce(ctx context.Context, queueName string, payload []byte) error {
+	if queueName == m.failingQueueName {
+		return status.Error(codes.Unavailable, "failed to produce message")
+	}
+
+	return m.Client.Produce(ctx, queueName, payload)
+}
+
+type mockDeadLetterMessageDataAccessor struct {
+	database.DeadLetterMessageDataAccessor
+	store *mockStore
+}
+
+func (m mockDeadLetterMessageDataAccessor) GetDeadLetterMessageWithXLock(
+	_ context.Context,
+	id uint64,
+) (database.DeadLetterMessage, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	if id == 0 || id > uint64(len(m.store.deadLetterList)) {
+		return database.DeadLetterMessage{}, database.ErrDeadLetterMessageNotFound
+	}
+
+	return m.store.deadLetterList[id-1], nil
+}
+
+func (m mockDeadLetterMessageDataAccessor) UpdateDeadLetterMessage(
+	_ context.Context,
+	message database.DeadLetterMessage,
+) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.deadLetterList[message.ID-1] = message
+	return nil
+}
+
+func (m mockDeadLetterMessageDataAccessor) WithDatabase(database.Database) database.DeadLetterMessageDataAccessor {
+	return m
+}
+
+type mockAPIKeyDataAccessor struct {
+	database.APIKeyDataAccessor
+	store *mockStore
+}
+
+func (m mockAPIKeyDataAccessor) CreateAPIKey(_ context.Context, apiKey database.APIKey) (uint64, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	apiKey.ID = uint64(len(m.store.apiKeyList) + 1)
+	m.store.apiKeyList = append(m.store.apiKeyList, apiKey)
+	return apiKey.ID, nil
+}
+
+func (m mockAPIKeyDataAccessor) GetAPIKey(_ context.Context, id uint64) (database.APIKey, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	if id == 0 || id > uint64(len(m.store.apiKeyList)) {
+		return database.APIKey{}, database.ErrAPIKeyNotFound
+	}
+
+	return m.store.apiKeyList[id-1], nil
+}
+
+func (m mockAPIKeyDataAccessor) GetAPIKeyListOfAccount(
+	_ context.Context,
+	accountID uint64,
+) ([]database.APIKey, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	return lo.Filter(m.store.apiKeyList, func(apiKey database.APIKey, _ int) bool {
+		return apiKey.OfAccountID == accountID && !apiKey.RevokedAt.Valid
+	}), nil
+}
+
+func (m mockAPIKeyDataAccessor) UpdateAPIKeyKeyHash(_ context.Context, id uint64, keyHash string) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.apiKeyList[id-1].KeyHash = keyHash
+	return nil
+}
+
+func (m mockAPIKeyDataAccessor) UpdateAPIKeyLastUsedAt(_ context.Context, id uint64, lastUsedAt time.Time) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.apiKeyList[id-1].LastUsedAt = sql.NullTime{Time: lastUsedAt, Valid: true}
+	return nil
+}
+
+func (m mockAPIKeyDataAccessor) UpdateAPIKeyRevokedAt(_ context.Context, id uint64, revokedAt time.Time) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.apiKeyList[id-1].RevokedAt = sql.NullTime{Time: revokedAt, Valid: true}
+	return nil
+}
+
+func (m mockAPIKeyDataAccessor) WithDatabase(database.Database) database.APIKeyDataAccessor {
+	return m
+}
+
+type mockShareLinkDataAccessor struct {
+	database.ShareLinkDataAccessor
+	store *mockStore
+}
+
+func (m mockShareLinkDataAccessor) GetShareLink(_ context.Context, id uint64) (database.ShareLink, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	if id == 0 || id > uint64(len(m.store.shareLinkList)) {
+		return database.ShareLink{}, database.ErrShareLinkNotFound
+	}
+
+	return m.store.shareLinkList[id-1], nil
+}
+
+func (m mockShareLinkDataAccessor) IncrementShareLinkDownloadCount(_ context.Context, id uint64) (bool, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	link := &m.store.shareLinkList[id-1]
+	if link.MaxDownloadCount.Valid && link.DownloadCount >= uint64(link.MaxDownloadCount.Int64) {
+		return false, nil
+	}
+
+	link.DownloadCount++
+	return true, nil
+}
+
+func (m mockShareLinkDataAccessor) UpdateShareLinkListRevokedAtOfAccountInWorkspace(
+	_ context.Context,
+	accountID, workspaceID uint64,
+	revokedAt time.Time,
+) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	for i, link := range m.store.shareLinkList {
+		taskWorkspaceID := m.store.downloadTaskList[link.OfDownloadTaskID-1].OfWorkspaceID
+		if link.OfAccountID == accountID && taskWorkspaceID.Valid && uint64(taskWorkspaceID.Int64) == workspaceID {
+			m.store.shareLinkList[i].RevokedAt = sql.NullTime{Time: revokedAt, Valid: true}
+		}
+	}
+
+	return nil
+}
+
+func (m mockShareLinkDataAccessor) WithDatabase(database.Database) database.ShareLinkDataAccessor {
+	return m
+}
+
+type mockWorkspaceDataAccessor struct {
+	database.WorkspaceDataAccessor
+	store *mockStore
+}
+
+func (m mockWorkspaceDataAccessor) GetWorkspaceWithXLock(_ context.Context, id uint64) (database.Workspace, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	if id == 0 || id > uint64(len(m.store.workspaceList)) {
+		return database.Workspace{}, database.ErrWorkspaceNotFound
+	}
+
+	return m.store.workspaceList[id-1], nil
+}
+
+func (m mockWorkspaceDataAccessor) WithDatabase(database.Database) database.WorkspaceDataAccessor {
+	return m
+}
+
+type mockWorkspaceMemberDataAccessor struct {
+	database.WorkspaceMemberDataAccessor
+	store *mockStore
+}
+
+// getIndex must be called with the mutex of the store held.
+func (m mockWorkspaceMemberDataAccessor) getIndex(workspaceID, accountID uint64) int {
+	_, index, _ := lo.FindIndexOf(m.store.memberList, func(member database.WorkspaceMember) bool {
+		return member.OfWorkspaceID == workspaceID && member.OfAccountID == accountID
+	})
+	return index
+}
+
+func (m mockWorkspaceMemberDataAccessor) GetWorkspaceMember(
+	_ context.Context,
+	workspaceID, accountID uint64,
+) (database.WorkspaceMember, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	index := m.getIndex(workspaceID, accountID)
+	if index < 0 {
+		return database.WorkspaceMember{}, database.ErrWorkspaceMemberNotFound
+	}
+
+	return m.store.memberList[index], nil
+}
+
+func (m mockWorkspaceMemberDataAccessor) GetWorkspaceMemberListOfWorkspace(
+	_ context.Context,
+	workspaceID uint64,
+) ([]database.WorkspaceMember, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	return lo.Filter(m.store.memberList, func(member database.WorkspaceMember, _ int) bool {
+		return member.OfWorkspaceID == workspaceID
+	}), nil
+}
+
+func (m mockWorkspaceMemberDataAccessor) GetWorkspaceMemberListOfAccount(
+	_ context.Context,
+	accountID uint64,
+) ([]database.WorkspaceMember, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	return lo.Filter(m.store.memberList, func(member database.WorkspaceMember, _ int) bool {
+		return member.OfAccountID == accountID
+	}), nil
+}
+
+func (m mockWorkspaceMemberDataAccessor) UpdateWorkspaceMemberRole(
+	_ context.Context,
+	workspaceID, accountID uint64,
+	role string,
+) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	m.store.memberList[m.getIndex(workspaceID, accountID)].Role = role
+	return nil
+}
+
+func (m mockWorkspaceMemberDataAccessor) DeleteWorkspaceMember(_ context.Context, workspaceID, accountID uint64) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	index := m.getIndex(workspaceID, accountID)
+	m.store.memberList = append(m.store.memberList[:index], m.store.memberList[index+1:]...)
+	return nil
+}
+
+func (m mockWorkspaceMemberDataAccessor) WithDatabase(database.Database) database.WorkspaceMemberDataAccessor {
+	return m
+}
+
+type mockRetentionRunDataAccessor struct {
+	database.RetentionRunDataAccessor
+}
+
+func (mockRetentionRunDataAccessor) CreateRetentionRun(context.Context, database.RetentionRun) (uint64, error) {
+	return 1, nil
+}
+
+type mockTokenPublicKeyDataAccessor struct {
+	database.TokenPublicKeyDataAccessor
+	store *mockStore
+}
+
+func (m mockTokenPublicKeyDataAccessor) CreatePublicKey(
+	_ context.Context,
+	tokenPublicKey database.TokenPublicKey,
+) (uint64, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	tokenPublicKey.ID = uint64(len(m.store.tokenPublicKeyList) + 1)
+	m.store.tokenPublicKeyList = append(m.store.tokenPublicKeyList, tokenPublicKey)
+	return tokenPublicKey.ID, nil
+}
+
+// getPublicKeyList must be called with the mutex of the store held, deleted keys have no ID.
+func (m mockTokenPublicKeyDataAccessor) getPublicKeyList() []database.TokenPublicKey {
+	return lo.Filter(m.store.tokenPublicKeyList, func(tokenPublicKey database.TokenPublicKey, _ int) bool {
+		return tokenPublicKey.ID != 0
+	})
+}
+
+func (m mockTokenPublicKeyDataAccessor) GetPublicKey(
+	_ context.Context,
+	id uint64,
+) (database.TokenPublicKey, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	tokenPublicKey, ok := lo.Find(m.getPublicKeyList(), func(tokenPublicKey database.TokenPublicKey) bool {
+		return tokenPublicKey.ID == id
+	})
+	if !ok {
+		return database.TokenPublicKey{}, sql.ErrNoRows
+	}
+
+	return tokenPublicKey, nil
+}
+
+func (m mockTokenPublicKeyDataAccessor) GetLatestActivePublicKeyWithXLock(
+	context.Context,
+) (database.TokenPublicKey, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	tokenPublicKey, _, ok := lo.FindLastIndexOf(m.getPublicKeyList(), func(tokenPublicKey database.TokenPublicKey) bool {
+		return !tokenPublicKey.RetiredAt.Valid
+	})
+	if !ok {
+		return database.TokenPublicKey{}, sql.ErrNoRows
+	}
+
+	return tokenPublicKey, nil
+}
+
+func (m mockTokenPublicKeyDataAccessor) GetPublicKeyListRetiredAfter(
+	_ context.Context,
+	retiredAfter time.Time,
+) ([]database.TokenPublicKey, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	return lo.Filter(m.getPublicKeyList(), func(tokenPublicKey database.TokenPublicKey, _ int) bool {
+		return !tokenPublicKey.RetiredAt.Valid || tokenPublicKey.RetiredAt.Time.After(retiredAfter)
+	}), nil
+}
+
+func (m mockTokenPublicKeyDataAccessor) UpdateOtherPublicKeyListRetired(
+	_ context.Context,
+	activeID uint64,
+	retiredAt time.Time,
+) error {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	for i := range m.store.tokenPublicKeyList {
+		tokenPublicKey := &m.store.tokenPublicKeyList[i]
+		if tokenPublicKey.ID != activeID && !tokenPublicKey.RetiredAt.Valid {
+			tokenPublicKey.RetiredAt = sql.NullTime{Time: retiredAt, Valid: true}
+		}
+	}
+
+	return nil
+}
+
+func (m mockTokenPublicKeyDataAccessor) DeletePublicKeyListRetiredBefore(
+	_ context.Context,
+	retiredBefore time.Time,
+) (int64, error) {
+	m.store.mutex.Lock()
+	defer m.store.mutex.Unlock()
+
+	var deletedCount int64
+	for i := range m.store.tokenPublicKeyList {
+		tokenPublicKey := &m.store.tokenPublicKeyList[i]
+		if tokenPublicKey.ID != 0 && tokenPublicKey.RetiredAt.Valid && tokenPublicKey.RetiredAt.Time.Before(retiredBefore) {
+			tokenPublicKey.ID = 0
+			deletedCount++
+		}
+	}
+
+	return deletedCount, nil
+}
+
+func (m mockTokenPublicKeyDataAccessor) WithDatabase(database.Database) database.TokenPublicKeyDataAccessor {
+	return m
+}
@@ -0,0 +1,684 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/cache"
+	"goload/internal/dataaccess/database"
+	"goload/internal/utils"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/golang-jwt/jwt"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	oidcDiscoveryPath           = "/.well-known/openid-configuration"
+	oidcScopeOpenID             = "openid"
+	oidcCodeChallengeMethodS256 = "S256"
+	oidcRandomByteCount         = 32
+	oidcHTTPTimeout             = 10 * time.Second
+	oidcMaxResponseByteCount    = 1 << 20
+	// oidcJSONWebKeySetMinRefreshInterval limits how often an unknown kid makes the keys of the provider be
+	// fetched again.
+	oidcJSONWebKeySetMinRefreshInterval = time.Minute
+)
+
+var (
+	errOIDCNotEnabled        = status.Error(codes.FailedPrecondition, "oidc login is not enabled")
+	errInvalidOIDCLoginState = status.Error(codes.InvalidArgument, "invalid or expired oidc login state")
+	errInvalidOIDCIDToken    = status.Error(codes.Unauthenticated, "invalid oidc id token")
+	errOIDCProvider          = status.Error(codes.Unavailable, "failed to communicate with the oidc provider")
+)
+
+type GetOIDCLoginURLOutput struct {
+	URL string
+	// State is also bound to the browser by the caller, so a callback cannot be completed in another browser.
+	State string
+}
+
+type CreateOIDCSessionParams struct {
+	State string
+	Code  string
+}
+
+type OIDC interface {
+	IsEnabled() bool
+	GetOIDCLoginURL(ctx context.Context) (GetOIDCLoginURLOutput, error)
+	// CreateOIDCSession completes the authorization code flow, provisioning or linking the account of the
+	// identity, and creates a session the same way CreateSession does.
+	CreateOIDCSession(ctx context.Context, params CreateOIDCSessionParams) (CreateSessionOutput, error)
+}
+
+type oidcProviderMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type oidcTokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type oidcIDTokenClaims struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	PreferredUsername string
+	GroupList         []string
+}
+
+// oidcProvider caches the metadata and the keys of the provider, it is shared by all copies of oidc.
+type oidcProvider struct {
+	mutex                 sync.Mutex
+	metadata              *oidcProviderMetadata
+	publicKeyMap          map[string]*rsa.PublicKey
+	publicKeyMapFetchedAt time.Time
+}
+
+type oidc struct {
+	goquDatabase                    *goqu.Database
+	oidcLoginStateCache             cache.OIDCLoginState
+	accountDataAccessor             database.AccountDataAccessor
+	accountOIDCIdentityDataAccessor database.AccountOIDCIdentityDataAccessor
+	sessionDataAccessor             database.SessionDataAccessor
+	tokenLogic                      Token
+	oidcConfig                      configs.OIDC
+	twoFactorRequirement            twoFactorRequirement
+	loginExpiresIn                  time.Duration
+	refreshTokenExpiresIn           time.Duration
+	provider                        *oidcProvider
+	httpClient                      *http.Client
+	logger                          *zap.Logger
+}
+
+func NewOIDC(
+	goquDatabase *goqu.Database,
+	oidcLoginStateCache cache.OIDCLoginState,
+	accountDataAccessor database.AccountDataAccessor,
+	accountOIDCIdentityDataAccessor database.AccountOIDCIdentityDataAccessor,
+	sessionDataAccessor database.SessionDataAccessor,
+	tokenLogic Token,
+	authConfig configs.Auth,
+	logger *zap.Logger,
+) (OIDC, error) {
+	o := &oidc{
+		goquDatabase:                    goquDatabase,
+		oidcLoginStateCache:             oidcLoginStateCache,
+		accountDataAccessor:             accountDataAccessor,
+		accountOIDCIdentityDataAccessor: accountOIDCIdentityDataAccessor,
+		sessionDataAccessor:             sessionDataAccessor,
+		twoFactorRequirement:            newTwoFactorRequirement(authConfig.TwoFactor),
+		tokenLogic:                      tokenLogic,
+		oidcConfig:                      authConfig.OIDC,
+		provider:                        &oidcProvider{},
+		httpClient:                      &http.Client{Timeout: oidcHTTPTimeout},
+		logger:                          logger,
+	}
+	if !authConfig.OIDC.Enabled {
+		return o, nil
+	}
+
+	if authConfig.OIDC.IssuerURL == "" || authConfig.OIDC.ClientID == "" || authConfig.OIDC.RedirectURL == "" {
+		return nil, errors.New("oidc issuer_url, client_id and redirect_url are required")
+	}
+
+	for group, role := range authConfig.OIDC.GroupRoleMap {
+		if _, ok := roleRankMap[Role(role)]; !ok {
+			return nil, fmt.Errorf("invalid role %s of oidc group %s", role, group)
+		}
+	}
+
+	var err error
+	o.loginExpiresIn, err = authConfig.OIDC.GetLoginExpiresInDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse login_expires_in: " + authConfig.OIDC.LoginExpiresIn)
+		return nil, err
+	}
+
+	o.refreshTokenExpiresIn, err = authConfig.Token.GetRefreshTokenExpiresInDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).
+			Error("failed to parse refresh_token_expires_in: " + authConfig.Token.RefreshTokenExpiresIn)
+		return nil, err
+	}
+
+	return o, nil
+}
+
+func generateOIDCRandomString() (string, error) {
+	randomBytes := make([]byte, oidcRandomByteCount)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(randomBytes), nil
+}
+
+func getOIDCCodeChallenge(codeVerifier string) string {
+	codeChallenge := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(codeChallenge[:])
+}
+
+func parseOIDCJSONWebKey(jsonWebKey JSONWebKey) (*rsa.PublicKey, error) {
+	nBytes, err := base64.RawURLEncoding.DecodeString(jsonWebKey.N)
+	if err != nil {
+		return nil, err
+	}
+
+	eBytes, err := base64.RawURLEncoding.DecodeString(jsonWebKey.E)
+	if err != nil {
+		return nil, err
+	}
+
+	e := new(big.Int).SetBytes(eBytes)
+	if !e.IsInt64() || e.Int64() > int64(^uint32(0)>>1) {
+		return nil, errors.New("public exponent is too large")
+	}
+
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(nBytes), E: int(e.Int64())}, nil
+}
+
+func getOIDCStringListClaim(claims jwt.MapClaims, name string) []string {
+	switch value := claims[name].(type) {
+	case string:
+		return []string{value}
+	case []any:
+		return lo.FilterMap(value, func(item any, _ int) (string, bool) {
+			itemString, ok := item.(string)
+			return itemString, ok
+		})
+	default:
+		return nil
+	}
+}
+
+func (o oidc) IsEnabled() bool {
+	return o.oidcConfig.Enabled
+}
+
+func (o oidc) getJSON(ctx context.Context, requestURL string, response any) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	httpResponse, err := o.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d from %s", httpResponse.StatusCode, requestURL)
+	}
+
+	return json.NewDecoder(io.LimitReader(httpResponse.Body, oidcMaxResponseByteCount)).Decode(response)
+}
+
+func (o oidc) getProviderMetadata(ctx context.Context) (oidcProviderMetadata, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	o.provider.mutex.Lock()
+	defer o.provider.mutex.Unlock()
+
+	if o.provider.metadata != nil {
+		return *o.provider.metadata, nil
+	}
+
+	metadata := oidcProviderMetadata{}
+	err := o.getJSON(ctx, strings.TrimSuffix(o.oidcConfig.IssuerURL, "/")+oidcDiscoveryPath, &metadata)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to discover oidc provider metadata")
+		return oidcProviderMetadata{}, errOIDCProvider
+	}
+
+	if strings.TrimSuffix(metadata.Issuer, "/") != strings.TrimSuffix(o.oidcConfig.IssuerURL, "/") {
+		logger.With(zap.String("issuer", metadata.Issuer)).Error("oidc provider metadata has a different issuer")
+		return oidcProviderMetadata{}, errOIDCProvider
+	}
+
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		logger.Error("oidc provider metadata is missing an endpoint")
+		return oidcProviderMetadata{}, errOIDCProvider
+	}
+
+	o.provider.metadata = &metadata
+	return metadata, nil
+}
+
+// getPublicKey returns the key the provider signed an ID token with, the keys are fetched again when the kid is
+// unknown since the provider may have rotated them.
+func (o oidc) getPublicKey(ctx context.Context, metadata oidcProviderMetadata, kid string) (*rsa.PublicKey, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	o.provider.mutex.Lock()
+	defer o.provider.mutex.Unlock()
+
+	if publicKey, ok := o.provider.publicKeyMap[kid]; ok {
+		return publicKey, nil
+	}
+
+	if o.provider.publicKeyMap != nil &&
+		time.Since(o.provider.publicKeyMapFetchedAt) < oidcJSONWebKeySetMinRefreshInterval {
+		return nil, fmt.Errorf("unknown kid %s", kid)
+	}
+
+	jsonWebKeySet := JSONWebKeySet{}
+	if err := o.getJSON(ctx, metadata.JWKSURI, &jsonWebKeySet); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get oidc provider json web key set")
+		return nil, errOIDCProvider
+	}
+
+	publicKeyMap := make(map[string]*rsa.PublicKey)
+	for _, jsonWebKey := range jsonWebKeySet.Keys {
+		if jsonWebKey.KeyType != jsonWebKeyTypeRSA || (jsonWebKey.Use != "" && jsonWebKey.Use != jsonWebKeyUseSignature) {
+			continue
+		}
+
+		publicKey, err := parseOIDCJSONWebKey(jsonWebKey)
+		if err != nil {
+			logger.With(zap.Error(err)).With(zap.String("kid", jsonWebKey.KeyID)).Warn("failed to parse oidc key")
+			continue
+		}
+
+		publicKeyMap[jsonWebKey.KeyID] = publicKey
+	}
+
+	o.provider.publicKeyMap = publicKeyMap
+	o.provider.publicKeyMapFetchedAt = time.Now()
+
+	publicKey, ok := publicKeyMap[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown kid %s", kid)
+	}
+
+	return publicKey, nil
+}
+
+func (o oidc) exchangeCode(
+	ctx context.Context,
+	metadata oidcProviderMetadata,
+	code string,
+	codeVerifier string,
+) (string, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {o.oidcConfig.RedirectURL},
+		"client_id":     {o.oidcConfig.ClientID},
+		"code_verifier": {codeVerifier},
+	}
+	request, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create oidc token request")
+		return "", errOIDCProvider
+	}
+
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	if o.oidcConfig.ClientSecret != "" {
+		request.SetBasicAuth(url.QueryEscape(o.oidcConfig.ClientID), url.QueryEscape(o.oidcConfig.ClientSecret))
+	}
+
+	httpResponse, err := o.httpClient.Do(request)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to send oidc token request")
+		return "", errOIDCProvider
+	}
+
+	defer httpResponse.Body.Close()
+
+	tokenResponse := oidcTokenResponse{}
+	err = json.NewDecoder(io.LimitReader(httpResponse.Body, oidcMaxResponseByteCount)).Decode(&tokenResponse)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to decode oidc token response")
+		return "", errOIDCProvider
+	}
+
+	if httpResponse.StatusCode != http.StatusOK {
+		logger.
+			With(zap.Int("status_code", httpResponse.StatusCode)).
+			With(zap.String("error", tokenResponse.Error)).
+			With(zap.String("error_description", tokenResponse.ErrorDescription)).
+			Warn("oidc provider rejected the authorization code")
+		return "", status.Error(codes.Unauthenticated, "oidc provider rejected the authorization code")
+	}
+
+	if tokenResponse.IDToken == "" {
+		logger.Error("oidc token response has no id token")
+		return "", errOIDCProvider
+	}
+
+	return tokenResponse.IDToken, nil
+}
+
+func (o oidc) verifyIDToken(
+	ctx context.Context,
+	metadata oidcProviderMetadata,
+	rawIDToken string,
+	nonce string,
+) (oidcIDTokenClaims, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(rawIDToken, claims, func(parsedToken *jwt.Token) (interface{}, error) {
+		if _, ok := parsedToken.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", parsedToken.Header["alg"])
+		}
+
+		kid, _ := parsedToken.Header["kid"].(string)
+		return o.getPublicKey(ctx, metadata, kid)
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to verify oidc id token")
+		return oidcIDTokenClaims{}, errInvalidOIDCIDToken
+	}
+
+	now := time.Now().Unix()
+	if !claims.VerifyExpiresAt(now, true) ||
+		!claims.VerifyIssuer(metadata.Issuer, true) ||
+		!claims.VerifyAudience(o.oidcConfig.ClientID, true) {
+		logger.Warn("oidc id token has an invalid exp, iss or aud claim")
+		return oidcIDTokenClaims{}, errInvalidOIDCIDToken
+	}
+
+	if tokenNonce, _ := claims["nonce"].(string); tokenNonce != nonce {
+		logger.Warn("oidc id token has an invalid nonce")
+		return oidcIDTokenClaims{}, errInvalidOIDCIDToken
+	}
+
+	idTokenClaims := oidcIDTokenClaims{}
+	idTokenClaims.Subject, _ = claims["sub"].(string)
+	if idTokenClaims.Subject == "" {
+		logger.Warn("oidc id token has no sub claim")
+		return oidcIDTokenClaims{}, errInvalidOIDCIDToken
+	}
+
+	idTokenClaims.Email, _ = claims["email"].(string)
+	idTokenClaims.PreferredUsername, _ = claims["preferred_username"].(string)
+	switch emailVerified := claims["email_verified"].(type) {
+	case bool:
+		idTokenClaims.EmailVerified = emailVerified
+	case string:
+		// some providers send the claim as a string
+		idTokenClaims.EmailVerified = emailVerified == "true"
+	}
+
+	if o.oidcConfig.GroupsClaim != "" {
+		idTokenClaims.GroupList = getOIDCStringListClaim(claims, o.oidcConfig.GroupsClaim)
+	}
+
+	return idTokenClaims, nil
+}
+
+// getGroupRole returns the highest ranked role mapped from the groups, and false when roles are not synced from
+// the provider.
+func (o oidc) getGroupRole(groupList []string) (Role, bool) {
+	if len(o.oidcConfig.GroupRoleMap) == 0 {
+		return "", false
+	}
+
+	role := RoleUser
+	for _, group := range groupList {
+		groupRole, ok := o.oidcConfig.GroupRoleMap[group]
+		if ok && roleRankMap[Role(groupRole)] > roleRankMap[role] {
+			role = Role(groupRole)
+		}
+	}
+
+	return role, true
+}
+
+func (o oidc) getAccountName(claims oidcIDTokenClaims) string {
+	if claims.Email != "" {
+		return claims.Email
+	}
+
+	if claims.PreferredUsername != "" {
+		return claims.PreferredUsername
+	}
+
+	return claims.Subject
+}
+
+// linkOrCreateAccount returns the account a new identity belongs to. An account named after the identity is only
+// linked when linking by email is enabled and the provider verified the email, otherwise anyone able to pick an
+// email or username at the provider could take over the account.
+func (o oidc) linkOrCreateAccount(
+	ctx context.Context,
+	accountDataAccessor database.AccountDataAccessor,
+	claims oidcIDTokenClaims,
+) (database.Account, error) {
+	accountName := o.getAccountName(claims)
+	existingAccount, err := accountDataAccessor.GetAccountByAccountName(ctx, accountName)
+	if err == nil {
+		if o.oidcConfig.LinkByEmail && claims.EmailVerified && claims.Email == accountName {
+			return existingAccount, nil
+		}
+
+		return database.Account{}, status.Error(
+			codes.AlreadyExists, "account name is already taken by an account not linked to this identity")
+	}
+
+	if !errors.Is(err, database.ErrAccountNotFound) {
+		return database.Account{}, err
+	}
+
+	newAccount := database.Account{
+		AccountName: accountName,
+		Role:        string(RoleUser),
+	}
+	newAccount.ID, err = accountDataAccessor.CreateAccount(ctx, newAccount)
+	if err != nil {
+		return database.Account{}, err
+	}
+
+	return newAccount, nil
+}
+
+func (o oidc) GetOIDCLoginURL(ctx context.Context) (GetOIDCLoginURLOutput, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	if !o.IsEnabled() {
+		return GetOIDCLoginURLOutput{}, errOIDCNotEnabled
+	}
+
+	metadata, err := o.getProviderMetadata(ctx)
+	if err != nil {
+		return GetOIDCLoginURLOutput{}, err
+	}
+
+	randomStringList := make([]string, 3)
+	for i := range randomStringList {
+		if randomStringList[i], err = generateOIDCRandomString(); err != nil {
+			logger.With(zap.Error(err)).Error("failed to generate oidc login state")
+			return GetOIDCLoginURLOutput{}, status.Error(codes.Internal, "failed to generate oidc login state")
+		}
+	}
+
+	state, nonce, codeVerifier := randomStringList[0], randomStringList[1], randomStringList[2]
+	err = o.oidcLoginStateCache.Add(ctx, state, cache.OIDCLoginStateData{
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+	}, o.loginExpiresIn)
+	if err != nil {
+		return GetOIDCLoginURLOutput{}, status.Error(codes.Internal, "failed to save oidc login state")
+	}
+
+	authorizationURL, err := url.Parse(metadata.AuthorizationEndpoint)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse oidc authorization endpoint")
+		return GetOIDCLoginURLOutput{}, errOIDCProvider
+	}
+
+	scopeList := o.oidcConfig.ScopeList
+	if !lo.Contains(scopeList, oidcScopeOpenID) {
+		scopeList = append([]string{oidcScopeOpenID}, scopeList...)
+	}
+
+	query := authorizationURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", o.oidcConfig.ClientID)
+	query.Set("redirect_uri", o.oidcConfig.RedirectURL)
+	query.Set("scope", strings.Join(scopeList, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", getOIDCCodeChallenge(codeVerifier))
+	query.Set("code_challenge_method", oidcCodeChallengeMethodS256)
+	authorizationURL.RawQuery = query.Encode()
+
+	return GetOIDCLoginURLOutput{
+		URL:   authorizationURL.String(),
+		State: state,
+	}, nil
+}
+
+func (o oidc) CreateOIDCSession(ctx context.Context, params CreateOIDCSessionParams) (CreateSessionOutput, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	if !o.IsEnabled() {
+		return CreateSessionOutput{}, errOIDCNotEnabled
+	}
+
+	if params.State == "" || params.Code == "" {
+		return CreateSessionOutput{}, errInvalidOIDCLoginState
+	}
+
+	loginState, err := o.oidcLoginStateCache.Take(ctx, params.State)
+	if err != nil {
+		if errors.Is(err, cache.ErrCacheMiss) {
+			return CreateSessionOutput{}, errInvalidOIDCLoginState
+		}
+
+		return CreateSessionOutput{}, status.Error(codes.Internal, "failed to get oidc login state")
+	}
+
+	metadata, err := o.getProviderMetadata(ctx)
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	rawIDToken, err := o.exchangeCode(ctx, metadata, params.Code, loginState.CodeVerifier)
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	claims, err := o.verifyIDToken(ctx, metadata, rawIDToken, loginState.Nonce)
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	var (
+		now           = time.Now()
+		account       database.Account
+		sessionIDList []uint64
+	)
+	txErr := o.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		accountDataAccessor := o.accountDataAccessor.WithDatabase(td)
+		accountOIDCIdentityDataAccessor := o.accountOIDCIdentityDataAccessor.WithDatabase(td)
+
+		identity, txErr := accountOIDCIdentityDataAccessor.GetAccountOIDCIdentityByIssuerSubject(
+			ctx, metadata.Issuer, claims.Subject)
+		switch {
+		case txErr == nil:
+			if account, txErr = accountDataAccessor.GetAccountByIDWithXLock(ctx, identity.OfAccountID); txErr != nil {
+				return txErr
+			}
+
+			txErr = accountOIDCIdentityDataAccessor.UpdateAccountOIDCIdentityLastLogin(ctx, identity.ID, claims.Email, now)
+			if txErr != nil {
+				return txErr
+			}
+		case errors.Is(txErr, database.ErrAccountOIDCIdentityNotFound):
+			if account, txErr = o.linkOrCreateAccount(ctx, accountDataAccessor, claims); txErr != nil {
+				return txErr
+			}
+
+			_, txErr = accountOIDCIdentityDataAccessor.CreateAccountOIDCIdentity(ctx, database.AccountOIDCIdentity{
+				OfAccountID: account.ID,
+				Issuer:      metadata.Issuer,
+				Subject:     claims.Subject,
+				Email:       claims.Email,
+				CreatedAt:   now,
+				LastLoginAt: now,
+			})
+			if txErr != nil {
+				return txErr
+			}
+
+			logger.With(zap.Uint64("account_id", account.ID)).Info("linked oidc identity to account")
+		default:
+			return txErr
+		}
+
+		if account.DisabledAt.Valid {
+			return errAccountDisabled
+		}
+
+		role, ok := o.getGroupRole(claims.GroupList)
+		if !ok || role == getAccountRoleList(account)[0] {
+			return nil
+		}
+
+		if txErr = accountDataAccessor.UpdateAccountRole(ctx, account.ID, string(role)); txErr != nil {
+			return txErr
+		}
+
+		// sessions of the account still carry the old role in their access tokens
+		account.Role = string(role)
+		sessionIDList, txErr = revokeActiveSessionListOfAccount(
+			ctx, o.sessionDataAccessor.WithDatabase(td), account.ID, now)
+		return txErr
+	})
+	if txErr != nil {
+		return CreateSessionOutput{}, txErr
+	}
+
+	if err = o.tokenLogic.RevokeSessionList(ctx, sessionIDList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to add sessions of account with synced role to revocation list")
+		return CreateSessionOutput{}, err
+	}
+
+	sessionID, refreshToken, err := createSession(
+		ctx, o.goquDatabase, o.sessionDataAccessor, account.ID, o.refreshTokenExpiresIn)
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	token, _, err := o.tokenLogic.GetToken(
+		ctx, account.ID, sessionID, o.twoFactorRequirement.getAccountRoleList(account))
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	return CreateSessionOutput{
+		Account:      databaseAccountToProtoAccount(account),
+		Token:        token,
+		RefreshToken: refreshToken,
+	}, nil
+}
@@ -0,0 +1,527 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/database"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	mockIdPClientID     = "goload"
+	mockIdPClientSecret = "client-secret"
+	mockIdPRedirectURL  = "http://goload.test/auth/oidc/callback"
+	mockIdPKeyID        = "mock-idp-key"
+	mockIdPSubject      = "subject-1"
+	mockIdPEmail        = "alice@example.com"
+)
+
+// mockIdPAuthorization is what the mock IdP remembers about an authorization code until it is exchanged.
+type mockIdPAuthorization struct {
+	codeChallenge string
+	nonce         string
+}
+
+// mockIdP is a local OpenID Connect provider serving discovery, its JSON web key set and the token endpoint.
+type mockIdP struct {
+	server        *httptest.Server
+	privateKey    *rsa.PrivateKey
+	signingKey    *rsa.PrivateKey
+	editClaims    func(claims jwt.MapClaims)
+	mutex         sync.Mutex
+	codeMap       map[string]mockIdPAuthorization
+	nextCode      int
+	jwksHitCount  int
+	tokenHitCount int
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate mock idp key: %v", err)
+	}
+
+	idp := &mockIdP{
+		privateKey: privateKey,
+		signingKey: privateKey,
+		codeMap:    make(map[string]mockIdPAuthorization),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(oidcDiscoveryPath, idp.handleDiscovery)
+	mux.HandleFunc("/jwks", idp.handleJSONWebKeySet)
+	mux.HandleFunc("/token", idp.handleToken)
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+
+	return idp
+}
+
+func (m *mockIdP) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeMockIdPJSON(w, http.StatusOK, oidcProviderMetadata{
+		Issuer:                m.server.URL,
+		AuthorizationEndpoint: m.server.URL + "/authorize",
+		TokenEndpoint:         m.server.URL + "/token",
+		JWKSURI:               m.server.URL + "/jwks",
+	})
+}
+
+func (m *mockIdP) handleJSONWebKeySet(w http.ResponseWriter, _ *http.Request) {
+	m.mutex.Lock()
+	m.jwksHitCount++
+	m.mutex.Unlock()
+
+	writeMockIdPJSON(w, http.StatusOK, JSONWebKeySet{Keys: []JSONWebKey{{
+		KeyType:   jsonWebKeyTypeRSA,
+		Use:       jsonWebKeyUseSignature,
+		Algorithm: jwt.SigningMethodRS256.Alg(),
+		KeyID:     mockIdPKeyID,
+		N:         base64.RawURLEncoding.EncodeToString(m.privateKey.N.Bytes()),
+		E:         base64.RawURLEncoding.EncodeToString(big.NewInt(int64(m.privateKey.E)).Bytes()),
+	}}})
+}
+
+func (m *mockIdP) handleToken(w http.ResponseWriter, r *http.Request) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.tokenHitCount++
+	invalidGrant := oidcTokenResponse{Error: "invalid_grant"}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if r.Method != http.MethodPost || !ok || clientID != mockIdPClientID || clientSecret != mockIdPClientSecret {
+		writeMockIdPJSON(w, http.StatusUnauthorized, oidcTokenResponse{Error: "invalid_client"})
+		return
+	}
+
+	if err := r.ParseForm(); err != nil ||
+		r.PostForm.Get("grant_type") != "authorization_code" ||
+		r.PostForm.Get("client_id") != mockIdPClientID ||
+		r.PostForm.Get("redirect_uri") != mockIdPRedirectURL {
+		writeMockIdPJSON(w, http.StatusBadRequest, oidcTokenResponse{Error: "invalid_request"})
+		return
+	}
+
+	authorization, ok := m.codeMap[r.PostForm.Get("code")]
+	if !ok {
+		writeMockIdPJSON(w, http.StatusBadRequest, invalidGrant)
+		return
+	}
+
+	// an authorization code can only be exchanged once
+	delete(m.codeMap, r.PostForm.Get("code"))
+	if getOIDCCodeChallenge(r.PostForm.Get("code_verifier")) != authorization.codeChallenge {
+		writeMockIdPJSON(w, http.StatusBadRequest, invalidGrant)
+		return
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":            m.server.URL,
+		"aud":            mockIdPClientID,
+		"sub":            mockIdPSubject,
+		"email":          mockIdPEmail,
+		"email_verified": true,
+		"nonce":          authorization.nonce,
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Minute).Unix(),
+	}
+	if m.editClaims != nil {
+		m.editClaims(claims)
+	}
+
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	idToken.Header["kid"] = mockIdPKeyID
+	rawIDToken, err := idToken.SignedString(m.signingKey)
+	if err != nil {
+		writeMockIdPJSON(w, http.StatusInternalServerError, oidcTokenResponse{Error: "server_error"})
+		return
+	}
+
+	writeMockIdPJSON(w, http.StatusOK, oidcTokenResponse{IDToken: rawIDToken})
+}
+
+// authorize plays the part of the browser and the login page, and returns the code the IdP redirects back with.
+func (m *mockIdP) authorize(t *testing.T, loginURL string) string {
+	t.Helper()
+
+	parsedURL, err := url.Parse(loginURL)
+	if err != nil {
+		t.Fatalf("failed to parse login url: %v", err)
+	}
+
+	query := parsedURL.Query()
+	if query.Get("code_challenge_method") != oidcCodeChallengeMethodS256 || query.Get("code_challenge") == "" {
+		t.Fatalf("login url has no S256 code challenge: %s", loginURL)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.nextCode++
+	code := fmt.Sprintf("code-%d", m.nextCode)
+	m.codeMap[code] = mockIdPAuthorization{
+		codeChallenge: query.Get("code_challenge"),
+		nonce:         query.Get("nonce"),
+	}
+
+	return code
+}
+
+func writeMockIdPJSON(w http.ResponseWriter, statusCode int, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func newTestOIDC(t *testing.T, idp *mockIdP, editConfig func(oidcConfig *configs.OIDC)) (OIDC, *mockStore) {
+	t.Helper()
+
+	store := newMockStore()
+	authConfig := configs.Auth{
+		Token: configs.Token{RefreshTokenExpiresIn: "24h"},
+		OIDC: configs.OIDC{
+			Enabled:        true,
+			IssuerURL:      idp.server.URL,
+			ClientID:       mockIdPClientID,
+			ClientSecret:   mockIdPClientSecret,
+			RedirectURL:    mockIdPRedirectURL,
+			ScopeList:      []string{"email", "profile"},
+			LoginExpiresIn: "10m",
+		},
+	}
+	if editConfig != nil {
+		editConfig(&authConfig.OIDC)
+	}
+
+	oidcLogic, err := NewOIDC(
+		newMockGoquDatabase(),
+		mockOIDCLoginStateCache{store: store},
+		mockAccountDataAccessor{store: store},
+		mockAccountOIDCIdentityDataAccessor{store: store},
+		mockSessionDataAccessor{store: store},
+		mockTokenLogic{store: store},
+		authConfig,
+		zap.NewNop(),
+	)
+	if err != nil {
+		t.Fatalf("failed to create oidc logic: %v", err)
+	}
+
+	return oidcLogic, store
+}
+
+func loginWithMockIdP(t *testing.T, oidcLogic OIDC, idp *mockIdP) (CreateSessionOutput, error) {
+	t.Helper()
+
+	ctx := context.Background()
+	loginURLOutput, err := oidcLogic.GetOIDCLoginURL(ctx)
+	if err != nil {
+		t.Fatalf("failed to get login url: %v", err)
+	}
+
+	return oidcLogic.CreateOIDCSession(ctx, CreateOIDCSessionParams{
+		State: loginURLOutput.State,
+		Code:  idp.authorize(t, loginURLOutput.URL),
+	})
+}
+
+func TestOIDCGetLoginURL(t *testing.T) {
+	idp := newMockIdP(t)
+	oidcLogic, store := newTestOIDC(t, idp, nil)
+
+	output, err := oidcLogic.GetOIDCLoginURL(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get login url: %v", err)
+	}
+
+	loginURL, err := url.Parse(output.URL)
+	if err != nil {
+		t.Fatalf("failed to parse login url: %v", err)
+	}
+
+	if loginURL.Scheme+"://"+loginURL.Host+loginURL.Path != idp.server.URL+"/authorize" {
+		t.Fatalf("login url does not point to the discovered authorization endpoint: %s", output.URL)
+	}
+
+	query := loginURL.Query()
+	loginState, ok := store.loginStateMap[output.State]
+	switch {
+	case !ok:
+		t.Fatal("login state was not saved")
+	case query.Get("state") != output.State:
+		t.Fatalf("unexpected state %q", query.Get("state"))
+	case query.Get("response_type") != "code" || query.Get("client_id") != mockIdPClientID:
+		t.Fatalf("unexpected response_type or client_id in %s", output.URL)
+	case query.Get("redirect_uri") != mockIdPRedirectURL:
+		t.Fatalf("unexpected redirect_uri %q", query.Get("redirect_uri"))
+	case query.Get("scope") != "openid email profile":
+		t.Fatalf("unexpected scope %q", query.Get("scope"))
+	case query.Get("nonce") != loginState.Nonce:
+		t.Fatalf("nonce of the login url does not match the saved nonce")
+	case query.Get("code_challenge") != getOIDCCodeChallenge(loginState.CodeVerifier):
+		t.Fatalf("code challenge does not match the saved code verifier")
+	}
+}
+
+func TestOIDCCreateSessionProvisionsAccount(t *testing.T) {
+	idp := newMockIdP(t)
+	oidcLogic, store := newTestOIDC(t, idp, nil)
+
+	output, err := loginWithMockIdP(t, oidcLogic, idp)
+	if err != nil {
+		t.Fatalf("failed to create oidc session: %v", err)
+	}
+
+	if len(store.accountList) != 1 || store.accountList[0].AccountName != mockIdPEmail {
+		t.Fatalf("expected account %s to be provisioned, got %+v", mockIdPEmail, store.accountList)
+	}
+
+	if store.accountList[0].Role != string(RoleUser) {
+		t.Fatalf("expected provisioned account to have role user, got %q", store.accountList[0].Role)
+	}
+
+	if len(store.identityList) != 1 ||
+		store.identityList[0].Issuer != idp.server.URL ||
+		store.identityList[0].Subject != mockIdPSubject ||
+		store.identityList[0].OfAccountID != store.accountList[0].ID {
+		t.Fatalf("expected identity to be linked to the provisioned account, got %+v", store.identityList)
+	}
+
+	if output.Account.GetId() != store.accountList[0].ID || output.Token == "" || output.RefreshToken == "" {
+		t.Fatalf("unexpected session output %+v", output)
+	}
+
+	if len(store.sessionList) != 1 || store.sessionList[0].RefreshTokenHash == "" {
+		t.Fatalf("expected one session with a refresh token hash, got %+v", store.sessionList)
+	}
+}
+
+func TestOIDCCreateSessionLinksBySubject(t *testing.T) {
+	idp := newMockIdP(t)
+	oidcLogic, store := newTestOIDC(t, idp, nil)
+
+	if _, err := loginWithMockIdP(t, oidcLogic, idp); err != nil {
+		t.Fatalf("failed to create first oidc session: %v", err)
+	}
+
+	// the email changed at the provider, the subject still identifies the same account
+	idp.editClaims = func(claims jwt.MapClaims) { claims["email"] = "alice@example.org" }
+	output, err := loginWithMockIdP(t, oidcLogic, idp)
+	if err != nil {
+		t.Fatalf("failed to create second oidc session: %v", err)
+	}
+
+	if len(store.accountList) != 1 || len(store.identityList) != 1 {
+		t.Fatalf("expected the identity to be reused, got %d accounts and %d identities",
+			len(store.accountList), len(store.identityList))
+	}
+
+	if output.Account.GetId() != store.accountList[0].ID || store.identityList[0].Email != "alice@example.org" {
+		t.Fatalf("expected the login to be recorded on the linked identity, got %+v", store.identityList[0])
+	}
+
+	if idp.jwksHitCount != 1 {
+		t.Fatalf("expected the json web key set to be fetched once, got %d", idp.jwksHitCount)
+	}
+}
+
+func TestOIDCCreateSessionLinksByEmail(t *testing.T) {
+	testCaseList := []struct {
+		name          string
+		linkByEmail   bool
+		emailVerified bool
+		expectedCode  codes.Code
+	}{
+		{name: "verified email", linkByEmail: true, emailVerified: true, expectedCode: codes.OK},
+		{name: "unverified email", linkByEmail: true, emailVerified: false, expectedCode: codes.AlreadyExists},
+		{name: "linking disabled", linkByEmail: false, emailVerified: true, expectedCode: codes.AlreadyExists},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			idp := newMockIdP(t)
+			idp.editClaims = func(claims jwt.MapClaims) { claims["email_verified"] = testCase.emailVerified }
+			oidcLogic, store := newTestOIDC(t, idp, func(oidcConfig *configs.OIDC) {
+				oidcConfig.LinkByEmail = testCase.linkByEmail
+			})
+			store.accountList = []database.Account{{ID: 1, AccountName: mockIdPEmail, Role: string(RoleUser)}}
+
+			output, err := loginWithMockIdP(t, oidcLogic, idp)
+			requireStatusCode(t, err, testCase.expectedCode)
+			if testCase.expectedCode != codes.OK {
+				if len(store.identityList) != 0 {
+					t.Fatalf("expected no identity to be linked, got %+v", store.identityList)
+				}
+
+				return
+			}
+
+			if len(store.accountList) != 1 || output.Account.GetId() != 1 {
+				t.Fatalf("expected the existing account to be linked, got %+v", store.accountList)
+			}
+
+			if len(store.identityList) != 1 || store.identityList[0].OfAccountID != 1 {
+				t.Fatalf("expected identity to be linked to the existing account, got %+v", store.identityList)
+			}
+		})
+	}
+}
+
+func TestOIDCCreateSessionMapsGroupsToRoles(t *testing.T) {
+	idp := newMockIdP(t)
+	oidcLogic, store := newTestOIDC(t, idp, func(oidcConfig *configs.OIDC) {
+		oidcConfig.GroupsClaim = "groups"
+		oidcConfig.GroupRoleMap = map[string]string{
+			"operators": string(RoleOperator),
+			"admins":    string(RoleAdmin),
+		}
+	})
+
+	testCaseList := []struct {
+		groups       any
+		expectedRole Role
+	}{
+		{groups: []string{"operators"}, expectedRole: RoleOperator},
+		{groups: []string{"unknown", "admins", "operators"}, expectedRole: RoleAdmin},
+		{groups: "admins", expectedRole: RoleAdmin},
+		{groups: []string{}, expectedRole: RoleUser},
+	}
+
+	for _, testCase := range testCaseList {
+		sessionCount := len(store.sessionList)
+		previousRole := RoleUser
+		if len(store.accountList) > 0 {
+			previousRole = Role(store.accountList[0].Role)
+		}
+
+		idp.editClaims = func(claims jwt.MapClaims) { claims["groups"] = testCase.groups }
+		output, err := loginWithMockIdP(t, oidcLogic, idp)
+		if err != nil {
+			t.Fatalf("failed to create oidc session with groups %v: %v", testCase.groups, err)
+		}
+
+		if Role(store.accountList[0].Role) != testCase.expectedRole ||
+			output.Account.GetRole() != roleToProtoRole(testCase.expectedRole) {
+			t.Fatalf("expected role %s for groups %v, got %s", testCase.expectedRole, testCase.groups,
+				store.accountList[0].Role)
+		}
+
+		// a changed role revokes the sessions issued with the old one
+		for _, session := range store.sessionList[:sessionCount] {
+			if previousRole != testCase.expectedRole && !session.RevokedAt.Valid {
+				t.Fatalf("expected session %d to be revoked after the role changed", session.ID)
+			}
+		}
+	}
+
+	if len(store.revokedSessionList) != 3 {
+		t.Fatalf("expected 3 sessions to be revoked, got %v", store.revokedSessionList)
+	}
+}
+
+func TestOIDCCreateSessionRejectsInvalidIDToken(t *testing.T) {
+	otherPrivateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	testCaseList := []struct {
+		name       string
+		editClaims func(claims jwt.MapClaims)
+		signingKey *rsa.PrivateKey
+	}{
+		{name: "wrong signature", signingKey: otherPrivateKey},
+		{name: "wrong issuer", editClaims: func(claims jwt.MapClaims) { claims["iss"] = "https://idp.example.com" }},
+		{name: "wrong audience", editClaims: func(claims jwt.MapClaims) { claims["aud"] = "another-client" }},
+		{name: "wrong nonce", editClaims: func(claims jwt.MapClaims) { claims["nonce"] = "another-nonce" }},
+		{name: "missing nonce", editClaims: func(claims jwt.MapClaims) { delete(claims, "nonce") }},
+		{name: "expired", editClaims: func(claims jwt.MapClaims) { claims["exp"] = time.Now().Add(-time.Minute).Unix() }},
+		{name: "missing subject", editClaims: func(claims jwt.MapClaims) { delete(claims, "sub") }},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			idp := newMockIdP(t)
+			idp.editClaims = testCase.editClaims
+			if testCase.signingKey != nil {
+				idp.signingKey = testCase.signingKey
+			}
+
+			oidcLogic, store := newTestOIDC(t, idp, nil)
+			_, err := loginWithMockIdP(t, oidcLogic, idp)
+			if !errors.Is(err, errInvalidOIDCIDToken) {
+				t.Fatalf("expected invalid id token error, got %v", err)
+			}
+
+			if len(store.accountList) != 0 || len(store.sessionList) != 0 {
+				t.Fatal("expected no account or session to be created")
+			}
+		})
+	}
+}
+
+func TestOIDCCreateSessionRejectsWrongCodeVerifier(t *testing.T) {
+	idp := newMockIdP(t)
+	oidcLogic, store := newTestOIDC(t, idp, nil)
+
+	ctx := context.Background()
+	loginURLOutput, err := oidcLogic.GetOIDCLoginURL(ctx)
+	if err != nil {
+		t.Fatalf("failed to get login url: %v", err)
+	}
+
+	code := idp.authorize(t, loginURLOutput.URL)
+	loginState := store.loginStateMap[loginURLOutput.State]
+	loginState.CodeVerifier = "another-code-verifier"
+	store.loginStateMap[loginURLOutput.State] = loginState
+
+	_, err = oidcLogic.CreateOIDCSession(ctx, CreateOIDCSessionParams{State: loginURLOutput.State, Code: code})
+	requireStatusCode(t, err, codes.Unauthenticated)
+	if len(store.accountList) != 0 {
+		t.Fatal("expected no account to be created")
+	}
+}
+
+func TestOIDCCreateSessionRejectsReplayedState(t *testing.T) {
+	idp := newMockIdP(t)
+	oidcLogic, _ := newTestOIDC(t, idp, nil)
+
+	ctx := context.Background()
+	loginURLOutput, err := oidcLogic.GetOIDCLoginURL(ctx)
+	if err != nil {
+		t.Fatalf("failed to get login url: %v", err)
+	}
+
+	params := CreateOIDCSessionParams{State: loginURLOutput.State, Code: idp.authorize(t, loginURLOutput.URL)}
+	if _, err = oidcLogic.CreateOIDCSession(ctx, params); err != nil {
+		t.Fatalf("failed to create oidc session: %v", err)
+	}
+
+	_, err = oidcLogic.CreateOIDCSession(ctx, params)
+	if !errors.Is(err, errInvalidOIDCLoginState) {
+		t.Fatalf("expected invalid login state error, got %v", err)
+	}
+
+	if idp.tokenHitCount != 1 {
+		t.Fatalf("expected the code to be exchanged once, got %d", idp.tokenHitCount)
+	}
+}
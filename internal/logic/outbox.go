@@ -0,0 +1,191 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/database"
+	"goload/internal/dataaccess/mq/producer"
+	"goload/internal/utils"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultOutboxRelayBatchSize = 100
+	outboxPruneBatchSize        = 1000
+)
+
+type Outbox interface {
+	RelayOutboxMessageList(ctx context.Context) (uint64, error)
+	PruneOutboxMessageList(ctx context.Context) (uint64, error)
+	GetOutboxRelayLag(ctx context.Context) (time.Duration, error)
+}
+
+type outbox struct {
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor
+	producerClient            producer.Client
+	goquDatabase              *goqu.Database
+	relayBatchSize            uint64
+	publishedMessageRetention time.Duration
+	logger                    *zap.Logger
+}
+
+func NewOutbox(
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	producerClient producer.Client,
+	goquDatabase *goqu.Database,
+	outboxConfig configs.Outbox,
+	logger *zap.Logger,
+) (Outbox, error) {
+	publishedMessageRetention, err := outboxConfig.GetPublishedMessageRetentionDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).
+			Error("failed to parse published_message_retention: " + outboxConfig.PublishedMessageRetention)
+		return nil, err
+	}
+
+	relayBatchSize := outboxConfig.RelayBatchSize
+	if relayBatchSize == 0 {
+		relayBatchSize = defaultOutboxRelayBatchSize
+	}
+
+	return &outbox{
+		outboxMessageDataAccessor: outboxMessageDataAccessor,
+		producerClient:            producerClient,
+		goquDatabase:              goquDatabase,
+		relayBatchSize:            relayBatchSize,
+		publishedMessageRetention: publishedMessageRetention,
+		logger:                    logger,
+	}, nil
+}
+
+// enqueueOutboxMessage stores a message to be published by the outbox relay. Calling it with the transaction
+// that makes the change the message describes ensures the message is published if and only if the change is
+// committed. Protobuf payloads are encoded with protojson, everything else with encoding/json.
+func enqueueOutboxMessage(
+	ctx context.Context,
+	outboxMessageDataAccessor database.OutboxMessageDataAccessor,
+	db database.Database,
+	queueName string,
+	payload any,
+) error {
+	var (
+		payloadBytes []byte
+		err          error
+	)
+	if protoPayload, ok := payload.(proto.Message); ok {
+		payloadBytes, err = protojson.Marshal(protoPayload)
+	} else {
+		payloadBytes, err = json.Marshal(payload)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to marshal %s message", queueName)
+	}
+
+	_, err = outboxMessageDataAccessor.WithDatabase(db).CreateOutboxMessage(ctx, database.OutboxMessage{
+		QueueName: queueName,
+		Payload:   payloadBytes,
+		CreatedAt: time.Now(),
+	})
+	return err
+}
+
+// relayOutboxMessageBatch publishes one batch of pending messages in order. Messages published before a failure
+// are still marked as published, and a crash before the commit only causes them to be published again.
+func (o outbox) relayOutboxMessageBatch(ctx context.Context) (uint64, bool, error) {
+	var (
+		publishedCount uint64
+		hasMore        bool
+		produceErr     error
+	)
+
+	txErr := o.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		messageList, err := o.outboxMessageDataAccessor.
+			WithDatabase(td).
+			GetPendingOutboxMessageListWithXLock(ctx, o.relayBatchSize)
+		if err != nil {
+			return err
+		}
+
+		publishedIDList := make([]uint64, 0, len(messageList))
+		for _, message := range messageList {
+			if produceErr = o.producerClient.Produce(ctx, message.QueueName, message.Payload); produceErr != nil {
+				break
+			}
+
+			publishedIDList = append(publishedIDList, message.ID)
+		}
+
+		if err = o.outboxMessageDataAccessor.
+			WithDatabase(td).
+			UpdateOutboxMessageListPublished(ctx, publishedIDList, time.Now()); err != nil {
+			return err
+		}
+
+		publishedCount = uint64(len(publishedIDList))
+		hasMore = uint64(len(messageList)) == o.relayBatchSize
+		return nil
+	})
+	if txErr != nil {
+		return 0, false, txErr
+	}
+
+	return publishedCount, hasMore && produceErr == nil, produceErr
+}
+
+func (o outbox) RelayOutboxMessageList(ctx context.Context) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	var totalPublishedCount uint64
+	for {
+		publishedCount, hasMore, err := o.relayOutboxMessageBatch(ctx)
+		totalPublishedCount += publishedCount
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to relay outbox message batch")
+			return totalPublishedCount, err
+		}
+
+		if !hasMore || ctx.Err() != nil {
+			return totalPublishedCount, nil
+		}
+	}
+}
+
+func (o outbox) PruneOutboxMessageList(ctx context.Context) (uint64, error) {
+	publishedBefore := time.Now().Add(-o.publishedMessageRetention)
+
+	var totalDeletedCount uint64
+	for {
+		deletedCount, err := o.outboxMessageDataAccessor.
+			DeleteOutboxMessageListPublishedBefore(ctx, publishedBefore, outboxPruneBatchSize)
+		totalDeletedCount += deletedCount
+		if err != nil {
+			return totalDeletedCount, err
+		}
+
+		if deletedCount < outboxPruneBatchSize || ctx.Err() != nil {
+			return totalDeletedCount, nil
+		}
+	}
+}
+
+// GetOutboxRelayLag returns how long the oldest pending message has been waiting, or zero if none is pending.
+func (o outbox) GetOutboxRelayLag(ctx context.Context) (time.Duration, error) {
+	message, found, err := o.outboxMessageDataAccessor.GetOldestPendingOutboxMessage(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if !found {
+		return 0, nil
+	}
+
+	return time.Since(message.CreatedAt), nil
+}
@@ -0,0 +1,113 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"goload/internal/dataaccess/database"
+	"goload/internal/dataaccess/mq/producer"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRelayOutboxMessageList(t *testing.T) {
+	testCaseList := []struct {
+		name             string
+		queueNameList    []string
+		publishedIDList  []uint64
+		failingQueueName string
+		relayBatchSize   uint64
+		// expectedPayloadList is what the queue receives, in order
+		expectedPayloadList    []string
+		expectedPendingIDList  []uint64
+		expectedErrCode        codes.Code
+		expectedPublishedCount uint64
+	}{
+		{
+			name:                   "every pending message is relayed in order over several batches",
+			queueNameList:          []string{"a", "a", "a", "a", "a"},
+			relayBatchSize:         2,
+			expectedPayloadList:    []string{"1", "2", "3", "4", "5"},
+			expectedPublishedCount: 5,
+		},
+		{
+			name:                   "published messages are not relayed again",
+			queueNameList:          []string{"a", "a", "a"},
+			publishedIDList:        []uint64{1, 2},
+			relayBatchSize:         100,
+			expectedPayloadList:    []string{"3"},
+			expectedPublishedCount: 1,
+		},
+		{
+			name:                   "messages after a failure are left pending",
+			queueNameList:          []string{"a", "a", "b", "a"},
+			failingQueueName:       "b",
+			relayBatchSize:         100,
+			expectedPayloadList:    []string{"1", "2"},
+			expectedPendingIDList:  []uint64{3, 4},
+			expectedErrCode:        codes.Unavailable,
+			expectedPublishedCount: 2,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			store := newMockStore()
+			for i, queueName := range testCase.queueNameList {
+				store.outboxMessageList = append(store.outboxMessageList, database.OutboxMessage{
+					ID:        uint64(i + 1),
+					QueueName: queueName,
+					Payload:   []byte(fmt.Sprint(i + 1)),
+					CreatedAt: time.Now(),
+				})
+			}
+
+			for _, id := range testCase.publishedIDList {
+				store.outboxMessageList[id-1].PublishedAt = sql.NullTime{Time: time.Now(), Valid: true}
+			}
+
+			broker := producer.NewInMemoryBroker()
+			o := outbox{
+				outboxMessageDataAccessor: mockOutboxMessageDataAccessor{store: store},
+				producerClient: mockProducerClient{
+					Client:           producer.NewInMemoryClient(broker, zap.NewNop()),
+					failingQueueName: testCase.failingQueueName,
+				},
+				goquDatabase:   newMockGoquDatabase(),
+				relayBatchSize: testCase.relayBatchSize,
+				logger:         zap.NewNop(),
+			}
+
+			publishedCount, err := o.RelayOutboxMessageList(context.Background())
+			requireStatusCode(t, err, testCase.expectedErrCode)
+			if publishedCount != testCase.expectedPublishedCount {
+				t.Fatalf("expected %d published messages, got %d", testCase.expectedPublishedCount, publishedCount)
+			}
+
+			queue := broker.GetQueue("a")
+			if len(queue) != len(testCase.expectedPayloadList) {
+				t.Fatalf("expected %d messages in the queue, got %d", len(testCase.expectedPayloadList), len(queue))
+			}
+
+			for _, expectedPayload := range testCase.expectedPayloadList {
+				if payload := string(<-queue); payload != expectedPayload {
+					t.Fatalf("expected payload %s, got %s", expectedPayload, payload)
+				}
+			}
+
+			pendingIDList := make([]uint64, 0)
+			for _, message := range store.outboxMessageList {
+				if !message.PublishedAt.Valid {
+					pendingIDList = append(pendingIDList, message.ID)
+				}
+			}
+
+			if fmt.Sprint(pendingIDList) != fmt.Sprint(testCase.expectedPendingIDList) {
+				t.Fatalf("expected pending messages %v, got %v", testCase.expectedPendingIDList, pendingIDList)
+			}
+		})
+	}
+}
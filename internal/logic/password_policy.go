@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"fmt"
+	"goload/internal/configs"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validatePassword checks a new password against the policy. Existing passwords are never checked, so that a
+// stricter policy does not lock anyone out.
+func validatePassword(passwordPolicy configs.PasswordPolicy, password string) error {
+	length := utf8.RuneCountInString(password)
+	if length < passwordPolicy.MinLength {
+		return status.Errorf(
+			codes.InvalidArgument, "password must be at least %d characters long", passwordPolicy.MinLength)
+	}
+
+	if passwordPolicy.MaxLength > 0 && length > passwordPolicy.MaxLength {
+		return status.Errorf(
+			codes.InvalidArgument, "password must be at most %d characters long", passwordPolicy.MaxLength)
+	}
+
+	var hasLowercase, hasUppercase, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			hasLowercase = true
+		case unicode.IsUpper(r):
+			hasUppercase = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || r == ' ':
+			hasSymbol = true
+		case unicode.IsControl(r):
+			return status.Error(codes.InvalidArgument, "password must not contain control characters")
+		}
+	}
+
+	missingList := make([]string, 0)
+	if passwordPolicy.RequireLowercase && !hasLowercase {
+		missingList = append(missingList, "a lowercase letter")
+	}
+
+	if passwordPolicy.RequireUppercase && !hasUppercase {
+		missingList = append(missingList, "an uppercase letter")
+	}
+
+	if passwordPolicy.RequireDigit && !hasDigit {
+		missingList = append(missingList, "a digit")
+	}
+
+	if passwordPolicy.RequireSymbol && !hasSymbol {
+		missingList = append(missingList, "a symbol")
+	}
+
+	if len(missingList) > 0 {
+		return status.Error(
+			codes.InvalidArgument, fmt.Sprintf("password must contain %s", strings.Join(missingList, ", ")))
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"goload/internal/configs"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestValidatePassword(t *testing.T) {
+	strictPasswordPolicy := configs.PasswordPolicy{
+		MinLength:        8,
+		MaxLength:        16,
+		RequireLowercase: true,
+		RequireUppercase: true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+	}
+
+	testCaseList := []struct {
+		name            string
+		passwordPolicy  configs.PasswordPolicy
+		password        string
+		expectedErrCode codes.Code
+	}{
+		{name: "no policy", password: ""},
+		{name: "meets the policy", passwordPolicy: strictPasswordPolicy, password: "Passw0rd!"},
+		{name: "space counts as a symbol", passwordPolicy: strictPasswordPolicy, password: "Passw0rd "},
+		{
+			name:            "too short",
+			passwordPolicy:  strictPasswordPolicy,
+			password:        "Pa0!",
+			expectedErrCode: codes.InvalidArgument,
+		},
+		{
+			name:           "length counts characters, not bytes",
+			passwordPolicy: configs.PasswordPolicy{MinLength: 4, MaxLength: 4},
+			password:       "éééé",
+		},
+		{
+			name:            "too long",
+			passwordPolicy:  strictPasswordPolicy,
+			password:        "Passw0rd!Passw0rd!",
+			expectedErrCode: codes.InvalidArgument,
+		},
+		{
+			name:            "missing character classes",
+			passwordPolicy:  strictPasswordPolicy,
+			password:        "password",
+			expectedErrCode: codes.InvalidArgument,
+		},
+		{
+			name:            "control character",
+			passwordPolicy:  configs.PasswordPolicy{MinLength: 1},
+			password:        "pass\tword",
+			expectedErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			requireStatusCode(t, validatePassword(testCase.passwordPolicy, testCase.password), testCase.expectedErrCode)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package logic
+
+import (
+	"context"
+	go_load "goload/internal/generated/downloadClient/v1"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Role string
+
+const (
+	RoleUser     Role = "user"
+	RoleOperator Role = "operator"
+	RoleAdmin    Role = "admin"
+)
+
+var (
+	errUnauthenticated  = status.Error(codes.Unauthenticated, "authentication required")
+	errPermissionDenied = status.Error(codes.PermissionDenied, "permission denied")
+
+	// roleRankMap orders the roles, a role is granted everything the roles of lower rank are granted.
+	roleRankMap = map[Role]int{
+		RoleUser:     1,
+		RoleOperator: 2,
+		RoleAdmin:    3,
+	}
+	protoRoleMap = map[go_load.Role]Role{
+		go_load.Role_ROLE_USER:     RoleUser,
+		go_load.Role_ROLE_OPERATOR: RoleOperator,
+		go_load.Role_ROLE_ADMIN:    RoleAdmin,
+	}
+)
+
+func roleToProtoRole(role Role) go_load.Role {
+	for protoRole, mappedRole := range protoRoleMap {
+		if mappedRole == role {
+			return protoRole
+		}
+	}
+
+	return go_load.Role_ROLE_UNSPECIFIED
+}
+
+func protoRoleToRole(protoRole go_load.Role) (Role, bool) {
+	role, ok := protoRoleMap[protoRole]
+	return role, ok
+}
+
+// Principal is the authenticated caller of a request. A principal authenticated with an API key has APIKeyID
+// and ScopeList set instead of SessionID and TokenID.
+type Principal struct {
+	AccountID uint64
+	SessionID uint64
+	TokenID   string
+	RoleList  []Role
+	APIKeyID  uint64
+	ScopeList []APIKeyScope
+}
+
+// HasRole reports whether the principal was granted the role, directly or through a role of higher rank.
+func (p Principal) HasRole(role Role) bool {
+	for _, principalRole := range p.RoleList {
+		if roleRankMap[principalRole] >= roleRankMap[role] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasScope reports whether the principal can act within the scope, sessions are not limited by scopes.
+func (p Principal) HasScope(scope APIKeyScope) bool {
+	if p.APIKeyID == 0 {
+		return true
+	}
+
+	return lo.Contains(p.ScopeList, scope)
+}
+
+type principalContextKey struct{}
+
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+func GetPrincipal(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(Principal)
+	return principal, ok
+}
+
+// getPrincipal is used by the logic methods that need an authenticated caller, it only fails when the method was
+// wrongly left out of the authentication policy.
+func getPrincipal(ctx context.Context) (Principal, error) {
+	principal, ok := GetPrincipal(ctx)
+	if !ok {
+		return Principal{}, errUnauthenticated
+	}
+
+	return principal, nil
+}
+
+func getPrincipalWithRole(ctx context.Context, role Role) (Principal, error) {
+	principal, err := getPrincipal(ctx)
+	if err != nil {
+		return Principal{}, err
+	}
+
+	if !principal.HasRole(role) {
+		return Principal{}, errPermissionDenied
+	}
+
+	return principal, nil
+}
+
+func getPrincipalAccountID(ctx context.Context) (uint64, error) {
+	principal, err := getPrincipal(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return principal.AccountID, nil
+}
@@ -0,0 +1,113 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/database"
+	go_load "goload/internal/generated/downloadClient/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errDownloadTaskCountQuotaExceeded = status.Error(
+		codes.ResourceExhausted, "download task quota exceeded, delete some download tasks first")
+	errActiveDownloadTaskCountQuotaExceeded = status.Error(
+		codes.ResourceExhausted, "active download task quota exceeded, wait for some download tasks to finish first")
+)
+
+// accountQuota is the quota enforced for an account or a workspace, 0 means unlimited.
+type accountQuota struct {
+	MaxDownloadTaskCount       uint64
+	MaxActiveDownloadTaskCount uint64
+}
+
+// getQuota fills in the configured quota where there is no override.
+func getQuota(maxDownloadTaskCount, maxActiveDownloadTaskCount sql.NullInt64, quotaConfig configs.Quota) accountQuota {
+	quota := accountQuota{
+		MaxDownloadTaskCount:       quotaConfig.MaxDownloadTaskCount,
+		MaxActiveDownloadTaskCount: quotaConfig.MaxActiveDownloadTaskCount,
+	}
+
+	if maxDownloadTaskCount.Valid {
+		quota.MaxDownloadTaskCount = uint64(maxDownloadTaskCount.Int64)
+	}
+
+	if maxActiveDownloadTaskCount.Valid {
+		quota.MaxActiveDownloadTaskCount = uint64(maxActiveDownloadTaskCount.Int64)
+	}
+
+	return quota
+}
+
+func getAccountQuota(account database.Account, quotaConfig configs.Quota) accountQuota {
+	return getQuota(account.QuotaMaxDownloadTaskCount, account.QuotaMaxActiveDownloadTaskCount, quotaConfig)
+}
+
+func isDownloadTaskQuotaExceededError(err error) bool {
+	return errors.Is(err, errDownloadTaskCountQuotaExceeded) || errors.Is(err, errActiveDownloadTaskCountQuotaExceeded)
+}
+
+// checkDownloadTaskQuota checks whether the account or the workspace of the task can have one more active
+// download task, and one more download task at all when newDownloadTask is set. The account or workspace row stays
+// locked until db is committed, so concurrent requests cannot both pass the check.
+func (d downloadTask) checkDownloadTaskQuota(
+	ctx context.Context,
+	db database.Database,
+	task database.DownloadTask,
+	newDownloadTask bool,
+) error {
+	var (
+		quota  accountQuota
+		filter database.DownloadTaskListFilter
+	)
+	if task.OfWorkspaceID.Valid {
+		workspace, err := d.workspaceDataAccessor.WithDatabase(db).
+			GetWorkspaceWithXLock(ctx, uint64(task.OfWorkspaceID.Int64))
+		if err != nil {
+			return err
+		}
+
+		quota = getWorkspaceQuota(workspace, d.quotaConfig)
+		filter = database.DownloadTaskListFilter{OfWorkspaceID: workspace.ID}
+	} else {
+		account, err := d.accountDataAccessor.WithDatabase(db).GetAccountByIDWithXLock(ctx, task.OfAccountID)
+		if err != nil {
+			return err
+		}
+
+		quota = getAccountQuota(account, d.quotaConfig)
+		filter = database.DownloadTaskListFilter{OfAccountID: account.ID}
+	}
+
+	if newDownloadTask && quota.MaxDownloadTaskCount > 0 {
+		downloadTaskCount, err := d.downloadTaskDataAccessor.WithDatabase(db).GetDownloadTaskCount(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		if downloadTaskCount >= quota.MaxDownloadTaskCount {
+			return errDownloadTaskCountQuotaExceeded
+		}
+	}
+
+	if quota.MaxActiveDownloadTaskCount > 0 {
+		filter.DownloadStatusList = []go_load.DownloadStatus{
+			go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING,
+			go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING,
+		}
+		activeDownloadTaskCount, err := d.downloadTaskDataAccessor.WithDatabase(db).GetDownloadTaskCount(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		if activeDownloadTaskCount >= quota.MaxActiveDownloadTaskCount {
+			return errActiveDownloadTaskCountQuotaExceeded
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,316 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/database"
+	"goload/internal/dataaccess/file"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/utils"
+)
+
+const (
+	retentionBatchSize = 100
+)
+
+type Retention interface {
+	RunRetention(ctx context.Context) (database.RetentionRun, error)
+}
+
+type retention struct {
+	accountDataAccessor      database.AccountDataAccessor
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor
+	retentionRunDataAccessor database.RetentionRunDataAccessor
+	lifecycleEventProducer   DownloadTaskLifecycleEventProducer
+	goquDatabase             *goqu.Database
+	fileClient               file.Client
+	fileTTL                  time.Duration
+	failedTaskGracePeriod    time.Duration
+	orphanedFileGracePeriod  time.Duration
+	logger                   *zap.Logger
+}
+
+func NewRetention(
+	accountDataAccessor database.AccountDataAccessor,
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
+	retentionRunDataAccessor database.RetentionRunDataAccessor,
+	lifecycleEventProducer DownloadTaskLifecycleEventProducer,
+	goquDatabase *goqu.Database,
+	fileClient file.Client,
+	retentionConfig configs.Retention,
+	logger *zap.Logger,
+) (Retention, error) {
+	fileTTL, err := retentionConfig.GetFileTTLDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse file_ttl: " + retentionConfig.FileTTL)
+		return nil, err
+	}
+
+	failedTaskGracePeriod, err := retentionConfig.GetFailedTaskGracePeriodDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).
+			Error("failed to parse failed_task_grace_period: " + retentionConfig.FailedTaskGracePeriod)
+		return nil, err
+	}
+
+	orphanedFileGracePeriod, err := retentionConfig.GetOrphanedFileGracePeriodDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).
+			Error("failed to parse orphaned_file_grace_period: " + retentionConfig.OrphanedFileGracePeriod)
+		return nil, err
+	}
+
+	return &retention{
+		accountDataAccessor:      accountDataAccessor,
+		downloadTaskDataAccessor: downloadTaskDataAccessor,
+		retentionRunDataAccessor: retentionRunDataAccessor,
+		lifecycleEventProducer:   lifecycleEventProducer,
+		goquDatabase:             goquDatabase,
+		fileClient:               fileClient,
+		fileTTL:                  fileTTL,
+		failedTaskGracePeriod:    failedTaskGracePeriod,
+		orphanedFileGracePeriod:  orphanedFileGracePeriod,
+		logger:                   logger,
+	}, nil
+}
+
+func getDownloadTaskIDFromFileName(fileName string) (uint64, bool) {
+	idString, found := strings.CutPrefix(fileName, downloadTaskFileNamePrefix)
+	if !found {
+		return 0, false
+	}
+
+	id, err := strconv.ParseUint(idString, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return id, true
+}
+
+func (r retention) getFileExpireTime(
+	task database.DownloadTask,
+	account database.Account,
+	fileInfo file.FileInfo,
+) (time.Time, bool) {
+	if task.ExpiresAt.Valid {
+		return task.ExpiresAt.Time, true
+	}
+
+	fileTTL := r.fileTTL
+	if account.FileTTLSeconds.Valid {
+		fileTTL = time.Duration(account.FileTTLSeconds.Int64) * time.Second
+	}
+
+	if fileTTL <= 0 {
+		return time.Time{}, false
+	}
+
+	return fileInfo.ModTime.Add(fileTTL), true
+}
+
+func (r retention) deleteFile(ctx context.Context, fileInfo file.FileInfo, run *database.RetentionRun) error {
+	if err := r.fileClient.Delete(ctx, fileInfo.FilePath); err != nil {
+		return err
+	}
+
+	run.ReclaimedBytes += fileInfo.Size
+	return nil
+}
+
+func (r retention) cleanUpFileBatch(
+	ctx context.Context,
+	fileInfoList map[uint64]file.FileInfo,
+	run *database.RetentionRun,
+	now time.Time,
+) error {
+	logger := utils.LoggerWithContext(ctx, r.logger)
+
+	taskList, err := r.downloadTaskDataAccessor.GetDownloadTaskListByIDList(ctx, lo.Keys(fileInfoList))
+	if err != nil {
+		return err
+	}
+
+	accountList, err := r.accountDataAccessor.GetAccountListByIDList(
+		ctx,
+		lo.Uniq(lo.Map(taskList, func(task database.DownloadTask, _ int) uint64 { return task.OfAccountID })),
+	)
+	if err != nil {
+		return err
+	}
+
+	taskMap := lo.KeyBy(taskList, func(task database.DownloadTask) uint64 { return task.ID })
+	accountMap := lo.KeyBy(accountList, func(account database.Account) uint64 { return account.ID })
+
+	for id, fileInfo := range fileInfoList {
+		task, ok := taskMap[id]
+		if !ok {
+			if now.Sub(fileInfo.ModTime) < r.orphanedFileGracePeriod {
+				continue
+			}
+
+			if err = r.deleteFile(ctx, fileInfo, run); err != nil {
+				logger.With(zap.Error(err)).With(zap.String("file_path", fileInfo.FilePath)).
+					Warn("failed to delete orphaned file")
+				continue
+			}
+
+			run.OrphanedFileCount++
+			continue
+		}
+
+		if task.DownloadStatus == go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING ||
+			task.DownloadStatus == go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING {
+			continue
+		}
+
+		expireTime, expirable := r.getFileExpireTime(task, accountMap[task.OfAccountID], fileInfo)
+		if !expirable || now.Before(expireTime) {
+			continue
+		}
+
+		if err = r.deleteFile(ctx, fileInfo, run); err != nil {
+			logger.With(zap.Error(err)).With(zap.String("file_path", fileInfo.FilePath)).
+				Warn("failed to delete expired file")
+			continue
+		}
+
+		run.ExpiredFileCount++
+	}
+
+	return nil
+}
+
+func (r retention) cleanUpFiles(ctx context.Context, run *database.RetentionRun, now time.Time) error {
+	fileInfoList, err := r.fileClient.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	fileInfoMap := make(map[uint64]file.FileInfo)
+	for _, fileInfo := range fileInfoList {
+		id, ok := getDownloadTaskIDFromFileName(fileInfo.FilePath)
+		if !ok {
+			continue
+		}
+
+		fileInfoMap[id] = fileInfo
+	}
+
+	for _, idBatch := range lo.Chunk(lo.Keys(fileInfoMap), retentionBatchSize) {
+		err = r.cleanUpFileBatch(ctx, lo.PickByKeys(fileInfoMap, idBatch), run, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// purgeTasks selects the candidates without locking, then locks them and checks them again, since a task can be
+// retried in between. Files are only deleted for tasks whose deletion was committed.
+func (r retention) purgeTasks(ctx context.Context, run *database.RetentionRun, now time.Time) error {
+	logger := utils.LoggerWithContext(ctx, r.logger)
+
+	purgeStatusList := []go_load.DownloadStatus{
+		go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED,
+		go_load.DownloadStatus_DOWNLOAD_STATUS_CANCELLED,
+	}
+	updatedBefore := now.Add(-r.failedTaskGracePeriod)
+	for {
+		candidateTaskList, err := r.downloadTaskDataAccessor.GetDownloadTaskListWithStatusUpdatedBefore(
+			ctx, purgeStatusList, updatedBefore, retentionBatchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(candidateTaskList) == 0 {
+			return nil
+		}
+
+		var taskList []database.DownloadTask
+		err = r.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+			lockedTaskList, getErr := r.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskListByIDListWithXLock(
+				ctx,
+				lo.Map(candidateTaskList, func(task database.DownloadTask, _ int) uint64 { return task.ID }),
+			)
+			if getErr != nil {
+				return getErr
+			}
+
+			taskList = lo.Filter(lockedTaskList, func(task database.DownloadTask, _ int) bool {
+				return lo.Contains(purgeStatusList, task.DownloadStatus) && task.UpdatedAt.Before(updatedBefore)
+			})
+			if len(taskList) == 0 {
+				return nil
+			}
+
+			for _, task := range taskList {
+				produceErr := r.lifecycleEventProducer.ProduceDownloadTaskLifecycleEvent(
+					ctx, td, task, &go_load.DownloadTaskLifecycleEvent{
+						Event: &go_load.DownloadTaskLifecycleEvent_Deleted{
+							Deleted: &go_load.DownloadTaskDeleted{Actor: downloadTaskActorSystem},
+						},
+					})
+				if produceErr != nil {
+					return produceErr
+				}
+			}
+
+			return r.downloadTaskDataAccessor.WithDatabase(td).DeleteDownloadTaskList(
+				ctx,
+				lo.Map(taskList, func(task database.DownloadTask, _ int) uint64 { return task.ID }),
+			)
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, task := range taskList {
+			if err = r.fileClient.Delete(ctx, getDownloadTaskFileName(task.ID)); err != nil {
+				// cleanUpFiles removes files without a matching task on a later run
+				logger.With(zap.Error(err)).With(zap.Uint64("id", task.ID)).
+					Warn("failed to delete file of purged download task")
+			}
+		}
+
+		run.PurgedTaskCount += uint64(len(taskList))
+		if len(candidateTaskList) < retentionBatchSize {
+			return nil
+		}
+	}
+}
+
+func (r retention) RunRetention(ctx context.Context) (database.RetentionRun, error) {
+	logger := utils.LoggerWithContext(ctx, r.logger)
+
+	now := time.Now()
+	run := database.RetentionRun{StartedAt: now}
+
+	if err := r.cleanUpFiles(ctx, &run, now); err != nil {
+		logger.With(zap.Error(err)).Error("failed to clean up expired and orphaned files")
+		return database.RetentionRun{}, err
+	}
+
+	if err := r.purgeTasks(ctx, &run, now); err != nil {
+		logger.With(zap.Error(err)).Error("failed to purge failed and cancelled download tasks")
+		return database.RetentionRun{}, err
+	}
+
+	run.FinishedAt = time.Now()
+	runID, err := r.retentionRunDataAccessor.CreateRetentionRun(ctx, run)
+	if err != nil {
+		return database.RetentionRun{}, err
+	}
+
+	run.ID = runID
+	logger.With(zap.Any("run", run)).Info("finished retention run")
+	return run, nil
+}
@@ -0,0 +1,134 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/database"
+	"goload/internal/dataaccess/file"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+func TestRunRetention(t *testing.T) {
+	now := time.Now()
+	testCaseList := []struct {
+		name            string
+		task            *database.DownloadTask
+		fileModTime     time.Time
+		expectedFile    bool
+		expectedDeleted bool
+	}{
+		{
+			name:        "file past the ttl",
+			task:        &database.DownloadTask{DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS},
+			fileModTime: now.Add(-2 * time.Hour),
+		},
+		{
+			name:        "file within the ttl",
+			task:        &database.DownloadTask{DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS},
+			fileModTime: now, expectedFile: true,
+		},
+		{
+			name:        "file past the ttl of a running download",
+			task:        &database.DownloadTask{DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING},
+			fileModTime: now.Add(-2 * time.Hour), expectedFile: true,
+		},
+		{
+			name:        "file within the ttl of its account",
+			task:        &database.DownloadTask{DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS, OfAccountID: 2},
+			fileModTime: now.Add(-2 * time.Hour), expectedFile: true,
+		},
+		{
+			name: "file of an expired task",
+			task: &database.DownloadTask{
+				DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS,
+				ExpiresAt:      sql.NullTime{Time: now.Add(-time.Minute), Valid: true},
+			},
+			fileModTime: now,
+		},
+		{name: "orphaned file past the grace period", fileModTime: now.Add(-2 * time.Hour)},
+		{name: "orphaned file within the grace period", fileModTime: now, expectedFile: true},
+		{
+			name: "failed task past the grace period",
+			task: &database.DownloadTask{
+				DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED, UpdatedAt: now.Add(-48 * time.Hour),
+			},
+			fileModTime: now, expectedDeleted: true,
+		},
+		{
+			name:        "failed task within the grace period",
+			task:        &database.DownloadTask{DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED, UpdatedAt: now},
+			fileModTime: now, expectedFile: true,
+		},
+	}
+
+	store := newMockStore()
+	store.accountList = []database.Account{
+		{ID: 2, FileTTLSeconds: sql.NullInt64{Int64: int64(24 * time.Hour / time.Second), Valid: true}},
+	}
+
+	downloadDirectory := t.TempDir()
+	idList := make([]uint64, len(testCaseList))
+	for i, testCase := range testCaseList {
+		// orphaned files get IDs that no task has
+		idList[i] = uint64(len(testCaseList) + i + 1)
+		if testCase.task != nil {
+			task := *testCase.task
+			task.ID = uint64(len(store.downloadTaskList) + 1)
+			store.downloadTaskList = append(store.downloadTaskList, task)
+			idList[i] = task.ID
+		}
+
+		filePath := filepath.Join(downloadDirectory, getDownloadTaskFileName(idList[i]))
+		if err := os.WriteFile(filePath, []byte("file"), 0o600); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+
+		if err := os.Chtimes(filePath, testCase.fileModTime, testCase.fileModTime); err != nil {
+			t.Fatalf("failed to set file time: %v", err)
+		}
+	}
+
+	r, err := NewRetention(
+		mockAccountDataAccessor{store: store},
+		mockDownloadTaskDataAccessor{store: store},
+		mockRetentionRunDataAccessor{},
+		mockDownloadTaskLifecycleEventProducer{store: store},
+		newMockGoquDatabase(),
+		file.NewLocalFileClient(zap.NewNop(), configs.DownloadConfig{DownloadDir: downloadDirectory}),
+		configs.Retention{FileTTL: "1h", FailedTaskGracePeriod: "24h", OrphanedFileGracePeriod: "1h"},
+		zap.NewNop(),
+	)
+	if err != nil {
+		t.Fatalf("failed to create retention: %v", err)
+	}
+
+	run, err := r.RunRetention(context.Background())
+	if err != nil {
+		t.Fatalf("failed to run retention: %v", err)
+	}
+
+	if run.ExpiredFileCount != 2 || run.OrphanedFileCount != 1 || run.PurgedTaskCount != 1 {
+		t.Fatalf("unexpected retention run %+v", run)
+	}
+
+	for i, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, statErr := os.Stat(filepath.Join(downloadDirectory, getDownloadTaskFileName(idList[i])))
+			if hasFile := statErr == nil; hasFile != testCase.expectedFile {
+				t.Fatalf("expected file %t, got %t", testCase.expectedFile, hasFile)
+			}
+
+			if deleted := lo.Contains(store.deletedTaskIDList, idList[i]); deleted != testCase.expectedDeleted {
+				t.Fatalf("expected task deleted %t, got %t", testCase.expectedDeleted, deleted)
+			}
+		})
+	}
+}
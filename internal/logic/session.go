@@ -0,0 +1,272 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"goload/internal/dataaccess/database"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/utils"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	refreshTokenSecretByteCount = 32
+	// refreshTokenRotationGracePeriod lets concurrent requests, e.g. from several browser tabs, refresh with the
+	// same refresh token without being taken for a reuse.
+	refreshTokenRotationGracePeriod = 10 * time.Second
+)
+
+var (
+	errInvalidRefreshToken = status.Error(codes.Unauthenticated, "invalid refresh token")
+	errRefreshTokenReused  = status.Error(
+		codes.Unauthenticated, "refresh token has already been used, the session has been revoked")
+)
+
+type RefreshSessionParams struct {
+	RefreshToken string
+}
+
+type RefreshSessionOutput struct {
+	Account *go_load.Account
+	Token   string
+	// RefreshToken is empty when the refresh token was rotated by a concurrent refresh in the grace period, the
+	// caller should keep the refresh token it got from that refresh.
+	RefreshToken string
+}
+
+type DeleteSessionParams struct{}
+
+type DeleteAllSessionsParams struct{}
+
+type DeleteAllSessionsOutput struct {
+	RevokedSessionCount uint64
+}
+
+// A refresh token is "<session id>.<secret>", only the SHA-256 of the secret is stored. The secret is replaced
+// on every refresh, and presenting a replaced secret revokes the session, since it means the token leaked.
+func generateRefreshToken(sessionID uint64) (string, string) {
+	secretBytes := make([]byte, refreshTokenSecretByteCount)
+	// crypto/rand.Read never returns an error on supported platforms
+	_, _ = rand.Read(secretBytes)
+	secret := hex.EncodeToString(secretBytes)
+	return fmt.Sprintf("%d.%s", sessionID, secret), hashRefreshTokenSecret(secret)
+}
+
+func hashRefreshTokenSecret(secret string) string {
+	secretHash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(secretHash[:])
+}
+
+func parseRefreshToken(refreshToken string) (uint64, string, bool) {
+	sessionIDString, secret, found := strings.Cut(refreshToken, ".")
+	if !found || secret == "" {
+		return 0, "", false
+	}
+
+	sessionID, err := strconv.ParseUint(sessionIDString, 10, 64)
+	if err != nil {
+		return 0, "", false
+	}
+
+	return sessionID, secret, true
+}
+
+// revokeActiveSessionListOfAccount revokes the sessions of the account in the database, the caller still has to add
+// the returned sessions to the revocation list of the token logic once the transaction is committed.
+func revokeActiveSessionListOfAccount(
+	ctx context.Context,
+	sessionDataAccessor database.SessionDataAccessor,
+	accountID uint64,
+	now time.Time,
+) ([]uint64, error) {
+	sessionList, err := sessionDataAccessor.GetActiveSessionListOfAccountWithXLock(ctx, accountID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionIDList := lo.Map(sessionList, func(session database.Session, _ int) uint64 { return session.ID })
+	if err = sessionDataAccessor.UpdateSessionListRevoked(ctx, sessionIDList, now); err != nil {
+		return nil, err
+	}
+
+	return sessionIDList, nil
+}
+
+// createSession creates a session for an account that has already been authenticated, and returns its ID with
+// its refresh token.
+func createSession(
+	ctx context.Context,
+	goquDatabase *goqu.Database,
+	sessionDataAccessor database.SessionDataAccessor,
+	accountID uint64,
+	refreshTokenExpiresIn time.Duration,
+) (uint64, string, error) {
+	now := time.Now()
+	session := database.Session{
+		OfAccountID: accountID,
+		CreatedAt:   now,
+		RefreshedAt: now,
+		ExpiresAt:   now.Add(refreshTokenExpiresIn),
+	}
+
+	var refreshToken string
+	txErr := goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		var err error
+		session.ID, err = sessionDataAccessor.WithDatabase(td).CreateSession(ctx, session)
+		if err != nil {
+			return err
+		}
+
+		// The refresh token embeds the session ID, so its hash can only be stored once the row exists.
+		refreshToken, session.RefreshTokenHash = generateRefreshToken(session.ID)
+		return sessionDataAccessor.WithDatabase(td).UpdateSession(ctx, session)
+	})
+	if txErr != nil {
+		return 0, "", txErr
+	}
+
+	return session.ID, refreshToken, nil
+}
+
+func (a account) RefreshSession(ctx context.Context, params RefreshSessionParams) (RefreshSessionOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	sessionID, secret, ok := parseRefreshToken(params.RefreshToken)
+	if !ok {
+		return RefreshSessionOutput{}, errInvalidRefreshToken
+	}
+
+	var (
+		now             = time.Now()
+		refreshToken    string
+		existingAccount database.Account
+		reused          bool
+	)
+
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		session, err := a.sessionDataAccessor.WithDatabase(td).GetSessionWithXLock(ctx, sessionID)
+		if err != nil {
+			if errors.Is(err, database.ErrSessionNotFound) {
+				return errInvalidRefreshToken
+			}
+
+			return err
+		}
+
+		if session.RevokedAt.Valid || !now.Before(session.ExpiresAt) {
+			return errInvalidRefreshToken
+		}
+
+		secretHash := hashRefreshTokenSecret(secret)
+		switch {
+		case subtle.ConstantTimeCompare([]byte(secretHash), []byte(session.RefreshTokenHash)) == 1:
+			session.PreviousRefreshTokenHash = session.RefreshTokenHash
+			refreshToken, session.RefreshTokenHash = generateRefreshToken(session.ID)
+			session.RefreshedAt = now
+			session.ExpiresAt = now.Add(a.refreshTokenExpiresIn)
+			if err = a.sessionDataAccessor.WithDatabase(td).UpdateSession(ctx, session); err != nil {
+				return err
+			}
+		case subtle.ConstantTimeCompare([]byte(secretHash), []byte(session.PreviousRefreshTokenHash)) == 1 &&
+			now.Sub(session.RefreshedAt) < refreshTokenRotationGracePeriod:
+			// a concurrent refresh already rotated the refresh token, only a new access token is issued
+		default:
+			// The revocation has to be committed, so the error is only returned after the transaction.
+			reused = true
+			session.RevokedAt = sql.NullTime{Time: now, Valid: true}
+			return a.sessionDataAccessor.WithDatabase(td).UpdateSession(ctx, session)
+		}
+
+		existingAccount, err = a.accountDataAccessor.WithDatabase(td).GetAccountByID(ctx, session.OfAccountID)
+		if err != nil {
+			return err
+		}
+
+		if existingAccount.DisabledAt.Valid {
+			return errAccountDisabled
+		}
+
+		return nil
+	})
+	if txErr != nil {
+		return RefreshSessionOutput{}, txErr
+	}
+
+	if reused {
+		logger.With(zap.Uint64("session_id", sessionID)).Warn("refresh token reused, revoking session")
+		if err := a.tokenLogic.RevokeSessionList(ctx, []uint64{sessionID}); err != nil {
+			logger.With(zap.Error(err)).Error("failed to add reused session to revocation list")
+		}
+
+		return RefreshSessionOutput{}, errRefreshTokenReused
+	}
+
+	token, _, err := a.tokenLogic.GetToken(
+		ctx, existingAccount.ID, sessionID, a.twoFactorRequirement.getAccountRoleList(existingAccount))
+	if err != nil {
+		return RefreshSessionOutput{}, err
+	}
+
+	return RefreshSessionOutput{
+		Account:      databaseAccountToProtoAccount(existingAccount),
+		Token:        token,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+func (a account) DeleteSession(ctx context.Context, params DeleteSessionParams) error {
+	principal, err := getPrincipal(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = a.sessionDataAccessor.UpdateSessionListRevoked(ctx, []uint64{principal.SessionID}, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return a.tokenLogic.RevokeSessionList(ctx, []uint64{principal.SessionID})
+}
+
+func (a account) DeleteAllSessions(
+	ctx context.Context,
+	params DeleteAllSessionsParams,
+) (DeleteAllSessionsOutput, error) {
+	principal, err := getPrincipal(ctx)
+	if err != nil {
+		return DeleteAllSessionsOutput{}, err
+	}
+
+	var sessionIDList []uint64
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		var revokeErr error
+		sessionIDList, revokeErr = revokeActiveSessionListOfAccount(
+			ctx, a.sessionDataAccessor.WithDatabase(td), principal.AccountID, time.Now())
+		return revokeErr
+	})
+	if txErr != nil {
+		return DeleteAllSessionsOutput{}, txErr
+	}
+
+	if err = a.tokenLogic.RevokeSessionList(ctx, sessionIDList); err != nil {
+		return DeleteAllSessionsOutput{}, err
+	}
+
+	return DeleteAllSessionsOutput{
+		RevokedSessionCount: uint64(len(sessionIDList)),
+	}, nil
+}
@@ -0,0 +1,150 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"goload/internal/dataaccess/database"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+func TestRefreshSession(t *testing.T) {
+	const (
+		presentCurrentToken  = "current"
+		presentPreviousToken = "previous"
+		presentWrongSecret   = "wrong secret"
+		presentMalformed     = "malformed"
+	)
+
+	testCaseList := []struct {
+		name string
+		// rotated refreshes the session once before, so that it has a previous refresh token
+		rotated              bool
+		refreshedAgo         time.Duration
+		revoked              bool
+		expired              bool
+		accountDisabled      bool
+		presentedToken       string
+		expectedErr          error
+		expectRotation       bool
+		expectSessionRevoked bool
+	}{
+		{name: "current token is rotated", presentedToken: presentCurrentToken, expectRotation: true},
+		{
+			name: "current token after a rotation is rotated", rotated: true, presentedToken: presentCurrentToken,
+			expectRotation: true,
+		},
+		{
+			name: "previous token within the grace period only gets an access token", rotated: true,
+			presentedToken: presentPreviousToken,
+		},
+		{
+			name: "previous token after the grace period revokes the session", rotated: true,
+			refreshedAgo: refreshTokenRotationGracePeriod, presentedToken: presentPreviousToken,
+			expectedErr: errRefreshTokenReused, expectSessionRevoked: true,
+		},
+		{
+			name: "unknown secret revokes the session", presentedToken: presentWrongSecret,
+			expectedErr: errRefreshTokenReused, expectSessionRevoked: true,
+		},
+		{name: "malformed token", presentedToken: presentMalformed, expectedErr: errInvalidRefreshToken},
+		{
+			name: "revoked session", revoked: true, presentedToken: presentCurrentToken,
+			expectedErr: errInvalidRefreshToken, expectSessionRevoked: true,
+		},
+		{
+			name: "expired session", expired: true, presentedToken: presentCurrentToken,
+			expectedErr: errInvalidRefreshToken,
+		},
+		{
+			name: "disabled account", accountDisabled: true, presentedToken: presentCurrentToken,
+			expectedErr: errAccountDisabled,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := newMockStore()
+			store.accountList = []database.Account{{ID: 1, AccountName: "alice123", Role: string(RoleUser)}}
+			a := account{
+				goquDatabase:          newMockGoquDatabase(),
+				accountDataAccessor:   mockAccountDataAccessor{store: store},
+				sessionDataAccessor:   mockSessionDataAccessor{store: store},
+				tokenLogic:            mockTokenLogic{store: store},
+				refreshTokenExpiresIn: time.Hour,
+				logger:                zap.NewNop(),
+			}
+
+			sessionID, refreshToken, err := createSession(ctx, a.goquDatabase, a.sessionDataAccessor, 1, time.Hour)
+			if err != nil {
+				t.Fatalf("failed to create session: %v", err)
+			}
+
+			previousRefreshToken := ""
+			if testCase.rotated {
+				output, refreshErr := a.RefreshSession(ctx, RefreshSessionParams{RefreshToken: refreshToken})
+				if refreshErr != nil {
+					t.Fatalf("failed to refresh session: %v", refreshErr)
+				}
+
+				previousRefreshToken, refreshToken = refreshToken, output.RefreshToken
+			}
+
+			session := &store.sessionList[sessionID-1]
+			session.RefreshedAt = session.RefreshedAt.Add(-testCase.refreshedAgo)
+			if testCase.revoked {
+				session.RevokedAt = sql.NullTime{Time: time.Now(), Valid: true}
+			}
+
+			if testCase.expired {
+				session.ExpiresAt = time.Now()
+			}
+
+			store.accountList[0].DisabledAt = sql.NullTime{Time: time.Now(), Valid: testCase.accountDisabled}
+
+			presentedToken := map[string]string{
+				presentCurrentToken:  refreshToken,
+				presentPreviousToken: previousRefreshToken,
+				presentWrongSecret:   "1.0000",
+				presentMalformed:     "not a refresh token",
+			}[testCase.presentedToken]
+
+			output, err := a.RefreshSession(ctx, RefreshSessionParams{RefreshToken: presentedToken})
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("expected error %v, got %v", testCase.expectedErr, err)
+			}
+
+			if err == nil && output.Token == "" {
+				t.Fatal("expected an access token")
+			}
+
+			if rotated := output.RefreshToken != ""; rotated != testCase.expectRotation ||
+				(rotated && (output.RefreshToken == refreshToken || output.RefreshToken == previousRefreshToken)) {
+				t.Fatalf("expected rotation %t, got refresh token %q", testCase.expectRotation, output.RefreshToken)
+			}
+
+			if store.sessionList[sessionID-1].RevokedAt.Valid != testCase.expectSessionRevoked {
+				t.Fatalf("expected session revoked %t", testCase.expectSessionRevoked)
+			}
+
+			if !testCase.expectSessionRevoked {
+				return
+			}
+
+			if errors.Is(err, errRefreshTokenReused) && !lo.Contains(store.revokedSessionList, sessionID) {
+				t.Fatal("expected the reused session to be added to the revocation list")
+			}
+
+			// once a reuse is detected, the legitimate holder of the session is logged out as well
+			if _, err = a.RefreshSession(ctx, RefreshSessionParams{RefreshToken: refreshToken}); !errors.Is(
+				err, errInvalidRefreshToken) {
+				t.Fatalf("expected the current refresh token to be refused, got %v", err)
+			}
+		})
+	}
+}
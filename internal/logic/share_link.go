@@ -0,0 +1,446 @@
+package logic
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/cache"
+	"goload/internal/dataaccess/database"
+	"goload/internal/dataaccess/file"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/utils"
+	"io"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	ShareLinkPathPrefix          = "/share/"
+	minShareLinkSigningKeyLength = 32
+	maxShareLinkCountPerAccount  = 100
+	// shareLinkPasswordThrottleNamespace keeps failed share link passwords apart from failed logins.
+	shareLinkPasswordThrottleNamespace = "share_link"
+)
+
+var (
+	errShareLinkNotConfigured = status.Error(codes.FailedPrecondition, "share links are not configured on this service")
+	// errInvalidShareLink is returned alike for a forged, unknown, revoked, expired or used up link, so that the
+	// response does not tell which links exist.
+	errInvalidShareLink           = status.Error(codes.NotFound, "share link not found or no longer valid")
+	errIncorrectShareLinkPassword = status.Error(codes.Unauthenticated, "incorrect share link password")
+	errPartialShareLinkDownload   = status.Error(
+		codes.FailedPrecondition, "a share link with a download limit can only be downloaded from the first byte")
+)
+
+type CreateShareLinkParams struct {
+	DownloadTaskID uint64
+	// ExpiresAt defaults to default_expires_in from now when nil.
+	ExpiresAt *time.Time
+	// Password is empty for a link without password.
+	Password string
+	// MaxDownloadCount is 0 for a link that can be downloaded any number of times.
+	MaxDownloadCount uint64
+}
+
+type CreateShareLinkOutput struct {
+	ShareLink *go_load.ShareLink
+}
+
+type ListShareLinksParams struct{}
+
+type ListShareLinksOutput struct {
+	ShareLinkList []*go_load.ShareLink
+}
+
+type RevokeShareLinkParams struct {
+	ShareLinkID uint64
+}
+
+type OpenShareLinkParams struct {
+	ShareLinkID uint64
+	ExpiresAt   int64
+	Signature   string
+	Password    string
+	// ClientIP is empty when it is not known, failed passwords are then only counted per link.
+	ClientIP string
+	// CountDownload uses up one of the downloads of the link, it is not set for requests that only want the
+	// headers.
+	CountDownload bool
+	// IsPartialDownload is set for a range that does not start at the first byte. Such a range is refused by a
+	// link with a download limit, since fetching the file in parts would otherwise never use up a download, and
+	// is not counted by a link without one.
+	IsPartialDownload bool
+}
+
+type OpenShareLinkOutput struct {
+	File     io.ReadSeekCloser
+	FileName string
+	ModTime  time.Time
+}
+
+type ShareLink interface {
+	IsEnabled() bool
+	CreateShareLink(ctx context.Context, params CreateShareLinkParams) (CreateShareLinkOutput, error)
+	ListShareLinks(ctx context.Context, params ListShareLinksParams) (ListShareLinksOutput, error)
+	RevokeShareLink(ctx context.Context, params RevokeShareLinkParams) error
+	// OpenShareLink validates a link for someone who is not logged in and opens the file it shares.
+	OpenShareLink(ctx context.Context, params OpenShareLinkParams) (OpenShareLinkOutput, error)
+}
+
+type shareLink struct {
+	shareLinkDataAccessor       database.ShareLinkDataAccessor
+	downloadTaskDataAccessor    database.DownloadTaskDataAccessor
+	workspaceMemberDataAccessor database.WorkspaceMemberDataAccessor
+	fileClient                  file.Client
+	hashLogic                   Hash
+	passwordThrottle            loginThrottle
+	shareLinkConfig             configs.ShareLink
+	signingKey                  []byte
+	defaultExpiresIn            time.Duration
+	maxExpiresIn                time.Duration
+	logger                      *zap.Logger
+}
+
+func NewShareLink(
+	shareLinkDataAccessor database.ShareLinkDataAccessor,
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
+	workspaceMemberDataAccessor database.WorkspaceMemberDataAccessor,
+	fileClient file.Client,
+	hashLogic Hash,
+	failedLoginAttemptCache cache.FailedLoginAttempt,
+	authConfig configs.Auth,
+	shareLinkConfig configs.ShareLink,
+	logger *zap.Logger,
+) (ShareLink, error) {
+	passwordThrottle, err := newLoginThrottle(
+		failedLoginAttemptCache.WithNamespace(shareLinkPasswordThrottleNamespace), authConfig.LoginThrottle, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &shareLink{
+		shareLinkDataAccessor:       shareLinkDataAccessor,
+		downloadTaskDataAccessor:    downloadTaskDataAccessor,
+		workspaceMemberDataAccessor: workspaceMemberDataAccessor,
+		fileClient:                  fileClient,
+		hashLogic:                   hashLogic,
+		passwordThrottle:            passwordThrottle,
+		shareLinkConfig:             shareLinkConfig,
+		logger:                      logger,
+	}
+	if shareLinkConfig.SigningKey == "" {
+		return s, nil
+	}
+
+	s.signingKey, err = base64.StdEncoding.DecodeString(shareLinkConfig.SigningKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to decode share link signing key")
+		return nil, err
+	}
+
+	if len(s.signingKey) < minShareLinkSigningKeyLength {
+		err = fmt.Errorf("share link signing key must be at least %d bytes long", minShareLinkSigningKeyLength)
+		logger.With(zap.Error(err)).Error("invalid share link signing key")
+		return nil, err
+	}
+
+	s.defaultExpiresIn, err = shareLinkConfig.GetDefaultExpiresInDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse default_expires_in: " + shareLinkConfig.DefaultExpiresIn)
+		return nil, err
+	}
+
+	s.maxExpiresIn, err = shareLinkConfig.GetMaxExpiresInDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse max_expires_in: " + shareLinkConfig.MaxExpiresIn)
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s shareLink) IsEnabled() bool {
+	return len(s.signingKey) > 0
+}
+
+// getSignature signs the ID and the expiry of a link, so that a URL cannot be guessed or have its expiry extended.
+func (s shareLink) getSignature(shareLinkID uint64, expiresAt int64) []byte {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(fmt.Sprintf("%d.%d", shareLinkID, expiresAt)))
+	return mac.Sum(nil)
+}
+
+func (s shareLink) getURL(link database.ShareLink) string {
+	expiresAt := link.ExpiresAt.Unix()
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expiresAt, 10))
+	query.Set("signature", base64.RawURLEncoding.EncodeToString(s.getSignature(link.ID, expiresAt)))
+
+	return fmt.Sprintf(
+		"%s%s%d?%s",
+		strings.TrimSuffix(s.shareLinkConfig.BaseURL, "/"),
+		ShareLinkPathPrefix,
+		link.ID,
+		query.Encode(),
+	)
+}
+
+func (s shareLink) databaseShareLinkToProtoShareLink(link database.ShareLink) *go_load.ShareLink {
+	return &go_load.ShareLink{
+		Id:               link.ID,
+		DownloadTaskId:   link.OfDownloadTaskID,
+		Url:              s.getURL(link),
+		HasPassword:      link.PasswordHash != "",
+		MaxDownloadCount: uint64(link.MaxDownloadCount.Int64),
+		DownloadCount:    link.DownloadCount,
+		ExpiresAt:        timestamppb.New(link.ExpiresAt),
+		CreatedAt:        timestamppb.New(link.CreatedAt),
+		RevokedAt:        nullTimeToProtoTimestamp(link.RevokedAt),
+	}
+}
+
+func (s shareLink) CreateShareLink(ctx context.Context, params CreateShareLinkParams) (CreateShareLinkOutput, error) {
+	if !s.IsEnabled() {
+		return CreateShareLinkOutput{}, errShareLinkNotConfigured
+	}
+
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return CreateShareLinkOutput{}, err
+	}
+
+	// the expiry is signed in seconds, and the database keeps no more than that
+	now := time.Now().Truncate(time.Second)
+	expiresAt := now.Add(s.defaultExpiresIn)
+	if params.ExpiresAt != nil {
+		expiresAt = params.ExpiresAt.Truncate(time.Second)
+	}
+
+	if !expiresAt.After(now) {
+		return CreateShareLinkOutput{}, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+	}
+
+	if expiresAt.Sub(now) > s.maxExpiresIn {
+		return CreateShareLinkOutput{}, status.Errorf(
+			codes.InvalidArgument, "expires_at must be at most %s from now", s.maxExpiresIn)
+	}
+
+	task, err := s.downloadTaskDataAccessor.GetDownloadTask(ctx, params.DownloadTaskID)
+	if err != nil {
+		return CreateShareLinkOutput{}, err
+	}
+
+	err = checkDownloadTaskRole(ctx, s.workspaceMemberDataAccessor, task, accountID, WorkspaceRoleEditor)
+	if err != nil {
+		return CreateShareLinkOutput{}, err
+	}
+
+	if task.DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS {
+		return CreateShareLinkOutput{}, status.Error(
+			codes.FailedPrecondition, "only the file of a successful download task can be shared")
+	}
+
+	shareLinkList, err := s.shareLinkDataAccessor.GetActiveShareLinkListOfAccount(ctx, accountID, now)
+	if err != nil {
+		return CreateShareLinkOutput{}, err
+	}
+
+	if len(shareLinkList) >= maxShareLinkCountPerAccount {
+		return CreateShareLinkOutput{}, status.Errorf(
+			codes.ResourceExhausted, "an account can have at most %d active share links", maxShareLinkCountPerAccount)
+	}
+
+	newShareLink := database.ShareLink{
+		OfAccountID:      accountID,
+		OfDownloadTaskID: task.ID,
+		ExpiresAt:        expiresAt,
+		CreatedAt:        now,
+	}
+	if params.MaxDownloadCount > 0 {
+		newShareLink.MaxDownloadCount = sql.NullInt64{Int64: int64(params.MaxDownloadCount), Valid: true}
+	}
+
+	if params.Password != "" {
+		newShareLink.PasswordHash, err = s.hashLogic.Hash(ctx, params.Password)
+		if err != nil {
+			return CreateShareLinkOutput{}, err
+		}
+	}
+
+	newShareLink.ID, err = s.shareLinkDataAccessor.CreateShareLink(ctx, newShareLink)
+	if err != nil {
+		return CreateShareLinkOutput{}, err
+	}
+
+	return CreateShareLinkOutput{
+		ShareLink: s.databaseShareLinkToProtoShareLink(newShareLink),
+	}, nil
+}
+
+func (s shareLink) ListShareLinks(ctx context.Context, _ ListShareLinksParams) (ListShareLinksOutput, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return ListShareLinksOutput{}, err
+	}
+
+	shareLinkList, err := s.shareLinkDataAccessor.GetActiveShareLinkListOfAccount(ctx, accountID, time.Now())
+	if err != nil {
+		return ListShareLinksOutput{}, err
+	}
+
+	protoShareLinkList := make([]*go_load.ShareLink, 0, len(shareLinkList))
+	for _, link := range shareLinkList {
+		protoShareLinkList = append(protoShareLinkList, s.databaseShareLinkToProtoShareLink(link))
+	}
+
+	return ListShareLinksOutput{
+		ShareLinkList: protoShareLinkList,
+	}, nil
+}
+
+func (s shareLink) RevokeShareLink(ctx context.Context, params RevokeShareLinkParams) error {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return err
+	}
+
+	existingShareLink, err := s.shareLinkDataAccessor.GetShareLink(ctx, params.ShareLinkID)
+	if err != nil {
+		return err
+	}
+
+	if existingShareLink.OfAccountID != accountID {
+		return status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	if existingShareLink.RevokedAt.Valid {
+		return nil
+	}
+
+	return s.shareLinkDataAccessor.UpdateShareLinkRevokedAt(ctx, existingShareLink.ID, time.Now())
+}
+
+func (s shareLink) OpenShareLink(ctx context.Context, params OpenShareLinkParams) (OpenShareLinkOutput, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("share_link_id", params.ShareLinkID))
+
+	if !s.IsEnabled() {
+		return OpenShareLinkOutput{}, errInvalidShareLink
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(params.Signature)
+	if err != nil || !hmac.Equal(signature, s.getSignature(params.ShareLinkID, params.ExpiresAt)) {
+		return OpenShareLinkOutput{}, errInvalidShareLink
+	}
+
+	existingShareLink, err := s.shareLinkDataAccessor.GetShareLink(ctx, params.ShareLinkID)
+	if err != nil {
+		if errors.Is(err, database.ErrShareLinkNotFound) {
+			return OpenShareLinkOutput{}, errInvalidShareLink
+		}
+
+		return OpenShareLinkOutput{}, err
+	}
+
+	if existingShareLink.RevokedAt.Valid ||
+		existingShareLink.ExpiresAt.Unix() != params.ExpiresAt ||
+		!time.Now().Before(existingShareLink.ExpiresAt) {
+		return OpenShareLinkOutput{}, errInvalidShareLink
+	}
+
+	if existingShareLink.PasswordHash != "" {
+		throttleSubject := getShareLinkPasswordThrottleSubject(existingShareLink.ID)
+		if err = s.passwordThrottle.check(ctx, throttleSubject, params.ClientIP); err != nil {
+			return OpenShareLinkOutput{}, err
+		}
+
+		isHashEqual, hashErr := s.hashLogic.IsHashEqual(ctx, params.Password, existingShareLink.PasswordHash)
+		if hashErr != nil {
+			return OpenShareLinkOutput{}, hashErr
+		}
+
+		if !isHashEqual {
+			s.passwordThrottle.recordFailure(ctx, throttleSubject, params.ClientIP)
+			return OpenShareLinkOutput{}, errIncorrectShareLinkPassword
+		}
+
+		s.passwordThrottle.recordSuccess(ctx, throttleSubject)
+	}
+
+	task, err := s.downloadTaskDataAccessor.GetDownloadTask(ctx, existingShareLink.OfDownloadTaskID)
+	if err != nil {
+		if errors.Is(err, database.ErrDownloadTaskNotFound) {
+			// the download task of the link was deleted
+			return OpenShareLinkOutput{}, errInvalidShareLink
+		}
+
+		return OpenShareLinkOutput{}, err
+	}
+
+	if task.DownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS {
+		return OpenShareLinkOutput{}, errInvalidShareLink
+	}
+
+	if params.IsPartialDownload && existingShareLink.MaxDownloadCount.Valid {
+		return OpenShareLinkOutput{}, errPartialShareLinkDownload
+	}
+
+	fileReader, fileInfo, err := s.fileClient.Open(ctx, getDownloadTaskFileName(task.ID))
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return OpenShareLinkOutput{}, errInvalidShareLink
+		}
+
+		return OpenShareLinkOutput{}, err
+	}
+
+	// counted last, so that a request that fails does not use up a download
+	if params.CountDownload && !params.IsPartialDownload {
+		counted, countErr := s.shareLinkDataAccessor.IncrementShareLinkDownloadCount(ctx, existingShareLink.ID)
+		if countErr != nil || !counted {
+			// the count error is the one worth returning
+			_ = fileReader.Close()
+			if countErr != nil {
+				return OpenShareLinkOutput{}, countErr
+			}
+
+			return OpenShareLinkOutput{}, errInvalidShareLink
+		}
+
+		logger.Info("downloading file of share link")
+	}
+
+	return OpenShareLinkOutput{
+		File:     fileReader,
+		FileName: getDownloadTaskSharedFileName(task),
+		ModTime:  fileInfo.ModTime,
+	}, nil
+}
+
+// getShareLinkPasswordThrottleSubject is counted like an account name by the password throttle of share links.
+func getShareLinkPasswordThrottleSubject(shareLinkID uint64) string {
+	return strconv.FormatUint(shareLinkID, 10)
+}
+
+// getDownloadTaskSharedFileName names the file after the last segment of the URL it was downloaded from.
+func getDownloadTaskSharedFileName(task database.DownloadTask) string {
+	if parsedURL, err := url.Parse(task.URL); err == nil {
+		if fileName := path.Base(parsedURL.Path); fileName != "." && fileName != "/" {
+			return fileName
+		}
+	}
+
+	return getDownloadTaskFileName(task.ID)
+}
@@ -0,0 +1,176 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/database"
+	"goload/internal/dataaccess/file"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"io"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const testShareLinkPassword = "open sesame"
+
+// newTestShareLink returns share link logic sharing the file of a successful download task.
+func newTestShareLink(t *testing.T, store *mockStore) shareLink {
+	t.Helper()
+
+	fileClient := file.NewLocalFileClient(zap.NewNop(), configs.DownloadConfig{DownloadDir: t.TempDir()})
+	writer, err := fileClient.Write(context.Background(), getDownloadTaskFileName(1))
+	if err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	if _, err = io.WriteString(writer, "content"); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatalf("failed to close file: %v", err)
+	}
+
+	store.downloadTaskList = []database.DownloadTask{{
+		ID:             1,
+		OfAccountID:    1,
+		URL:            "https://example.com/files/report.pdf",
+		DownloadStatus: go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS,
+	}}
+
+	return shareLink{
+		shareLinkDataAccessor:    mockShareLinkDataAccessor{store: store},
+		downloadTaskDataAccessor: mockDownloadTaskDataAccessor{store: store},
+		fileClient:               fileClient,
+		hashLogic:                mockHash{},
+		passwordThrottle:         newTestLoginThrottle(t, 3),
+		signingKey:               []byte("0123456789abcdef0123456789abcdef"),
+		logger:                   zap.NewNop(),
+	}
+}
+
+func TestOpenShareLink(t *testing.T) {
+	testCaseList := []struct {
+		name             string
+		hasPassword      bool
+		maxDownloadCount int64
+		expired          bool
+		revoked          bool
+		forgeSignature   bool
+		extendExpiry     bool
+		password         string
+		headOnly         bool
+		isPartial        bool
+		// openCount opens the link that many times, only the error of the last one is checked
+		openCount             int
+		expectedErr           error
+		expectedDownloadCount uint64
+	}{
+		{name: "valid link", openCount: 1, expectedDownloadCount: 1},
+		{name: "forged signature", forgeSignature: true, openCount: 1, expectedErr: errInvalidShareLink},
+		{name: "extended expiry", extendExpiry: true, openCount: 1, expectedErr: errInvalidShareLink},
+		{name: "expired link", expired: true, openCount: 1, expectedErr: errInvalidShareLink},
+		{name: "revoked link", revoked: true, openCount: 1, expectedErr: errInvalidShareLink},
+		{
+			name: "missing password", hasPassword: true, openCount: 1,
+			expectedErr: errIncorrectShareLinkPassword,
+		},
+		{
+			name: "correct password", hasPassword: true, password: testShareLinkPassword, openCount: 1,
+			expectedDownloadCount: 1,
+		},
+		{
+			name: "wrong passwords are throttled", hasPassword: true, password: "wrong", openCount: 4,
+			expectedErr: errTooManyFailedLoginAttempts,
+		},
+		{
+			name: "download limit used up", maxDownloadCount: 2, openCount: 3, expectedErr: errInvalidShareLink,
+			expectedDownloadCount: 2,
+		},
+		{name: "head requests are not counted", maxDownloadCount: 1, headOnly: true, openCount: 3},
+		{
+			name: "partial download of a limited link", maxDownloadCount: 1, isPartial: true, openCount: 1,
+			expectedErr: errPartialShareLinkDownload,
+		},
+		{name: "partial downloads of an unlimited link are not counted", isPartial: true, openCount: 3},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			store := newMockStore()
+			s := newTestShareLink(t, store)
+
+			link := database.ShareLink{
+				ID:               1,
+				OfAccountID:      1,
+				OfDownloadTaskID: 1,
+				ExpiresAt:        time.Now().Add(time.Hour).Truncate(time.Second),
+				CreatedAt:        time.Now(),
+			}
+			if testCase.hasPassword {
+				link.PasswordHash = "hashed:" + testShareLinkPassword
+			}
+
+			if testCase.maxDownloadCount > 0 {
+				link.MaxDownloadCount = sql.NullInt64{Int64: testCase.maxDownloadCount, Valid: true}
+			}
+
+			if testCase.revoked {
+				link.RevokedAt = sql.NullTime{Time: time.Now(), Valid: true}
+			}
+
+			expiresAt := link.ExpiresAt.Unix()
+			signature := s.getSignature(link.ID, expiresAt)
+			if testCase.expired {
+				// the link is signed with its expiry, so only the stored expiry is moved
+				link.ExpiresAt = time.Now().Add(-time.Second).Truncate(time.Second)
+				expiresAt = link.ExpiresAt.Unix()
+				signature = s.getSignature(link.ID, expiresAt)
+			}
+
+			if testCase.forgeSignature {
+				signature = s.getSignature(link.ID+1, expiresAt)
+			}
+
+			if testCase.extendExpiry {
+				expiresAt += 3600
+			}
+
+			store.shareLinkList = []database.ShareLink{link}
+
+			var err error
+			for i := 0; i < testCase.openCount; i++ {
+				var output OpenShareLinkOutput
+				output, err = s.OpenShareLink(context.Background(), OpenShareLinkParams{
+					ShareLinkID:       link.ID,
+					ExpiresAt:         expiresAt,
+					Signature:         base64.RawURLEncoding.EncodeToString(signature),
+					Password:          testCase.password,
+					ClientIP:          "192.0.2.1",
+					CountDownload:     !testCase.headOnly,
+					IsPartialDownload: testCase.isPartial,
+				})
+				if err == nil {
+					if output.FileName != "report.pdf" {
+						t.Fatalf("expected file name report.pdf, got %s", output.FileName)
+					}
+
+					_ = output.File.Close()
+				}
+			}
+
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("expected error %v, got %v", testCase.expectedErr, err)
+			}
+
+			if downloadCount := store.shareLinkList[0].DownloadCount; downloadCount != testCase.expectedDownloadCount {
+				t.Fatalf("expected download count %d, got %d", testCase.expectedDownloadCount, downloadCount)
+			}
+		})
+	}
+}
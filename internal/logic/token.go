@@ -2,12 +2,14 @@ package logic
 
 import (
 	"context"
+	"crypto/cipher"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"database/sql"
 	"encoding/pem"
 	"errors"
+	"github.com/doug-martin/goqu/v9"
 	"github.com/golang-jwt/jwt"
 	"go.uber.org/zap"
 	"goload/internal/configs"
@@ -16,11 +18,14 @@ import (
 	"goload/internal/utils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"strconv"
 	"time"
 )
 
 const (
 	rs512KeyPairCount = 2048
+	// tokenPublicKeyCacheTTL bounds how long a cached key of a later retired key can keep verifying tokens.
+	tokenPublicKeyCacheTTL = time.Hour
 )
 
 var (
@@ -29,14 +34,35 @@ var (
 	errCannotGetTokenKidClaim  = status.Errorf(codes.Unauthenticated, "cannot get token's kid claims")
 	errCannotGetTokenSubClaim  = status.Errorf(codes.Unauthenticated, "cannot get token's sub claims")
 	errCannotGetTokenExpClaim  = status.Errorf(codes.Unauthenticated, "cannot get token's exp claims")
+	errCannotGetTokenSidClaim  = status.Errorf(codes.Unauthenticated, "cannot get token's sid claims")
+	errSessionRevoked          = status.Errorf(codes.Unauthenticated, "session has been revoked")
 	errTokenPublicKeyNotFound  = status.Errorf(codes.Unauthenticated, "token public key not found")
 	errInvalidToken            = status.Errorf(codes.Unauthenticated, "invalid token")
 	errFailedToSignToken       = status.Errorf(codes.Internal, "failed to sign token")
 )
 
+type TokenClaims struct {
+	AccountID  uint64
+	SessionID  uint64
+	TokenID    string
+	RoleList   []Role
+	ExpireTime time.Time
+}
+
 type Token interface {
-	GetToken(ctx context.Context, accountID uint64) (string, time.Time, error)
+	GetToken(ctx context.Context, accountID uint64, sessionID uint64, roleList []Role) (string, time.Time, error)
+	// GetTokenClaims validates the token and rejects it if its session has been revoked.
+	GetTokenClaims(ctx context.Context, token string) (TokenClaims, error)
 	GetAccountIDAndExpireTime(ctx context.Context, token string) (uint64, time.Time, error)
+	// Authenticate validates the token and returns the principal it was issued to.
+	Authenticate(ctx context.Context, token string) (Principal, error)
+	// RevokeSessionList makes access tokens of the sessions invalid right away, instead of when they expire.
+	RevokeSessionList(ctx context.Context, sessionIDList []uint64) error
+	// RotateSigningKey picks up the signing key rotated by other replicas, rotates it when it is due and deletes
+	// the keys retired for longer than the overlap window.
+	RotateSigningKey(ctx context.Context) error
+	// GetJSONWebKeySet returns the public keys that tokens can currently be verified with.
+	GetJSONWebKeySet(ctx context.Context) (JSONWebKeySet, error)
 	WithDatabase(database database.Database) Token
 }
 
@@ -53,9 +79,15 @@ type token struct {
 	accountDataAccessor        database.AccountDataAccessor
 	tokenPublicKeyCache        cache.TokenPublicKey
 	tokenPublicKeyDataAccessor database.TokenPublicKeyDataAccessor
+	revokedSessionCache        cache.RevokedSession
+	sessionDataAccessor        database.SessionDataAccessor
+	goquDatabase               *goqu.Database
 	expiresIn                  time.Duration
-	privateKey                 *rsa.PrivateKey
-	tokenPublicKeyID           uint64
+	activeSessionCacheTTL      time.Duration
+	rotationInterval           time.Duration
+	rotationOverlap            time.Duration
+	signingKeyAEAD             cipher.AEAD
+	signingKey                 *tokenSigningKey
 	authConfig                 configs.Auth
 	logger                     *zap.Logger
 }
@@ -77,6 +109,9 @@ func pemEncodePublicKey(key *rsa.PublicKey) ([]byte, error) {
 func NewToken(accountDataAccessor database.AccountDataAccessor,
 	tokenPublicKey cache.TokenPublicKey,
 	tokenDataAccessor database.TokenPublicKeyDataAccessor,
+	revokedSessionCache cache.RevokedSession,
+	sessionDataAccessor database.SessionDataAccessor,
+	goquDatabase *goqu.Database,
 	auth configs.Auth,
 	logger *zap.Logger) (Token, error) {
 	expiresIn, err := auth.Token.GetExpiresInDuration()
@@ -85,51 +120,107 @@ func NewToken(accountDataAccessor database.AccountDataAccessor,
 		return nil, err
 	}
 
-	rsaKeyPair, err := generateRSAKeyPair(rs512KeyPairCount)
+	activeSessionCacheTTL, err := auth.Token.GetActiveSessionCacheTTLDuration()
 	if err != nil {
-		logger.With(zap.Error(err)).Error("Failed to generate rsa key pair")
+		logger.With(zap.Error(err)).Error("failed to parse active_session_cache_ttl: " + auth.Token.ActiveSessionCacheTTL)
 		return nil, err
 	}
 
-	publicKeyBytes, err := pemEncodePublicKey(&rsaKeyPair.PublicKey)
+	signingKeyConfig := auth.Token.SigningKey
+	rotationInterval, err := signingKeyConfig.GetRotationIntervalDuration()
 	if err != nil {
-		logger.With(zap.Error(err)).Error("Failed to encode public key in pem format")
+		logger.With(zap.Error(err)).Error("failed to parse rotation_interval: " + signingKeyConfig.RotationInterval)
 		return nil, err
 	}
 
-	tokenPublicKeyID, err := tokenDataAccessor.CreatePublicKey(
-		context.Background(),
-		database.TokenPublicKey{PublicKey: string(publicKeyBytes)},
-	)
-
+	rotationOverlap, err := signingKeyConfig.GetRotationOverlapDuration()
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to create public key entry in database")
+		logger.With(zap.Error(err)).Error("failed to parse rotation_overlap: " + signingKeyConfig.RotationOverlap)
 		return nil, err
 	}
 
-	return &token{
+	// tokens signed right before a rotation must stay verifiable until they expire
+	if rotationOverlap < expiresIn {
+		logger.Error("rotation_overlap must not be shorter than expires_in")
+		return nil, errors.New("rotation_overlap must not be shorter than expires_in")
+	}
+
+	t := &token{
 		accountDataAccessor:        accountDataAccessor,
 		tokenPublicKeyCache:        tokenPublicKey,
 		tokenPublicKeyDataAccessor: tokenDataAccessor,
+		revokedSessionCache:        revokedSessionCache,
+		sessionDataAccessor:        sessionDataAccessor,
+		goquDatabase:               goquDatabase,
 		expiresIn:                  expiresIn,
-		privateKey:                 rsaKeyPair,
-		tokenPublicKeyID:           tokenPublicKeyID,
+		activeSessionCacheTTL:      activeSessionCacheTTL,
+		rotationInterval:           rotationInterval,
+		rotationOverlap:            rotationOverlap,
+		signingKey:                 &tokenSigningKey{},
 		authConfig:                 auth,
 		logger:                     logger,
-	}, nil
+	}
+
+	switch signingKeyConfig.Source {
+	case configs.SigningKeySourceDatabase:
+		t.signingKeyAEAD, err = parseEncryptionKey(signingKeyConfig.EncryptionKey)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to parse signing key encryption_key")
+			return nil, err
+		}
+
+		if err = t.loadDatabaseSigningKey(context.Background()); err != nil {
+			logger.With(zap.Error(err)).Error("failed to load signing key from database")
+			return nil, err
+		}
+
+	case configs.SigningKeySourceFile, configs.SigningKeySourceConfig:
+		privateKey, err := getTokenStaticSigningKey(signingKeyConfig)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to load signing key from " + string(signingKeyConfig.Source))
+			return nil, err
+		}
+
+		if err = t.registerStaticSigningKey(context.Background(), privateKey); err != nil {
+			logger.With(zap.Error(err)).Error("failed to register signing key in database")
+			return nil, err
+		}
+
+	default:
+		logger.Error("unsupported signing key source: " + string(signingKeyConfig.Source))
+		return nil, errors.New("unsupported signing key source")
+	}
+
+	return t, nil
 }
 
-func (t token) GetToken(ctx context.Context, accountID uint64) (string, time.Time, error) {
+func (t token) GetToken(
+	ctx context.Context,
+	accountID uint64,
+	sessionID uint64,
+	roleList []Role,
+) (string, time.Time, error) {
 	logger := utils.LoggerWithContext(ctx, t.logger)
 
+	tokenPublicKeyID, privateKey, err := t.signingKey.get()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("Failed to get signing key")
+		return "", time.Time{}, errFailedToSignToken
+	}
+
 	expireTime := time.Now().Add(t.expiresIn)
 	token := jwt.NewWithClaims(jwt.SigningMethodRS512, jwt.MapClaims{
-		"sub": accountID,
-		"exp": expireTime.Unix(),
-		"kid": t.tokenPublicKeyID,
+		"sub":   accountID,
+		"sid":   sessionID,
+		"roles": roleList,
+		"jti":   utils.NewID(),
+		"exp":   expireTime.Unix(),
+		"kid":   tokenPublicKeyID,
 	})
+	// the header kid lets verifiers look the key up in the JSON Web Key Set
+	token.Header["kid"] = strconv.FormatUint(tokenPublicKeyID, 10)
 
-	tokenString, err := token.SignedString(t.privateKey)
+	tokenString, err := token.SignedString(privateKey)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("Failed to sign token")
 		return "", time.Time{}, errFailedToSignToken
@@ -138,7 +229,7 @@ func (t token) GetToken(ctx context.Context, accountID uint64) (string, time.Tim
 	return tokenString, expireTime, nil
 }
 
-func (t token) GetAccountIDAndExpireTime(ctx context.Context, token string) (uint64, time.Time, error) {
+func (t token) GetTokenClaims(ctx context.Context, token string) (TokenClaims, error) {
 	logger := utils.LoggerWithContext(ctx, t.logger)
 
 	parsedToken, err := jwt.Parse(token, func(parsedToken *jwt.Token) (interface{}, error) {
@@ -164,33 +255,140 @@ func (t token) GetAccountIDAndExpireTime(ctx context.Context, token string) (uin
 
 	if err != nil {
 		logger.With(zap.Error(err)).Error("Failed to parse token")
-		return 0, time.Time{}, err
+		return TokenClaims{}, err
 	}
 
 	if !parsedToken.Valid {
 		logger.Error("invalid token")
-		return 0, time.Time{}, errInvalidToken
+		return TokenClaims{}, errInvalidToken
 	}
 
 	claims, ok := parsedToken.Claims.(jwt.MapClaims)
 	if !ok {
 		logger.Error("Cannot get token's claim")
-		return 0, time.Time{}, errCannotGetTokenClaim
+		return TokenClaims{}, errCannotGetTokenClaim
 	}
 
 	accountId, ok := claims["sub"].(float64)
 	if !ok {
 		logger.Error("cannot get token's sub claim")
-		return 0, time.Time{}, errCannotGetTokenSubClaim
+		return TokenClaims{}, errCannotGetTokenSubClaim
+	}
+
+	sessionID, ok := claims["sid"].(float64)
+	if !ok {
+		logger.Error("cannot get token's sid claim")
+		return TokenClaims{}, errCannotGetTokenSidClaim
 	}
 
 	expireTimeUnix, ok := claims["exp"].(float64)
 	if !ok {
 		logger.Error("Cannot get token's exp claim")
-		return 0, time.Time{}, errCannotGetTokenExpClaim
+		return TokenClaims{}, errCannotGetTokenExpClaim
+	}
+
+	tokenID, _ := claims["jti"].(string)
+
+	// tokens issued before roles were introduced have no roles claim
+	roleList := []Role{RoleUser}
+	if roleClaimList, ok := claims["roles"].([]any); ok && len(roleClaimList) > 0 {
+		roleList = make([]Role, 0, len(roleClaimList))
+		for _, roleClaim := range roleClaimList {
+			if role, ok := roleClaim.(string); ok {
+				roleList = append(roleList, Role(role))
+			}
+		}
+	}
+
+	sessionRevoked, err := t.isSessionRevoked(ctx, uint64(sessionID))
+	if err != nil {
+		return TokenClaims{}, err
+	}
+
+	if sessionRevoked {
+		logger.With(zap.Uint64("session_id", uint64(sessionID))).Warn("token of revoked session used")
+		return TokenClaims{}, errSessionRevoked
+	}
+
+	return TokenClaims{
+		AccountID:  uint64(accountId),
+		SessionID:  uint64(sessionID),
+		TokenID:    tokenID,
+		RoleList:   roleList,
+		ExpireTime: time.Unix(int64(expireTimeUnix), 0),
+	}, nil
+}
+
+func (t token) GetAccountIDAndExpireTime(ctx context.Context, token string) (uint64, time.Time, error) {
+	claims, err := t.GetTokenClaims(ctx, token)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	return claims.AccountID, claims.ExpireTime, nil
+}
+
+func (t token) Authenticate(ctx context.Context, token string) (Principal, error) {
+	claims, err := t.GetTokenClaims(ctx, token)
+	if err != nil {
+		return Principal{}, err
 	}
 
-	return uint64(accountId), time.Unix(int64(expireTimeUnix), 0), nil
+	return Principal{
+		AccountID: claims.AccountID,
+		SessionID: claims.SessionID,
+		TokenID:   claims.TokenID,
+		RoleList:  claims.RoleList,
+	}, nil
+}
+
+// isSessionRevoked checks the cache, and the sessions table when the cache does not know the session or is
+// unavailable. Every revocation is committed to the sessions table, so a revocation is noticed even by replicas
+// that do not share the cache, once the session's active entry expires.
+func (t token) isSessionRevoked(ctx context.Context, sessionID uint64) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("session_id", sessionID))
+
+	revoked, found, err := t.revokedSessionCache.Get(ctx, sessionID)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to check revocation list in cache, will fall back to database")
+	} else if found {
+		return revoked, nil
+	}
+
+	session, err := t.sessionDataAccessor.GetSession(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, database.ErrSessionNotFound) {
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	if session.RevokedAt.Valid {
+		if err = t.revokedSessionCache.Add(ctx, sessionID, t.expiresIn); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to add revoked session to cache")
+		}
+
+		return true, nil
+	}
+
+	if t.activeSessionCacheTTL > 0 {
+		if err = t.revokedSessionCache.AddActive(ctx, sessionID, t.activeSessionCacheTTL); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to add active session to cache")
+		}
+	}
+
+	return false, nil
+}
+
+func (t token) RevokeSessionList(ctx context.Context, sessionIDList []uint64) error {
+	for _, sessionID := range sessionIDList {
+		if err := t.revokedSessionCache.Add(ctx, sessionID, t.expiresIn); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (t token) getJWTPublicKey(ctx context.Context, id uint64) (*rsa.PublicKey, error) {
@@ -212,7 +410,18 @@ func (t token) getJWTPublicKey(ctx context.Context, id uint64) (*rsa.PublicKey,
 		return nil, err
 	}
 
-	err = t.tokenPublicKeyCache.Set(ctx, id, tokenPublicKey.PublicKey)
+	cacheTTL := tokenPublicKeyCacheTTL
+	if tokenPublicKey.RetiredAt.Valid {
+		cacheTTL = time.Until(tokenPublicKey.RetiredAt.Time.Add(t.rotationOverlap))
+		if cacheTTL <= 0 {
+			logger.Warn("token signed by a key retired for longer than the overlap window")
+			return nil, errTokenPublicKeyNotFound
+		}
+
+		cacheTTL = min(cacheTTL, tokenPublicKeyCacheTTL)
+	}
+
+	err = t.tokenPublicKeyCache.Set(ctx, id, tokenPublicKey.PublicKey, cacheTTL)
 	if err != nil {
 		logger.With(zap.Error(err)).Warn("failed to set public key into cache")
 	}
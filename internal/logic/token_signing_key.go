@@ -0,0 +1,330 @@
+package logic
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/golang-jwt/jwt"
+	"go.uber.org/zap"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/database"
+	"goload/internal/utils"
+)
+
+const (
+	encryptionKeyLength      = 32
+	jsonWebKeyTypeRSA        = "RSA"
+	jsonWebKeyUseSignature   = "sig"
+	jsonWebKeyAlgorithmRS512 = "RS512"
+)
+
+var (
+	errSigningKeyNotActive = errors.New("signing key is not loaded")
+)
+
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	N         string `json:"n"`
+	E         string `json:"e"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// tokenSigningKey is shared by all copies of token, so a rotation is seen by every caller right away.
+type tokenSigningKey struct {
+	mutex      sync.RWMutex
+	id         uint64
+	privateKey *rsa.PrivateKey
+}
+
+func (k *tokenSigningKey) get() (uint64, *rsa.PrivateKey, error) {
+	k.mutex.RLock()
+	defer k.mutex.RUnlock()
+
+	if k.privateKey == nil {
+		return 0, nil, errSigningKeyNotActive
+	}
+
+	return k.id, k.privateKey, nil
+}
+
+func (k *tokenSigningKey) getID() uint64 {
+	k.mutex.RLock()
+	defer k.mutex.RUnlock()
+
+	return k.id
+}
+
+func (k *tokenSigningKey) set(id uint64, privateKey *rsa.PrivateKey) {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	k.id = id
+	k.privateKey = privateKey
+}
+
+func parseEncryptionKey(encryptionKey string) (cipher.AEAD, error) {
+	keyBytes, err := base64.StdEncoding.DecodeString(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keyBytes) != encryptionKeyLength {
+		return nil, fmt.Errorf("encryption key must be %d bytes long", encryptionKeyLength)
+	}
+
+	block, err := aes.NewCipher(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func encryptTokenSigningKey(aead cipher.AEAD, privateKey *rsa.PrivateKey) (string, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	encrypted := aead.Seal(nonce, nonce, x509.MarshalPKCS1PrivateKey(privateKey), nil)
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+func decryptTokenSigningKey(aead cipher.AEAD, encryptedPrivateKey string) (*rsa.PrivateKey, error) {
+	encrypted, err := base64.StdEncoding.DecodeString(encryptedPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(encrypted) < aead.NonceSize() {
+		return nil, errors.New("encrypted private key is too short")
+	}
+
+	nonce, cipherText := encrypted[:aead.NonceSize()], encrypted[aead.NonceSize():]
+	privateKeyBytes, err := aead.Open(nil, nonce, cipherText, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return x509.ParsePKCS1PrivateKey(privateKeyBytes)
+}
+
+func getTokenStaticSigningKey(signingKeyConfig configs.SigningKey) (*rsa.PrivateKey, error) {
+	privateKeyPEM := []byte(signingKeyConfig.PrivateKey)
+	if signingKeyConfig.Source == configs.SigningKeySourceFile {
+		var err error
+		privateKeyPEM, err = os.ReadFile(signingKeyConfig.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(privateKeyPEM) == 0 {
+		return nil, errors.New("private key is empty")
+	}
+
+	return jwt.ParseRSAPrivateKeyFromPEM(privateKeyPEM)
+}
+
+func getJSONWebKey(tokenPublicKey database.TokenPublicKey) (JSONWebKey, error) {
+	publicKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(tokenPublicKey.PublicKey))
+	if err != nil {
+		return JSONWebKey{}, err
+	}
+
+	return JSONWebKey{
+		KeyType:   jsonWebKeyTypeRSA,
+		Use:       jsonWebKeyUseSignature,
+		Algorithm: jsonWebKeyAlgorithmRS512,
+		KeyID:     strconv.FormatUint(tokenPublicKey.ID, 10),
+		N:         base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+		E:         base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+	}, nil
+}
+
+// registerStaticSigningKey makes the key loaded from a file or the config the only active one. Replicas booted
+// with the same key share the same row.
+func (t token) registerStaticSigningKey(ctx context.Context, privateKey *rsa.PrivateKey) error {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	publicKeyBytes, err := pemEncodePublicKey(&privateKey.PublicKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to encode public key in pem format")
+		return err
+	}
+
+	var tokenPublicKeyID uint64
+	txErr := t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		tokenPublicKeyDataAccessor := t.tokenPublicKeyDataAccessor.WithDatabase(td)
+
+		tokenPublicKey, getErr := tokenPublicKeyDataAccessor.GetPublicKeyByPublicKey(ctx, string(publicKeyBytes))
+		if getErr != nil && !errors.Is(getErr, sql.ErrNoRows) {
+			return getErr
+		}
+
+		now := time.Now()
+		if getErr == nil && !tokenPublicKey.RetiredAt.Valid {
+			tokenPublicKeyID = tokenPublicKey.ID
+		} else {
+			// a key that was retired before is registered again, so it gets a fresh overlap window later on
+			tokenPublicKeyID, getErr = tokenPublicKeyDataAccessor.CreatePublicKey(ctx, database.TokenPublicKey{
+				PublicKey: string(publicKeyBytes),
+				CreatedAt: now,
+			})
+			if getErr != nil {
+				return getErr
+			}
+		}
+
+		return tokenPublicKeyDataAccessor.UpdateOtherPublicKeyListRetired(ctx, tokenPublicKeyID, now)
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	t.signingKey.set(tokenPublicKeyID, privateKey)
+	logger.With(zap.Uint64("kid", tokenPublicKeyID)).Info("loaded token signing key")
+	return nil
+}
+
+// loadDatabaseSigningKey loads the newest key from the database, and generates a new one when there is none or it
+// is due for rotation. The row lock makes sure only one replica rotates.
+func (t token) loadDatabaseSigningKey(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	var (
+		tokenPublicKeyID uint64
+		privateKey       *rsa.PrivateKey
+	)
+	txErr := t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		tokenPublicKeyDataAccessor := t.tokenPublicKeyDataAccessor.WithDatabase(td)
+
+		now := time.Now()
+		tokenPublicKey, err := tokenPublicKeyDataAccessor.GetLatestActivePublicKeyWithXLock(ctx)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		if err == nil &&
+			tokenPublicKey.EncryptedPrivateKey.Valid &&
+			now.Sub(tokenPublicKey.CreatedAt) < t.rotationInterval {
+			tokenPublicKeyID = tokenPublicKey.ID
+			if tokenPublicKeyID == t.signingKey.getID() {
+				return nil
+			}
+
+			privateKey, err = decryptTokenSigningKey(t.signingKeyAEAD, tokenPublicKey.EncryptedPrivateKey.String)
+			if err != nil {
+				logger.With(zap.Error(err)).Error("failed to decrypt token signing key")
+				return err
+			}
+
+			return nil
+		}
+
+		privateKey, err = generateRSAKeyPair(rs512KeyPairCount)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to generate rsa key pair")
+			return err
+		}
+
+		publicKeyBytes, err := pemEncodePublicKey(&privateKey.PublicKey)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to encode public key in pem format")
+			return err
+		}
+
+		encryptedPrivateKey, err := encryptTokenSigningKey(t.signingKeyAEAD, privateKey)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to encrypt token signing key")
+			return err
+		}
+
+		tokenPublicKeyID, err = tokenPublicKeyDataAccessor.CreatePublicKey(ctx, database.TokenPublicKey{
+			PublicKey:           string(publicKeyBytes),
+			EncryptedPrivateKey: sql.NullString{String: encryptedPrivateKey, Valid: true},
+			CreatedAt:           now,
+		})
+		if err != nil {
+			return err
+		}
+
+		logger.With(zap.Uint64("kid", tokenPublicKeyID)).Info("rotated token signing key")
+		return tokenPublicKeyDataAccessor.UpdateOtherPublicKeyListRetired(ctx, tokenPublicKeyID, now)
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	if privateKey != nil {
+		t.signingKey.set(tokenPublicKeyID, privateKey)
+	}
+
+	return nil
+}
+
+func (t token) RotateSigningKey(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	// keys from a file or the config are only rotated by redeploying with a new key
+	if t.authConfig.Token.SigningKey.Source == configs.SigningKeySourceDatabase {
+		if err := t.loadDatabaseSigningKey(ctx); err != nil {
+			return err
+		}
+	}
+
+	deletedCount, err := t.tokenPublicKeyDataAccessor.DeletePublicKeyListRetiredBefore(
+		ctx, time.Now().Add(-t.rotationOverlap))
+	if err != nil {
+		return err
+	}
+
+	if deletedCount > 0 {
+		logger.With(zap.Int64("deleted_count", deletedCount)).Info("deleted retired token public keys")
+	}
+
+	return nil
+}
+
+func (t token) GetJSONWebKeySet(ctx context.Context) (JSONWebKeySet, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	tokenPublicKeyList, err := t.tokenPublicKeyDataAccessor.GetPublicKeyListRetiredAfter(
+		ctx, time.Now().Add(-t.rotationOverlap))
+	if err != nil {
+		return JSONWebKeySet{}, err
+	}
+
+	jsonWebKeySet := JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(tokenPublicKeyList))}
+	for _, tokenPublicKey := range tokenPublicKeyList {
+		jsonWebKey, err := getJSONWebKey(tokenPublicKey)
+		if err != nil {
+			logger.With(zap.Error(err)).With(zap.Uint64("kid", tokenPublicKey.ID)).Error("failed to parse public key")
+			continue
+		}
+
+		jsonWebKeySet.Keys = append(jsonWebKeySet.Keys, jsonWebKey)
+	}
+
+	return jsonWebKeySet, nil
+}
@@ -0,0 +1,206 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/cache"
+	"goload/internal/dataaccess/database"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func newTestEncryptionKey(t *testing.T, length int) string {
+	t.Helper()
+
+	keyBytes := make([]byte, length)
+	if _, err := rand.Read(keyBytes); err != nil {
+		t.Fatalf("failed to generate encryption key: %v", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(keyBytes)
+}
+
+func TestParseEncryptionKey(t *testing.T) {
+	testCaseList := []struct {
+		name          string
+		encryptionKey string
+		expectedErr   bool
+	}{
+		{name: "32 bytes", encryptionKey: newTestEncryptionKey(t, encryptionKeyLength)},
+		{name: "16 bytes", encryptionKey: newTestEncryptionKey(t, 16), expectedErr: true},
+		{name: "empty", expectedErr: true},
+		{name: "not base64", encryptionKey: "not base64!", expectedErr: true},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			if _, err := parseEncryptionKey(testCase.encryptionKey); (err != nil) != testCase.expectedErr {
+				t.Fatalf("expected error %t, got %v", testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestDecryptTokenSigningKey(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, rs512KeyPairCount)
+	if err != nil {
+		t.Fatalf("failed to generate rsa key: %v", err)
+	}
+
+	aead, err := parseEncryptionKey(newTestEncryptionKey(t, encryptionKeyLength))
+	if err != nil {
+		t.Fatalf("failed to parse encryption key: %v", err)
+	}
+
+	otherAEAD, err := parseEncryptionKey(newTestEncryptionKey(t, encryptionKeyLength))
+	if err != nil {
+		t.Fatalf("failed to parse encryption key: %v", err)
+	}
+
+	encryptedPrivateKey, err := encryptTokenSigningKey(aead, privateKey)
+	if err != nil {
+		t.Fatalf("failed to encrypt private key: %v", err)
+	}
+
+	tamperedBytes, _ := base64.StdEncoding.DecodeString(encryptedPrivateKey)
+	tamperedBytes[len(tamperedBytes)-1] ^= 1
+
+	testCaseList := []struct {
+		name                string
+		otherEncryptionKey  bool
+		encryptedPrivateKey string
+		expectedErr         bool
+	}{
+		{name: "same encryption key", encryptedPrivateKey: encryptedPrivateKey},
+		{
+			name: "other encryption key", otherEncryptionKey: true, encryptedPrivateKey: encryptedPrivateKey,
+			expectedErr: true,
+		},
+		{
+			name:                "tampered private key",
+			encryptedPrivateKey: base64.StdEncoding.EncodeToString(tamperedBytes),
+			expectedErr:         true,
+		},
+		{name: "too short", encryptedPrivateKey: base64.StdEncoding.EncodeToString([]byte("short")), expectedErr: true},
+		{name: "not base64", encryptedPrivateKey: "not base64!", expectedErr: true},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			decryptAEAD := aead
+			if testCase.otherEncryptionKey {
+				decryptAEAD = otherAEAD
+			}
+
+			decryptedPrivateKey, err := decryptTokenSigningKey(decryptAEAD, testCase.encryptedPrivateKey)
+			if (err != nil) != testCase.expectedErr {
+				t.Fatalf("expected error %t, got %v", testCase.expectedErr, err)
+			}
+
+			if err == nil && !decryptedPrivateKey.Equal(privateKey) {
+				t.Fatal("expected the decrypted private key to be the encrypted one")
+			}
+		})
+	}
+}
+
+func TestRotateSigningKey(t *testing.T) {
+	const (
+		rotationInterval = 24 * time.Hour
+		rotationOverlap  = time.Hour
+	)
+
+	ctx := context.Background()
+	store := newMockStore()
+	store.sessionList = []database.Session{{ID: 1, OfAccountID: 1, ExpiresAt: time.Now().Add(time.Hour)}}
+
+	tokenLogic, err := NewToken(
+		mockAccountDataAccessor{store: store},
+		cache.NewTokenPublicKey(cache.NewInMemoryClient(zap.NewNop()), zap.NewNop()),
+		mockTokenPublicKeyDataAccessor{store: store},
+		cache.NewRevokedSession(cache.NewInMemoryClient(zap.NewNop()), zap.NewNop()),
+		mockSessionDataAccessor{store: store},
+		newMockGoquDatabase(),
+		configs.Auth{Token: configs.Token{
+			ExpiresIn:             "15m",
+			ActiveSessionCacheTTL: "1m",
+			SigningKey: configs.SigningKey{
+				Source:           configs.SigningKeySourceDatabase,
+				EncryptionKey:    newTestEncryptionKey(t, encryptionKeyLength),
+				RotationInterval: rotationInterval.String(),
+				RotationOverlap:  rotationOverlap.String(),
+			},
+		}},
+		zap.NewNop(),
+	)
+	if err != nil {
+		t.Fatalf("failed to create token logic: %v", err)
+	}
+
+	requireKeyIDList := func(t *testing.T, expectedKeyIDList ...string) {
+		t.Helper()
+
+		jsonWebKeySet, err := tokenLogic.GetJSONWebKeySet(ctx)
+		if err != nil {
+			t.Fatalf("failed to get json web key set: %v", err)
+		}
+
+		if len(jsonWebKeySet.Keys) != len(expectedKeyIDList) {
+			t.Fatalf("expected keys %v, got %+v", expectedKeyIDList, jsonWebKeySet.Keys)
+		}
+
+		for i, jsonWebKey := range jsonWebKeySet.Keys {
+			if jsonWebKey.KeyID != expectedKeyIDList[i] || jsonWebKey.Algorithm != jsonWebKeyAlgorithmRS512 {
+				t.Fatalf("expected keys %v, got %+v", expectedKeyIDList, jsonWebKeySet.Keys)
+			}
+		}
+	}
+
+	oldToken, _, err := tokenLogic.GetToken(ctx, 1, 1, []Role{RoleUser})
+	if err != nil {
+		t.Fatalf("failed to get token: %v", err)
+	}
+
+	// a key within its rotation interval keeps signing
+	if err = tokenLogic.RotateSigningKey(ctx); err != nil {
+		t.Fatalf("failed to rotate signing key: %v", err)
+	}
+
+	requireKeyIDList(t, "1")
+
+	store.tokenPublicKeyList[0].CreatedAt = time.Now().Add(-rotationInterval)
+	if err = tokenLogic.RotateSigningKey(ctx); err != nil {
+		t.Fatalf("failed to rotate signing key: %v", err)
+	}
+
+	requireKeyIDList(t, "1", "2")
+	if !store.tokenPublicKeyList[0].RetiredAt.Valid {
+		t.Fatal("expected the old key to be retired")
+	}
+
+	// tokens signed by the retired key stay valid during the overlap
+	if _, err = tokenLogic.GetTokenClaims(ctx, oldToken); err != nil {
+		t.Fatalf("expected the token of the retired key to be valid, got %v", err)
+	}
+
+	newToken, _, err := tokenLogic.GetToken(ctx, 1, 1, []Role{RoleUser})
+	if err != nil {
+		t.Fatalf("failed to get token: %v", err)
+	}
+
+	if _, err = tokenLogic.GetTokenClaims(ctx, newToken); err != nil {
+		t.Fatalf("expected the token of the new key to be valid, got %v", err)
+	}
+
+	store.tokenPublicKeyList[0].RetiredAt.Time = time.Now().Add(-2 * rotationOverlap)
+	if err = tokenLogic.RotateSigningKey(ctx); err != nil {
+		t.Fatalf("failed to rotate signing key: %v", err)
+	}
+
+	requireKeyIDList(t, "2")
+}
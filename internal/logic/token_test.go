@@ -0,0 +1,110 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"goload/internal/dataaccess/cache"
+	"goload/internal/dataaccess/database"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// newTestTokenReplica returns token logic with its own in-memory cache, as a replica of the service.
+func newTestTokenReplica(store *mockStore, activeSessionCacheTTL time.Duration) token {
+	return token{
+		revokedSessionCache:   cache.NewRevokedSession(cache.NewInMemoryClient(zap.NewNop()), zap.NewNop()),
+		sessionDataAccessor:   mockSessionDataAccessor{store: store},
+		expiresIn:             15 * time.Minute,
+		activeSessionCacheTTL: activeSessionCacheTTL,
+		logger:                zap.NewNop(),
+	}
+}
+
+func TestIsSessionRevoked(t *testing.T) {
+	ctx := context.Background()
+
+	testCaseList := []struct {
+		name string
+		// revoke revokes session 1 the way the service does, on the first replica.
+		revoke                bool
+		revokeInDatabaseOnly  bool
+		activeSessionCacheTTL time.Duration
+		waitForTTL            bool
+		expectedFirstReplica  bool
+		expectedSecondReplica bool
+	}{
+		{name: "active session", activeSessionCacheTTL: time.Minute},
+		{
+			name: "revoked session", revoke: true, activeSessionCacheTTL: time.Minute,
+			expectedFirstReplica: true,
+		},
+		{
+			name: "revoked session seen by another replica once its active entry expired", revoke: true,
+			activeSessionCacheTTL: 20 * time.Millisecond, waitForTTL: true,
+			expectedFirstReplica: true, expectedSecondReplica: true,
+		},
+		{
+			name: "revoked session without active entries", revoke: true,
+			expectedFirstReplica: true, expectedSecondReplica: true,
+		},
+		{
+			name: "revoked in the database while the cache is empty", revokeInDatabaseOnly: true,
+			activeSessionCacheTTL: time.Minute, expectedFirstReplica: true, expectedSecondReplica: true,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			store := newMockStore()
+			store.sessionList = []database.Session{{ID: 1, OfAccountID: 1, ExpiresAt: time.Now().Add(time.Hour)}}
+			firstReplica := newTestTokenReplica(store, testCase.activeSessionCacheTTL)
+			secondReplica := newTestTokenReplica(store, testCase.activeSessionCacheTTL)
+
+			if !testCase.revokeInDatabaseOnly {
+				// both replicas have seen the session active before it is revoked
+				for _, replica := range []token{firstReplica, secondReplica} {
+					if revoked, err := replica.isSessionRevoked(ctx, 1); err != nil || revoked {
+						t.Fatalf("expected the session to be active, got %v, %v", revoked, err)
+					}
+				}
+			}
+
+			if testCase.revoke || testCase.revokeInDatabaseOnly {
+				store.sessionList[0].RevokedAt = sql.NullTime{Time: time.Now(), Valid: true}
+			}
+
+			if testCase.revoke {
+				if err := firstReplica.RevokeSessionList(ctx, []uint64{1}); err != nil {
+					t.Fatalf("failed to revoke session: %v", err)
+				}
+			}
+
+			if testCase.waitForTTL {
+				time.Sleep(2 * testCase.activeSessionCacheTTL)
+			}
+
+			for i, expected := range []bool{testCase.expectedFirstReplica, testCase.expectedSecondReplica} {
+				replica := []token{firstReplica, secondReplica}[i]
+				revoked, err := replica.isSessionRevoked(ctx, 1)
+				if err != nil {
+					t.Fatalf("failed to check session: %v", err)
+				}
+
+				if revoked != expected {
+					t.Errorf("replica %d: expected revoked %v, got %v", i+1, expected, revoked)
+				}
+			}
+		})
+	}
+}
+
+func TestIsSessionRevokedOfDeletedSession(t *testing.T) {
+	replica := newTestTokenReplica(newMockStore(), time.Minute)
+
+	revoked, err := replica.isSessionRevoked(context.Background(), 1)
+	if err != nil || !revoked {
+		t.Fatalf("expected a deleted session to be revoked, got %v, %v", revoked, err)
+	}
+}
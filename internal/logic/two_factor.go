@@ -0,0 +1,510 @@
+package logic
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/database"
+	"goload/internal/utils"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	totpSecretByteCount = 20
+	totpDigitCount      = 6
+	totpPeriod          = 30 * time.Second
+	// totpAllowedTimeStepSkew accepts codes of the time steps next to the current one, for clocks that drift.
+	totpAllowedTimeStepSkew = 1
+	recoveryCodeByteCount   = 10
+)
+
+var (
+	errTwoFactorNotConfigured = status.Error(
+		codes.FailedPrecondition, "two-factor authentication is not configured on this service")
+	errTwoFactorAlreadyEnabled = status.Error(
+		codes.FailedPrecondition, "two-factor authentication is already enabled")
+	errTwoFactorNotEnabled           = status.Error(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	errTwoFactorEnrollmentNotStarted = status.Error(
+		codes.FailedPrecondition, "two-factor enrollment has not been started")
+	errTwoFactorRequiredForRole = status.Error(
+		codes.FailedPrecondition, "two-factor authentication is required for the role of the account")
+	errTwoFactorCodeRequired = status.Error(codes.FailedPrecondition, "two-factor code is required")
+	errInvalidTwoFactorCode  = status.Error(codes.Unauthenticated, "incorrect two-factor code")
+
+	totpSecretEncoding   = base32.StdEncoding.WithPadding(base32.NoPadding)
+	recoveryCodeEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+)
+
+type EnrollTwoFactorParams struct {
+	Password string
+	ClientIP string
+}
+
+type EnrollTwoFactorOutput struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+type ConfirmTwoFactorParams struct {
+	Code string
+}
+
+type ConfirmTwoFactorOutput struct {
+	RecoveryCodeList []string
+}
+
+type DisableTwoFactorParams struct {
+	Password string
+	Code     string
+}
+
+type RegenerateRecoveryCodesParams struct {
+	Code string
+}
+
+type RegenerateRecoveryCodesOutput struct {
+	RecoveryCodeList []string
+}
+
+// twoFactorRequirement holds the roles that only take effect for accounts that enabled two-factor authentication.
+type twoFactorRequirement map[Role]struct{}
+
+func newTwoFactorRequirement(twoFactorConfig configs.TwoFactor) twoFactorRequirement {
+	if twoFactorConfig.EncryptionKey == "" {
+		// nobody could comply, so the requirement would only lock privileged accounts out
+		return twoFactorRequirement{}
+	}
+
+	return lo.SliceToMap(twoFactorConfig.RequiredRoleList, func(role string) (Role, struct{}) {
+		return Role(role), struct{}{}
+	})
+}
+
+func (t twoFactorRequirement) isRequiredForRole(role Role) bool {
+	_, ok := t[role]
+	return ok
+}
+
+// getAccountRoleList returns the roles tokens and principals of the account carry, an account with a role that
+// requires two-factor authentication acts as a user until it has enabled it.
+func (t twoFactorRequirement) getAccountRoleList(account database.Account) []Role {
+	roleList := getAccountRoleList(account)
+	if !account.TwoFactorEnabledAt.Valid && t.isRequiredForRole(roleList[0]) {
+		return []Role{RoleUser}
+	}
+
+	return roleList
+}
+
+// generateTOTPCode implements RFC 6238 over HMAC-SHA1, the only algorithm every authenticator app supports.
+func generateTOTPCode(secret []byte, timeStep uint64) string {
+	message := make([]byte, 8)
+	binary.BigEndian.PutUint64(message, timeStep)
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(message)
+	sum := mac.Sum(nil)
+
+	// dynamic truncation, RFC 4226 section 5.3
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigitCount, value%1_000_000)
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != totpDigitCount {
+		return false
+	}
+
+	_, err := strconv.ParseUint(code, 10, 64)
+	return err == nil
+}
+
+// findTOTPTimeStep returns the time step the code is valid for around now.
+func findTOTPTimeStep(secret []byte, code string, now time.Time) (uint64, bool) {
+	currentTimeStep := uint64(now.Unix()) / uint64(totpPeriod/time.Second)
+	for skew := -totpAllowedTimeStepSkew; skew <= totpAllowedTimeStepSkew; skew++ {
+		timeStep := currentTimeStep + uint64(skew)
+		if hmac.Equal([]byte(generateTOTPCode(secret, timeStep)), []byte(code)) {
+			return timeStep, true
+		}
+	}
+
+	return 0, false
+}
+
+func getTOTPProvisioningURI(issuer, accountName string, secret []byte) string {
+	query := url.Values{}
+	query.Set("secret", totpSecretEncoding.EncodeToString(secret))
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", strconv.Itoa(totpDigitCount))
+	query.Set("period", strconv.Itoa(int(totpPeriod/time.Second)))
+
+	return (&url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + accountName,
+		RawQuery: query.Encode(),
+	}).String()
+}
+
+// The account ID is bound to the ciphertext, so a secret copied to another account row does not decrypt.
+func getTOTPSecretAdditionalData(accountID uint64) []byte {
+	return []byte(strconv.FormatUint(accountID, 10))
+}
+
+func encryptTOTPSecret(aead cipher.AEAD, accountID uint64, secret []byte) (string, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	encrypted := aead.Seal(nonce, nonce, secret, getTOTPSecretAdditionalData(accountID))
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
+
+func decryptTOTPSecret(aead cipher.AEAD, accountID uint64, encryptedSecret string) ([]byte, error) {
+	encrypted, err := base64.StdEncoding.DecodeString(encryptedSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(encrypted) < aead.NonceSize() {
+		return nil, errors.New("encrypted totp secret is too short")
+	}
+
+	nonce, cipherText := encrypted[:aead.NonceSize()], encrypted[aead.NonceSize():]
+	return aead.Open(nil, nonce, cipherText, getTOTPSecretAdditionalData(accountID))
+}
+
+// A recovery code is 16 lowercase base32 characters shown in two groups of 8, it is compared with the dash, spaces
+// and case removed. Only its SHA-256 is stored, it is random enough for a fast hash.
+func generateRecoveryCode() (string, string, error) {
+	codeBytes := make([]byte, recoveryCodeByteCount)
+	if _, err := rand.Read(codeBytes); err != nil {
+		return "", "", err
+	}
+
+	code := recoveryCodeEncoding.EncodeToString(codeBytes)
+	return code[:8] + "-" + code[8:], hashRecoveryCode(code), nil
+}
+
+func hashRecoveryCode(code string) string {
+	normalizedCode := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	codeHash := sha256.Sum256([]byte(normalizedCode))
+	return hex.EncodeToString(codeHash[:])
+}
+
+// replaceRecoveryCodeList replaces every recovery code of the account with new ones, and returns them.
+func (a account) replaceRecoveryCodeList(ctx context.Context, td *goqu.TxDatabase, accountID uint64) ([]string, error) {
+	accountRecoveryCodeDataAccessor := a.accountRecoveryCodeDataAccessor.WithDatabase(td)
+	if err := accountRecoveryCodeDataAccessor.DeleteAccountRecoveryCodeListOfAccount(ctx, accountID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	recoveryCodeList := make([]string, 0, a.twoFactorConfig.RecoveryCodeCount)
+	accountRecoveryCodeList := make([]database.AccountRecoveryCode, 0, a.twoFactorConfig.RecoveryCodeCount)
+	for i := 0; i < a.twoFactorConfig.RecoveryCodeCount; i++ {
+		code, codeHash, err := generateRecoveryCode()
+		if err != nil {
+			utils.LoggerWithContext(ctx, a.logger).With(zap.Error(err)).Error("failed to generate recovery code")
+			return nil, status.Error(codes.Internal, "failed to generate recovery code")
+		}
+
+		recoveryCodeList = append(recoveryCodeList, code)
+		accountRecoveryCodeList = append(accountRecoveryCodeList, database.AccountRecoveryCode{
+			OfAccountID: accountID,
+			CodeHash:    codeHash,
+			CreatedAt:   now,
+		})
+	}
+
+	if err := accountRecoveryCodeDataAccessor.CreateAccountRecoveryCodeList(ctx, accountRecoveryCodeList); err != nil {
+		return nil, err
+	}
+
+	return recoveryCodeList, nil
+}
+
+// verifyTOTPCode accepts a code at most once, codes of the last accepted time step and earlier are rejected.
+func (a account) verifyTOTPCode(ctx context.Context, td *goqu.TxDatabase, accountID uint64, code string) error {
+	accountTOTP, err := a.accountTOTPDataAccessor.WithDatabase(td).GetAccountTOTPWithXLock(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	secret, err := decryptTOTPSecret(a.totpSecretAEAD, accountID, accountTOTP.EncryptedSecret)
+	if err != nil {
+		utils.LoggerWithContext(ctx, a.logger).With(zap.Error(err)).Error("failed to decrypt totp secret")
+		return status.Error(codes.Internal, "failed to decrypt totp secret")
+	}
+
+	timeStep, ok := findTOTPTimeStep(secret, code, time.Now())
+	if !ok || timeStep <= accountTOTP.LastUsedTimeStep {
+		return errInvalidTwoFactorCode
+	}
+
+	return a.accountTOTPDataAccessor.WithDatabase(td).UpdateAccountTOTPLastUsedTimeStep(ctx, accountID, timeStep)
+}
+
+// verifyTwoFactorCode checks a TOTP code, or a recovery code when allowRecoveryCode is set, of an account that has
+// enabled two-factor authentication. A recovery code is used up by it.
+func (a account) verifyTwoFactorCode(
+	ctx context.Context,
+	accountID uint64,
+	code string,
+	allowRecoveryCode bool,
+) error {
+	if a.totpSecretAEAD == nil {
+		return errTwoFactorNotConfigured
+	}
+
+	if isTOTPCode(code) {
+		return a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+			return a.verifyTOTPCode(ctx, td, accountID, code)
+		})
+	}
+
+	if !allowRecoveryCode {
+		return errInvalidTwoFactorCode
+	}
+
+	used, err := a.accountRecoveryCodeDataAccessor.UseAccountRecoveryCode(
+		ctx, accountID, hashRecoveryCode(code), time.Now())
+	if err != nil {
+		return err
+	}
+
+	if !used {
+		return errInvalidTwoFactorCode
+	}
+
+	utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID)).Info("used recovery code")
+	return nil
+}
+
+func (a account) EnrollTwoFactor(ctx context.Context, params EnrollTwoFactorParams) (EnrollTwoFactorOutput, error) {
+	if a.totpSecretAEAD == nil {
+		return EnrollTwoFactorOutput{}, errTwoFactorNotConfigured
+	}
+
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return EnrollTwoFactorOutput{}, err
+	}
+
+	existingAccount, err := a.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return EnrollTwoFactorOutput{}, err
+	}
+
+	if existingAccount.TwoFactorEnabledAt.Valid {
+		return EnrollTwoFactorOutput{}, errTwoFactorAlreadyEnabled
+	}
+
+	if err = a.reauthenticateAccount(ctx, existingAccount, params.Password, "", params.ClientIP); err != nil {
+		return EnrollTwoFactorOutput{}, err
+	}
+
+	secret := make([]byte, totpSecretByteCount)
+	if _, err = rand.Read(secret); err != nil {
+		utils.LoggerWithContext(ctx, a.logger).With(zap.Error(err)).Error("failed to generate totp secret")
+		return EnrollTwoFactorOutput{}, status.Error(codes.Internal, "failed to generate totp secret")
+	}
+
+	encryptedSecret, err := encryptTOTPSecret(a.totpSecretAEAD, accountID, secret)
+	if err != nil {
+		utils.LoggerWithContext(ctx, a.logger).With(zap.Error(err)).Error("failed to encrypt totp secret")
+		return EnrollTwoFactorOutput{}, status.Error(codes.Internal, "failed to encrypt totp secret")
+	}
+
+	// starting over replaces the secret of an enrollment that was never confirmed
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		if deleteErr := a.accountTOTPDataAccessor.WithDatabase(td).DeleteAccountTOTP(ctx, accountID); deleteErr != nil {
+			return deleteErr
+		}
+
+		return a.accountTOTPDataAccessor.WithDatabase(td).CreateAccountTOTP(ctx, database.AccountTOTP{
+			OfAccountID:     accountID,
+			EncryptedSecret: encryptedSecret,
+			CreatedAt:       time.Now(),
+		})
+	})
+	if txErr != nil {
+		return EnrollTwoFactorOutput{}, txErr
+	}
+
+	return EnrollTwoFactorOutput{
+		Secret:          totpSecretEncoding.EncodeToString(secret),
+		ProvisioningURI: getTOTPProvisioningURI(a.twoFactorConfig.Issuer, existingAccount.AccountName, secret),
+	}, nil
+}
+
+func (a account) ConfirmTwoFactor(ctx context.Context, params ConfirmTwoFactorParams) (ConfirmTwoFactorOutput, error) {
+	if a.totpSecretAEAD == nil {
+		return ConfirmTwoFactorOutput{}, errTwoFactorNotConfigured
+	}
+
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return ConfirmTwoFactorOutput{}, err
+	}
+
+	if !isTOTPCode(params.Code) {
+		return ConfirmTwoFactorOutput{}, errInvalidTwoFactorCode
+	}
+
+	var recoveryCodeList []string
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		existingAccount, getErr := a.accountDataAccessor.WithDatabase(td).GetAccountByIDWithXLock(ctx, accountID)
+		if getErr != nil {
+			return getErr
+		}
+
+		if existingAccount.TwoFactorEnabledAt.Valid {
+			return errTwoFactorAlreadyEnabled
+		}
+
+		if verifyErr := a.verifyTOTPCode(ctx, td, accountID, params.Code); verifyErr != nil {
+			if errors.Is(verifyErr, database.ErrAccountTOTPNotFound) {
+				return errTwoFactorEnrollmentNotStarted
+			}
+
+			return verifyErr
+		}
+
+		updateErr := a.accountDataAccessor.WithDatabase(td).UpdateAccountTwoFactorEnabledAt(
+			ctx, accountID, sql.NullTime{Time: time.Now(), Valid: true})
+		if updateErr != nil {
+			return updateErr
+		}
+
+		recoveryCodeList, updateErr = a.replaceRecoveryCodeList(ctx, td, accountID)
+		return updateErr
+	})
+	if txErr != nil {
+		return ConfirmTwoFactorOutput{}, txErr
+	}
+
+	utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID)).
+		Info("enabled two-factor authentication")
+	return ConfirmTwoFactorOutput{
+		RecoveryCodeList: recoveryCodeList,
+	}, nil
+}
+
+func (a account) DisableTwoFactor(ctx context.Context, params DisableTwoFactorParams) error {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return err
+	}
+
+	existingAccount, err := a.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	if !existingAccount.TwoFactorEnabledAt.Valid {
+		return errTwoFactorNotEnabled
+	}
+
+	if a.twoFactorRequirement.isRequiredForRole(getAccountRoleList(existingAccount)[0]) {
+		return errTwoFactorRequiredForRole
+	}
+
+	if _, err = a.verifyAccountPassword(ctx, accountID, params.Password); err != nil {
+		return err
+	}
+
+	if err = a.verifyTwoFactorCode(ctx, accountID, params.Code, true); err != nil {
+		return err
+	}
+
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		for _, deleteFunc := range []func(context.Context, uint64) error{
+			a.accountRecoveryCodeDataAccessor.WithDatabase(td).DeleteAccountRecoveryCodeListOfAccount,
+			a.accountTOTPDataAccessor.WithDatabase(td).DeleteAccountTOTP,
+		} {
+			if deleteErr := deleteFunc(ctx, accountID); deleteErr != nil {
+				return deleteErr
+			}
+		}
+
+		return a.accountDataAccessor.WithDatabase(td).UpdateAccountTwoFactorEnabledAt(ctx, accountID, sql.NullTime{})
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID)).
+		Info("disabled two-factor authentication")
+	return nil
+}
+
+func (a account) RegenerateRecoveryCodes(
+	ctx context.Context,
+	params RegenerateRecoveryCodesParams,
+) (RegenerateRecoveryCodesOutput, error) {
+	if a.totpSecretAEAD == nil {
+		return RegenerateRecoveryCodesOutput{}, errTwoFactorNotConfigured
+	}
+
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return RegenerateRecoveryCodesOutput{}, err
+	}
+
+	existingAccount, err := a.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return RegenerateRecoveryCodesOutput{}, err
+	}
+
+	if !existingAccount.TwoFactorEnabledAt.Valid {
+		return RegenerateRecoveryCodesOutput{}, errTwoFactorNotEnabled
+	}
+
+	// a recovery code is not enough, whoever only has one of them should not get a whole new set
+	if !isTOTPCode(params.Code) {
+		return RegenerateRecoveryCodesOutput{}, errInvalidTwoFactorCode
+	}
+
+	var recoveryCodeList []string
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		if verifyErr := a.verifyTOTPCode(ctx, td, accountID, params.Code); verifyErr != nil {
+			return verifyErr
+		}
+
+		var replaceErr error
+		recoveryCodeList, replaceErr = a.replaceRecoveryCodeList(ctx, td, accountID)
+		return replaceErr
+	})
+	if txErr != nil {
+		return RegenerateRecoveryCodesOutput{}, txErr
+	}
+
+	return RegenerateRecoveryCodesOutput{
+		RecoveryCodeList: recoveryCodeList,
+	}, nil
+}
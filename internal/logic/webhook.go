@@ -0,0 +1,642 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/database"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/utils"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	WebhookEventTypeTest = "webhook_test"
+
+	WebhookHeaderEventType  = "X-Goload-Event"
+	WebhookHeaderEventID    = "X-Goload-Event-ID"
+	WebhookHeaderDeliveryID = "X-Goload-Delivery-ID"
+	WebhookHeaderTimestamp  = "X-Goload-Timestamp"
+	// WebhookHeaderSignature is "sha256=" followed by the hex encoded HMAC-SHA256 of
+	// "<X-Goload-Timestamp>.<body>", keyed with the secret of the webhook or of the task's callback_url.
+	WebhookHeaderSignature = "X-Goload-Signature"
+
+	webhookSecretPrefix             = "whsec_"
+	webhookSecretByteCount          = 32
+	maxWebhookCountPerAccount       = 16
+	maxWebhookURLLength             = 2000
+	maxWebhookLastErrorLength       = 1024
+	defaultWebhookDeliveryBatchSize = 100
+	defaultWebhookDeliveryListLimit = 100
+	maxWebhookDeliveryListLimit     = 100
+	webhookDialTimeout              = 30 * time.Second
+)
+
+var (
+	errWebhookAddressNotAllowed = errors.New("webhook address is not allowed")
+
+	// blockedWebhookPrefixList complements the checks of netip.Addr for ranges it has no method for.
+	blockedWebhookPrefixList = []netip.Prefix{
+		netip.MustParsePrefix("0.0.0.0/8"),
+		netip.MustParsePrefix("100.64.0.0/10"),
+	}
+)
+
+type CreateWebhookParams struct {
+	URL string
+}
+
+type CreateWebhookOutput struct {
+	Webhook *go_load.Webhook
+	Secret  string
+}
+
+type GetWebhookListParams struct{}
+
+type GetWebhookListOutput struct {
+	WebhookList []*go_load.Webhook
+}
+
+type DeleteWebhookParams struct {
+	WebhookID uint64
+}
+
+type TestWebhookParams struct {
+	WebhookID uint64
+}
+
+type TestWebhookOutput struct {
+	WebhookDelivery *go_load.WebhookDelivery
+}
+
+type GetWebhookDeliveryListParams struct {
+	WebhookID      uint64
+	DownloadTaskID uint64
+	Offset         uint64
+	Limit          uint64
+}
+
+type GetWebhookDeliveryListOutput struct {
+	WebhookDeliveryList []*go_load.WebhookDelivery
+}
+
+type Webhook interface {
+	CreateWebhook(ctx context.Context, params CreateWebhookParams) (CreateWebhookOutput, error)
+	GetWebhookList(ctx context.Context, params GetWebhookListParams) (GetWebhookListOutput, error)
+	DeleteWebhook(ctx context.Context, params DeleteWebhookParams) error
+	TestWebhook(ctx context.Context, params TestWebhookParams) (TestWebhookOutput, error)
+	GetWebhookDeliveryList(ctx context.Context, params GetWebhookDeliveryListParams) (GetWebhookDeliveryListOutput, error)
+	// EnqueueDownloadTaskWebhookDeliveryList schedules the delivery of event to every webhook of the task's
+	// account and to the task's callback_url. It is idempotent for the same event.
+	EnqueueDownloadTaskWebhookDeliveryList(
+		ctx context.Context,
+		eventType string,
+		event *go_load.DownloadTaskLifecycleEvent,
+	) error
+	// DeliverDueWebhookDeliveryList sends one batch of due deliveries and returns how many succeeded.
+	DeliverDueWebhookDeliveryList(ctx context.Context) (uint64, error)
+}
+
+type webhook struct {
+	webhookDataAccessor         database.WebhookDataAccessor
+	webhookDeliveryDataAccessor database.WebhookDeliveryDataAccessor
+	downloadTaskDataAccessor    database.DownloadTaskDataAccessor
+	goquDatabase                *goqu.Database
+	httpClient                  *http.Client
+	requestTimeout              time.Duration
+	deliveryBatchSize           uint64
+	maxAttempts                 uint32
+	initialBackoff              time.Duration
+	maxBackoff                  time.Duration
+	logger                      *zap.Logger
+}
+
+func NewWebhook(
+	webhookDataAccessor database.WebhookDataAccessor,
+	webhookDeliveryDataAccessor database.WebhookDeliveryDataAccessor,
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
+	goquDatabase *goqu.Database,
+	webhookConfig configs.Webhook,
+	logger *zap.Logger,
+) (Webhook, error) {
+	requestTimeout, err := webhookConfig.GetRequestTimeoutDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse webhook request_timeout: " + webhookConfig.RequestTimeout)
+		return nil, err
+	}
+
+	initialBackoff, err := webhookConfig.GetInitialBackoffDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse webhook initial_backoff: " + webhookConfig.InitialBackoff)
+		return nil, err
+	}
+
+	maxBackoff, err := webhookConfig.GetMaxBackoffDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse webhook max_backoff: " + webhookConfig.MaxBackoff)
+		return nil, err
+	}
+
+	deliveryBatchSize := webhookConfig.DeliveryBatchSize
+	if deliveryBatchSize == 0 {
+		deliveryBatchSize = defaultWebhookDeliveryBatchSize
+	}
+
+	return &webhook{
+		webhookDataAccessor:         webhookDataAccessor,
+		webhookDeliveryDataAccessor: webhookDeliveryDataAccessor,
+		downloadTaskDataAccessor:    downloadTaskDataAccessor,
+		goquDatabase:                goquDatabase,
+		httpClient:                  newWebhookHTTPClient(requestTimeout, webhookConfig.AllowPrivateNetwork),
+		requestTimeout:              requestTimeout,
+		deliveryBatchSize:           deliveryBatchSize,
+		maxAttempts:                 max(webhookConfig.MaxAttempts, 1),
+		initialBackoff:              initialBackoff,
+		maxBackoff:                  maxBackoff,
+		logger:                      logger,
+	}, nil
+}
+
+func generateWebhookSecret() string {
+	secretBytes := make([]byte, webhookSecretByteCount)
+	// crypto/rand.Read never returns an error on supported platforms
+	_, _ = rand.Read(secretBytes)
+	return webhookSecretPrefix + hex.EncodeToString(secretBytes)
+}
+
+func isWebhookAddressAllowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() || addr.IsMulticast() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() {
+		return false
+	}
+
+	return !lo.SomeBy(blockedWebhookPrefixList, func(prefix netip.Prefix) bool { return prefix.Contains(addr) })
+}
+
+// controlWebhookDial runs after the host name is resolved, right before connecting, so a host name that resolves
+// to an internal address, even only on a later lookup, is refused as well.
+func controlWebhookDial(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+
+	if !isWebhookAddressAllowed(addrPort.Addr()) {
+		return fmt.Errorf("%w: %s", errWebhookAddressNotAllowed, addrPort.Addr())
+	}
+
+	return nil
+}
+
+func newWebhookHTTPClient(requestTimeout time.Duration, allowPrivateNetwork bool) *http.Client {
+	dialer := &net.Dialer{Timeout: webhookDialTimeout}
+	if !allowPrivateNetwork {
+		dialer.Control = controlWebhookDial
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// going through a proxy would only let the address of the proxy be checked
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: requestTimeout, Transport: transport}
+}
+
+func validateWebhookURL(webhookURL string) error {
+	if len(webhookURL) > maxWebhookURLLength {
+		return status.Error(codes.InvalidArgument, "webhook url is too long")
+	}
+
+	parsedURL, err := url.Parse(webhookURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return status.Errorf(codes.InvalidArgument, "invalid webhook url %q", webhookURL)
+	}
+
+	return nil
+}
+
+func getWebhookSignature(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func databaseWebhookToProtoWebhook(webhook database.Webhook) *go_load.Webhook {
+	return &go_load.Webhook{
+		Id:        webhook.ID,
+		Url:       webhook.URL,
+		CreatedAt: timestamppb.New(webhook.CreatedAt),
+	}
+}
+
+func databaseWebhookDeliveryToProtoWebhookDelivery(delivery database.WebhookDelivery) *go_load.WebhookDelivery {
+	protoDelivery := &go_load.WebhookDelivery{
+		Id:                     delivery.ID,
+		WebhookId:              delivery.OfWebhookID,
+		DownloadTaskId:         delivery.OfDownloadTaskID,
+		EventId:                delivery.EventID,
+		EventType:              delivery.EventType,
+		Url:                    delivery.URL,
+		DeliveryStatus:         delivery.DeliveryStatus,
+		Attempt:                delivery.Attempt,
+		LastResponseStatusCode: delivery.LastResponseStatusCode,
+		LastError:              delivery.LastError,
+		CreatedAt:              timestamppb.New(delivery.CreatedAt),
+		DeliveredAt:            nullTimeToProtoTimestamp(delivery.DeliveredAt),
+	}
+
+	if delivery.DeliveryStatus == go_load.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING {
+		protoDelivery.NextAttemptAt = timestamppb.New(delivery.NextAttemptAt)
+	}
+
+	return protoDelivery
+}
+
+func (w webhook) getWebhookOfAccount(ctx context.Context, webhookID uint64) (database.Webhook, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return database.Webhook{}, err
+	}
+
+	webhook, err := w.webhookDataAccessor.GetWebhook(ctx, webhookID)
+	if err != nil {
+		return database.Webhook{}, err
+	}
+
+	if webhook.OfAccountID != accountID {
+		return database.Webhook{}, status.Error(codes.PermissionDenied, "permission denied")
+	}
+
+	return webhook, nil
+}
+
+func (w webhook) CreateWebhook(ctx context.Context, params CreateWebhookParams) (CreateWebhookOutput, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return CreateWebhookOutput{}, err
+	}
+
+	if err = validateWebhookURL(params.URL); err != nil {
+		return CreateWebhookOutput{}, err
+	}
+
+	webhook := database.Webhook{
+		OfAccountID: accountID,
+		URL:         params.URL,
+		Secret:      generateWebhookSecret(),
+		CreatedAt:   time.Now(),
+	}
+
+	txErr := w.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		webhookList, getWebhookListErr := w.webhookDataAccessor.
+			WithDatabase(td).
+			GetWebhookListOfAccount(ctx, accountID)
+		if getWebhookListErr != nil {
+			return getWebhookListErr
+		}
+
+		if len(webhookList) >= maxWebhookCountPerAccount {
+			return status.Errorf(
+				codes.FailedPrecondition, "an account can have at most %d webhooks", maxWebhookCountPerAccount)
+		}
+
+		webhookID, createWebhookErr := w.webhookDataAccessor.WithDatabase(td).CreateWebhook(ctx, webhook)
+		if createWebhookErr != nil {
+			return createWebhookErr
+		}
+
+		webhook.ID = webhookID
+		return nil
+	})
+	if txErr != nil {
+		return CreateWebhookOutput{}, txErr
+	}
+
+	return CreateWebhookOutput{
+		Webhook: databaseWebhookToProtoWebhook(webhook),
+		Secret:  webhook.Secret,
+	}, nil
+}
+
+func (w webhook) GetWebhookList(ctx context.Context, params GetWebhookListParams) (GetWebhookListOutput, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return GetWebhookListOutput{}, err
+	}
+
+	webhookList, err := w.webhookDataAccessor.GetWebhookListOfAccount(ctx, accountID)
+	if err != nil {
+		return GetWebhookListOutput{}, err
+	}
+
+	return GetWebhookListOutput{
+		WebhookList: lo.Map(webhookList, func(webhook database.Webhook, _ int) *go_load.Webhook {
+			return databaseWebhookToProtoWebhook(webhook)
+		}),
+	}, nil
+}
+
+// DeleteWebhook does not cancel pending deliveries to the webhook, they are still attempted.
+func (w webhook) DeleteWebhook(ctx context.Context, params DeleteWebhookParams) error {
+	webhook, err := w.getWebhookOfAccount(ctx, params.WebhookID)
+	if err != nil {
+		return err
+	}
+
+	return w.webhookDataAccessor.DeleteWebhook(ctx, webhook.ID)
+}
+
+// TestWebhook sends a WebhookTestEvent synchronously, without retrying, and records it in the delivery log.
+func (w webhook) TestWebhook(ctx context.Context, params TestWebhookParams) (TestWebhookOutput, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("webhook_id", params.WebhookID))
+
+	webhook, err := w.getWebhookOfAccount(ctx, params.WebhookID)
+	if err != nil {
+		return TestWebhookOutput{}, err
+	}
+
+	now := time.Now()
+	eventID := utils.NewID()
+	payload, err := protojson.Marshal(&go_load.WebhookTestEvent{
+		EventId:    eventID,
+		WebhookId:  webhook.ID,
+		OccurredAt: timestamppb.New(now),
+	})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to marshal webhook test event")
+		return TestWebhookOutput{}, status.Error(codes.Internal, "failed to marshal webhook test event")
+	}
+
+	delivery := database.WebhookDelivery{
+		OfAccountID:    webhook.OfAccountID,
+		OfWebhookID:    webhook.ID,
+		EventID:        eventID,
+		EventType:      WebhookEventTypeTest,
+		URL:            webhook.URL,
+		Secret:         webhook.Secret,
+		Payload:        payload,
+		DeliveryStatus: go_load.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING,
+		// the delivery is leased for longer than it can take, so the delivery job does not pick it up meanwhile
+		NextAttemptAt: now.Add(2 * w.requestTimeout),
+		CreatedAt:     now,
+	}
+
+	delivery.ID, err = w.webhookDeliveryDataAccessor.CreateWebhookDelivery(ctx, delivery)
+	if err != nil {
+		return TestWebhookOutput{}, err
+	}
+
+	w.deliverWebhookDelivery(ctx, &delivery, 1)
+	if err = w.webhookDeliveryDataAccessor.UpdateWebhookDelivery(ctx, delivery); err != nil {
+		return TestWebhookOutput{}, err
+	}
+
+	return TestWebhookOutput{
+		WebhookDelivery: databaseWebhookDeliveryToProtoWebhookDelivery(delivery),
+	}, nil
+}
+
+func (w webhook) GetWebhookDeliveryList(
+	ctx context.Context,
+	params GetWebhookDeliveryListParams,
+) (GetWebhookDeliveryListOutput, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return GetWebhookDeliveryListOutput{}, err
+	}
+
+	limit := params.Limit
+	if limit == 0 {
+		limit = defaultWebhookDeliveryListLimit
+	}
+
+	deliveryList, err := w.webhookDeliveryDataAccessor.GetWebhookDeliveryList(
+		ctx,
+		database.WebhookDeliveryListFilter{
+			OfAccountID:      accountID,
+			OfWebhookID:      params.WebhookID,
+			OfDownloadTaskID: params.DownloadTaskID,
+		},
+		params.Offset,
+		min(limit, maxWebhookDeliveryListLimit),
+	)
+	if err != nil {
+		return GetWebhookDeliveryListOutput{}, err
+	}
+
+	return GetWebhookDeliveryListOutput{
+		WebhookDeliveryList: lo.Map(
+			deliveryList,
+			func(delivery database.WebhookDelivery, _ int) *go_load.WebhookDelivery {
+				return databaseWebhookDeliveryToProtoWebhookDelivery(delivery)
+			},
+		),
+	}, nil
+}
+
+func (w webhook) EnqueueDownloadTaskWebhookDeliveryList(
+	ctx context.Context,
+	eventType string,
+	event *go_load.DownloadTaskLifecycleEvent,
+) error {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.String("event_id", event.GetEventId()))
+
+	payload, err := protojson.Marshal(event)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to marshal download task lifecycle event")
+		return status.Error(codes.Internal, "failed to marshal download task lifecycle event")
+	}
+
+	webhookList, err := w.webhookDataAccessor.GetWebhookListOfAccount(ctx, event.GetAccountId())
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	newDelivery := func(webhookID uint64, webhookURL, secret string) database.WebhookDelivery {
+		return database.WebhookDelivery{
+			OfAccountID:      event.GetAccountId(),
+			OfWebhookID:      webhookID,
+			OfDownloadTaskID: event.GetDownloadTask().GetId(),
+			EventID:          event.GetEventId(),
+			EventType:        eventType,
+			URL:              webhookURL,
+			Secret:           secret,
+			Payload:          payload,
+			DeliveryStatus:   go_load.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING,
+			NextAttemptAt:    now,
+			CreatedAt:        now,
+		}
+	}
+
+	deliveryList := lo.Map(webhookList, func(webhook database.Webhook, _ int) database.WebhookDelivery {
+		return newDelivery(webhook.ID, webhook.URL, webhook.Secret)
+	})
+
+	if event.GetDownloadTask().GetCallbackUrl() != "" {
+		task, getDownloadTaskErr := w.downloadTaskDataAccessor.GetDownloadTask(ctx, event.GetDownloadTask().GetId())
+		switch {
+		case getDownloadTaskErr == nil:
+			deliveryList = append(deliveryList, newDelivery(0, task.CallbackURL, task.CallbackSecret))
+		case status.Code(getDownloadTaskErr) == codes.NotFound:
+			logger.Warn("download task was deleted before its callback was scheduled")
+		default:
+			return getDownloadTaskErr
+		}
+	}
+
+	return w.webhookDeliveryDataAccessor.CreateWebhookDeliveryList(ctx, deliveryList)
+}
+
+func (w webhook) getBackoff(attempt uint32) time.Duration {
+	backoff := w.initialBackoff
+	for i := uint32(1); i < attempt && backoff < w.maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, w.maxBackoff)
+}
+
+func (w webhook) sendWebhookDelivery(ctx context.Context, delivery database.WebhookDelivery) (int, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(WebhookHeaderEventType, delivery.EventType)
+	request.Header.Set(WebhookHeaderEventID, delivery.EventID)
+	request.Header.Set(WebhookHeaderDeliveryID, strconv.FormatUint(delivery.ID, 10))
+	request.Header.Set(WebhookHeaderTimestamp, timestamp)
+	request.Header.Set(WebhookHeaderSignature, getWebhookSignature(delivery.Secret, timestamp, delivery.Payload))
+
+	response, err := w.httpClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+	// the body is drained so that the connection can be reused
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return response.StatusCode, fmt.Errorf("unexpected http response status code: %d", response.StatusCode)
+	}
+
+	return response.StatusCode, nil
+}
+
+// deliverWebhookDelivery makes one attempt and updates delivery with its outcome, it is up to the caller to
+// persist it.
+func (w webhook) deliverWebhookDelivery(ctx context.Context, delivery *database.WebhookDelivery, maxAttempts uint32) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("webhook_delivery_id", delivery.ID))
+
+	statusCode, err := w.sendWebhookDelivery(ctx, *delivery)
+	now := time.Now()
+	delivery.Attempt++
+	delivery.LastResponseStatusCode = int32(statusCode)
+	if err == nil {
+		delivery.DeliveryStatus = go_load.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+		delivery.LastError = ""
+		delivery.DeliveredAt = sql.NullTime{Time: now, Valid: true}
+		return
+	}
+
+	logger.With(zap.Error(err)).With(zap.Uint32("attempt", delivery.Attempt)).Warn("failed to deliver webhook")
+	delivery.LastError = err.Error()
+	if len(delivery.LastError) > maxWebhookLastErrorLength {
+		delivery.LastError = delivery.LastError[:maxWebhookLastErrorLength]
+	}
+
+	if delivery.Attempt >= maxAttempts {
+		delivery.DeliveryStatus = go_load.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+		return
+	}
+
+	delivery.NextAttemptAt = now.Add(w.getBackoff(delivery.Attempt))
+}
+
+func (w webhook) DeliverDueWebhookDeliveryList(ctx context.Context) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger)
+
+	// Due deliveries are leased by pushing their next attempt time past the time it takes to send them, so that
+	// the HTTP requests are not made while holding row locks, and a crash only delays them.
+	var deliveryList []database.WebhookDelivery
+	txErr := w.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		now := time.Now()
+
+		var err error
+		deliveryList, err = w.webhookDeliveryDataAccessor.
+			WithDatabase(td).
+			GetDueWebhookDeliveryListWithXLock(ctx, now, w.deliveryBatchSize)
+		if err != nil {
+			return err
+		}
+
+		return w.webhookDeliveryDataAccessor.WithDatabase(td).UpdateWebhookDeliveryListNextAttemptAt(
+			ctx,
+			lo.Map(deliveryList, func(delivery database.WebhookDelivery, _ int) uint64 { return delivery.ID }),
+			now.Add(2*w.requestTimeout),
+		)
+	})
+	if txErr != nil {
+		return 0, txErr
+	}
+
+	var (
+		waitGroup      sync.WaitGroup
+		mutex          sync.Mutex
+		deliveredCount uint64
+		firstErr       error
+	)
+	for i := range deliveryList {
+		waitGroup.Add(1)
+		go func(delivery *database.WebhookDelivery) {
+			defer waitGroup.Done()
+
+			w.deliverWebhookDelivery(ctx, delivery, w.maxAttempts)
+			err := w.webhookDeliveryDataAccessor.UpdateWebhookDelivery(context.WithoutCancel(ctx), *delivery)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				logger.With(zap.Error(err)).Error("failed to update webhook delivery")
+				firstErr = lo.Ternary(firstErr == nil, err, firstErr)
+				return
+			}
+
+			if delivery.DeliveryStatus == go_load.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED {
+				deliveredCount++
+			}
+		}(&deliveryList[i])
+	}
+
+	waitGroup.Wait()
+	return deliveredCount, firstErr
+}
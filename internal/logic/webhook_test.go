@@ -0,0 +1,209 @@
+package logic
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"goload/internal/dataaccess/database"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func TestIsWebhookAddressAllowed(t *testing.T) {
+	testCaseList := []struct {
+		address         string
+		expectedAllowed bool
+	}{
+		{address: "93.184.216.34", expectedAllowed: true},
+		{address: "2606:2800:220:1:248:1893:25c8:1946", expectedAllowed: true},
+		{address: "127.0.0.1"},
+		{address: "::1"},
+		{address: "10.1.2.3"},
+		{address: "172.16.0.1"},
+		{address: "192.168.1.1"},
+		{address: "169.254.169.254"},
+		{address: "100.64.0.1"},
+		{address: "0.0.0.0"},
+		{address: "0.1.2.3"},
+		{address: "::"},
+		{address: "fc00::1"},
+		{address: "fe80::1"},
+		{address: "224.0.0.1"},
+		{address: "::ffff:127.0.0.1"},
+		{address: "::ffff:169.254.169.254"},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.address, func(t *testing.T) {
+			if allowed := isWebhookAddressAllowed(netip.MustParseAddr(testCase.address)); allowed !=
+				testCase.expectedAllowed {
+				t.Fatalf("expected allowed %t, got %t", testCase.expectedAllowed, allowed)
+			}
+		})
+	}
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	testCaseList := []struct {
+		name            string
+		url             string
+		expectedErrCode codes.Code
+	}{
+		{name: "https", url: "https://example.com/hook"},
+		{name: "http", url: "http://example.com:8080/hook"},
+		{name: "other scheme", url: "file:///etc/passwd", expectedErrCode: codes.InvalidArgument},
+		{name: "no host", url: "https:///hook", expectedErrCode: codes.InvalidArgument},
+		{name: "not a url", url: "://", expectedErrCode: codes.InvalidArgument},
+		{
+			name: "too long", url: "https://example.com/" + strings.Repeat("a", maxWebhookURLLength),
+			expectedErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			requireStatusCode(t, validateWebhookURL(testCase.url), testCase.expectedErrCode)
+		})
+	}
+}
+
+func TestWebhookHTTPClientRefusesInternalAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	testCaseList := []struct {
+		name                string
+		allowPrivateNetwork bool
+		expectedErr         error
+	}{
+		{name: "refused by default", expectedErr: errWebhookAddressNotAllowed},
+		{name: "allowed with allow_private_network", allowPrivateNetwork: true},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			// the test server listens on a loopback address
+			response, err := newWebhookHTTPClient(time.Second, testCase.allowPrivateNetwork).Get(server.URL)
+			if err == nil {
+				response.Body.Close()
+			}
+
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("expected error %v, got %v", testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestDeliverWebhookDelivery(t *testing.T) {
+	const secret = "whsec_test"
+
+	testCaseList := []struct {
+		name                string
+		responseStatusCode  int
+		attempt             uint32
+		expectedStatus      go_load.WebhookDeliveryStatus
+		expectedNextAttempt bool
+	}{
+		{
+			name: "delivered", responseStatusCode: http.StatusNoContent,
+			expectedStatus: go_load.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED,
+		},
+		{
+			name: "retried after a failure", responseStatusCode: http.StatusInternalServerError,
+			expectedStatus:      go_load.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING,
+			expectedNextAttempt: true,
+		},
+		{
+			name: "failed after the last attempt", responseStatusCode: http.StatusInternalServerError, attempt: 2,
+			expectedStatus: go_load.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			var signatureErr error
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				signatureErr = checkTestWebhookSignature(r, secret)
+				w.WriteHeader(testCase.responseStatusCode)
+			}))
+			defer server.Close()
+
+			w := webhook{
+				httpClient:     newWebhookHTTPClient(time.Second, true),
+				initialBackoff: time.Minute,
+				maxBackoff:     time.Hour,
+				logger:         zap.NewNop(),
+			}
+			delivery := database.WebhookDelivery{
+				ID:             1,
+				EventID:        "event-1",
+				EventType:      "download_task_completed",
+				URL:            server.URL,
+				Secret:         secret,
+				Payload:        []byte(`{"download_task":{"id":"1"}}`),
+				DeliveryStatus: go_load.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING,
+				Attempt:        testCase.attempt,
+			}
+
+			w.deliverWebhookDelivery(context.Background(), &delivery, 3)
+			if signatureErr != nil {
+				t.Fatal(signatureErr)
+			}
+
+			if delivery.DeliveryStatus != testCase.expectedStatus {
+				t.Fatalf("expected status %s, got %s", testCase.expectedStatus, delivery.DeliveryStatus)
+			}
+
+			if delivery.Attempt != testCase.attempt+1 ||
+				delivery.LastResponseStatusCode != int32(testCase.responseStatusCode) {
+				t.Fatalf("unexpected attempt %d with status code %d", delivery.Attempt,
+					delivery.LastResponseStatusCode)
+			}
+
+			if hasNextAttempt := delivery.NextAttemptAt.After(time.Now()); hasNextAttempt !=
+				testCase.expectedNextAttempt {
+				t.Fatalf("expected next attempt %t, got %t", testCase.expectedNextAttempt, hasNextAttempt)
+			}
+		})
+	}
+}
+
+// checkTestWebhookSignature verifies a request the way a receiver would, independently of getWebhookSignature.
+func checkTestWebhookSignature(r *http.Request, secret string) error {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+
+	timestamp := r.Header.Get(WebhookHeaderTimestamp)
+	unixTime, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || time.Since(time.Unix(unixTime, 0)) > time.Minute {
+		return errors.New("missing or stale timestamp")
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + string(body)))
+	expectedSignature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(r.Header.Get(WebhookHeaderSignature)), []byte(expectedSignature)) {
+		return errors.New("invalid signature")
+	}
+
+	if r.Header.Get(WebhookHeaderEventID) != "event-1" || r.Header.Get(WebhookHeaderDeliveryID) != "1" {
+		return errors.New("missing event headers")
+	}
+
+	return nil
+}
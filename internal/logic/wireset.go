@@ -8,5 +8,15 @@ var WireSet = wire.NewSet(
 	NewAccount,
 	NewHash,
 	NewToken,
+	NewDownloadTaskLifecycleEventProducer,
 	NewDownloadTask,
+	NewRetention,
+	NewOutbox,
+	NewDeadLetter,
+	NewWebhook,
+	NewAdmin,
+	NewAPIKey,
+	NewOIDC,
+	NewShareLink,
+	NewWorkspace,
 )
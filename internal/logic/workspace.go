@@ -0,0 +1,628 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"goload/internal/configs"
+	"goload/internal/dataaccess/database"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"goload/internal/utils"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WorkspaceRole string
+
+const (
+	WorkspaceRoleViewer WorkspaceRole = "viewer"
+	WorkspaceRoleEditor WorkspaceRole = "editor"
+	WorkspaceRoleOwner  WorkspaceRole = "owner"
+
+	maxWorkspaceCountPerAccount = 100
+)
+
+var (
+	errWorkspaceLastOwner = status.Error(codes.FailedPrecondition, "a workspace must keep at least one owner")
+
+	// workspaceRoleRankMap orders the workspace roles like roleRankMap orders the roles.
+	workspaceRoleRankMap = map[WorkspaceRole]int{
+		WorkspaceRoleViewer: 1,
+		WorkspaceRoleEditor: 2,
+		WorkspaceRoleOwner:  3,
+	}
+	protoWorkspaceRoleMap = map[go_load.WorkspaceRole]WorkspaceRole{
+		go_load.WorkspaceRole_WORKSPACE_ROLE_VIEWER: WorkspaceRoleViewer,
+		go_load.WorkspaceRole_WORKSPACE_ROLE_EDITOR: WorkspaceRoleEditor,
+		go_load.WorkspaceRole_WORKSPACE_ROLE_OWNER:  WorkspaceRoleOwner,
+	}
+)
+
+func workspaceRoleToProtoWorkspaceRole(role WorkspaceRole) go_load.WorkspaceRole {
+	for protoRole, mappedRole := range protoWorkspaceRoleMap {
+		if mappedRole == role {
+			return protoRole
+		}
+	}
+
+	return go_load.WorkspaceRole_WORKSPACE_ROLE_UNSPECIFIED
+}
+
+func isWorkspaceRoleGranted(memberRole, role WorkspaceRole) bool {
+	return workspaceRoleRankMap[memberRole] >= workspaceRoleRankMap[role]
+}
+
+// checkWorkspaceRole returns the membership of the account in the workspace, it fails with PermissionDenied when
+// the account is not a member granted role.
+func checkWorkspaceRole(
+	ctx context.Context,
+	workspaceMemberDataAccessor database.WorkspaceMemberDataAccessor,
+	workspaceID uint64,
+	accountID uint64,
+	role WorkspaceRole,
+) (database.WorkspaceMember, error) {
+	member, err := workspaceMemberDataAccessor.GetWorkspaceMember(ctx, workspaceID, accountID)
+	if err != nil {
+		if errors.Is(err, database.ErrWorkspaceMemberNotFound) {
+			return database.WorkspaceMember{}, errPermissionDenied
+		}
+
+		return database.WorkspaceMember{}, err
+	}
+
+	if !isWorkspaceRoleGranted(WorkspaceRole(member.Role), role) {
+		return database.WorkspaceMember{}, errPermissionDenied
+	}
+
+	return member, nil
+}
+
+// checkDownloadTaskRole checks that the account can work on the download task. A personal download task is only
+// accessible to its account, the download task of a workspace to the members granted role.
+func checkDownloadTaskRole(
+	ctx context.Context,
+	workspaceMemberDataAccessor database.WorkspaceMemberDataAccessor,
+	task database.DownloadTask,
+	accountID uint64,
+	role WorkspaceRole,
+) error {
+	if !task.OfWorkspaceID.Valid {
+		if task.OfAccountID != accountID {
+			return errPermissionDenied
+		}
+
+		return nil
+	}
+
+	_, err := checkWorkspaceRole(ctx, workspaceMemberDataAccessor, uint64(task.OfWorkspaceID.Int64), accountID, role)
+	return err
+}
+
+func getWorkspaceQuota(workspace database.Workspace, quotaConfig configs.Quota) accountQuota {
+	return getQuota(workspace.QuotaMaxDownloadTaskCount, workspace.QuotaMaxActiveDownloadTaskCount, quotaConfig)
+}
+
+type CreateWorkspaceParams struct {
+	DisplayName string
+}
+
+type CreateWorkspaceOutput struct {
+	Workspace *go_load.Workspace
+}
+
+type GetWorkspaceListParams struct{}
+
+type GetWorkspaceListOutput struct {
+	WorkspaceList []*go_load.Workspace
+}
+
+type DeleteWorkspaceParams struct {
+	WorkspaceID uint64
+}
+
+type GetWorkspaceMemberListParams struct {
+	WorkspaceID uint64
+}
+
+type GetWorkspaceMemberListOutput struct {
+	WorkspaceMemberList []*go_load.WorkspaceMember
+}
+
+type AddWorkspaceMemberParams struct {
+	WorkspaceID uint64
+	AccountName string
+	Role        go_load.WorkspaceRole
+}
+
+type AddWorkspaceMemberOutput struct {
+	WorkspaceMember *go_load.WorkspaceMember
+}
+
+type UpdateWorkspaceMemberParams struct {
+	WorkspaceID uint64
+	AccountID   uint64
+	Role        go_load.WorkspaceRole
+}
+
+type UpdateWorkspaceMemberOutput struct {
+	WorkspaceMember *go_load.WorkspaceMember
+}
+
+type RemoveWorkspaceMemberParams struct {
+	WorkspaceID uint64
+	AccountID   uint64
+}
+
+type UpdateWorkspaceQuotaParams struct {
+	WorkspaceID uint64
+	Quota       *go_load.AccountQuota
+}
+
+type UpdateWorkspaceQuotaOutput struct {
+	Workspace *go_load.AdminWorkspace
+}
+
+// Workspace manages workspaces and their members. Membership changes lock the workspace, so that concurrent
+// changes cannot leave a workspace without an owner.
+type Workspace interface {
+	CreateWorkspace(ctx context.Context, params CreateWorkspaceParams) (CreateWorkspaceOutput, error)
+	GetWorkspaceList(ctx context.Context, params GetWorkspaceListParams) (GetWorkspaceListOutput, error)
+	DeleteWorkspace(ctx context.Context, params DeleteWorkspaceParams) error
+	GetWorkspaceMemberList(ctx context.Context, params GetWorkspaceMemberListParams) (GetWorkspaceMemberListOutput, error)
+	AddWorkspaceMember(ctx context.Context, params AddWorkspaceMemberParams) (AddWorkspaceMemberOutput, error)
+	UpdateWorkspaceMember(ctx context.Context, params UpdateWorkspaceMemberParams) (UpdateWorkspaceMemberOutput, error)
+	// RemoveWorkspaceMember also lets a member leave the workspace.
+	RemoveWorkspaceMember(ctx context.Context, params RemoveWorkspaceMemberParams) error
+	// UpdateWorkspaceQuota requires RoleAdmin.
+	UpdateWorkspaceQuota(ctx context.Context, params UpdateWorkspaceQuotaParams) (UpdateWorkspaceQuotaOutput, error)
+}
+
+type workspace struct {
+	goquDatabase                *goqu.Database
+	accountDataAccessor         database.AccountDataAccessor
+	workspaceDataAccessor       database.WorkspaceDataAccessor
+	workspaceMemberDataAccessor database.WorkspaceMemberDataAccessor
+	downloadTaskDataAccessor    database.DownloadTaskDataAccessor
+	shareLinkDataAccessor       database.ShareLinkDataAccessor
+	quotaConfig                 configs.Quota
+	logger                      *zap.Logger
+}
+
+func NewWorkspace(
+	goquDatabase *goqu.Database,
+	accountDataAccessor database.AccountDataAccessor,
+	workspaceDataAccessor database.WorkspaceDataAccessor,
+	workspaceMemberDataAccessor database.WorkspaceMemberDataAccessor,
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
+	shareLinkDataAccessor database.ShareLinkDataAccessor,
+	quotaConfig configs.Quota,
+	logger *zap.Logger,
+) Workspace {
+	return &workspace{
+		goquDatabase:                goquDatabase,
+		accountDataAccessor:         accountDataAccessor,
+		workspaceDataAccessor:       workspaceDataAccessor,
+		workspaceMemberDataAccessor: workspaceMemberDataAccessor,
+		downloadTaskDataAccessor:    downloadTaskDataAccessor,
+		shareLinkDataAccessor:       shareLinkDataAccessor,
+		quotaConfig:                 quotaConfig,
+		logger:                      logger,
+	}
+}
+
+func databaseWorkspaceToProtoWorkspace(workspace database.Workspace, role WorkspaceRole) *go_load.Workspace {
+	return &go_load.Workspace{
+		Id:          workspace.ID,
+		DisplayName: workspace.DisplayName,
+		Role:        workspaceRoleToProtoWorkspaceRole(role),
+		CreatedAt:   timestamppb.New(workspace.CreatedAt),
+	}
+}
+
+func databaseWorkspaceMemberToProtoWorkspaceMember(
+	member database.WorkspaceMember,
+	account database.Account,
+) *go_load.WorkspaceMember {
+	return &go_load.WorkspaceMember{
+		Account: &go_load.Account{
+			Id:          account.ID,
+			AccountName: account.AccountName,
+		},
+		Role:      workspaceRoleToProtoWorkspaceRole(WorkspaceRole(member.Role)),
+		CreatedAt: timestamppb.New(member.CreatedAt),
+	}
+}
+
+// checkWorkspaceCountOfAccount fails when the account cannot join one more workspace.
+func (w workspace) checkWorkspaceCountOfAccount(ctx context.Context, db database.Database, accountID uint64) error {
+	memberList, err := w.workspaceMemberDataAccessor.WithDatabase(db).GetWorkspaceMemberListOfAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	if len(memberList) >= maxWorkspaceCountPerAccount {
+		return status.Errorf(
+			codes.ResourceExhausted, "an account can be a member of at most %d workspaces", maxWorkspaceCountPerAccount)
+	}
+
+	return nil
+}
+
+// lockWorkspace locks the workspace and checks that the account is a member granted role, it must be called in a
+// transaction.
+func (w workspace) lockWorkspace(
+	ctx context.Context,
+	db database.Database,
+	workspaceID uint64,
+	accountID uint64,
+	role WorkspaceRole,
+) (database.Workspace, database.WorkspaceMember, error) {
+	existingWorkspace, err := w.workspaceDataAccessor.WithDatabase(db).GetWorkspaceWithXLock(ctx, workspaceID)
+	if err != nil {
+		return database.Workspace{}, database.WorkspaceMember{}, err
+	}
+
+	member, err := checkWorkspaceRole(ctx, w.workspaceMemberDataAccessor.WithDatabase(db), workspaceID, accountID, role)
+	if err != nil {
+		return database.Workspace{}, database.WorkspaceMember{}, err
+	}
+
+	return existingWorkspace, member, nil
+}
+
+// checkWorkspaceOwnerLeft fails when the member is the last owner of the workspace, and would stop being one.
+func (w workspace) checkWorkspaceOwnerLeft(
+	ctx context.Context,
+	db database.Database,
+	member database.WorkspaceMember,
+) error {
+	if WorkspaceRole(member.Role) != WorkspaceRoleOwner {
+		return nil
+	}
+
+	memberList, err := w.workspaceMemberDataAccessor.WithDatabase(db).
+		GetWorkspaceMemberListOfWorkspace(ctx, member.OfWorkspaceID)
+	if err != nil {
+		return err
+	}
+
+	for _, otherMember := range memberList {
+		if otherMember.OfAccountID != member.OfAccountID && WorkspaceRole(otherMember.Role) == WorkspaceRoleOwner {
+			return nil
+		}
+	}
+
+	return errWorkspaceLastOwner
+}
+
+func (w workspace) CreateWorkspace(ctx context.Context, params CreateWorkspaceParams) (CreateWorkspaceOutput, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return CreateWorkspaceOutput{}, err
+	}
+
+	newWorkspace := database.Workspace{
+		DisplayName: params.DisplayName,
+		CreatedAt:   time.Now(),
+	}
+	txErr := w.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		// the account is locked so that concurrent requests cannot go over the workspace count
+		if _, lockErr := w.accountDataAccessor.WithDatabase(td).GetAccountByIDWithXLock(ctx, accountID); lockErr != nil {
+			return lockErr
+		}
+
+		if checkErr := w.checkWorkspaceCountOfAccount(ctx, td, accountID); checkErr != nil {
+			return checkErr
+		}
+
+		workspaceID, createErr := w.workspaceDataAccessor.WithDatabase(td).CreateWorkspace(ctx, newWorkspace)
+		if createErr != nil {
+			return createErr
+		}
+
+		newWorkspace.ID = workspaceID
+		return w.workspaceMemberDataAccessor.WithDatabase(td).CreateWorkspaceMember(ctx, database.WorkspaceMember{
+			OfWorkspaceID: workspaceID,
+			OfAccountID:   accountID,
+			Role:          string(WorkspaceRoleOwner),
+			CreatedAt:     newWorkspace.CreatedAt,
+		})
+	})
+	if txErr != nil {
+		return CreateWorkspaceOutput{}, txErr
+	}
+
+	return CreateWorkspaceOutput{
+		Workspace: databaseWorkspaceToProtoWorkspace(newWorkspace, WorkspaceRoleOwner),
+	}, nil
+}
+
+func (w workspace) GetWorkspaceList(ctx context.Context, _ GetWorkspaceListParams) (GetWorkspaceListOutput, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return GetWorkspaceListOutput{}, err
+	}
+
+	memberList, err := w.workspaceMemberDataAccessor.GetWorkspaceMemberListOfAccount(ctx, accountID)
+	if err != nil {
+		return GetWorkspaceListOutput{}, err
+	}
+
+	workspaceList, err := w.workspaceDataAccessor.GetWorkspaceListByIDList(
+		ctx, lo.Map(memberList, func(member database.WorkspaceMember, _ int) uint64 { return member.OfWorkspaceID }))
+	if err != nil {
+		return GetWorkspaceListOutput{}, err
+	}
+
+	memberMap := lo.KeyBy(memberList, func(member database.WorkspaceMember) uint64 { return member.OfWorkspaceID })
+	return GetWorkspaceListOutput{
+		WorkspaceList: lo.Map(workspaceList, func(workspace database.Workspace, _ int) *go_load.Workspace {
+			return databaseWorkspaceToProtoWorkspace(workspace, WorkspaceRole(memberMap[workspace.ID].Role))
+		}),
+	}, nil
+}
+
+func (w workspace) DeleteWorkspace(ctx context.Context, params DeleteWorkspaceParams) error {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return w.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		if _, _, lockErr := w.lockWorkspace(ctx, td, params.WorkspaceID, accountID, WorkspaceRoleOwner); lockErr != nil {
+			return lockErr
+		}
+
+		// download tasks are created with the workspace locked, so none can show up before it is deleted
+		downloadTaskCount, countErr := w.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskCount(
+			ctx, database.DownloadTaskListFilter{OfWorkspaceID: params.WorkspaceID})
+		if countErr != nil {
+			return countErr
+		}
+
+		if downloadTaskCount > 0 {
+			return status.Error(codes.FailedPrecondition, "delete the download tasks of the workspace first")
+		}
+
+		return w.workspaceDataAccessor.WithDatabase(td).DeleteWorkspace(ctx, params.WorkspaceID)
+	})
+}
+
+func (w workspace) GetWorkspaceMemberList(
+	ctx context.Context,
+	params GetWorkspaceMemberListParams,
+) (GetWorkspaceMemberListOutput, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return GetWorkspaceMemberListOutput{}, err
+	}
+
+	_, err = checkWorkspaceRole(ctx, w.workspaceMemberDataAccessor, params.WorkspaceID, accountID, WorkspaceRoleViewer)
+	if err != nil {
+		return GetWorkspaceMemberListOutput{}, err
+	}
+
+	memberList, err := w.workspaceMemberDataAccessor.GetWorkspaceMemberListOfWorkspace(ctx, params.WorkspaceID)
+	if err != nil {
+		return GetWorkspaceMemberListOutput{}, err
+	}
+
+	accountList, err := w.accountDataAccessor.GetAccountListByIDList(
+		ctx, lo.Map(memberList, func(member database.WorkspaceMember, _ int) uint64 { return member.OfAccountID }))
+	if err != nil {
+		return GetWorkspaceMemberListOutput{}, err
+	}
+
+	accountMap := lo.KeyBy(accountList, func(account database.Account) uint64 { return account.ID })
+	return GetWorkspaceMemberListOutput{
+		WorkspaceMemberList: lo.Map(memberList, func(member database.WorkspaceMember, _ int) *go_load.WorkspaceMember {
+			return databaseWorkspaceMemberToProtoWorkspaceMember(member, accountMap[member.OfAccountID])
+		}),
+	}, nil
+}
+
+func (w workspace) AddWorkspaceMember(
+	ctx context.Context,
+	params AddWorkspaceMemberParams,
+) (AddWorkspaceMemberOutput, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return AddWorkspaceMemberOutput{}, err
+	}
+
+	role, ok := protoWorkspaceRoleMap[params.Role]
+	if !ok {
+		return AddWorkspaceMemberOutput{}, status.Error(codes.InvalidArgument, "invalid workspace role")
+	}
+
+	output := AddWorkspaceMemberOutput{}
+	txErr := w.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		if _, _, lockErr := w.lockWorkspace(ctx, td, params.WorkspaceID, accountID, WorkspaceRoleOwner); lockErr != nil {
+			return lockErr
+		}
+
+		memberAccount, getAccountErr := w.accountDataAccessor.WithDatabase(td).
+			GetAccountByAccountName(ctx, params.AccountName)
+		if getAccountErr != nil {
+			return getAccountErr
+		}
+
+		_, getMemberErr := w.workspaceMemberDataAccessor.WithDatabase(td).
+			GetWorkspaceMember(ctx, params.WorkspaceID, memberAccount.ID)
+		if getMemberErr == nil {
+			return status.Error(codes.AlreadyExists, "account is already a member of the workspace")
+		}
+
+		if !errors.Is(getMemberErr, database.ErrWorkspaceMemberNotFound) {
+			return getMemberErr
+		}
+
+		if checkErr := w.checkWorkspaceCountOfAccount(ctx, td, memberAccount.ID); checkErr != nil {
+			return checkErr
+		}
+
+		member := database.WorkspaceMember{
+			OfWorkspaceID: params.WorkspaceID,
+			OfAccountID:   memberAccount.ID,
+			Role:          string(role),
+			CreatedAt:     time.Now(),
+		}
+		if createErr := w.workspaceMemberDataAccessor.WithDatabase(td).CreateWorkspaceMember(ctx, member); createErr != nil {
+			return createErr
+		}
+
+		output.WorkspaceMember = databaseWorkspaceMemberToProtoWorkspaceMember(member, memberAccount)
+		return nil
+	})
+	if txErr != nil {
+		return AddWorkspaceMemberOutput{}, txErr
+	}
+
+	return output, nil
+}
+
+func (w workspace) UpdateWorkspaceMember(
+	ctx context.Context,
+	params UpdateWorkspaceMemberParams,
+) (UpdateWorkspaceMemberOutput, error) {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return UpdateWorkspaceMemberOutput{}, err
+	}
+
+	role, ok := protoWorkspaceRoleMap[params.Role]
+	if !ok {
+		return UpdateWorkspaceMemberOutput{}, status.Error(codes.InvalidArgument, "invalid workspace role")
+	}
+
+	output := UpdateWorkspaceMemberOutput{}
+	txErr := w.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		if _, _, lockErr := w.lockWorkspace(ctx, td, params.WorkspaceID, accountID, WorkspaceRoleOwner); lockErr != nil {
+			return lockErr
+		}
+
+		member, getMemberErr := w.workspaceMemberDataAccessor.WithDatabase(td).
+			GetWorkspaceMember(ctx, params.WorkspaceID, params.AccountID)
+		if getMemberErr != nil {
+			return getMemberErr
+		}
+
+		if role != WorkspaceRoleOwner {
+			if checkErr := w.checkWorkspaceOwnerLeft(ctx, td, member); checkErr != nil {
+				return checkErr
+			}
+		}
+
+		updateErr := w.workspaceMemberDataAccessor.WithDatabase(td).
+			UpdateWorkspaceMemberRole(ctx, params.WorkspaceID, params.AccountID, string(role))
+		if updateErr != nil {
+			return updateErr
+		}
+
+		memberAccount, getAccountErr := w.accountDataAccessor.WithDatabase(td).GetAccountByID(ctx, params.AccountID)
+		if getAccountErr != nil {
+			return getAccountErr
+		}
+
+		member.Role = string(role)
+		output.WorkspaceMember = databaseWorkspaceMemberToProtoWorkspaceMember(member, memberAccount)
+		return nil
+	})
+	if txErr != nil {
+		return UpdateWorkspaceMemberOutput{}, txErr
+	}
+
+	return output, nil
+}
+
+func (w workspace) RemoveWorkspaceMember(ctx context.Context, params RemoveWorkspaceMemberParams) error {
+	accountID, err := getPrincipalAccountID(ctx)
+	if err != nil {
+		return err
+	}
+
+	requiredRole := WorkspaceRoleOwner
+	if params.AccountID == accountID {
+		requiredRole = WorkspaceRoleViewer
+	}
+
+	return w.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		if _, _, lockErr := w.lockWorkspace(ctx, td, params.WorkspaceID, accountID, requiredRole); lockErr != nil {
+			return lockErr
+		}
+
+		member, getMemberErr := w.workspaceMemberDataAccessor.WithDatabase(td).
+			GetWorkspaceMember(ctx, params.WorkspaceID, params.AccountID)
+		if getMemberErr != nil {
+			return getMemberErr
+		}
+
+		if checkErr := w.checkWorkspaceOwnerLeft(ctx, td, member); checkErr != nil {
+			return checkErr
+		}
+
+		deleteErr := w.workspaceMemberDataAccessor.WithDatabase(td).
+			DeleteWorkspaceMember(ctx, params.WorkspaceID, params.AccountID)
+		if deleteErr != nil {
+			return deleteErr
+		}
+
+		// the download tasks stay in the workspace, but the member no longer gets to share them
+		return w.shareLinkDataAccessor.WithDatabase(td).UpdateShareLinkListRevokedAtOfAccountInWorkspace(
+			ctx, params.AccountID, params.WorkspaceID, time.Now())
+	})
+}
+
+func (w workspace) UpdateWorkspaceQuota(
+	ctx context.Context,
+	params UpdateWorkspaceQuotaParams,
+) (UpdateWorkspaceQuotaOutput, error) {
+	logger := utils.LoggerWithContext(ctx, w.logger).With(zap.Uint64("workspace_id", params.WorkspaceID))
+
+	if _, err := getPrincipalWithRole(ctx, RoleAdmin); err != nil {
+		return UpdateWorkspaceQuotaOutput{}, err
+	}
+
+	quota := params.Quota
+	if quota == nil {
+		quota = &go_load.AccountQuota{}
+	}
+
+	var existingWorkspace database.Workspace
+	txErr := w.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		var err error
+		existingWorkspace, err = w.workspaceDataAccessor.WithDatabase(td).GetWorkspaceWithXLock(ctx, params.WorkspaceID)
+		if err != nil {
+			return err
+		}
+
+		existingWorkspace.QuotaMaxDownloadTaskCount = protoUint64ToNullInt64(quota.MaxDownloadTaskCount)
+		existingWorkspace.QuotaMaxActiveDownloadTaskCount = protoUint64ToNullInt64(quota.MaxActiveDownloadTaskCount)
+		return w.workspaceDataAccessor.WithDatabase(td).UpdateWorkspaceQuota(ctx, existingWorkspace)
+	})
+	if txErr != nil {
+		return UpdateWorkspaceQuotaOutput{}, txErr
+	}
+
+	logger.Info("updated workspace quota")
+	effectiveQuota := getWorkspaceQuota(existingWorkspace, w.quotaConfig)
+	return UpdateWorkspaceQuotaOutput{
+		Workspace: &go_load.AdminWorkspace{
+			Workspace: databaseWorkspaceToProtoWorkspace(existingWorkspace, ""),
+			Quota: &go_load.AccountQuota{
+				MaxDownloadTaskCount:       nullInt64ToProtoUint64(existingWorkspace.QuotaMaxDownloadTaskCount),
+				MaxActiveDownloadTaskCount: nullInt64ToProtoUint64(existingWorkspace.QuotaMaxActiveDownloadTaskCount),
+			},
+			EffectiveQuota: &go_load.AccountQuota{
+				MaxDownloadTaskCount:       proto.Uint64(effectiveQuota.MaxDownloadTaskCount),
+				MaxActiveDownloadTaskCount: proto.Uint64(effectiveQuota.MaxActiveDownloadTaskCount),
+			},
+		},
+	}, nil
+}
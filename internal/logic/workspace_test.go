@@ -0,0 +1,204 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"goload/internal/dataaccess/database"
+	go_load "goload/internal/generated/downloadClient/v1"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCheckDownloadTaskRole(t *testing.T) {
+	store := newMockStore()
+	store.memberList = []database.WorkspaceMember{
+		{OfWorkspaceID: 1, OfAccountID: 1, Role: string(WorkspaceRoleOwner)},
+		{OfWorkspaceID: 1, OfAccountID: 2, Role: string(WorkspaceRoleEditor)},
+		{OfWorkspaceID: 1, OfAccountID: 3, Role: string(WorkspaceRoleViewer)},
+	}
+	personalTask := database.DownloadTask{ID: 1, OfAccountID: 2}
+	workspaceTask := database.DownloadTask{ID: 2, OfAccountID: 1, OfWorkspaceID: sql.NullInt64{Int64: 1, Valid: true}}
+
+	testCaseList := []struct {
+		name            string
+		task            database.DownloadTask
+		accountID       uint64
+		role            WorkspaceRole
+		expectedErrCode codes.Code
+	}{
+		{name: "own personal task", task: personalTask, accountID: 2, role: WorkspaceRoleOwner},
+		{
+			name: "personal task of another account", task: personalTask, accountID: 1, role: WorkspaceRoleViewer,
+			expectedErrCode: codes.PermissionDenied,
+		},
+		{name: "viewer reads", task: workspaceTask, accountID: 3, role: WorkspaceRoleViewer},
+		{
+			name: "viewer cannot edit", task: workspaceTask, accountID: 3, role: WorkspaceRoleEditor,
+			expectedErrCode: codes.PermissionDenied,
+		},
+		{name: "editor edits", task: workspaceTask, accountID: 2, role: WorkspaceRoleEditor},
+		{
+			name: "editor cannot manage", task: workspaceTask, accountID: 2, role: WorkspaceRoleOwner,
+			expectedErrCode: codes.PermissionDenied,
+		},
+		{name: "owner manages", task: workspaceTask, accountID: 1, role: WorkspaceRoleOwner},
+		{
+			name: "non-member cannot read", task: workspaceTask, accountID: 4, role: WorkspaceRoleViewer,
+			expectedErrCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := checkDownloadTaskRole(context.Background(), mockWorkspaceMemberDataAccessor{store: store},
+				testCase.task, testCase.accountID, testCase.role)
+			requireStatusCode(t, err, testCase.expectedErrCode)
+		})
+	}
+}
+
+func TestUpdateWorkspaceMembership(t *testing.T) {
+	updateRole := func(accountID uint64, role go_load.WorkspaceRole) func(w workspace, ctx context.Context) error {
+		return func(w workspace, ctx context.Context) error {
+			_, err := w.UpdateWorkspaceMember(ctx, UpdateWorkspaceMemberParams{
+				WorkspaceID: 1,
+				AccountID:   accountID,
+				Role:        role,
+			})
+			return err
+		}
+	}
+	remove := func(accountID uint64) func(w workspace, ctx context.Context) error {
+		return func(w workspace, ctx context.Context) error {
+			return w.RemoveWorkspaceMember(ctx, RemoveWorkspaceMemberParams{WorkspaceID: 1, AccountID: accountID})
+		}
+	}
+
+	testCaseList := []struct {
+		name            string
+		callerID        uint64
+		secondOwner     bool
+		update          func(w workspace, ctx context.Context) error
+		expectedErrCode codes.Code
+		// expectedRoleMap is the role of each member afterwards, an absent member was removed
+		expectedRoleMap map[uint64]WorkspaceRole
+		// expectedRevokedLink is set when the share link of the editor was revoked
+		expectedRevokedLink bool
+	}{
+		{
+			name: "owner changes a role", callerID: 1,
+			update: updateRole(2, go_load.WorkspaceRole_WORKSPACE_ROLE_VIEWER),
+			expectedRoleMap: map[uint64]WorkspaceRole{
+				1: WorkspaceRoleOwner, 2: WorkspaceRoleViewer, 3: WorkspaceRoleViewer,
+			},
+		},
+		{
+			name: "editor cannot change a role", callerID: 2,
+			update:          updateRole(3, go_load.WorkspaceRole_WORKSPACE_ROLE_EDITOR),
+			expectedErrCode: codes.PermissionDenied,
+		},
+		{
+			name: "last owner cannot step down", callerID: 1,
+			update:          updateRole(1, go_load.WorkspaceRole_WORKSPACE_ROLE_EDITOR),
+			expectedErrCode: codes.FailedPrecondition,
+		},
+		{
+			name: "owner steps down next to another owner", callerID: 1, secondOwner: true,
+			update: updateRole(1, go_load.WorkspaceRole_WORKSPACE_ROLE_EDITOR),
+			expectedRoleMap: map[uint64]WorkspaceRole{
+				1: WorkspaceRoleEditor, 2: WorkspaceRoleEditor, 3: WorkspaceRoleViewer, 4: WorkspaceRoleOwner,
+			},
+		},
+		{
+			name: "owner removes a member", callerID: 1, update: remove(2),
+			expectedRoleMap:     map[uint64]WorkspaceRole{1: WorkspaceRoleOwner, 3: WorkspaceRoleViewer},
+			expectedRevokedLink: true,
+		},
+		{
+			name: "editor leaves", callerID: 2, update: remove(2),
+			expectedRoleMap:     map[uint64]WorkspaceRole{1: WorkspaceRoleOwner, 3: WorkspaceRoleViewer},
+			expectedRevokedLink: true,
+		},
+		{
+			name: "viewer cannot remove another member", callerID: 3, update: remove(2),
+			expectedErrCode: codes.PermissionDenied,
+		},
+		{name: "last owner cannot leave", callerID: 1, update: remove(1), expectedErrCode: codes.FailedPrecondition},
+		{name: "non-member cannot leave", callerID: 5, update: remove(5), expectedErrCode: codes.PermissionDenied},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			store := newMockStore()
+			store.accountList = []database.Account{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}, {ID: 5}}
+			store.workspaceList = []database.Workspace{{ID: 1, DisplayName: "team"}}
+			store.memberList = []database.WorkspaceMember{
+				{OfWorkspaceID: 1, OfAccountID: 1, Role: string(WorkspaceRoleOwner)},
+				{OfWorkspaceID: 1, OfAccountID: 2, Role: string(WorkspaceRoleEditor)},
+				{OfWorkspaceID: 1, OfAccountID: 3, Role: string(WorkspaceRoleViewer)},
+			}
+			if testCase.secondOwner {
+				store.memberList = append(store.memberList,
+					database.WorkspaceMember{OfWorkspaceID: 1, OfAccountID: 4, Role: string(WorkspaceRoleOwner)})
+			}
+
+			// the editor shared a download task of the workspace
+			store.downloadTaskList = []database.DownloadTask{
+				{ID: 1, OfAccountID: 2, OfWorkspaceID: sql.NullInt64{Int64: 1, Valid: true}},
+			}
+			store.shareLinkList = []database.ShareLink{
+				{ID: 1, OfAccountID: 2, OfDownloadTaskID: 1, ExpiresAt: time.Now().Add(time.Hour)},
+			}
+
+			w := workspace{
+				goquDatabase:                newMockGoquDatabase(),
+				accountDataAccessor:         mockAccountDataAccessor{store: store},
+				workspaceDataAccessor:       mockWorkspaceDataAccessor{store: store},
+				workspaceMemberDataAccessor: mockWorkspaceMemberDataAccessor{store: store},
+				shareLinkDataAccessor:       mockShareLinkDataAccessor{store: store},
+				logger:                      zap.NewNop(),
+			}
+			ctx := WithPrincipal(context.Background(), Principal{
+				AccountID: testCase.callerID,
+				SessionID: 1,
+				RoleList:  []Role{RoleUser},
+			})
+
+			roleMapBefore := getTestWorkspaceRoleMap(store)
+			err := testCase.update(w, ctx)
+			requireStatusCode(t, err, testCase.expectedErrCode)
+
+			expectedRoleMap := testCase.expectedRoleMap
+			if err != nil {
+				expectedRoleMap = roleMapBefore
+			}
+
+			roleMap := getTestWorkspaceRoleMap(store)
+			if len(roleMap) != len(expectedRoleMap) {
+				t.Fatalf("expected members %v, got %v", expectedRoleMap, roleMap)
+			}
+
+			for accountID, expectedRole := range expectedRoleMap {
+				if roleMap[accountID] != expectedRole {
+					t.Fatalf("expected members %v, got %v", expectedRoleMap, roleMap)
+				}
+			}
+
+			if store.shareLinkList[0].RevokedAt.Valid != testCase.expectedRevokedLink {
+				t.Fatalf("expected share link revoked %t", testCase.expectedRevokedLink)
+			}
+		})
+	}
+}
+
+func getTestWorkspaceRoleMap(store *mockStore) map[uint64]WorkspaceRole {
+	roleMap := make(map[uint64]WorkspaceRole)
+	for _, member := range store.memberList {
+		roleMap[member.OfAccountID] = WorkspaceRole(member.Role)
+	}
+
+	return roleMap
+}
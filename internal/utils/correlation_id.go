@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+const (
+	CorrelationIDMetadataKey = "x-correlation-id"
+)
+
+type correlationIDContextKey struct{}
+
+func NewID() string {
+	idBytes := make([]byte, 16)
+	// crypto/rand.Read never returns an error on supported platforms
+	_, _ = rand.Read(idBytes)
+	return hex.EncodeToString(idBytes)
+}
+
+func WithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, correlationIDContextKey{}, correlationID)
+}
+
+func GetCorrelationID(ctx context.Context) string {
+	correlationID, _ := ctx.Value(correlationIDContextKey{}).(string)
+	return correlationID
+}
@@ -41,5 +41,9 @@ func InitializeLogger(logConfigs configs.Log) (*zap.Logger, func(), error) {
 }
 
 func LoggerWithContext(ctx context.Context, logger *zap.Logger) *zap.Logger {
+	if correlationID := GetCorrelationID(ctx); correlationID != "" {
+		return logger.With(zap.String("correlation_id", correlationID))
+	}
+
 	return logger
 }
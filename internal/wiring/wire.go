@@ -28,3 +28,8 @@ func InitializeGRPCServer(configFilePath configs.ConfigFilePath) (*app.Server, f
 	wire.Build(WireSet)
 	return nil, nil, nil
 }
+
+func InitializeDeadLetterLogic(configFilePath configs.ConfigFilePath) (logic.DeadLetter, func(), error) {
+	wire.Build(WireSet)
+	return nil, nil, nil
+}
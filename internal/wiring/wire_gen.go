@@ -20,6 +20,7 @@ import (
 	"goload/internal/handler/consumers"
 	"goload/internal/handler/grpc"
 	"goload/internal/handler/http"
+	"goload/internal/handler/jobs"
 	"goload/internal/logic"
 	"goload/internal/utils"
 )
@@ -48,49 +49,178 @@ func InitializeGRPCServer(configFilePath configs.ConfigFilePath) (*app.Server, f
 	takenAccountName := cache.NewTakenAccountName(client, logger)
 	accountDataAccessor := database.NewAccountDataAccessor(goquDatabase, logger)
 	accountPasswordDataAccessor := database.NewAccountPasswordDataAccessor(goquDatabase, logger)
+	failedLoginAttempt := cache.NewFailedLoginAttempt(client, logger)
+	sessionDataAccessor := database.NewSessionDataAccessor(goquDatabase, logger)
+	downloadTaskDataAccessor := database.NewDownloadTaskDataAccessor(goquDatabase, logger)
+	webhookDataAccessor := database.NewWebhookDataAccessor(goquDatabase, logger)
+	webhookDeliveryDataAccessor := database.NewWebhookDeliveryDataAccessor(goquDatabase, logger)
+	apiKeyDataAccessor := database.NewAPIKeyDataAccessor(goquDatabase, logger)
+	accountOIDCIdentityDataAccessor := database.NewAccountOIDCIdentityDataAccessor(goquDatabase, logger)
+	accountTOTPDataAccessor := database.NewAccountTOTPDataAccessor(goquDatabase, logger)
+	accountRecoveryCodeDataAccessor := database.NewAccountRecoveryCodeDataAccessor(goquDatabase, logger)
+	workspaceMemberDataAccessor := database.NewWorkspaceMemberDataAccessor(goquDatabase, logger)
+	shareLinkDataAccessor := database.NewShareLinkDataAccessor(goquDatabase, logger)
+	downloadTaskTagDataAccessor := database.NewDownloadTaskTagDataAccessor(goquDatabase, logger)
+	downloadTaskLabelDataAccessor := database.NewDownloadTaskLabelDataAccessor(goquDatabase, logger)
+	outboxMessageDataAccessor := database.NewOutboxMessageDataAccessor(goquDatabase, logger)
+	downloadTaskLifecycleEventProducer := logic.NewDownloadTaskLifecycleEventProducer(accountDataAccessor, downloadTaskTagDataAccessor, downloadTaskLabelDataAccessor, outboxMessageDataAccessor, logger)
+	downloadConfig := config.Download
+	fileClient := file.NewLocalFileClient(logger, downloadConfig)
 	auth := config.Auth
 	hash := logic.NewHash(auth)
 	tokenPublicKey := cache.NewTokenPublicKey(client, logger)
 	tokenPublicKeyDataAccessor := database.NewTokenPublicKeyDataAccessor(goquDatabase, logger)
-	token, err := logic.NewToken(accountDataAccessor, tokenPublicKey, tokenPublicKeyDataAccessor, auth, logger)
+	revokedSession := cache.NewRevokedSession(client, logger)
+	token, err := logic.NewToken(accountDataAccessor, tokenPublicKey, tokenPublicKeyDataAccessor, revokedSession, sessionDataAccessor, goquDatabase, auth, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	account := logic.NewAccount(goquDatabase, takenAccountName, accountDataAccessor, accountPasswordDataAccessor, hash, token, logger)
-	downloadTaskDataAccessor := database.NewDownloadTaskDataAccessor(goquDatabase, logger)
-	mq := config.MQ
-	producerClient, err := producer.NewClient(mq, logger)
+	account, err := logic.NewAccount(goquDatabase, takenAccountName, accountDataAccessor, accountPasswordDataAccessor, failedLoginAttempt, sessionDataAccessor, downloadTaskDataAccessor, webhookDataAccessor, webhookDeliveryDataAccessor, apiKeyDataAccessor, accountOIDCIdentityDataAccessor, accountTOTPDataAccessor, accountRecoveryCodeDataAccessor, workspaceMemberDataAccessor, shareLinkDataAccessor, downloadTaskLifecycleEventProducer, fileClient, hash, token, auth, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	downloadTaskCreatedProducer := producer.NewDownloadTaskCreatedProducer(producerClient, logger)
-	downloadConfig := config.Download
-	fileClient := file.NewLocalFileClient(logger, downloadConfig)
-	downloadTask := logic.NewDownloadTask(token, accountDataAccessor, downloadTaskDataAccessor, downloadTaskCreatedProducer, goquDatabase, fileClient, logger)
-	goLoadServiceServer := grpc.NewHandler(account, downloadTask)
+	downloadTaskEventDataAccessor := database.NewDownloadTaskEventDataAccessor(goquDatabase, logger)
+	workspaceDataAccessor := database.NewWorkspaceDataAccessor(goquDatabase, logger)
+	quota := config.Quota
+	downloadTask, err := logic.NewDownloadTask(accountDataAccessor, downloadTaskDataAccessor, downloadTaskEventDataAccessor, downloadTaskTagDataAccessor, downloadTaskLabelDataAccessor, workspaceDataAccessor, workspaceMemberDataAccessor, outboxMessageDataAccessor, downloadTaskLifecycleEventProducer, goquDatabase, fileClient, quota, downloadConfig, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	webhook := config.Webhook
+	logicWebhook, err := logic.NewWebhook(webhookDataAccessor, webhookDeliveryDataAccessor, downloadTaskDataAccessor, goquDatabase, webhook, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	apiKey := logic.NewAPIKey(apiKeyDataAccessor, accountDataAccessor, hash, auth, logger)
+	shareLink := config.ShareLink
+	logicShareLink, err := logic.NewShareLink(shareLinkDataAccessor, downloadTaskDataAccessor, workspaceMemberDataAccessor, fileClient, hash, failedLoginAttempt, auth, shareLink, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	workspace := logic.NewWorkspace(goquDatabase, accountDataAccessor, workspaceDataAccessor, workspaceMemberDataAccessor, downloadTaskDataAccessor, shareLinkDataAccessor, quota, logger)
+	goLoadServiceServer := grpc.NewHandler(account, downloadTask, logicWebhook, apiKey, logicShareLink, workspace)
+	admin := logic.NewAdmin(goquDatabase, accountDataAccessor, sessionDataAccessor, token, quota, logger)
+	goLoadAdminServiceServer := grpc.NewAdminHandler(admin, downloadTask, workspace)
 	configsGRPC := config.GRPC
-	server := grpc.NewServer(goLoadServiceServer, configsGRPC, logger)
+	server := grpc.NewServer(goLoadServiceServer, goLoadAdminServiceServer, token, apiKey, configsGRPC, logger)
 	configsHTTP := config.HTTP
-	httpServer := http.NewServer(configsGRPC, configsHTTP, auth, logger)
-	downloadTaskCreated := consumers.NewDownloadTaskCreated(logger)
-	consumerConsumer, err := consumer.NewConsumer(mq, logger)
+	oidcLoginState := cache.NewOIDCLoginState(client, logger)
+	oidc, err := logic.NewOIDC(goquDatabase, oidcLoginState, accountDataAccessor, accountOIDCIdentityDataAccessor, sessionDataAccessor, token, auth, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	root := consumers.NewRoot(downloadTaskCreated, consumerConsumer, logger)
-	appServer := app.NewServer(server, httpServer, root, logger)
+	httpServer := http.NewServer(configsGRPC, configsHTTP, auth, token, oidc, logicShareLink, logger)
+	downloadTaskCreated := consumers.NewDownloadTaskCreated(downloadTask, logger)
+	downloadTaskWebhook := consumers.NewDownloadTaskWebhook(logicWebhook, logger)
+	deadLetterMessageDataAccessor := database.NewDeadLetterMessageDataAccessor(goquDatabase, logger)
+	deadLetter := logic.NewDeadLetter(deadLetterMessageDataAccessor, outboxMessageDataAccessor, goquDatabase, logger)
+	consumersDeadLetter := consumers.NewDeadLetter(deadLetter, logger)
+	mq := config.MQ
+	inMemoryBroker := producer.NewInMemoryBroker()
+	producerClient, err := producer.NewClient(mq, inMemoryBroker, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	consumerConsumer, err := consumer.NewConsumer(mq, producerClient, inMemoryBroker, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	root := consumers.NewRoot(downloadTaskCreated, downloadTaskWebhook, consumersDeadLetter, consumerConsumer, logger)
+	retentionRunDataAccessor := database.NewRetentionRunDataAccessor(goquDatabase, logger)
+	retention := config.Retention
+	logicRetention, err := logic.NewRetention(accountDataAccessor, downloadTaskDataAccessor, retentionRunDataAccessor, downloadTaskLifecycleEventProducer, goquDatabase, fileClient, retention, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	jobsRetention, err := jobs.NewRetention(logicRetention, retention, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	outbox := config.Outbox
+	logicOutbox, err := logic.NewOutbox(outboxMessageDataAccessor, producerClient, goquDatabase, outbox, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	outboxRelay, err := jobs.NewOutboxRelay(logicOutbox, outbox, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	webhookDelivery, err := jobs.NewWebhookDelivery(logicWebhook, webhook, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	tokenSigningKeyRotation, err := jobs.NewTokenSigningKeyRotation(token, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	staleDownloadTask, err := jobs.NewStaleDownloadTask(downloadTask, downloadConfig, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	jobsRoot := jobs.NewRoot(jobsRetention, outboxRelay, webhookDelivery, tokenSigningKeyRotation, staleDownloadTask, logger)
+	appServer := app.NewServer(server, httpServer, root, jobsRoot, logger)
 	return appServer, func() {
 		cleanup2()
 		cleanup()
 	}, nil
 }
 
+func InitializeDeadLetterLogic(configFilePath configs.ConfigFilePath) (logic.DeadLetter, func(), error) {
+	config, err := configs.NewConfig(configFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	configsDatabase := config.Database
+	log := config.Log
+	logger, cleanup, err := utils.InitializeLogger(log)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup2, err := database.InitializeAndMigrateUpDB(configsDatabase, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	goquDatabase := database.InitializeGoquDB(db)
+	deadLetterMessageDataAccessor := database.NewDeadLetterMessageDataAccessor(goquDatabase, logger)
+	outboxMessageDataAccessor := database.NewOutboxMessageDataAccessor(goquDatabase, logger)
+	deadLetter := logic.NewDeadLetter(deadLetterMessageDataAccessor, outboxMessageDataAccessor, goquDatabase, logger)
+	return deadLetter, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
 // wire.go:
 
 var WireSet = wire.NewSet(configs.WireSet, utils.WireSet, dataaccess.WireSet, logic.WireSet, handler.WireSet, app.WireSet)